@@ -0,0 +1,178 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/micasa-dev/micasa/internal/claudecli"
+	"github.com/micasa-dev/micasa/internal/config"
+	"github.com/micasa-dev/micasa/internal/extract"
+	"github.com/micasa-dev/micasa/internal/llm"
+)
+
+// toolCheck is one row of `micasa --check-tools`. Required tools are the
+// ones an enabled feature needs; the rest are reported but never fail the
+// check.
+type toolCheck struct {
+	Name     string `json:"name"`
+	Purpose  string `json:"purpose"`
+	Required bool   `json:"required"`
+	OK       bool   `json:"ok"`
+	// Detail is the resolved path or endpoint when OK, otherwise why not.
+	Detail string `json:"detail"`
+}
+
+// llmTarget is the connection a configured LLM pipeline would use.
+type llmTarget struct {
+	provider string
+	baseURL  string
+	model    string
+	apiKey   string
+	timeout  time.Duration
+}
+
+// String describes the target for the check's detail column.
+func (t llmTarget) String() string {
+	if t.baseURL == "" || t.provider == "claude-cli" {
+		return t.provider + " " + t.model
+	}
+	return t.provider + " " + t.model + " at " + t.baseURL
+}
+
+// runCheckTools writes the report behind `micasa --check-tools`, as plain
+// text or JSON, and returns an error naming any required tool that is
+// missing so setup scripts can rely on the exit code.
+func runCheckTools(w io.Writer, asJSON bool) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+	checks := toolChecks(cfg, extract.ResolveOCRTools(), pingLLM)
+	if asJSON {
+		err = writeToolChecksJSON(w, checks)
+	} else {
+		err = writeTable(w, "TOOLS", checks, toolCheckCols)
+	}
+	if err != nil {
+		return err
+	}
+	return missingToolsError(checks)
+}
+
+// toolChecks checks the extraction binaries in tools and pings each LLM
+// pipeline. OCR tools are required when OCR is enabled, and each LLM when
+// its pipeline is.
+func toolChecks(cfg config.Config, tools *extract.OCRTools, ping func(llmTarget) error) []toolCheck {
+	ocr := cfg.Extraction.OCR.IsEnabled()
+	binary := func(name, purpose, path string, required bool) toolCheck {
+		c := toolCheck{Name: name, Purpose: purpose, Required: required, OK: path != ""}
+		c.Detail = path
+		if path == "" {
+			c.Detail = "not found on PATH"
+		}
+		return c
+	}
+	model := func(name, purpose string, t llmTarget, required bool) toolCheck {
+		c := toolCheck{Name: name, Purpose: purpose, Required: required, OK: true, Detail: t.String()}
+		if err := ping(t); err != nil {
+			c.OK = false
+			c.Detail = err.Error()
+		}
+		return c
+	}
+	exLLM := cfg.Extraction.LLM
+	chatLLM := cfg.Chat.LLM
+	return []toolCheck{
+		binary("pdftotext", "text from digital PDFs", tools.PDFToText, true),
+		binary("tesseract", "OCR of scans and images", tools.Tesseract, ocr),
+		binary("pdftocairo", "PDF page rendering for OCR", tools.PDFToCairo, ocr),
+		binary("pdfinfo", "PDF page counts for OCR", tools.PDFInfo, ocr),
+		model("extraction LLM", "structured document extraction", llmTarget{
+			provider: exLLM.Provider,
+			baseURL:  exLLM.BaseURL,
+			model:    exLLM.Model,
+			apiKey:   exLLM.APIKey,
+			timeout:  exLLM.TimeoutDuration(),
+		}, exLLM.IsEnabled()),
+		model("chat LLM", "questions in the chat overlay", llmTarget{
+			provider: chatLLM.Provider,
+			baseURL:  chatLLM.BaseURL,
+			model:    chatLLM.Model,
+			apiKey:   chatLLM.APIKey,
+			timeout:  chatLLM.TimeoutDuration(),
+		}, cfg.Chat.IsEnabled()),
+	}
+}
+
+// pingLLM reports whether t's server is reachable and serves its model.
+// The claude CLI has no server to ask, so finding the binary is enough.
+func pingLLM(t llmTarget) error {
+	if t.model == "" {
+		return errors.New("no model configured")
+	}
+	if t.provider == "claude-cli" {
+		_, err := claudecli.NewClient(t.model, t.timeout)
+		return err
+	}
+	client, err := llm.NewClient(t.provider, t.baseURL, t.model, t.apiKey, t.timeout)
+	if err != nil {
+		return err
+	}
+	return client.Ping(context.Background())
+}
+
+// missingToolsError names the required checks that failed, or returns nil.
+func missingToolsError(checks []toolCheck) error {
+	var missing []string
+	for _, c := range checks {
+		if c.Required && !c.OK {
+			missing = append(missing, c.Name)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return fmt.Errorf("missing required tools: %s", strings.Join(missing, ", "))
+}
+
+var toolCheckCols = []showCol[toolCheck]{
+	{header: "TOOL", value: func(c toolCheck) string { return c.Name }},
+	{header: "STATUS", value: func(c toolCheck) string {
+		switch {
+		case c.OK:
+			return "ok"
+		case c.Required:
+			return "missing"
+		default:
+			return "unavailable"
+		}
+	}},
+	{header: "REQUIRED", value: func(c toolCheck) string {
+		if c.Required {
+			return "yes"
+		}
+		return "no"
+	}},
+	{header: "USED FOR", value: func(c toolCheck) string { return c.Purpose }},
+	{header: "DETAIL", value: func(c toolCheck) string { return c.Detail }},
+}
+
+func writeToolChecksJSON(w io.Writer, checks []toolCheck) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(map[string]any{
+		"ok":    missingToolsError(checks) == nil,
+		"tools": checks,
+	}); err != nil {
+		return fmt.Errorf("encode JSON: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/micasa-dev/micasa/internal/config"
+	"github.com/micasa-dev/micasa/internal/extract"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func checksByName(checks []toolCheck) map[string]toolCheck {
+	out := make(map[string]toolCheck, len(checks))
+	for _, c := range checks {
+		out[c.Name] = c
+	}
+	return out
+}
+
+func TestToolChecks(t *testing.T) {
+	t.Parallel()
+	tools := &extract.OCRTools{PDFToText: "/bin/pdftotext", PDFInfo: "/bin/pdfinfo"}
+	ping := func(tgt llmTarget) error {
+		if tgt.model == "down" {
+			return errors.New("connection refused")
+		}
+		return nil
+	}
+	cfg := config.Config{}
+	cfg.Extraction.LLM.Provider = "ollama"
+	cfg.Extraction.LLM.BaseURL = "http://localhost:11434"
+	cfg.Extraction.LLM.Model = "qwen3"
+	cfg.Chat.LLM.Model = "down"
+
+	checks := checksByName(toolChecks(cfg, tools, ping))
+	assert.True(t, checks["pdftotext"].OK)
+	assert.Equal(t, "/bin/pdftotext", checks["pdftotext"].Detail)
+	assert.False(t, checks["tesseract"].OK)
+	assert.True(t, checks["tesseract"].Required, "OCR is on by default")
+	assert.True(t, checks["extraction LLM"].OK)
+	assert.Equal(t, "ollama qwen3 at http://localhost:11434", checks["extraction LLM"].Detail)
+	assert.False(t, checks["chat LLM"].OK)
+	assert.Equal(t, "connection refused", checks["chat LLM"].Detail)
+
+	off := false
+	cfg.Extraction.OCR.Enable = &off
+	cfg.Chat.Enable = &off
+	checks = checksByName(toolChecks(cfg, tools, ping))
+	assert.False(t, checks["tesseract"].Required)
+	assert.False(t, checks["chat LLM"].Required)
+	assert.NoError(t, missingToolsError(toolChecks(cfg, tools, ping)))
+}
+
+func TestMissingToolsError(t *testing.T) {
+	t.Parallel()
+	checks := []toolCheck{
+		{Name: "pdftotext", Required: true, OK: true},
+		{Name: "tesseract", Required: true},
+		{Name: "pdfinfo"},
+		{Name: "chat LLM", Required: true},
+	}
+	err := missingToolsError(checks)
+	require.Error(t, err)
+	assert.Equal(t, "missing required tools: tesseract, chat LLM", err.Error())
+	assert.NoError(t, missingToolsError(checks[:1]))
+}
+
+func TestWriteToolChecks(t *testing.T) {
+	t.Parallel()
+	checks := []toolCheck{
+		{Name: "pdftotext", Purpose: "text from digital PDFs", Required: true, OK: true, Detail: "/bin/pdftotext"},
+		{Name: "tesseract", Purpose: "OCR of scans and images", Detail: "not found on PATH"},
+	}
+
+	var text bytes.Buffer
+	require.NoError(t, writeTable(&text, "TOOLS", checks, toolCheckCols))
+	assert.Contains(t, text.String(), "=== TOOLS ===")
+	assert.Regexp(t, `pdftotext\s+ok\s+yes`, text.String())
+	assert.Regexp(t, `tesseract\s+unavailable\s+no`, text.String())
+
+	var buf bytes.Buffer
+	require.NoError(t, writeToolChecksJSON(&buf, checks))
+	var report struct {
+		OK    bool        `json:"ok"`
+		Tools []toolCheck `json:"tools"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &report))
+	assert.True(t, report.OK)
+	assert.Equal(t, checks, report.Tools)
+}
+
+func TestCheckToolsRejectsWithin(t *testing.T) {
+	t.Parallel()
+	_, err := executeCLI("--check-tools", "--within", "30d")
+	require.ErrorContains(t, err, "--within requires --due")
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/spf13/cobra"
+)
+
+const (
+	checklistFormatMarkdown = "markdown"
+	checklistFormatText     = "text"
+)
+
+func newChecklistCmd() *cobra.Command {
+	var format string
+
+	cmd := &cobra.Command{
+		Use:   "checklist [database-path]",
+		Short: "Print a maintenance checklist for the coming year",
+		Long: `Print a printable maintenance checklist grouped by month, with a
+checkbox per task. Recurring tasks are projected from their last service
+date and interval; overdue tasks are listed first. Season-tagged tasks
+without a schedule appear in the first month of their season.`,
+		Example: `  micasa checklist > checklist.md
+  micasa checklist --format text | lpr`,
+		Args:          cobra.MaximumNArgs(1),
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if format != checklistFormatMarkdown && format != checklistFormatText {
+				return fmt.Errorf(
+					"unknown format %q -- supported: %s, %s",
+					format, checklistFormatMarkdown, checklistFormatText,
+				)
+			}
+			store, err := openExisting(dbPathFromEnvOrArg(args))
+			if err != nil {
+				return err
+			}
+			defer func() { _ = store.Close() }()
+			now := time.Now()
+			groups, err := store.MaintenanceChecklist(now)
+			if err != nil {
+				return fmt.Errorf("build checklist: %w", err)
+			}
+			return writeChecklist(cmd.OutOrStdout(), groups, now, format == checklistFormatMarkdown)
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", checklistFormatMarkdown,
+		"Output format: markdown or text")
+	return cmd
+}
+
+// writeChecklist renders checklist groups as Markdown task lists or as
+// plain text with bracket checkboxes.
+func writeChecklist(w io.Writer, groups []data.ChecklistGroup, now time.Time, markdown bool) error {
+	var b strings.Builder
+	if markdown {
+		fmt.Fprintf(&b, "# Maintenance checklist\n\n_Generated %s_\n", fmtDateVal(now))
+	} else {
+		fmt.Fprintf(&b, "MAINTENANCE CHECKLIST (generated %s)\n", fmtDateVal(now))
+	}
+	if len(groups) == 0 {
+		b.WriteString("\nNothing scheduled.\n")
+	}
+	for _, g := range groups {
+		title := g.Title()
+		if !g.Overdue {
+			title += " (" + g.Season + ")"
+		}
+		if markdown {
+			fmt.Fprintf(&b, "\n## %s\n\n", title)
+		} else {
+			fmt.Fprintf(&b, "\n%s\n", strings.ToUpper(title))
+		}
+		for _, e := range g.Entries {
+			line := e.Item.Name
+			if detail := checklistDetail(e); detail != "" {
+				line += " (" + detail + ")"
+			}
+			if markdown {
+				fmt.Fprintf(&b, "- [ ] %s\n", line)
+			} else {
+				fmt.Fprintf(&b, "  [ ] %s\n", line)
+			}
+		}
+	}
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("write checklist: %w", err)
+	}
+	return nil
+}
+
+// checklistDetail joins the category, appliance, and due date of an entry.
+func checklistDetail(e data.ChecklistEntry) string {
+	var parts []string
+	if e.Item.Category.Name != "" {
+		parts = append(parts, e.Item.Category.Name)
+	}
+	if e.Item.Appliance.Name != "" {
+		parts = append(parts, e.Item.Appliance.Name)
+	}
+	if e.Due != nil {
+		parts = append(parts, "due "+fmtDateVal(*e.Due))
+	}
+	return strings.Join(parts, ", ")
+}
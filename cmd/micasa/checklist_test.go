@@ -0,0 +1,98 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteChecklistMarkdown(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)
+	due := time.Date(2026, 11, 20, 0, 0, 0, 0, time.UTC)
+	overdue := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	groups := []data.ChecklistGroup{
+		{
+			Overdue: true,
+			Entries: []data.ChecklistEntry{{
+				Item: data.MaintenanceItem{
+					Name:     "HVAC filter",
+					Category: data.MaintenanceCategory{Name: "HVAC"},
+				},
+				Due: &overdue,
+			}},
+		},
+		{
+			Month:  time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC),
+			Season: data.SeasonFall,
+			Entries: []data.ChecklistEntry{{
+				Item: data.MaintenanceItem{
+					Name:      "Descale",
+					Appliance: data.Appliance{Name: "Water Heater"},
+				},
+				Due: &due,
+			}},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeChecklist(&buf, groups, now, true))
+	assert.Equal(t, `# Maintenance checklist
+
+_Generated 2026-10-15_
+
+## Overdue
+
+- [ ] HVAC filter (HVAC, due 2026-10-01)
+
+## November 2026 (fall)
+
+- [ ] Descale (Water Heater, due 2026-11-20)
+`, buf.String())
+}
+
+func TestWriteChecklistText(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)
+	groups := []data.ChecklistGroup{{
+		Month:   time.Date(2027, 3, 1, 0, 0, 0, 0, time.UTC),
+		Season:  data.SeasonSpring,
+		Entries: []data.ChecklistEntry{{Item: data.MaintenanceItem{Name: "Drain hoses"}}},
+	}}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeChecklist(&buf, groups, now, false))
+	assert.Equal(t, `MAINTENANCE CHECKLIST (generated 2026-10-15)
+
+MARCH 2027 (SPRING)
+  [ ] Drain hoses
+`, buf.String())
+}
+
+func TestChecklistCmdRejectsUnknownFormat(t *testing.T) {
+	t.Parallel()
+	cmd := newChecklistCmd()
+	cmd.SetArgs([]string{"--format", "pdf"})
+	cmd.SetOut(&bytes.Buffer{})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown format "pdf"`)
+}
+
+func TestWriteChecklistEmpty(t *testing.T) {
+	t.Parallel()
+	store := newTestStoreWithMigration(t)
+	groups, err := store.MaintenanceChecklist(time.Now())
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, writeChecklist(&buf, groups, time.Now(), true))
+	assert.Contains(t, buf.String(), "Nothing scheduled.")
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// errDeclined is returned when the user answers no to a confirmation.
+var errDeclined = errors.New("cancelled -- nothing was changed")
+
+// yesFlagUsage is the help text for --yes on commands that confirm first.
+const yesFlagUsage = "Don't ask for confirmation"
+
+// confirm describes what a command is about to change and asks before
+// going ahead. Anything but y or yes, including no input at all, returns
+// errDeclined. yes (the --yes flag) skips the question.
+func confirm(in io.Reader, out io.Writer, yes bool, action string) error {
+	if yes {
+		return nil
+	}
+	_, _ = fmt.Fprintf(out, "%s\nContinue? [y/N] ", action)
+	if !answeredYes(in) {
+		return errDeclined
+	}
+	return nil
+}
+
+// answeredYes reads one line from in and reports whether it says y or yes.
+func answeredYes(in io.Reader) bool {
+	answer, _ := bufio.NewReader(in).ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true
+	}
+	return false
+}
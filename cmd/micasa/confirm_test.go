@@ -0,0 +1,50 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package main
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfirm(t *testing.T) {
+	t.Parallel()
+	for _, answer := range []string{"y\n", "YES\n", " yes "} {
+		var out bytes.Buffer
+		require.NoError(t, confirm(strings.NewReader(answer), &out, false, "This will add 2 appliances to x.db."), answer)
+		assert.Equal(t, "This will add 2 appliances to x.db.\nContinue? [y/N] ", out.String())
+	}
+	for _, answer := range []string{"", "\n", "n\n", "yep\n"} {
+		err := confirm(strings.NewReader(answer), &bytes.Buffer{}, false, "Go?")
+		require.ErrorIs(t, err, errDeclined, answer)
+	}
+}
+
+func TestConfirmYesSkipsQuestion(t *testing.T) {
+	t.Parallel()
+	var out bytes.Buffer
+	require.NoError(t, confirm(strings.NewReader(""), &out, true, "Go?"))
+	assert.Empty(t, out.String())
+}
+
+func TestSeedOnlyConfirmsExistingDatabase(t *testing.T) {
+	t.Parallel()
+	db := createTestDB(t)
+	_, err := executeCLIWithInput("n\n", "demo", "--seed-only", db)
+	require.ErrorIs(t, err, errDeclined)
+	assert.Empty(t, listAppliances(t, db))
+
+	_, err = executeCLI("demo", "--seed-only", "--yes", db)
+	require.NoError(t, err)
+	assert.NotEmpty(t, listAppliances(t, db))
+
+	fresh := filepath.Join(t.TempDir(), "fresh.db")
+	_, err = executeCLI("demo", "--seed-only", fresh)
+	require.NoError(t, err, "a new database needs no confirmation")
+}
@@ -0,0 +1,151 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/micasa-dev/micasa/internal/config"
+	"github.com/micasa-dev/micasa/internal/data"
+)
+
+// dueOpts holds the flags behind `micasa --due`.
+type dueOpts struct {
+	within string
+	json   bool
+}
+
+// printDueReport writes the report behind `micasa --due`: a one-line
+// summary, then the overdue and upcoming maintenance and expiring
+// warranties, as plain text or JSON. With no --within the windows match the
+// dashboard's (30 days for maintenance, the warranty look-ahead for
+// warranties); --within sets both.
+func printDueReport(w io.Writer, dbPath string, now time.Time, opts dueOpts) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+	dueBy := now.AddDate(0, 0, data.DueSoonDays)
+	warrantyBy := now.AddDate(0, 0, cfg.Dashboard.WarrantyLookaheadDays)
+	if opts.within != "" {
+		within, err := data.ParseInterval(opts.within, data.IntervalUnitDays)
+		if err != nil {
+			return fmt.Errorf("--within %q: want a duration like 30d, 2w, or 3m", opts.within)
+		}
+		dueBy = within.After(now)
+		warrantyBy = dueBy
+	}
+	store, err := openExisting(dbPath)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = store.Close() }()
+
+	report, err := store.DueReport(now, cfg.Dashboard.MaintenanceGraceDays, dueBy, warrantyBy)
+	if err != nil {
+		return fmt.Errorf("build due report: %w", err)
+	}
+	if opts.json {
+		return writeDueJSON(w, report)
+	}
+	return writeDueText(w, report)
+}
+
+var dueItemCols = []showCol[data.DueItem]{
+	{header: "NAME", value: func(d data.DueItem) string { return d.Item.Name }},
+	{header: "APPLIANCE", value: func(d data.DueItem) string { return fmtStr(d.Item.Appliance.Name) }},
+	{header: "DUE", value: func(d data.DueItem) string { return fmtDateVal(d.NextDue) }},
+	{header: "WHEN", value: func(d data.DueItem) string { return fmtDueDays(d.Days) }},
+}
+
+var dueWarrantyCols = []showCol[data.DueWarranty]{
+	{header: "APPLIANCE", value: func(d data.DueWarranty) string { return d.Appliance.Name }},
+	{header: "EXPIRES", value: func(d data.DueWarranty) string { return fmtDate(d.Appliance.WarrantyExpiry) }},
+	{header: "WHEN", value: func(d data.DueWarranty) string { return fmtDueDays(d.Days) }},
+}
+
+func writeDueText(w io.Writer, report data.DueReport) error {
+	sum := report.Summary()
+	if sum.IsZero() {
+		_, err := fmt.Fprintln(w, "Nothing due.")
+		return err
+	}
+	if _, err := fmt.Fprintln(w, sum.String()); err != nil {
+		return err
+	}
+	return errors.Join(
+		writeDueSection(w, "OVERDUE", report.Overdue, dueItemCols),
+		writeDueSection(w, "UPCOMING", report.Upcoming, dueItemCols),
+		writeDueSection(w, "WARRANTIES", report.Warranties, dueWarrantyCols),
+	)
+}
+
+// writeDueSection writes a table preceded by a blank line, or nothing when
+// there are no rows.
+func writeDueSection[T any](w io.Writer, header string, rows []T, cols []showCol[T]) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+	return writeTable(w, header, rows, cols)
+}
+
+// fmtDueDays describes a day offset: "today", "in 5 days", "3 days overdue".
+func fmtDueDays(days int) string {
+	switch {
+	case days == 0:
+		return "today"
+	case days > 0:
+		return fmt.Sprintf("in %d %s", days, pluralize(days, "day"))
+	default:
+		return fmt.Sprintf("%d %s overdue", -days, pluralize(-days, "day"))
+	}
+}
+
+func writeDueJSON(w io.Writer, report data.DueReport) error {
+	item := func(d data.DueItem) map[string]any {
+		m := map[string]any{
+			"id":       d.Item.ID,
+			"name":     d.Item.Name,
+			"next_due": fmtDateVal(d.NextDue),
+			"days":     d.Days,
+		}
+		if d.Item.Appliance.Name != "" {
+			m["appliance"] = d.Item.Appliance.Name
+		}
+		return m
+	}
+	items := func(ds []data.DueItem) []map[string]any {
+		out := make([]map[string]any, len(ds))
+		for i, d := range ds {
+			out[i] = item(d)
+		}
+		return out
+	}
+	warranties := make([]map[string]any, len(report.Warranties))
+	for i, d := range report.Warranties {
+		warranties[i] = map[string]any{
+			"id":              d.Appliance.ID,
+			"name":            d.Appliance.Name,
+			"warranty_expiry": fmtDate(d.Appliance.WarrantyExpiry),
+			"days":            d.Days,
+		}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(map[string]any{
+		"overdue":    items(report.Overdue),
+		"upcoming":   items(report.Upcoming),
+		"warranties": warranties,
+	}); err != nil {
+		return fmt.Errorf("encode JSON: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package main
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// Export formats accepted by --format.
+const (
+	exportFormatJSON = "json"
+	exportFormatCSV  = "csv"
+)
+
+func newExportCmd() *cobra.Command {
+	var format, out string
+	var includeDeleted bool
+
+	cmd := &cobra.Command{
+		Use:   "export [database-path]",
+		Short: "Dump all data as JSON or CSV",
+		Long: `Write the house profile, project types, maintenance categories, projects,
+quotes, maintenance items, appliances, vendors, and service log to stdout or
+--out. Money is written as integer cents and dates as RFC 3339, so nothing
+is rounded, and ` + "`micasa import`" + ` reads the JSON back.
+
+JSON is one object keyed by entity: house, project_types,
+maintenance_categories, projects, quotes, maintenance, appliances, vendors,
+service_log.
+
+CSV is one file per entity. When --out is an existing directory the files
+are written into it, replacing earlier exports; otherwise they are written
+as a zip archive, which is never written to a terminal.`,
+		Example: `  micasa export | jq .projects
+  micasa export --format=csv --out=~/micasa-export/
+  micasa export --format=csv --out=micasa.zip
+  micasa export --include-deleted --out=micasa.json ~/house.db`,
+		Args:          cobra.MaximumNArgs(1),
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if format != exportFormatJSON && format != exportFormatCSV {
+				return fmt.Errorf("unknown format %q: use json or csv", format)
+			}
+			store, err := openExisting(dbPathFromEnvOrArg(args))
+			if err != nil {
+				return err
+			}
+			defer func() { _ = store.Close() }()
+			tables, err := store.Export(includeDeleted)
+			if err != nil {
+				return fmt.Errorf("export: %w", err)
+			}
+			return writeExport(cmd.OutOrStdout(), tables, format, data.ExpandHome(out))
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", exportFormatJSON, "Output format: json or csv")
+	cmd.Flags().StringVar(&out, "out", "", "Write to `path` instead of stdout; a directory gets one CSV file per entity")
+	cmd.Flags().BoolVar(&includeDeleted, "include-deleted", false, "Include soft-deleted rows, with a deleted_at column")
+
+	return cmd
+}
+
+// writeExport writes tables in format to out, or to stdout when out is
+// empty.
+func writeExport(stdout io.Writer, tables []data.ExportTable, format, out string) error {
+	if format == exportFormatCSV && out != "" {
+		if info, err := os.Stat(out); err == nil && info.IsDir() {
+			return writeExportCSVDir(out, tables)
+		}
+	}
+	if format == exportFormatCSV && out == "" && isTerminal(stdout) {
+		return errors.New(
+			"CSV export is a zip archive -- pass --out or redirect stdout to a file",
+		)
+	}
+	w := stdout
+	var f *os.File
+	if out != "" {
+		var err error
+		f, err = os.OpenFile(out, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+		if err != nil {
+			return fmt.Errorf("create export file: %w", err)
+		}
+		defer func() { _ = f.Close() }()
+		w = f
+	}
+
+	var err error
+	if format == exportFormatJSON {
+		err = writeExportJSON(w, tables)
+	} else {
+		err = writeExportZip(w, tables)
+	}
+	if err != nil {
+		return err
+	}
+	if f != nil {
+		if err := f.Close(); err != nil {
+			return fmt.Errorf("write export file: %w", err)
+		}
+	}
+	return nil
+}
+
+// writeExportJSON writes one object keyed by table name. The house is an
+// object, null when there's no profile; the rest are arrays.
+func writeExportJSON(w io.Writer, tables []data.ExportTable) error {
+	result := make(map[string]any, len(tables))
+	for _, t := range tables {
+		objs := t.Objects()
+		if t.Name != data.ExportHouse {
+			result[t.Name] = objs
+			continue
+		}
+		result[t.Name] = nil
+		if len(objs) > 0 {
+			result[t.Name] = objs[0]
+		}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(result); err != nil {
+		return fmt.Errorf("encode JSON: %w", err)
+	}
+	return nil
+}
+
+// writeExportZip writes a zip archive with a <table>.csv entry per table.
+func writeExportZip(w io.Writer, tables []data.ExportTable) error {
+	zw := zip.NewWriter(w)
+	for _, t := range tables {
+		entry, err := zw.Create(t.Name + ".csv")
+		if err != nil {
+			return fmt.Errorf("write %s.csv: %w", t.Name, err)
+		}
+		if err := writeExportCSV(entry, t); err != nil {
+			return err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("write zip: %w", err)
+	}
+	return nil
+}
+
+// writeExportCSVDir writes <table>.csv per table into dir.
+func writeExportCSVDir(dir string, tables []data.ExportTable) error {
+	for _, t := range tables {
+		path := filepath.Join(dir, t.Name+".csv")
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+		if err != nil {
+			return fmt.Errorf("create %s: %w", path, err)
+		}
+		if err := writeExportCSV(f, t); err != nil {
+			_ = f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return fmt.Errorf("write %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+func writeExportCSV(w io.Writer, t data.ExportTable) error {
+	cw := csv.NewWriter(w)
+	if err := cw.WriteAll(t.Records()); err != nil {
+		return fmt.Errorf("write %s.csv: %w", t.Name, err)
+	}
+	return nil
+}
+
+// isTerminal reports whether w is a terminal.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	return ok && term.IsTerminal(int(f.Fd()))
+}
@@ -0,0 +1,162 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/micasa-dev/micasa/internal/config"
+	"github.com/micasa-dev/micasa/internal/data"
+)
+
+// exportManifestName is the file listing what `micasa --export-docs` wrote.
+const exportManifestName = "manifest.csv"
+
+// exportManifestHeader names the manifest columns. file is the exported
+// file's name in the target directory, empty when the document has no
+// file contents.
+var exportManifestHeader = []string{
+	"id", "title", "file", "original_name", "mime_type", "size_bytes",
+	"sha256", "entity_kind", "entity_id",
+}
+
+// exportDocuments writes the file of every document in the database at
+// dbPath into dir under its original name, numbering names that collide,
+// and a manifest.csv mapping each document to its file. Existing files in
+// dir are never overwritten. Documents without file contents are listed
+// in the manifest with an empty file column.
+func exportDocuments(w io.Writer, dbPath, dir string) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+	store, err := openExisting(dbPath)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = store.Close() }()
+	store.SetBlobStorage(blobDir(dbPath, cfg.Documents), false)
+
+	docs, err := store.ListDocuments(false)
+	if err != nil {
+		return fmt.Errorf("list documents: %w", err)
+	}
+	dir = data.ExpandHome(dir)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("create export directory: %w", err)
+	}
+
+	manifest, manifestName, err := createUnique(dir, exportManifestName)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = manifest.Close() }()
+	cw := csv.NewWriter(manifest)
+	if err := cw.Write(exportManifestHeader); err != nil {
+		return fmt.Errorf("write manifest: %w", err)
+	}
+
+	var exported, empty int
+	for _, doc := range docs {
+		content, err := store.GetDocumentBlob(doc.ID)
+		if err != nil {
+			return fmt.Errorf("document %s: %w", doc.ID, err)
+		}
+		var name, sum string
+		if len(content) > 0 {
+			if name, err = writeExportFile(dir, exportFileName(doc), content); err != nil {
+				return fmt.Errorf("document %s: %w", doc.ID, err)
+			}
+			sum = fmt.Sprintf("%x", sha256.Sum256(content))
+			exported++
+		} else {
+			empty++
+		}
+		if err := cw.Write([]string{
+			doc.ID, doc.Title, name, doc.FileName, doc.MIMEType,
+			strconv.Itoa(len(content)), sum, doc.EntityKind, doc.EntityID,
+		}); err != nil {
+			return fmt.Errorf("write manifest: %w", err)
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("write manifest: %w", err)
+	}
+	if err := manifest.Close(); err != nil {
+		return fmt.Errorf("write manifest: %w", err)
+	}
+
+	summary := fmt.Sprintf(
+		"Exported %d %s to %s (manifest: %s)",
+		exported, pluralize(exported, "document"), dir, manifestName,
+	)
+	if empty > 0 {
+		summary += fmt.Sprintf("; %d without file contents", empty)
+	}
+	if _, err := fmt.Fprintln(w, summary+"."); err != nil {
+		return fmt.Errorf("write output: %w", err)
+	}
+	return nil
+}
+
+// exportFileName returns a safe base name for doc's file, falling back to
+// its ID when the stored name is unusable.
+func exportFileName(doc data.Document) string {
+	name := strings.NewReplacer("/", "_", "\\", "_").Replace(strings.TrimSpace(doc.FileName))
+	if name == "" || name == "." || name == ".." {
+		return "document-" + doc.ID
+	}
+	return name
+}
+
+// writeExportFile writes content to a new file in dir named name, or the
+// first free "name (n).ext", and returns the name used.
+func writeExportFile(dir, name string, content []byte) (string, error) {
+	f, used, err := createUnique(dir, name)
+	if err != nil {
+		return "", err
+	}
+	if _, err := f.Write(content); err != nil {
+		_ = f.Close()
+		return "", fmt.Errorf("write %s: %w", used, err)
+	}
+	if err := f.Close(); err != nil {
+		return "", fmt.Errorf("write %s: %w", used, err)
+	}
+	return used, nil
+}
+
+// createUnique creates a file in dir named name, or "stem (2).ext",
+// "stem (3).ext", and so on when that name is taken.
+func createUnique(dir, name string) (*os.File, string, error) {
+	ext := filepath.Ext(name)
+	stem := strings.TrimSuffix(name, ext)
+	if stem == "" {
+		stem, ext = name, ""
+	}
+	used := name
+	for n := 2; ; n++ {
+		f, err := os.OpenFile( //nolint:gosec // user-chosen export directory
+			filepath.Join(dir, used),
+			os.O_WRONLY|os.O_CREATE|os.O_EXCL,
+			0o600,
+		)
+		if err == nil {
+			return f, used, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, "", fmt.Errorf("create %s: %w", used, err)
+		}
+		used = fmt.Sprintf("%s (%d)%s", stem, n, ext)
+	}
+}
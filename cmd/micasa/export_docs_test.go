@@ -0,0 +1,76 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package main
+
+import (
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportDocs(t *testing.T) {
+	t.Parallel()
+	db := createTestDB(t)
+	store, err := data.Open(db)
+	require.NoError(t, err)
+	require.NoError(t, store.SetMaxDocumentSize(1<<20))
+	for _, doc := range []data.Document{
+		{Title: "Dishwasher Manual", FileName: "manual.pdf", Data: []byte("dishwasher")},
+		{Title: "Furnace Manual", FileName: "manual.pdf", Data: []byte("furnace")},
+		{Title: "Odd Name", FileName: "../../escape.txt", Data: []byte("odd")},
+		{Title: "Just Notes"},
+	} {
+		doc.SizeBytes = int64(len(doc.Data))
+		require.NoError(t, store.CreateDocument(&doc))
+	}
+	require.NoError(t, store.Close())
+
+	dir := filepath.Join(t.TempDir(), "export")
+	out, err := executeCLI("--export-docs", dir, db)
+	require.NoError(t, err)
+	assert.Contains(t, out, "Exported 3 documents")
+	assert.Contains(t, out, "1 without file contents")
+
+	manuals := map[string]bool{}
+	for _, name := range []string{"manual.pdf", "manual (2).pdf"} {
+		content, err := os.ReadFile(filepath.Join(dir, name))
+		require.NoError(t, err, name)
+		manuals[string(content)] = true
+	}
+	assert.Equal(t, map[string]bool{"dishwasher": true, "furnace": true}, manuals)
+	assert.FileExists(t, filepath.Join(dir, ".._.._escape.txt"))
+
+	f, err := os.Open(filepath.Join(dir, exportManifestName))
+	require.NoError(t, err)
+	defer func() { _ = f.Close() }()
+	records, err := csv.NewReader(f).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 5)
+	assert.Equal(t, exportManifestHeader, records[0])
+	byTitle := map[string][]string{}
+	for _, r := range records[1:] {
+		byTitle[r[1]] = r
+	}
+	assert.Empty(t, byTitle["Just Notes"][2], "no file for a document without contents")
+	assert.Equal(t, "furnace", mustRead(t, filepath.Join(dir, byTitle["Furnace Manual"][2])))
+	assert.Len(t, byTitle["Furnace Manual"][6], 64, "sha256 hex")
+
+	// Exporting again never overwrites: everything gets a new name.
+	out, err = executeCLI("--export-docs", dir, db)
+	require.NoError(t, err)
+	assert.Contains(t, out, "manifest (2).csv")
+	assert.FileExists(t, filepath.Join(dir, "manual (3).pdf"))
+}
+
+func mustRead(t *testing.T, path string) string {
+	t.Helper()
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return string(content)
+}
@@ -0,0 +1,185 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createExportTestDB returns a database with one appliance and one
+// deleted vendor.
+func createExportTestDB(t *testing.T) string {
+	t.Helper()
+	db := createTestDB(t)
+	store, err := data.Open(db)
+	require.NoError(t, err)
+	cost := int64(89999)
+	require.NoError(t, store.CreateAppliance(&data.Appliance{Name: "Dishwasher", CostCents: &cost}))
+	v := data.Vendor{Name: "Gone Plumbing"}
+	require.NoError(t, store.CreateVendor(&v))
+	require.NoError(t, store.DeleteVendor(v.ID))
+	require.NoError(t, store.Close())
+	return db
+}
+
+func TestExportJSON(t *testing.T) {
+	t.Parallel()
+	db := createExportTestDB(t)
+
+	out, err := executeCLI("export", db)
+	require.NoError(t, err)
+	var got map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal([]byte(out), &got))
+	assert.JSONEq(t, "null", string(got["house"]))
+	assert.JSONEq(t, "[]", string(got["projects"]))
+	assert.JSONEq(t, "[]", string(got["vendors"]), "deleted vendors are left out")
+
+	var appliances []map[string]any
+	require.NoError(t, json.Unmarshal(got["appliances"], &appliances))
+	require.Len(t, appliances, 1)
+	assert.InDelta(t, 89999, appliances[0]["cost_cents"], 0)
+
+	out, err = executeCLI("export", "--include-deleted", db)
+	require.NoError(t, err)
+	var withDeleted struct {
+		Vendors []map[string]any `json:"vendors"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &withDeleted))
+	require.Len(t, withDeleted.Vendors, 1)
+	assert.NotNil(t, withDeleted.Vendors[0]["deleted_at"])
+}
+
+func TestExportCSVDir(t *testing.T) {
+	t.Parallel()
+	db := createExportTestDB(t)
+	dir := t.TempDir()
+
+	_, err := executeCLI("export", "--format=csv", "--out", dir, db)
+	require.NoError(t, err)
+	for _, name := range []string{"house", "projects", "quotes", "maintenance", "vendors", "service_log"} {
+		assert.FileExists(t, filepath.Join(dir, name+".csv"))
+	}
+	f, err := os.Open(filepath.Join(dir, "appliances.csv"))
+	require.NoError(t, err)
+	defer func() { _ = f.Close() }()
+	records, err := csv.NewReader(f).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, "id", records[0][0])
+	assert.Contains(t, records[1], "Dishwasher")
+	assert.Contains(t, records[1], "89999")
+}
+
+func TestExportCSVZip(t *testing.T) {
+	t.Parallel()
+	db := createExportTestDB(t)
+	path := filepath.Join(t.TempDir(), "export.zip")
+
+	_, err := executeCLI("export", "--format=csv", "--out", path, db)
+	require.NoError(t, err)
+	zr, err := zip.OpenReader(path)
+	require.NoError(t, err)
+	defer func() { _ = zr.Close() }()
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	assert.Equal(t, []string{
+		"house.csv", "project_types.csv", "maintenance_categories.csv",
+		"projects.csv", "quotes.csv", "maintenance.csv", "appliances.csv",
+		"vendors.csv", "service_log.csv",
+	}, names)
+}
+
+func TestExportUnknownFormat(t *testing.T) {
+	t.Parallel()
+	db := createExportTestDB(t)
+	_, err := executeCLI("export", "--format=xml", db)
+	require.ErrorContains(t, err, `unknown format "xml"`)
+}
+
+func TestImportExportedBundle(t *testing.T) {
+	t.Parallel()
+	src := createExportTestDB(t)
+	bundle := filepath.Join(t.TempDir(), "micasa.json")
+	_, err := executeCLI("export", "--include-deleted", "--out", bundle, src)
+	require.NoError(t, err)
+
+	dst := filepath.Join(t.TempDir(), "new.db")
+	out, err := executeCLI("import", bundle, dst)
+	require.NoError(t, err)
+	assert.Contains(t, out, "Imported 1 vendor, 1 appliance.")
+
+	store, err := data.Open(dst)
+	require.NoError(t, err)
+	appliances, err := store.ListAppliances(false)
+	require.NoError(t, err)
+	require.Len(t, appliances, 1)
+	assert.Equal(t, "Dishwasher", appliances[0].Name)
+	vendors, err := store.ListVendors(false)
+	require.NoError(t, err)
+	assert.Empty(t, vendors, "the vendor stays deleted")
+	require.NoError(t, store.Close())
+
+	out, err = executeCLI("import", bundle, dst)
+	require.NoError(t, err)
+	assert.Contains(t, out, "Nothing imported; skipped")
+}
+
+func TestImportBundleMissingFile(t *testing.T) {
+	t.Parallel()
+	db := createTestDB(t)
+	_, err := executeCLI("import", filepath.Join(t.TempDir(), "nope.json"), db)
+	require.ErrorContains(t, err, "nope.json")
+}
+
+func TestImportBundleReplace(t *testing.T) {
+	t.Parallel()
+	src := createExportTestDB(t)
+	bundle := filepath.Join(t.TempDir(), "micasa.json")
+	_, err := executeCLI("export", "--include-deleted", "--out", bundle, src)
+	require.NoError(t, err)
+	dst := filepath.Join(t.TempDir(), "new.db")
+	_, err = executeCLI("import", bundle, dst)
+	require.NoError(t, err)
+
+	raw, err := os.ReadFile(bundle)
+	require.NoError(t, err)
+	raw = bytes.ReplaceAll(raw, []byte("Gone Plumbing"), []byte("Gone Plumbing Co"))
+	require.NoError(t, os.WriteFile(bundle, raw, 0o600))
+
+	out, err := executeCLI("import", "--replace", bundle, dst)
+	require.NoError(t, err)
+	assert.Contains(t, out, "1 vendor (0 new, 1 replaced)")
+
+	store, err := data.Open(dst)
+	require.NoError(t, err)
+	defer func() { _ = store.Close() }()
+	vendors, err := store.ListVendors(true)
+	require.NoError(t, err)
+	require.Len(t, vendors, 1)
+	assert.Equal(t, "Gone Plumbing Co", vendors[0].Name, "the deleted vendor is replaced")
+	assert.True(t, vendors[0].DeletedAt.Valid, "and stays deleted")
+}
+
+func TestExportCSVToStdoutPipe(t *testing.T) {
+	t.Parallel()
+	db := createExportTestDB(t)
+	out, err := executeCLI("export", "--format=csv", db)
+	require.NoError(t, err)
+	zr, err := zip.NewReader(strings.NewReader(out), int64(len(out)))
+	require.NoError(t, err, "a redirected stdout gets the zip")
+	assert.NotEmpty(t, zr.File)
+}
@@ -0,0 +1,558 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/micasa-dev/micasa/internal/config"
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/micasa-dev/micasa/internal/locale"
+	"github.com/spf13/cobra"
+)
+
+// Appliance fields an import column can map to, in form order.
+const (
+	importName         = "name"
+	importBrand        = "brand"
+	importModel        = "model"
+	importSerial       = "serial"
+	importPurchaseDate = "purchase_date"
+	importWarranty     = "warranty"
+	importLocation     = "location"
+	importCost         = "cost"
+	importNotes        = "notes"
+)
+
+var applianceImportFields = []string{
+	importName, importBrand, importModel, importSerial, importPurchaseDate,
+	importWarranty, importLocation, importCost, importNotes,
+}
+
+// applianceHeaderAliases maps normalized spreadsheet headers to appliance
+// fields. Headers are normalized by normalizeHeader before lookup.
+var applianceHeaderAliases = map[string]string{
+	"name":             importName,
+	"appliance":        importName,
+	"item":             importName,
+	"product":          importName,
+	"brand":            importBrand,
+	"manufacturer":     importBrand,
+	"make":             importBrand,
+	"mfr":              importBrand,
+	"model":            importModel,
+	"model_number":     importModel,
+	"model_no":         importModel,
+	"serial":           importSerial,
+	"serial_number":    importSerial,
+	"serial_no":        importSerial,
+	"sn":               importSerial,
+	"purchase_date":    importPurchaseDate,
+	"purchased":        importPurchaseDate,
+	"date_purchased":   importPurchaseDate,
+	"bought":           importPurchaseDate,
+	"warranty":         importWarranty,
+	"warranty_expiry":  importWarranty,
+	"warranty_expires": importWarranty,
+	"warranty_end":     importWarranty,
+	"location":         importLocation,
+	"room":             importLocation,
+	"cost":             importCost,
+	"price":            importCost,
+	"purchase_price":   importCost,
+	"notes":            importNotes,
+	"note":             importNotes,
+	"comments":         importNotes,
+}
+
+// importRow is one record from an import file, labeled for error messages.
+type importRow struct {
+	label  string // "line 3" for CSV, "item 2" for JSON
+	values []string
+}
+
+// importTable is the parsed content of an import file.
+type importTable struct {
+	headers []string
+	rows    []importRow
+}
+
+func newImportCmd() *cobra.Command {
+	var replace bool
+
+	cmd := &cobra.Command{
+		Use:   "import <bundle.json> [database-path]",
+		Short: "Load an export bundle, or bulk-load records from a spreadsheet",
+		Long: `Load a JSON bundle written by ` + "`micasa export`" + `, in one transaction: if
+any row fails, nothing is imported. The database is created if it doesn't
+exist.
+
+Rows whose ID is already in the database are skipped, or overwritten with
+--replace. Project types, maintenance categories, and vendors, and active
+appliances and projects, that have a new ID but the same name (title, for
+projects) as an existing record are linked to that record instead of being
+added again, and rows that refer to them follow. A row that refers to a
+record in neither the bundle nor the database is an error.
+
+To load appliances from a spreadsheet, use ` + "`micasa import appliances`" + `.`,
+		Example: `  micasa export --out=micasa.json ~/old.db
+  micasa import micasa.json ~/new.db
+  micasa import --replace micasa.json`,
+		Args:          cobra.RangeArgs(1, 2),
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bundle, err := readImportBundle(args[0])
+			if err != nil {
+				return err
+			}
+			store, err := openAndMigrate(dbPathFromEnvOrArg(args[1:]))
+			if err != nil {
+				return err
+			}
+			defer func() { _ = store.Close() }()
+			cfg, err := config.Load()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+			if err := store.SeedDefaultsWith(cfg.Seed.Names()); err != nil {
+				return fmt.Errorf("seed defaults: %w", err)
+			}
+			counts, err := store.ImportBundle(bundle, replace)
+			if err != nil {
+				return fmt.Errorf("import %s: %w", args[0], err)
+			}
+			return writeImportSummary(cmd.OutOrStdout(), counts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&replace, "replace", false,
+		"Overwrite records whose ID is already in the database")
+	cmd.AddCommand(newImportAppliancesCmd())
+	return cmd
+}
+
+func newImportAppliancesCmd() *cobra.Command {
+	var mappings []string
+	var dryRun, yes bool
+
+	cmd := &cobra.Command{
+		Use:   "appliances <file> [database-path]",
+		Short: "Import appliances from a CSV or JSON file",
+		Long: `Import appliances from a CSV file with a header row, or a JSON array of
+objects. Columns are matched to appliance fields by name (e.g. "Manufacturer"
+maps to brand, "Serial #" to serial); use --map for headers that aren't
+recognized. Fields: ` + strings.Join(applianceImportFields, ", ") + `.
+
+Dates take the same formats as the appliance form. The warranty column takes
+an expiry date, or a length like "2y" counted from the purchase date.
+
+Rows with errors are reported and skipped; the rest are imported. Rows whose
+name matches an existing appliance are skipped, so a file can be imported
+again after fixing the rows that failed.
+
+Before writing, the command says how many appliances it will add to which
+database and asks to continue; --yes skips the question.`,
+		Example: `  micasa import appliances appliances.csv
+  micasa import appliances --map "Unit=name" --map "Paid=cost" inventory.csv
+  micasa import appliances --dry-run appliances.json
+  micasa import appliances --yes appliances.csv ~/house.db`,
+		Args:          cobra.RangeArgs(1, 2),
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			overrides, err := parseImportMappings(mappings)
+			if err != nil {
+				return err
+			}
+			table, err := readImportFile(args[0])
+			if err != nil {
+				return err
+			}
+			dbPath, err := resolveDBPathArg(dbPathFromEnvOrArg(args[1:]))
+			if err != nil {
+				return err
+			}
+			store, err := openExisting(dbPath)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = store.Close() }()
+			cfg, err := config.Load()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+			store.SetCurrencyFormats(cfg.Locale.CurrencyFormats())
+			if err := store.ResolveCurrency(cfg.Locale.Currency); err != nil {
+				return fmt.Errorf("resolve currency: %w", err)
+			}
+			var ask func(int) error
+			if !dryRun {
+				ask = func(n int) error {
+					return confirm(cmd.InOrStdin(), cmd.ErrOrStderr(), yes, fmt.Sprintf(
+						"This will add %d %s to %s.", n, pluralize(n, "appliance"), dbPath,
+					))
+				}
+			}
+			return importAppliances(cmd.OutOrStdout(), store, table, overrides, dryRun, ask)
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&mappings, "map", nil,
+		`Map a column to a field, as "Header=field" (repeatable)`)
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false,
+		"Check every row and report errors without importing")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, yesFlagUsage)
+	return cmd
+}
+
+// normalizeHeader lowercases a header and joins its words with
+// underscores, so "Model #", "model-number", and "Model Number" compare
+// by their letters and digits alone.
+func normalizeHeader(h string) string {
+	words := strings.FieldsFunc(strings.ToLower(h), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, "_")
+}
+
+// parseImportMappings parses --map values into normalized header -> field.
+func parseImportMappings(mappings []string) (map[string]string, error) {
+	out := make(map[string]string, len(mappings))
+	for _, m := range mappings {
+		header, field, ok := strings.Cut(m, "=")
+		field = strings.TrimSpace(field)
+		if !ok || strings.TrimSpace(header) == "" {
+			return nil, fmt.Errorf("--map %q: use the form \"Header=field\"", m)
+		}
+		if !slices.Contains(applianceImportFields, field) {
+			return nil, fmt.Errorf(
+				"--map %q: unknown field %q -- supported: %s",
+				m, field, strings.Join(applianceImportFields, ", "),
+			)
+		}
+		out[normalizeHeader(header)] = field
+	}
+	return out, nil
+}
+
+// mapImportColumns assigns each header to an appliance field. Overrides
+// win over the built-in aliases. Returns the field per column ("" for
+// ignored columns). A field claimed by two columns, or no name column, is
+// an error.
+func mapImportColumns(headers []string, overrides map[string]string) ([]string, error) {
+	fields := make([]string, len(headers))
+	claimed := make(map[string]string, len(headers))
+	for i, h := range headers {
+		key := normalizeHeader(h)
+		field, ok := overrides[key]
+		if !ok {
+			field = applianceHeaderAliases[key]
+		}
+		if field == "" {
+			continue
+		}
+		if prev, dup := claimed[field]; dup {
+			return nil, fmt.Errorf(
+				"columns %q and %q both map to %s -- use --map to pick one",
+				prev, h, field,
+			)
+		}
+		claimed[field] = h
+		fields[i] = field
+	}
+	if _, ok := claimed[importName]; !ok {
+		return nil, fmt.Errorf(
+			"no column maps to name -- use --map \"<header>=name\" (headers: %s)",
+			strings.Join(headers, ", "),
+		)
+	}
+	return fields, nil
+}
+
+// readImportFile reads a CSV or JSON import file, chosen by extension.
+func readImportFile(path string) (importTable, error) {
+	f, err := os.Open(path) //nolint:gosec // user-specified import file
+	if err != nil {
+		return importTable{}, fmt.Errorf("open import file: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		return readImportJSON(f)
+	}
+	return readImportCSV(f)
+}
+
+func readImportCSV(r io.Reader) (importTable, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	headers, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return importTable{}, errors.New("import file is empty")
+	}
+	if err != nil {
+		return importTable{}, fmt.Errorf("read CSV header: %w", err)
+	}
+	// Spreadsheet exports often start with a UTF-8 byte order mark.
+	headers[0] = strings.TrimPrefix(headers[0], "\ufeff")
+
+	table := importTable{headers: headers}
+	for {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return importTable{}, fmt.Errorf("read CSV: %w", err)
+		}
+		line, _ := cr.FieldPos(0)
+		table.rows = append(table.rows, importRow{
+			label:  fmt.Sprintf("line %d", line),
+			values: rec,
+		})
+	}
+	return table, nil
+}
+
+func readImportJSON(r io.Reader) (importTable, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	var items []map[string]any
+	if err := dec.Decode(&items); err != nil {
+		return importTable{}, fmt.Errorf("read JSON (expected an array of objects): %w", err)
+	}
+
+	// Columns are the union of keys in first-seen order, sorted within
+	// each object since Go maps are unordered.
+	var table importTable
+	index := make(map[string]int)
+	for _, item := range items {
+		keys := make([]string, 0, len(item))
+		for k := range item {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			if _, ok := index[k]; !ok {
+				index[k] = len(table.headers)
+				table.headers = append(table.headers, k)
+			}
+		}
+	}
+	for i, item := range items {
+		values := make([]string, len(table.headers))
+		for k, v := range item {
+			if v != nil {
+				values[index[k]] = fmt.Sprint(v)
+			}
+		}
+		table.rows = append(table.rows, importRow{
+			label:  fmt.Sprintf("item %d", i+1),
+			values: values,
+		})
+	}
+	return table, nil
+}
+
+// parseApplianceRow builds an appliance from one row's field values,
+// validating with the same parsers as the appliance form.
+func parseApplianceRow(values map[string]string, cur locale.Currency) (data.Appliance, error) {
+	name := strings.TrimSpace(values[importName])
+	if name == "" {
+		return data.Appliance{}, errors.New("name is required")
+	}
+	purchaseDate, err := data.ParseOptionalDate(values[importPurchaseDate])
+	if err != nil {
+		return data.Appliance{}, data.FieldError("Purchase Date", err)
+	}
+	warranty, err := parseImportWarranty(values[importWarranty], purchaseDate)
+	if err != nil {
+		return data.Appliance{}, err
+	}
+	cost, err := cur.ParseOptionalCents(values[importCost])
+	if err != nil {
+		return data.Appliance{}, data.FieldError("Cost", err)
+	}
+	return data.Appliance{
+		Name:           name,
+		Brand:          strings.TrimSpace(values[importBrand]),
+		ModelNumber:    strings.TrimSpace(values[importModel]),
+		SerialNumber:   strings.TrimSpace(values[importSerial]),
+		PurchaseDate:   purchaseDate,
+		WarrantyExpiry: warranty,
+		Location:       strings.TrimSpace(values[importLocation]),
+		CostCents:      cost,
+		Notes:          strings.TrimSpace(values[importNotes]),
+	}, nil
+}
+
+// parseImportWarranty parses a warranty length ("2y", "18m") added to the
+// purchase date, or an expiry date. Lengths are tried first because the
+// date parser would read "2y" as two years from today. A bare number needs
+// a unit, since spreadsheets disagree on whether "2" means years or months.
+func parseImportWarranty(input string, purchased *time.Time) (*time.Time, error) {
+	months, err := data.ParseIntervalMonths(input)
+	if err != nil || months == 0 || !strings.ContainsAny(strings.ToLower(input), "ym") {
+		expiry, err := data.ParseOptionalDate(input)
+		if err != nil {
+			return nil, data.FieldError("Warranty", err)
+		}
+		return expiry, nil
+	}
+	if purchased == nil {
+		return nil, data.WithHint(data.ErrInvalidDate,
+			"Warranty length needs a purchase date -- or give the expiry date instead")
+	}
+	end := data.AddMonths(*purchased, months)
+	return &end, nil
+}
+
+// importAppliances validates every row, then asks confirm (when non-nil)
+// before creating the valid, new ones. Rows that fail are reported and
+// skipped.
+func importAppliances(
+	w io.Writer,
+	store *data.Store,
+	table importTable,
+	overrides map[string]string,
+	dryRun bool,
+	confirm func(n int) error,
+) error {
+	fields, err := mapImportColumns(table.headers, overrides)
+	if err != nil {
+		return err
+	}
+	var mapped, ignored []string
+	for i, h := range table.headers {
+		if fields[i] == "" {
+			ignored = append(ignored, h)
+			continue
+		}
+		mapped = append(mapped, h+" -> "+fields[i])
+	}
+	if _, err := fmt.Fprintf(w, "Columns: %s\n", strings.Join(mapped, ", ")); err != nil {
+		return fmt.Errorf("write output: %w", err)
+	}
+	if len(ignored) > 0 {
+		if _, err := fmt.Fprintf(w, "Ignored: %s\n", strings.Join(ignored, ", ")); err != nil {
+			return fmt.Errorf("write output: %w", err)
+		}
+	}
+
+	existing, err := store.ListAppliances(false)
+	if err != nil {
+		return fmt.Errorf("list appliances: %w", err)
+	}
+	seen := make(map[string]bool, len(existing))
+	for _, a := range existing {
+		seen[strings.ToLower(a.Name)] = true
+	}
+
+	slog.Debug("import started",
+		"kind", "appliances", "rows", len(table.rows), "dry_run", dryRun)
+	reportRow := func(row importRow, err error) error {
+		slog.Debug("import row failed", "row", row.label, "error", err)
+		if _, werr := fmt.Fprintf(w, "%s: %v\n", row.label, err); werr != nil {
+			return fmt.Errorf("write output: %w", werr)
+		}
+		return nil
+	}
+	cur := store.Currency()
+	var duplicates, failed int
+	var rows []importRow
+	var items []data.Appliance
+	for _, row := range table.rows {
+		values := make(map[string]string, len(fields))
+		for i, f := range fields {
+			if f != "" && i < len(row.values) {
+				values[f] = row.values[i]
+			}
+		}
+		item, err := parseApplianceRow(values, cur)
+		if err != nil {
+			failed++
+			if err := reportRow(row, err); err != nil {
+				return err
+			}
+			continue
+		}
+		if seen[strings.ToLower(item.Name)] {
+			duplicates++
+			continue
+		}
+		seen[strings.ToLower(item.Name)] = true
+		rows = append(rows, row)
+		items = append(items, item)
+	}
+
+	if !dryRun && len(items) > 0 && confirm != nil {
+		if err := confirm(len(items)); err != nil {
+			return err
+		}
+	}
+	imported := len(items)
+	if !dryRun {
+		imported = 0
+		for i := range items {
+			if err := store.CreateAppliance(&items[i]); err != nil {
+				failed++
+				if err := reportRow(rows[i], err); err != nil {
+					return err
+				}
+				continue
+			}
+			imported++
+		}
+	}
+
+	verb := "Imported"
+	if dryRun {
+		verb = "Would import"
+	}
+	summary := fmt.Sprintf("%s %d %s", verb, imported, pluralize(imported, "appliance"))
+	if duplicates > 0 {
+		summary += fmt.Sprintf(
+			"; skipped %d already present", duplicates,
+		)
+	}
+	if _, err := fmt.Fprintln(w, summary+"."); err != nil {
+		return fmt.Errorf("write output: %w", err)
+	}
+	slog.Debug("import finished",
+		"kind", "appliances", "imported", imported, "skipped", duplicates,
+		"failed", failed, "dry_run", dryRun)
+	if failed > 0 {
+		return fmt.Errorf(
+			"%d of %d %s had errors and %s not imported",
+			failed, len(table.rows), pluralize(len(table.rows), "row"), wereOrWas(failed),
+		)
+	}
+	return nil
+}
+
+func pluralize(n int, noun string) string {
+	if n == 1 {
+		return noun
+	}
+	return noun + "s"
+}
+
+func wereOrWas(n int) string {
+	if n == 1 {
+		return "was"
+	}
+	return "were"
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/micasa-dev/micasa/internal/data"
+)
+
+// bundleNouns names a table's rows in the import summary, singular and
+// plural.
+var bundleNouns = map[string][2]string{
+	data.TableHouseProfiles:         {"house profile", "house profiles"},
+	data.TableProjectTypes:          {"project type", "project types"},
+	data.TableMaintenanceCategories: {"maintenance category", "maintenance categories"},
+	data.TableProjects:              {"project", "projects"},
+	data.TableQuotes:                {"quote", "quotes"},
+	data.TableMaintenanceItems:      {"maintenance item", "maintenance items"},
+	data.TableAppliances:            {"appliance", "appliances"},
+	data.TableVendors:               {"vendor", "vendors"},
+	data.TableServiceLogEntries:     {"service log entry", "service log entries"},
+}
+
+func readImportBundle(path string) (data.Bundle, error) {
+	var b data.Bundle
+	raw, err := os.ReadFile(data.ExpandHome(path)) //nolint:gosec // user-specified import file
+	if err != nil {
+		return b, fmt.Errorf("read %s: %w", path, err)
+	}
+	if err := json.Unmarshal(raw, &b); err != nil {
+		return b, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return b, nil
+}
+
+// writeImportSummary writes e.g. "Imported 12 projects, 4 vendors (2 new)."
+// A table's count is every row it brought in; "new" rows were inserted
+// rather than matched by name or replaced. Tables whose rows were all
+// matched or skipped, like the default project types, are left out.
+func writeImportSummary(w io.Writer, counts []data.ImportCount) error {
+	var parts []string
+	skipped := 0
+	for _, c := range counts {
+		skipped += c.Skipped
+		slog.Debug("import table",
+			"table", c.Table, "rows", c.Rows, "new", c.New, "matched", c.Matched,
+			"replaced", c.Replaced, "skipped", c.Skipped)
+		if c.New+c.Replaced == 0 {
+			continue
+		}
+		n := c.New + c.Matched + c.Replaced
+		noun := bundleNouns[c.Table]
+		part := fmt.Sprintf("%d %s", n, noun[1])
+		if n == 1 {
+			part = fmt.Sprintf("%d %s", n, noun[0])
+		}
+		var details []string
+		if c.New < n {
+			details = append(details, fmt.Sprintf("%d new", c.New))
+		}
+		if c.Replaced > 0 {
+			details = append(details, fmt.Sprintf("%d replaced", c.Replaced))
+		}
+		if len(details) > 0 {
+			part += " (" + strings.Join(details, ", ") + ")"
+		}
+		parts = append(parts, part)
+	}
+
+	summary := "Nothing imported"
+	if len(parts) > 0 {
+		summary = "Imported " + strings.Join(parts, ", ")
+	}
+	if skipped > 0 {
+		summary += fmt.Sprintf(
+			"; skipped %d %s already present (use --replace to overwrite)",
+			skipped, pluralize(skipped, "record"),
+		)
+	}
+	if _, err := fmt.Fprintln(w, summary+"."); err != nil {
+		return fmt.Errorf("write output: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeImportFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func listAppliances(t *testing.T, dbPath string) []data.Appliance {
+	t.Helper()
+	store, err := data.Open(dbPath)
+	require.NoError(t, err)
+	defer func() { _ = store.Close() }()
+	items, err := store.ListAppliances(false)
+	require.NoError(t, err)
+	return items
+}
+
+func TestImportAppliancesCSV(t *testing.T) {
+	t.Parallel()
+	db := createTestDB(t)
+	file := writeImportFile(t, "appliances.csv",
+		"\ufeffProduct,Manufacturer,Model #,Serial Number,Purchased,Warranty,Price,Color\n"+
+			"Dishwasher,Bosch,SHX878,FD1234,2024-03-15,2y,899.00,steel\n"+
+			"Fridge,LG,LRMVS3006S,,2023-06-01,2028-06-01,\"2,499.99\",black\n")
+
+	out, err := executeCLI("import", "appliances", "--yes", file, db)
+	require.NoError(t, err)
+	assert.Contains(t, out, "Product -> name")
+	assert.Contains(t, out, "Model # -> model")
+	assert.Contains(t, out, "Ignored: Color")
+	assert.Contains(t, out, "Imported 2 appliances.")
+
+	items := listAppliances(t, db)
+	require.Len(t, items, 2)
+	byName := map[string]data.Appliance{}
+	for _, a := range items {
+		byName[a.Name] = a
+	}
+	dw := byName["Dishwasher"]
+	assert.Equal(t, "Bosch", dw.Brand)
+	assert.Equal(t, "FD1234", dw.SerialNumber)
+	require.NotNil(t, dw.WarrantyExpiry)
+	assert.Equal(t, "2026-03-15", data.FormatDate(dw.WarrantyExpiry), "2y counts from purchase")
+	require.NotNil(t, dw.CostCents)
+	assert.Equal(t, int64(89900), *dw.CostCents)
+	assert.Equal(t, int64(249999), *byName["Fridge"].CostCents)
+}
+
+func TestImportAppliancesRowErrors(t *testing.T) {
+	t.Parallel()
+	db := createTestDB(t)
+	file := writeImportFile(t, "appliances.csv",
+		"name,purchase date,cost\n"+
+			"Washer,2024-01-10,650\n"+
+			"Dryer,not a date,600\n"+
+			",2024-01-10,10\n"+
+			"Water Heater,2022-05-01,lots\n")
+
+	out, err := executeCLI("import", "appliances", "--yes", file, db)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "3 of 4 rows had errors")
+	assert.Contains(t, out, "line 3: Purchase Date should be YYYY-MM-DD")
+	assert.Contains(t, out, "line 4: name is required")
+	assert.Contains(t, out, "line 5: Cost should look like")
+	assert.Contains(t, out, "Imported 1 appliance.")
+
+	items := listAppliances(t, db)
+	require.Len(t, items, 1, "valid rows are imported despite errors")
+	assert.Equal(t, "Washer", items[0].Name)
+
+	// Re-importing skips what's already there.
+	out, err = executeCLI("import", "appliances", "--yes", file, db)
+	require.Error(t, err)
+	assert.Contains(t, out, "Imported 0 appliances; skipped 1 already present.")
+	assert.Len(t, listAppliances(t, db), 1)
+}
+
+func TestImportAppliancesJSONWithMapping(t *testing.T) {
+	t.Parallel()
+	db := createTestDB(t)
+	file := writeImportFile(t, "appliances.json",
+		`[{"Unit": "Furnace", "brand": "Carrier", "Paid": 4200, "room": "Basement"},
+		  {"Unit": "Sump Pump", "notes": null}]`)
+
+	out, err := executeCLI("import", "appliances", "--yes", "--map", "Unit=name", "--map", "Paid=cost", file, db)
+	require.NoError(t, err)
+	assert.Contains(t, out, "Imported 2 appliances.")
+
+	items := listAppliances(t, db)
+	require.Len(t, items, 2)
+	for _, a := range items {
+		if a.Name == "Furnace" {
+			assert.Equal(t, "Basement", a.Location)
+			require.NotNil(t, a.CostCents)
+			assert.Equal(t, int64(420000), *a.CostCents)
+		}
+	}
+}
+
+func TestImportAppliancesDryRun(t *testing.T) {
+	t.Parallel()
+	db := createTestDB(t)
+	file := writeImportFile(t, "appliances.csv", "name\nMicrowave\n")
+
+	out, err := executeCLI("import", "appliances", "--dry-run", file, db)
+	require.NoError(t, err)
+	assert.Contains(t, out, "Would import 1 appliance.")
+	assert.Empty(t, listAppliances(t, db))
+}
+
+func TestImportAppliancesConfirms(t *testing.T) {
+	t.Parallel()
+	db := createTestDB(t)
+	file := writeImportFile(t, "appliances.csv", "name\nMicrowave\nToaster\n")
+
+	for _, answer := range []string{"", "n\n", "maybe\n"} {
+		_, err := executeCLIWithInput(answer, "import", "appliances", file, db)
+		require.ErrorIs(t, err, errDeclined, "answer %q", answer)
+		assert.Empty(t, listAppliances(t, db))
+	}
+
+	out, err := executeCLIWithInput("y\n", "import", "appliances", file, db)
+	require.NoError(t, err)
+	assert.Contains(t, out, "Imported 2 appliances.")
+	assert.Len(t, listAppliances(t, db), 2)
+
+	// Nothing new to add means nothing to confirm.
+	out, err = executeCLI("import", "appliances", file, db)
+	require.NoError(t, err)
+	assert.Contains(t, out, "skipped 2 already present")
+}
+
+func TestImportAppliancesMappingErrors(t *testing.T) {
+	t.Parallel()
+	db := createTestDB(t)
+
+	_, err := executeCLI("import", "appliances",
+		writeImportFile(t, "a.csv", "Thing,Brand\nOven,GE\n"), db)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no column maps to name")
+
+	_, err = executeCLI("import", "appliances",
+		writeImportFile(t, "b.csv", "Name,Brand,Make\nOven,GE,GE\n"), db)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"Brand" and "Make" both map to brand`)
+
+	_, err = executeCLI("import", "appliances", "--map", "Thing=color",
+		writeImportFile(t, "c.csv", "Thing\nOven\n"), db)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown field "color"`)
+}
+
+func TestNormalizeHeader(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "model", normalizeHeader("Model #"))
+	assert.Equal(t, "serial_number", normalizeHeader(" Serial-Number "))
+	assert.Equal(t, "purchase_date", normalizeHeader("Purchase Date"))
+}
@@ -0,0 +1,35 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package main
+
+import (
+	"io"
+	"log/slog"
+)
+
+// newJSONLogger writes one JSON object per event (time, level, msg, and
+// the event's fields) to w. Debug events are included so scripts see
+// routine progress, not just failures.
+func newJSONLogger(w io.Writer) *slog.Logger {
+	return slog.New(slog.NewJSONHandler(w, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+	}))
+}
+
+// setupLogging installs the JSON logger as the default when --json-logs
+// is set. Without it the default logger is left alone.
+func setupLogging(w io.Writer, jsonLogs bool) {
+	if jsonLogs {
+		slog.SetDefault(newJSONLogger(w))
+	}
+}
+
+// quietTUILogs silences plain log output while the TUI owns the terminal,
+// since stray lines on stderr would scribble over the screen. JSON logs
+// are kept: they are meant to be redirected to a file or pipe.
+func quietTUILogs() {
+	if _, ok := slog.Default().Handler().(*slog.JSONHandler); !ok {
+		slog.SetDefault(slog.New(slog.DiscardHandler))
+	}
+}
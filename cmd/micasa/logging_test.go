@@ -0,0 +1,89 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewJSONLogger(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	logger := newJSONLogger(&buf)
+
+	logger.Debug("extraction started", "file", "manual.pdf")
+	logger.Error("extraction failed", "error", errors.New("boom"))
+
+	var events []map[string]any
+	sc := bufio.NewScanner(&buf)
+	for sc.Scan() {
+		var ev map[string]any
+		require.NoError(t, json.Unmarshal(sc.Bytes(), &ev), sc.Text())
+		events = append(events, ev)
+	}
+	require.Len(t, events, 2, "debug events are kept")
+	assert.Equal(t, "DEBUG", events[0]["level"])
+	assert.Equal(t, "extraction started", events[0]["msg"])
+	assert.Equal(t, "manual.pdf", events[0]["file"])
+	assert.NotEmpty(t, events[0]["time"])
+	assert.Equal(t, "ERROR", events[1]["level"])
+	assert.Equal(t, "boom", events[1]["error"])
+}
+
+func TestJSONLogsImport(t *testing.T) {
+	t.Parallel()
+	bin := getTestBin(t)
+	db := createTestDB(t)
+	file := writeImportFile(t, "appliances.csv", "name,cost\nWasher,650\nDryer,lots\n")
+
+	cmd := exec.CommandContext(t.Context(), bin,
+		"--json-logs", "import", "appliances", "--yes", file, db)
+	cmd.Env = append(os.Environ(), "XDG_CONFIG_HOME="+t.TempDir())
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	stdout, err := cmd.Output()
+	require.Error(t, err, "one row fails")
+	assert.Contains(t, string(stdout), "Imported 1 appliance.")
+
+	byMsg := map[string]map[string]any{}
+	sc := bufio.NewScanner(&stderr)
+	for sc.Scan() {
+		var ev map[string]any
+		if json.Unmarshal(sc.Bytes(), &ev) != nil {
+			continue // fang's error banner is plain text
+		}
+		byMsg[ev["msg"].(string)] = ev
+	}
+	require.Contains(t, byMsg, "import started")
+	assert.InDelta(t, 2, byMsg["import started"]["rows"], 0)
+	require.Contains(t, byMsg, "import row failed")
+	assert.Equal(t, "line 3", byMsg["import row failed"]["row"])
+	require.Contains(t, byMsg, "import finished")
+	assert.InDelta(t, 1, byMsg["import finished"]["imported"], 0)
+	assert.InDelta(t, 1, byMsg["import finished"]["failed"], 0)
+}
+
+func TestNoJSONLogsByDefault(t *testing.T) {
+	t.Parallel()
+	bin := getTestBin(t)
+	db := createTestDB(t)
+	file := writeImportFile(t, "appliances.csv", "name\nWasher\n")
+
+	cmd := exec.CommandContext(t.Context(), bin, "import", "appliances", "--yes", file, db)
+	cmd.Env = append(os.Environ(), "XDG_CONFIG_HOME="+t.TempDir())
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	require.NoError(t, cmd.Run())
+	assert.NotContains(t, stderr.String(), "import started",
+		"routine events are debug-level and stay quiet without --json-logs")
+}
@@ -8,11 +8,15 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
+	"net/http"
 	"os"
 	"os/exec"
 	"os/signal"
 	"path/filepath"
 	"runtime/debug"
+	"text/tabwriter"
+	"time"
 
 	tea "charm.land/bubbletea/v2"
 	"charm.land/fang/v2"
@@ -21,16 +25,30 @@ import (
 	"github.com/micasa-dev/micasa/internal/config"
 	"github.com/micasa-dev/micasa/internal/data"
 	"github.com/micasa-dev/micasa/internal/extract"
+	"github.com/micasa-dev/micasa/internal/fxrates"
+	"github.com/micasa-dev/micasa/internal/locale"
 	"github.com/spf13/cobra"
 )
 
+// fxFetchTimeout bounds the startup exchange rate fetch so an unreachable
+// endpoint delays launch by at most this long.
+const fxFetchTimeout = 3 * time.Second
+
 // version is set at build time via -ldflags "-X main.version=...".
 var version = "dev"
 
 // runOpts holds flags for the root (TUI launcher) command.
 type runOpts struct {
-	dbPath    string
-	printPath bool
+	dbPath        string
+	profile       string
+	listProfiles  bool
+	printPath     bool
+	exportDocs    string
+	checkTools    bool
+	backupOnStart bool
+	seedFrom      string
+	due           bool
+	dueOpts       dueOpts
 }
 
 // demoOpts holds flags for the demo subcommand.
@@ -38,6 +56,7 @@ type demoOpts struct {
 	dbPath   string
 	years    int
 	seedOnly bool
+	yes      bool
 }
 
 // backupOpts holds flags for the backup subcommand.
@@ -49,6 +68,7 @@ type backupOpts struct {
 
 func newRootCmd() *cobra.Command {
 	opts := &runOpts{}
+	var jsonLogs bool
 
 	root := &cobra.Command{
 		Use:           data.AppName + " [database-path]",
@@ -57,6 +77,9 @@ func newRootCmd() *cobra.Command {
 		Args:          cobra.MaximumNArgs(1),
 		SilenceErrors: true,
 		SilenceUsage:  true,
+		PersistentPreRun: func(cmd *cobra.Command, _ []string) {
+			setupLogging(cmd.ErrOrStderr(), jsonLogs)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) > 0 {
 				opts.dbPath = args[0]
@@ -67,6 +90,28 @@ func newRootCmd() *cobra.Command {
 
 	root.Flags().
 		BoolVar(&opts.printPath, "print-path", false, "Print the resolved database path and exit")
+	root.Flags().
+		StringVar(&opts.profile, "profile", "", "Use the named profile's database (default: MICASA_PROFILE)")
+	root.Flags().
+		BoolVar(&opts.listProfiles, "list-profiles", false, "List profiles and their database paths, then exit")
+	root.Flags().
+		StringVar(&opts.exportDocs, "export-docs", "", "Write every document's file and a manifest.csv to `dir`, then exit")
+	root.Flags().
+		BoolVar(&opts.backupOnStart, "backup-on-start", false, "Back up the database before opening it (see [backup] in the config)")
+	root.Flags().
+		StringVar(&opts.seedFrom, "seed-from", "", "Add the project types and maintenance categories in the [seed] section of TOML `file` to the built-ins")
+	root.Flags().
+		BoolVar(&opts.checkTools, "check-tools", false, "Report OCR tools and LLM reachability; exit non-zero if a required one is missing")
+
+	root.Flags().
+		BoolVar(&opts.due, "due", false, "Print overdue and upcoming maintenance and expiring warranties, then exit")
+	root.Flags().
+		StringVar(&opts.dueOpts.within, "within", "", "With --due, how far ahead to look, e.g. 30d, 2w, 3m (default: the dashboard's windows)")
+	root.Flags().
+		BoolVar(&opts.dueOpts.json, "json", false, "With --due or --check-tools, output as JSON")
+
+	root.PersistentFlags().
+		BoolVar(&jsonLogs, "json-logs", false, "Write structured JSON log events to stderr")
 
 	root.AddCommand(
 		newDemoCmd(),
@@ -76,6 +121,9 @@ func newRootCmd() *cobra.Command {
 		newMCPCmd(),
 		newShowCmd(),
 		newQueryCmd(),
+		newImportCmd(),
+		newExportCmd(),
+		newChecklistCmd(),
 		newGenCLIRefCmd(),
 	)
 
@@ -99,6 +147,15 @@ func main() {
 }
 
 func runTUI(w io.Writer, opts *runOpts) error {
+	if opts.listProfiles {
+		return printProfiles(w)
+	}
+	if opts.checkTools {
+		if opts.dueOpts.within != "" {
+			return errors.New("--within requires --due")
+		}
+		return runCheckTools(w, opts.dueOpts.json)
+	}
 	dbPath, err := opts.resolveDBPath()
 	if err != nil {
 		return fmt.Errorf("resolve db path: %w", err)
@@ -107,7 +164,34 @@ func runTUI(w io.Writer, opts *runOpts) error {
 		_, _ = fmt.Fprintln(w, dbPath)
 		return nil
 	}
-	return launchTUI(dbPath, nil)
+	if opts.exportDocs != "" {
+		return exportDocuments(w, dbPath, opts.exportDocs)
+	}
+	if !opts.due && (opts.dueOpts.within != "" || opts.dueOpts.json) {
+		return errors.New("--within and --json require --due or --check-tools")
+	}
+	if opts.due {
+		return printDueReport(w, dbPath, time.Now(), opts.dueOpts)
+	}
+	return launchTUI(dbPath, nil, opts.backupOnStart, opts.seedFrom)
+}
+
+// seedNames returns the project types and maintenance categories to seed
+// on top of the built-ins: the config's [seed] section, then the one in
+// the --seed-from file.
+func seedNames(cfg config.Config, seedFrom string) (data.SeedNames, error) {
+	names := cfg.Seed.Names()
+	if seedFrom == "" {
+		return names, nil
+	}
+	extra, err := config.LoadSeed(data.ExpandHome(seedFrom))
+	if err != nil {
+		return names, fmt.Errorf("--seed-from: %w", err)
+	}
+	more := extra.Names()
+	names.ProjectTypes = append(names.ProjectTypes, more.ProjectTypes...)
+	names.MaintenanceCategories = append(names.MaintenanceCategories, more.MaintenanceCategories...)
+	return names, nil
 }
 
 // seedOpts controls optional demo-data seeding passed from the demo
@@ -135,25 +219,35 @@ func seedStore(store *data.Store, seed *seedOpts) error {
 	return nil
 }
 
-func launchTUI(dbPath string, seed *seedOpts) error {
+func launchTUI(dbPath string, seed *seedOpts, backupOnStart bool, seedFrom string) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+	names, err := seedNames(cfg, seedFrom)
+	if err != nil {
+		return err
+	}
+
 	store, err := data.Open(dbPath)
 	if err != nil {
 		return fmt.Errorf("open database: %w", err)
 	}
-	if err := store.AutoMigrate(); err != nil {
+	if backupOnStart || cfg.Backup.IsOnStartEnabled() {
+		if err := backupOnOpen(store, dbPath, cfg.Backup); err != nil {
+			return err
+		}
+	}
+	if err := migrateWithPrompt(store, dbPath, cfg.Backup.Keep, os.Stdin, os.Stderr); err != nil {
 		return fmt.Errorf("migrate database: %w", err)
 	}
-	if err := store.SeedDefaults(); err != nil {
+	if err := store.SeedDefaultsWith(names); err != nil {
 		return fmt.Errorf("seed defaults: %w", err)
 	}
 	if err := seedStore(store, seed); err != nil {
 		return err
 	}
 
-	cfg, err := config.Load()
-	if err != nil {
-		return fmt.Errorf("load config: %w", err)
-	}
 	if len(cfg.Warnings) > 0 {
 		isDark := lipgloss.HasDarkBackground(os.Stdin, os.Stderr)
 		warnColor := "#F0E442" // Wong yellow (dark bg)
@@ -168,6 +262,10 @@ func launchTUI(dbPath string, seed *seedOpts) error {
 	if err := store.SetMaxDocumentSize(cfg.Documents.MaxFileSize.Bytes()); err != nil {
 		return fmt.Errorf("configure document size limit: %w", err)
 	}
+	if err := configureBlobStorage(store, dbPath, cfg.Documents); err != nil {
+		return err
+	}
+	store.SetLastServicedSync(cfg.Maintenance.IsSyncLastServicedEnabled())
 	cacheDir, err := data.DocumentCacheDir()
 	if err != nil {
 		return fmt.Errorf("resolve document cache directory: %w", err)
@@ -175,17 +273,59 @@ func launchTUI(dbPath string, seed *seedOpts) error {
 	if _, err := data.EvictStaleCache(cacheDir, cfg.Documents.CacheTTLDuration()); err != nil {
 		return fmt.Errorf("evict stale cache: %w", err)
 	}
+	pageCacheDir, err := data.PageCacheDir()
+	if err != nil {
+		return fmt.Errorf("resolve page cache directory: %w", err)
+	}
+	if _, err := data.EvictStaleCache(pageCacheDir, cfg.Documents.CacheTTLDuration()); err != nil {
+		return fmt.Errorf("evict stale page cache: %w", err)
+	}
 
+	store.SetCurrencyFormats(cfg.Locale.CurrencyFormats())
 	if err := store.ResolveCurrency(cfg.Locale.Currency); err != nil {
 		return fmt.Errorf("resolve currency: %w", err)
 	}
+	cur := store.Currency().
+		WithRounding(cfg.Locale.RoundingMode()).
+		WithSymbolSpacing(cfg.Locale.SymbolSpacingMode())
+	if cfg.Locale.IsNumericInputEnabled() {
+		cur = cur.WithInputLocale(locale.DetectNumericLocale())
+	}
+	store.SetCurrency(cur)
+
+	display, err := locale.ParseDisplay(cfg.UI.Locale)
+	if err != nil {
+		return fmt.Errorf("resolve ui locale: %w", err)
+	}
 
 	appOpts := app.Options{
-		DBPath:          dbPath,
-		ConfigPath:      config.Path(),
-		FilePickerDir:   cfg.Documents.ResolvedFilePickerDir(),
-		AddressAutofill: cfg.Address.IsAutofillEnabled(),
-		AddressCountry:  config.DetectCountry(),
+		DBPath:               dbPath,
+		ConfigPath:           config.Path(),
+		FilePickerDir:        cfg.Documents.ResolvedFilePickerDir(),
+		AutoLinkDocuments:    cfg.Documents.IsAutoLinkEnabled(),
+		DocumentTitles:       cfg.Documents.Title.Rules(),
+		DocStorageWarning:    cfg.Documents.StorageWarning.Bytes(),
+		AddressAutofill:      cfg.Address.IsAutofillEnabled(),
+		AddressCountry:       config.DetectCountry(),
+		MaintenanceGraceDays: cfg.Dashboard.MaintenanceGraceDays,
+		SnoozeDays:           cfg.Dashboard.SnoozeDays,
+		WarrantyWindow:       cfg.Dashboard.WarrantyWindow(),
+		DueSummary:           true,
+		IntervalUnit:         data.IntervalUnit(cfg.Maintenance.IntervalUnit),
+		DefaultPerformer:     cfg.Maintenance.DefaultPerformer,
+		ReviewChanges:        cfg.UI.IsReviewChangesEnabled(),
+		Display:              display,
+		CurrencyRates:        conversionRates(cfg.Currency, cur.Code()),
+		IdleLock:             cfg.UI.IdleLockDuration(),
+		NoteLines:            cfg.UI.NoteLines,
+		StatusSegments:       cfg.UI.StatusSegments(),
+		CompactStatus:        cfg.UI.CompactStatus,
+		MinWidth:             cfg.UI.MinWidth,
+		MinHeight:            cfg.UI.MinHeight,
+		DisableMouse:         !cfg.UI.IsMouseEnabled(),
+		HomeKey:              cfg.UI.HomeKey,
+		DefaultSorts:         cfg.Sort.ByTab(),
+		FormFields:           formFields(cfg.Forms),
 	}
 
 	chatLLM := cfg.Chat.LLM
@@ -196,6 +336,9 @@ func launchTUI(dbPath string, seed *seedOpts) error {
 		chatLLM.Model,
 		chatLLM.APIKey,
 		chatLLM.ExtraContext,
+		chatLLM.AnswerLanguage,
+		chatLLM.MaxSchemaTokens,
+		chatLLM.ContextTokens,
 		chatLLM.TimeoutDuration(),
 		chatLLM.Effort,
 	)
@@ -206,17 +349,28 @@ func launchTUI(dbPath string, seed *seedOpts) error {
 		0, // pdftotext uses its own internal default timeout (30s)
 		cfg.Extraction.OCR.IsEnabled(),
 	)
+	if cfg.Extraction.OCR.IsCacheEnabled() {
+		extractors = extract.WithPageCache(extractors, pageCacheDir)
+	}
+	if cfg.Extraction.OCR.IsAutoDetectLangEnabled() {
+		extractors = extract.WithLangDetection(extractors)
+	}
 	appOpts.SetExtraction(
 		exLLM.Provider,
 		exLLM.BaseURL,
 		exLLM.Model,
 		exLLM.APIKey,
 		exLLM.TimeoutDuration(),
-		exLLM.Effort,
+		cfg.Extraction.Effort(),
 		extractors,
 		exLLM.IsEnabled(),
 		cfg.Extraction.OCR.TSV.IsEnabled(),
 		cfg.Extraction.OCR.TSV.Threshold(),
+		exLLM.MaxInputChars,
+		exLLM.ContextTokens,
+		cfg.Extraction.Prompts,
+		exLLM.RunsOnImport(),
+		cfg.Extraction.Strict,
 	)
 
 	tryLoadSyncConfig(store, &appOpts)
@@ -229,6 +383,7 @@ func launchTUI(dbPath string, seed *seedOpts) error {
 	fmt.Fprint(os.Stderr, "\033[22;2t\033]2;micasa\007")
 	defer fmt.Fprint(os.Stderr, "\033[23;2t")
 
+	quietTUILogs()
 	_, err = tea.NewProgram(model).Run()
 	if err != nil {
 		return fmt.Errorf("running program: %w", err)
@@ -236,16 +391,127 @@ func launchTUI(dbPath string, seed *seedOpts) error {
 	return nil
 }
 
+// formFields converts the [forms] config to the UI's per-type field lists.
+func formFields(cfg config.Forms) map[string]app.FormFields {
+	byType := cfg.ByType()
+	forms := make(map[string]app.FormFields, len(byType))
+	for k, f := range byType {
+		forms[k] = app.FormFields{Add: f.AddFields(), Required: f.RequiredFields()}
+	}
+	return forms
+}
+
+// conversionRates returns the rates for approximate cross-currency totals.
+// With fetching enabled, today's rates for the base currency come from the
+// rates endpoint or its on-disk cache; the configured static rates are the
+// fallback when neither has any.
+func conversionRates(cfg config.Currency, house string) locale.Rates {
+	static := cfg.ConversionRates()
+	if !cfg.IsFetchRatesEnabled() {
+		return static
+	}
+	cachePath, err := data.FXRatesCachePath()
+	if err != nil {
+		slog.Warn("resolve rates cache", "error", err)
+		return static
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), fxFetchTimeout)
+	defer cancel()
+	base := cfg.ResolvedBase(house)
+	snap, err := fxrates.Current(ctx, &http.Client{}, cfg.RatesURL, base, cachePath, time.Now())
+	if err != nil {
+		slog.Warn("fetch exchange rates", "url", cfg.RatesURL, "error", err)
+	}
+	if len(snap.Rates) == 0 {
+		return static
+	}
+	return snap.Conversion()
+}
+
+// configureBlobStorage points the store at the document file directory and
+// moves existing document contents to the configured backend. In-memory
+// databases always keep their documents in the database.
+func configureBlobStorage(store *data.Store, dbPath string, cfg config.Documents) error {
+	if dbPath == ":memory:" {
+		return nil
+	}
+	store.SetBlobStorage(blobDir(dbPath, cfg), cfg.IsFileStorage())
+	move := store.MoveDocumentBlobsToDatabase
+	if cfg.IsFileStorage() {
+		move = store.MoveDocumentBlobsToFiles
+	}
+	if _, err := move(); err != nil {
+		return fmt.Errorf("move document files: %w", err)
+	}
+	return nil
+}
+
+// blobDir returns the directory that holds document files for dbPath.
+func blobDir(dbPath string, cfg config.Documents) string {
+	if cfg.BlobDir != "" {
+		return data.ExpandHome(cfg.BlobDir)
+	}
+	return data.DefaultBlobDir(dbPath)
+}
+
+// backupOnOpen takes a rotating startup backup of an existing database.
+// Fresh and in-memory databases have nothing worth saving and are skipped.
+func backupOnOpen(store *data.Store, dbPath string, cfg config.Backup) error {
+	if dbPath == ":memory:" {
+		return nil
+	}
+	ok, err := store.IsMicasaDB()
+	if err != nil {
+		return fmt.Errorf("check database schema: %w", err)
+	}
+	if !ok {
+		return nil
+	}
+	if _, err := store.BackupTo(
+		context.Background(), dbPath, cfg.ResolvedDir(dbPath), cfg.Keep,
+	); err != nil {
+		return fmt.Errorf("backup on start: %w", err)
+	}
+	return nil
+}
+
 // resolveDBPath returns the database path to use. Precedence:
 // 1. Explicit positional arg (opts.dbPath)
-// 2. data.DefaultDBPath(), which honors MICASA_DB_PATH env var internally.
+// 2. --profile (opts.profile)
+// 3. data.DefaultDBPath(), which honors the MICASA_DB_PATH and
+// MICASA_PROFILE env vars internally.
 func (opts *runOpts) resolveDBPath() (string, error) {
+	if opts.dbPath != "" && opts.profile != "" {
+		return "", errors.New("pass a database path or --profile, not both")
+	}
 	if opts.dbPath != "" {
 		return data.ExpandHome(opts.dbPath), nil
 	}
+	if opts.profile != "" {
+		return data.ProfileDBPath(opts.profile)
+	}
 	return data.DefaultDBPath()
 }
 
+// printProfiles lists each profile's name and database path.
+func printProfiles(w io.Writer) error {
+	names, err := data.ListProfiles()
+	if err != nil {
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, name := range names {
+		path, err := data.ProfileDBPath(name)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(tw, "%s\t%s\n", name, path); err != nil {
+			return fmt.Errorf("write profiles: %w", err)
+		}
+	}
+	return tw.Flush()
+}
+
 func newDemoCmd() *cobra.Command {
 	opts := &demoOpts{}
 
@@ -256,11 +522,11 @@ func newDemoCmd() *cobra.Command {
 		Args:          cobra.MaximumNArgs(1),
 		SilenceErrors: true,
 		SilenceUsage:  true,
-		RunE: func(_ *cobra.Command, args []string) error {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) > 0 {
 				opts.dbPath = args[0]
 			}
-			return runDemo(opts)
+			return runDemo(cmd.InOrStdin(), cmd.ErrOrStderr(), opts)
 		},
 	}
 
@@ -268,6 +534,8 @@ func newDemoCmd() *cobra.Command {
 		IntVar(&opts.years, "years", 0, "Generate N years of simulated home ownership data")
 	cmd.Flags().
 		BoolVar(&opts.seedOnly, "seed-only", false, "Seed data and exit without launching the TUI")
+	cmd.Flags().
+		BoolVarP(&opts.yes, "yes", "y", false, "With --seed-only, don't ask before adding sample data to an existing database")
 
 	return cmd
 }
@@ -281,29 +549,38 @@ func (opts *demoOpts) resolveDBPath() string {
 	return ":memory:"
 }
 
-func runDemo(opts *demoOpts) error {
+func runDemo(in io.Reader, out io.Writer, opts *demoOpts) error {
 	if opts.years < 0 {
 		return errors.New("--years must be non-negative")
 	}
 	if opts.seedOnly {
-		return runSeedOnly(opts)
+		return runSeedOnly(in, out, opts)
 	}
 	// Non-nil seedOpts always triggers demo seeding; years==0 seeds the
 	// small fixed demo, years>0 seeds N years of scaled data.
-	return launchTUI(opts.resolveDBPath(), &seedOpts{years: opts.years})
+	return launchTUI(opts.resolveDBPath(), &seedOpts{years: opts.years}, false, "")
 }
 
-func runSeedOnly(opts *demoOpts) error {
+func runSeedOnly(in io.Reader, out io.Writer, opts *demoOpts) error {
 	dbPath := opts.resolveDBPath()
 	if dbPath == ":memory:" {
 		return errors.New("--seed-only requires a database path")
 	}
+	// Seeding a fresh file is harmless; mixing fictitious records into an
+	// existing database is not.
+	if _, err := os.Stat(dbPath); err == nil {
+		if err := confirm(in, out, opts.yes, fmt.Sprintf(
+			"This will add sample data to the existing database %s.", dbPath,
+		)); err != nil {
+			return err
+		}
+	}
 	store, err := data.Open(dbPath)
 	if err != nil {
 		return fmt.Errorf("open database: %w", err)
 	}
 	defer func() { _ = store.Close() }()
-	if err := store.AutoMigrate(); err != nil {
+	if err := migrateOrRefuse(store, dbPath); err != nil {
 		return fmt.Errorf("migrate database: %w", err)
 	}
 	if err := store.SeedDefaults(); err != nil {
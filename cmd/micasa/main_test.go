@@ -6,6 +6,7 @@ package main
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -18,6 +19,8 @@ import (
 	"time"
 
 	"charm.land/fang/v2"
+	"github.com/adrg/xdg"
+	"github.com/micasa-dev/micasa/internal/config"
 	"github.com/micasa-dev/micasa/internal/data"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -34,8 +37,15 @@ func TestMain(m *testing.M) {
 // executeCLI runs the CLI in-process with the given args and returns
 // captured stdout and any error.
 func executeCLI(args ...string) (string, error) {
+	return executeCLIWithInput("", args...)
+}
+
+// executeCLIWithInput is executeCLI with stdin reading from input, for
+// commands that ask before changing anything.
+func executeCLIWithInput(input string, args ...string) (string, error) {
 	root := newRootCmd()
 	var stdout bytes.Buffer
+	root.SetIn(strings.NewReader(input))
 	root.SetOut(&stdout)
 	root.SetErr(io.Discard)
 	root.SetArgs(args)
@@ -390,3 +400,167 @@ func noopEditor() string {
 	}
 	return "true"
 }
+
+func TestBackupOnOpen(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ExistingDB", func(t *testing.T) {
+		t.Parallel()
+		src := createTestDB(t)
+		store, err := data.Open(src)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = store.Close() })
+
+		dir := filepath.Join(t.TempDir(), "snapshots")
+		require.NoError(t, backupOnOpen(store, src, config.Backup{Keep: 3, Dir: dir}))
+
+		backups, err := filepath.Glob(filepath.Join(dir, "source-*.db"))
+		require.NoError(t, err)
+		assert.Len(t, backups, 1)
+	})
+
+	t.Run("FreshDBSkipped", func(t *testing.T) {
+		t.Parallel()
+		path := filepath.Join(t.TempDir(), "new.db")
+		store, err := data.Open(path)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = store.Close() })
+
+		require.NoError(t, backupOnOpen(store, path, config.Backup{Keep: 3}))
+		assert.NoDirExists(t, filepath.Join(filepath.Dir(path), "backups"))
+	})
+}
+
+func TestSeedNames(t *testing.T) {
+	t.Parallel()
+	cfg := config.Config{Seed: config.Seed{ProjectTypes: "Pool"}}
+
+	names, err := seedNames(cfg, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Pool"}, names.ProjectTypes)
+
+	file := filepath.Join(t.TempDir(), "seed.toml")
+	require.NoError(t, os.WriteFile(file, []byte(
+		"[seed]\nproject_types = \"Solar\"\nmaintenance_categories = \"Pool, Solar\"\n",
+	), 0o600))
+	names, err = seedNames(cfg, file)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Pool", "Solar"}, names.ProjectTypes, "the file extends the config")
+	assert.Equal(t, []string{"Pool", "Solar"}, names.MaintenanceCategories)
+
+	_, err = seedNames(cfg, filepath.Join(t.TempDir(), "nope.toml"))
+	require.ErrorContains(t, err, "--seed-from")
+}
+
+func TestDueFlag(t *testing.T) {
+	t.Parallel()
+	path := createTestDB(t)
+
+	out, err := executeCLI("--due", path)
+	require.NoError(t, err)
+	assert.Equal(t, "Nothing due.\n", out)
+
+	store, err := data.Open(path)
+	require.NoError(t, err)
+	cats, err := store.MaintenanceCategories()
+	require.NoError(t, err)
+	last := time.Now().AddDate(-1, 0, 0)
+	require.NoError(t, store.CreateMaintenance(&data.MaintenanceItem{
+		Name: "Gutters", CategoryID: cats[0].ID, LastServicedAt: &last, IntervalMonths: 6,
+	}))
+	require.NoError(t, store.Close())
+
+	out, err = executeCLI("--due", path)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(out, "1 overdue\n\n=== OVERDUE ===\n"), out)
+	assert.Contains(t, out, "Gutters")
+	assert.Contains(t, out, "days overdue")
+	assert.NotContains(t, out, "UPCOMING")
+}
+
+func TestDueFlagWithinAndJSON(t *testing.T) {
+	t.Parallel()
+	path := createTestDB(t)
+	store, err := data.Open(path)
+	require.NoError(t, err)
+	cats, err := store.MaintenanceCategories()
+	require.NoError(t, err)
+	due := time.Now().AddDate(0, 0, 45)
+	require.NoError(t, store.CreateMaintenance(&data.MaintenanceItem{
+		Name: "Chimney sweep", CategoryID: cats[0].ID, DueDate: &due,
+	}))
+	require.NoError(t, store.Close())
+
+	out, err := executeCLI("--due", path)
+	require.NoError(t, err)
+	assert.Equal(t, "Nothing due.\n", out, "45 days out is past the default window")
+
+	out, err = executeCLI("--due", "--within", "60d", path)
+	require.NoError(t, err)
+	assert.Contains(t, out, "1 due soon")
+	assert.Contains(t, out, "=== UPCOMING ===")
+	assert.Contains(t, out, "Chimney sweep")
+
+	out, err = executeCLI("--due", "--within", "2m", "--json", path)
+	require.NoError(t, err)
+	var report map[string][]map[string]any
+	require.NoError(t, json.Unmarshal([]byte(out), &report))
+	assert.Empty(t, report["overdue"])
+	assert.Empty(t, report["warranties"])
+	require.Len(t, report["upcoming"], 1)
+	assert.Equal(t, "Chimney sweep", report["upcoming"][0]["name"])
+	assert.Equal(t, due.Format("2006-01-02"), report["upcoming"][0]["next_due"])
+	assert.InDelta(t, 45, report["upcoming"][0]["days"], 1)
+}
+
+func TestDueFlagErrors(t *testing.T) {
+	t.Parallel()
+	path := createTestDB(t)
+	_, err := executeCLI("--within", "30d", path)
+	require.ErrorContains(t, err, "require --due")
+	_, err = executeCLI("--json", path)
+	require.ErrorContains(t, err, "require --due")
+	_, err = executeCLI("--due", "--within", "soon", path)
+	require.ErrorContains(t, err, "--within")
+}
+
+func TestResolveDBPath_Profile(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("XDG_DATA_HOME", home)
+	xdg.Reload()
+	t.Cleanup(xdg.Reload)
+	t.Setenv("MICASA_DB_PATH", "/env/override.db")
+
+	opts := runOpts{profile: "rental"}
+	got, err := opts.resolveDBPath()
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(home, "micasa", "profiles", "rental.db"), got,
+		"--profile wins over MICASA_DB_PATH")
+
+	opts = runOpts{profile: "rental", dbPath: "/explicit.db"}
+	_, err = opts.resolveDBPath()
+	require.ErrorContains(t, err, "not both")
+
+	opts = runOpts{profile: "bad name"}
+	_, err = opts.resolveDBPath()
+	require.Error(t, err)
+}
+
+func TestListProfilesFlag(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("XDG_DATA_HOME", home)
+	xdg.Reload()
+	t.Cleanup(xdg.Reload)
+
+	out, err := executeCLI("--list-profiles")
+	require.NoError(t, err)
+	assert.Empty(t, out)
+
+	path, err := data.ProfileDBPath("house")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, nil, 0o600))
+
+	out, err = executeCLI("--list-profiles")
+	require.NoError(t, err)
+	assert.Equal(t, "house  "+path+"\n", out)
+}
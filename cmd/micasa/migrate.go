@@ -0,0 +1,93 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/micasa-dev/micasa/internal/data"
+)
+
+// errMigrationDeclined is returned when the user answers no to the
+// migration prompt.
+var errMigrationDeclined = errors.New(
+	"migration cancelled -- the database was left unchanged",
+)
+
+// errMigrationRequired is returned by commands that cannot prompt when the
+// database is on an older schema.
+var errMigrationRequired = errors.New("database needs a schema migration")
+
+// migrateWithPrompt migrates the store, asking first when an existing
+// database is on an older schema. On yes it backs the database up next to
+// dbPath, keeping the newest keepN such backups (0 keeps all), migrates,
+// and reports the new version. Fresh and current
+// databases migrate without a prompt; databases from a newer binary are
+// refused with *data.SchemaTooNewError.
+func migrateWithPrompt(
+	store *data.Store,
+	dbPath string,
+	keepN int,
+	in io.Reader,
+	out io.Writer,
+) error {
+	plan, err := store.PendingMigration()
+	if err != nil {
+		return err
+	}
+	if !plan.Needed() || dbPath == ":memory:" {
+		return store.AutoMigrate()
+	}
+
+	backupPath := data.MigrationBackupPath(dbPath, plan.From, time.Now())
+	_, _ = fmt.Fprintf(out,
+		"%s uses schema version %d; this micasa uses version %d.\nChanges:\n",
+		dbPath, plan.From, plan.To,
+	)
+	for i, c := range plan.Changes {
+		_, _ = fmt.Fprintf(out, "  v%d: %s\n", plan.From+i+1, c)
+	}
+	_, _ = fmt.Fprintf(out, "A backup will be saved to %s first.\nMigrate now? [y/N] ", backupPath)
+
+	if !answeredYes(in) {
+		return errMigrationDeclined
+	}
+
+	if err := store.Backup(context.Background(), backupPath); err != nil {
+		return fmt.Errorf("back up before migrating: %w", err)
+	}
+	if err := data.RotateMigrationBackups(dbPath, keepN); err != nil {
+		return fmt.Errorf("prune old migration backups: %w", err)
+	}
+	if err := store.AutoMigrate(); err != nil {
+		return fmt.Errorf("%w -- restore from %s if the database is damaged", err, backupPath)
+	}
+	_, _ = fmt.Fprintf(out,
+		"Migrated to schema version %d (was %d). Backup: %s\n",
+		plan.To, plan.From, backupPath,
+	)
+	return nil
+}
+
+// migrateOrRefuse migrates fresh and current databases like
+// migrateWithPrompt, but refuses an existing database on an older schema
+// rather than migrating it without a prompt or backup. Commands that run
+// unattended use it so that only the interactive path changes the schema.
+func migrateOrRefuse(store *data.Store, dbPath string) error {
+	plan, err := store.PendingMigration()
+	if err != nil {
+		return err
+	}
+	if plan.Needed() && dbPath != ":memory:" {
+		return fmt.Errorf(
+			"%w: %s uses schema version %d, this micasa uses version %d -- run `micasa %s` to review the changes and migrate with a backup",
+			errMigrationRequired, dbPath, plan.From, plan.To, dbPath,
+		)
+	}
+	return store.AutoMigrate()
+}
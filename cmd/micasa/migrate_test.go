@@ -0,0 +1,130 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// openAtSchemaVersion opens a migrated test database after rewriting its
+// stored schema version.
+func openAtSchemaVersion(t *testing.T, v int) (*data.Store, string) {
+	t.Helper()
+	path := createTestDB(t)
+	store, err := data.Open(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+	require.NoError(t, store.GormDB().Exec(fmt.Sprintf("PRAGMA user_version = %d", v)).Error)
+	return store, path
+}
+
+func TestMigrateWithPrompt_Accept(t *testing.T) {
+	t.Parallel()
+	store, path := openAtSchemaVersion(t, 0)
+	var out bytes.Buffer
+
+	require.NoError(t, migrateWithPrompt(store, path, 0, strings.NewReader("y\n"), &out))
+
+	v, err := store.StoredSchemaVersion()
+	require.NoError(t, err)
+	assert.Equal(t, data.SchemaVersion, v)
+	assert.Contains(t, out.String(), "uses schema version 0")
+	assert.Contains(t, out.String(), "Migrate now? [y/N]")
+	assert.Contains(t, out.String(), fmt.Sprintf("Migrated to schema version %d", data.SchemaVersion))
+
+	backups, err := filepath.Glob(path + ".schema-v0-*.backup")
+	require.NoError(t, err)
+	assert.Len(t, backups, 1, "a backup is written before migrating")
+}
+
+func TestMigrateWithPrompt_Decline(t *testing.T) {
+	t.Parallel()
+	for _, answer := range []string{"n\n", "\n", ""} {
+		store, path := openAtSchemaVersion(t, 0)
+
+		err := migrateWithPrompt(store, path, 0, strings.NewReader(answer), &bytes.Buffer{})
+
+		require.ErrorIs(t, err, errMigrationDeclined)
+		v, err := store.StoredSchemaVersion()
+		require.NoError(t, err)
+		assert.Zero(t, v, "declining leaves the database alone")
+		backups, err := filepath.Glob(path + ".schema-v0-*.backup")
+		require.NoError(t, err)
+		assert.Empty(t, backups)
+	}
+}
+
+func TestMigrateWithPrompt_CurrentSkipsPrompt(t *testing.T) {
+	t.Parallel()
+	store, path := openAtSchemaVersion(t, data.SchemaVersion)
+	var out bytes.Buffer
+
+	require.NoError(t, migrateWithPrompt(store, path, 0, strings.NewReader(""), &out))
+	assert.Empty(t, out.String())
+}
+
+func TestMigrateWithPrompt_NewerRefused(t *testing.T) {
+	t.Parallel()
+	store, path := openAtSchemaVersion(t, data.SchemaVersion+1)
+	var out bytes.Buffer
+
+	err := migrateWithPrompt(store, path, 0, strings.NewReader("y\n"), &out)
+
+	var tooNew *data.SchemaTooNewError
+	require.ErrorAs(t, err, &tooNew)
+	assert.Empty(t, out.String(), "no prompt for a database from a newer binary")
+}
+
+func TestMigrateWithPrompt_PrunesOldBackups(t *testing.T) {
+	t.Parallel()
+	store, path := openAtSchemaVersion(t, 0)
+	old := path + ".schema-v0-20240101-080000.backup"
+	require.NoError(t, os.WriteFile(old, nil, 0o600))
+
+	require.NoError(t, migrateWithPrompt(store, path, 1, strings.NewReader("y\n"), &bytes.Buffer{}))
+
+	assert.NoFileExists(t, old)
+	backups, err := filepath.Glob(path + ".schema-v0-*.backup")
+	require.NoError(t, err)
+	assert.Len(t, backups, 1)
+}
+
+func TestMigrateOrRefuse_OlderRefused(t *testing.T) {
+	t.Parallel()
+	store, path := openAtSchemaVersion(t, 0)
+
+	err := migrateOrRefuse(store, path)
+
+	require.ErrorIs(t, err, errMigrationRequired)
+	assert.ErrorContains(t, err, "uses schema version 0")
+	v, err := store.StoredSchemaVersion()
+	require.NoError(t, err)
+	assert.Zero(t, v, "refusing leaves the database alone")
+}
+
+func TestMigrateOrRefuse_CurrentMigrates(t *testing.T) {
+	t.Parallel()
+	store, path := openAtSchemaVersion(t, data.SchemaVersion)
+
+	require.NoError(t, migrateOrRefuse(store, path))
+}
+
+func TestOpenExisting_RefusesOlderSchema(t *testing.T) {
+	t.Parallel()
+	store, path := openAtSchemaVersion(t, 0)
+	require.NoError(t, store.Close())
+
+	_, err := openExisting(path)
+
+	require.ErrorIs(t, err, errMigrationRequired)
+}
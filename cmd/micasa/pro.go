@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/dustin/go-humanize"
+	"github.com/micasa-dev/micasa/internal/config"
 	"github.com/micasa-dev/micasa/internal/crypto"
 	"github.com/micasa-dev/micasa/internal/data"
 	"github.com/micasa-dev/micasa/internal/sync"
@@ -135,10 +136,19 @@ func openAndMigrate(dbPath string) (*data.Store, error) {
 	if err != nil {
 		return nil, fmt.Errorf("open database: %w", err)
 	}
-	if err := store.AutoMigrate(); err != nil {
+	if err := migrateOrRefuse(store, resolved); err != nil {
 		_ = store.Close()
 		return nil, fmt.Errorf("migrate database: %w", err)
 	}
+	cfg, err := config.Load()
+	if err != nil {
+		_ = store.Close()
+		return nil, fmt.Errorf("load config: %w", err)
+	}
+	if err := configureBlobStorage(store, resolved, cfg.Documents); err != nil {
+		_ = store.Close()
+		return nil, err
+	}
 	return store, nil
 }
 
@@ -793,25 +803,28 @@ func runProDevicesList(dbPath string) error {
 }
 
 func newProDevicesRevokeCmd() *cobra.Command {
-	return &cobra.Command{
+	var yes bool
+	cmd := &cobra.Command{
 		Use:           "revoke <device-id> [database-path]",
 		Short:         "Revoke a device",
 		Args:          cobra.RangeArgs(1, 2),
 		SilenceErrors: true,
 		SilenceUsage:  true,
-		RunE: func(_ *cobra.Command, args []string) error {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			var dbPath string
 			if len(args) > 1 {
 				dbPath = args[1]
 			} else {
 				dbPath = os.Getenv("MICASA_DB_PATH")
 			}
-			return runProDevicesRevoke(args[0], dbPath)
+			return runProDevicesRevoke(cmd.InOrStdin(), cmd.ErrOrStderr(), yes, args[0], dbPath)
 		},
 	}
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, yesFlagUsage)
+	return cmd
 }
 
-func runProDevicesRevoke(deviceID, dbPath string) error {
+func runProDevicesRevoke(in io.Reader, out io.Writer, yes bool, deviceID, dbPath string) error {
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
 	defer stop()
 
@@ -824,6 +837,12 @@ func runProDevicesRevoke(deviceID, dbPath string) error {
 	if deviceID == deps.device.ID {
 		return errors.New("cannot revoke your own device")
 	}
+	if err := confirm(in, out, yes, fmt.Sprintf(
+		"This will revoke device %s: it stops syncing with household %s until it joins again.",
+		deviceID, deps.device.HouseholdID,
+	)); err != nil {
+		return err
+	}
 
 	client := sync.NewManagementClient(deps.device.RelayURL, deps.token)
 	if err := client.RevokeDevice(
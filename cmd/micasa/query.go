@@ -46,10 +46,11 @@ with a 10-second timeout.`,
 }
 
 func runQuery(ctx context.Context, w io.Writer, store *data.Store, sql string, asJSON bool) error {
-	columns, rows, err := store.ReadOnlyQuery(ctx, sql)
+	cols, typed, err := store.ReadOnlyQuery(ctx, sql)
 	if err != nil {
 		return err
 	}
+	columns, rows := data.ColumnNames(cols), data.QueryRowStrings(typed)
 
 	if asJSON {
 		return writeQueryJSON(w, columns, rows)
@@ -45,6 +45,13 @@ func fmtIntAlways(n int) string {
 	return strconv.Itoa(n)
 }
 
+func fmtMonth(month int) string {
+	if month < 1 || month > 12 {
+		return "-"
+	}
+	return time.Month(month).String()
+}
+
 func fmtFloat(f float64) string {
 	if f == 0 {
 		return "-"
@@ -391,6 +398,7 @@ func projectToMap(p data.Project) map[string]any {
 		"end_date":        p.EndDate,
 		"budget_cents":    p.BudgetCents,
 		"actual_cents":    p.ActualCents,
+		"budget_currency": p.BudgetCurrency,
 		"description":     p.Description,
 	}
 }
@@ -467,6 +475,7 @@ func applianceToMap(a data.Appliance) map[string]any {
 		"purchase_date":   a.PurchaseDate,
 		"warranty_expiry": a.WarrantyExpiry,
 		"cost_cents":      a.CostCents,
+		"cost_currency":   a.CostCurrency,
 		"notes":           a.Notes,
 	}
 }
@@ -536,7 +545,9 @@ var quoteCols = []showCol[data.Quote]{
 	{"TOTAL", func(q data.Quote) string { return fmtMoneyVal(q.TotalCents) }},
 	{"LABOR", func(q data.Quote) string { return fmtMoney(q.LaborCents) }},
 	{"MATERIALS", func(q data.Quote) string { return fmtMoney(q.MaterialsCents) }},
+	{"TAX", func(q data.Quote) string { return fmtMoney(q.TaxCents) }},
 	{"RECEIVED", func(q data.Quote) string { return fmtDate(q.ReceivedDate) }},
+	{"ACCEPTED", func(q data.Quote) string { return fmtDate(q.AcceptedDate) }},
 	{"NOTES", func(q data.Quote) string { return fmtStr(q.Notes) }},
 }
 
@@ -550,7 +561,9 @@ func quoteToMap(q data.Quote) map[string]any {
 		"total_cents":     q.TotalCents,
 		"labor_cents":     q.LaborCents,
 		"materials_cents": q.MaterialsCents,
+		"tax_cents":       q.TaxCents,
 		"received_date":   q.ReceivedDate,
+		"accepted_date":   q.AcceptedDate,
 		"notes":           q.Notes,
 	}
 }
@@ -572,8 +585,9 @@ var maintenanceCols = []showCol[data.MaintenanceItem]{
 	{"CATEGORY", func(m data.MaintenanceItem) string { return fmtStr(m.Category.Name) }},
 	{"APPLIANCE", func(m data.MaintenanceItem) string { return fmtStr(m.Appliance.Name) }},
 	{"SEASON", func(m data.MaintenanceItem) string { return fmtStr(m.Season) }},
+	{"MONTH", func(m data.MaintenanceItem) string { return fmtMonth(m.Month) }},
 	{"LAST SERVICED", func(m data.MaintenanceItem) string { return fmtDate(m.LastServicedAt) }},
-	{"INTERVAL", func(m data.MaintenanceItem) string { return fmtIntAlways(m.IntervalMonths) }},
+	{"INTERVAL", func(m data.MaintenanceItem) string { return fmtStr(m.Interval().String()) }},
 	{"DUE", func(m data.MaintenanceItem) string { return fmtDate(m.DueDate) }},
 	{"COST", func(m data.MaintenanceItem) string { return fmtMoney(m.CostCents) }},
 }
@@ -587,8 +601,10 @@ func maintenanceToMap(m data.MaintenanceItem) map[string]any {
 		"appliance_id":     m.ApplianceID,
 		"appliance":        m.Appliance.Name,
 		"season":           m.Season,
+		"month":            m.Month,
 		"last_serviced_at": m.LastServicedAt,
 		"interval_months":  m.IntervalMonths,
+		"interval_days":    m.IntervalDays,
 		"due_date":         m.DueDate,
 		"cost_cents":       m.CostCents,
 		"notes":            m.Notes,
@@ -0,0 +1,82 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newArchiveModel(t *testing.T) (*Model, data.Appliance) {
+	t.Helper()
+	m := newTestModelWithStore(t)
+	item := data.Appliance{Name: "Old Fridge"}
+	require.NoError(t, m.store.CreateAppliance(&item))
+	m.active = tabIndex(tabAppliances)
+	require.NoError(t, m.reloadActiveTab())
+	m.enterEditMode()
+	return m, item
+}
+
+func TestArchiveKeyTogglesArchive(t *testing.T) {
+	t.Parallel()
+	m, item := newArchiveModel(t)
+	require.Contains(t, m.statusView(), "archive")
+
+	sendKey(m, keyZ)
+	assert.Equal(t, "Archived Old Fridge.", m.status.Text)
+	fetched, err := m.store.GetAppliance(item.ID)
+	require.NoError(t, err)
+	require.NotNil(t, fetched.ArchivedAt)
+	assert.Zero(t, daysUntil(time.Now(), *fetched.ArchivedAt))
+	assert.Empty(t, m.activeTab().Rows, "archived appliances are hidden")
+
+	m.enterNormalMode()
+	sendKey(m, keyT)
+	assert.Equal(t, "Archived shown.", m.status.Text)
+	require.Len(t, m.activeTab().Rows, 1)
+	assert.True(t, m.activeTab().Rows[0].Archived)
+
+	m.enterEditMode()
+	sendKey(m, keyZ)
+	assert.Equal(t, "Unarchived Old Fridge.", m.status.Text)
+	fetched, err = m.store.GetAppliance(item.ID)
+	require.NoError(t, err)
+	assert.Nil(t, fetched.ArchivedAt)
+}
+
+func TestArchivedApplianceHidesMaintenance(t *testing.T) {
+	t.Parallel()
+	m, item := newArchiveModel(t)
+	cats, err := m.store.MaintenanceCategories()
+	require.NoError(t, err)
+	require.NoError(t, m.store.CreateMaintenance(&data.MaintenanceItem{
+		Name: "Clean Coils", CategoryID: cats[0].ID, ApplianceID: &item.ID,
+	}))
+	require.NoError(t, m.store.CreateMaintenance(&data.MaintenanceItem{
+		Name: "Gutters", CategoryID: cats[0].ID,
+	}))
+	sendKey(m, keyZ)
+
+	m.active = tabIndex(tabMaintenance)
+	require.NoError(t, m.reloadActiveTab())
+	require.Len(t, m.activeTab().Rows, 1)
+
+	m.enterNormalMode()
+	sendKey(m, keyT)
+	assert.Len(t, m.activeTab().Rows, 2)
+}
+
+func TestArchiveKeySnoozesOnMaintenance(t *testing.T) {
+	t.Parallel()
+	m, _ := newSnoozeModel(t)
+	assert.NotContains(t, m.statusView(), "archive")
+
+	sendKey(m, keyZ)
+	assert.Contains(t, m.status.Text, "Snoozed")
+}
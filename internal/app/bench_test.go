@@ -117,7 +117,7 @@ func BenchmarkNaturalWidths(b *testing.B) {
 	)
 	b.ResetTimer()
 	for b.Loop() {
-		_ = naturalWidths(visSpecs, visCells, "$")
+		_ = naturalWidths(visSpecs, visCells, "$", 1)
 	}
 }
 
@@ -136,7 +136,7 @@ func BenchmarkComputeTableViewport(b *testing.B) {
 	sep := m.styles.TableSeparator().Render(" │ ")
 	b.ResetTimer()
 	for b.Loop() {
-		_ = computeTableViewport(tab, 120, sep, "$")
+		_ = computeTableViewport(tab, 120, sep, "$", 1)
 	}
 }
 
@@ -150,7 +150,7 @@ func BenchmarkComputeTableViewportPins(b *testing.B) {
 	sep := m.styles.TableSeparator().Render(" │ ")
 	b.ResetTimer()
 	for b.Loop() {
-		_ = computeTableViewport(tab, 120, sep, "$")
+		_ = computeTableViewport(tab, 120, sep, "$", 1)
 	}
 }
 
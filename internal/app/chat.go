@@ -6,6 +6,10 @@ package app
 import (
 	"context"
 	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,6 +23,7 @@ import (
 	"github.com/micasa-dev/micasa/internal/config"
 	"github.com/micasa-dev/micasa/internal/data"
 	"github.com/micasa-dev/micasa/internal/llm"
+	"github.com/micasa-dev/micasa/internal/locale"
 	ollamaPull "github.com/micasa-dev/micasa/internal/ollama"
 )
 
@@ -33,7 +38,16 @@ const (
 type chatMessage struct {
 	Role    string // roleUser, roleAssistant, roleError, or roleNotice
 	Content string
-	SQL     string // For assistant messages: the SQL query used (if any)
+	SQL     string     // For assistant messages: the SQL query used (if any)
+	Links   []chatLink // For assistant messages: records the query returned
+}
+
+// chatLink is a record returned by an answer's query that the user can jump
+// to from the chat.
+type chatLink struct {
+	Tab   TabKind
+	ID    string
+	Label string
 }
 
 // chatState holds the state of the LLM chat overlay.
@@ -56,6 +70,8 @@ type chatState struct {
 	HistoryCur   int             // index into History for up/down browsing (-1 = live input)
 	HistoryBuf   string          // stashed live input while browsing history
 	Visible      bool            // false when the overlay is hidden but session persists
+	Picking      bool            // true while choosing a record from the latest answer
+	LinkCursor   int             // selected record while Picking
 }
 
 // modelCompleter is the inline autocomplete list for /model.
@@ -106,6 +122,50 @@ var wellKnownModels = []string{
 	"qwen3:72b",
 }
 
+// chatMaxLinks caps the records listed under an answer.
+const chatMaxLinks = 10
+
+// chatLinkTabs maps the tables a chat query can select ids from to the tab
+// that shows them.
+var chatLinkTabs = map[string]TabKind{
+	data.TableProjects:         tabProjects,
+	data.TableQuotes:           tabQuotes,
+	data.TableMaintenanceItems: tabMaintenance,
+	data.TableIncidents:        tabIncidents,
+	data.TableAppliances:       tabAppliances,
+	data.TableVendors:          tabVendors,
+	data.TableDocuments:        tabDocuments,
+}
+
+// chatLinkColumns maps foreign key columns in a chat query's results to the
+// tab of the record they point at.
+var chatLinkColumns = map[string]TabKind{
+	data.ColProjectID:         tabProjects,
+	data.ColMaintenanceItemID: tabMaintenance,
+	data.ColApplianceID:       tabAppliances,
+	data.ColVendorID:          tabVendors,
+}
+
+var (
+	sqlFromRe   = regexp.MustCompile(`(?i)\bfrom\s+"?([a-z_]+)"?(?:\s+(?:as\s+)?[a-z_]+)?\s*(,)?`)
+	sqlJoinRe   = regexp.MustCompile(`(?i)\bjoin\b`)
+	sqlSelectRe = regexp.MustCompile(`(?i)\bselect\b`)
+)
+
+// sqlFromTable returns the one table a query selects from, or "" when it
+// joins tables or nests a SELECT, since an id column could then come from
+// any of them.
+func sqlFromTable(sql string) string {
+	if sqlJoinRe.MatchString(sql) || len(sqlSelectRe.FindAllStringIndex(sql, 2)) > 1 {
+		return ""
+	}
+	m := sqlFromRe.FindStringSubmatch(sql)
+	if m == nil || m[2] != "" {
+		return ""
+	}
+	return strings.ToLower(m[1])
+}
+
 // chatChunkMsg delivers a single streamed token to the Bubbletea update loop.
 type chatChunkMsg struct {
 	Content string
@@ -125,8 +185,8 @@ type sqlChunkMsg struct {
 type sqlResultMsg struct {
 	Question string // original user question
 	SQL      string // generated SELECT statement
-	Columns  []string
-	Rows     [][]string
+	Columns  []data.ColumnMeta
+	Rows     [][]any
 	Err      error // set if SQL generation, validation, or execution failed
 }
 
@@ -341,6 +401,7 @@ func (m *Model) startSQLStream(query string) tea.Cmd {
 	client := m.llmClient
 	store := m.store
 	extraContext := m.chatCfg.ExtraContext
+	schemaTokens := m.chatCfg.SchemaTokens
 	chatTimeout := m.chatInferenceTimeout()
 	appCtx := m.lifecycleCtx()
 	// Capture conversation history on the main goroutine before the closure
@@ -349,14 +410,21 @@ func (m *Model) startSQLStream(query string) tea.Cmd {
 	history := m.buildConversationHistory()
 
 	return func() tea.Msg {
-		// Build schema info and column hints inside the goroutine to avoid
-		// blocking the UI thread with DB queries.
-		tables := buildTableInfoFrom(store)
+		// Build schema info and column hints, and learn the context window,
+		// inside the goroutine to avoid blocking the UI thread with DB
+		// queries and the server probe.
+		budget := llm.PromptBudget(client.ContextTokens(appCtx))
+		if schemaTokens == 0 {
+			schemaTokens = budget / 2
+		}
+		tables := llm.TrimSchema(buildTableInfoFrom(store), query, schemaTokens)
 		columnHints := ""
 		if store != nil {
 			columnHints = store.ColumnHints()
 		}
-		sqlPrompt := llm.BuildSQLPrompt(tables, time.Now(), columnHints, extraContext)
+		sqlPrompt := llm.BuildSQLPrompt(
+			tables, time.Now(), columnHints, extraContext, llm.AsksAboutDeleted(query), budget,
+		)
 
 		// Build conversation history: system + all previous user/assistant exchanges + current query.
 		messages := []llm.Message{
@@ -767,18 +835,23 @@ func (m *Model) handleSQLResult(msg sqlResultMsg) tea.Cmd {
 		return m.startFallbackStream(msg.Question)
 	}
 
+	if i := m.latestAnswerIndex(); i >= 0 {
+		m.chat.Messages[i].Links = resultLinks(msg.SQL, msg.Columns, msg.Rows)
+	}
+
 	// The SQL is already stored in the assistant message's SQL field.
 	// Stage 2: summarize results via streaming LLM call.
 	// Always send unformatted numbers to the LLM so the stored response
 	// contains regular dollar amounts. Client-side magTransformText handles
 	// mag notation at render time, making it toggleable.
-	resultsTable := llm.FormatResultsTable(msg.Columns, msg.Rows)
+	resultsTable := llm.FormatResultsTable(m.formatQueryResults(msg.Columns, msg.Rows))
 	summaryPrompt := llm.BuildSummaryPrompt(
 		msg.Question,
 		msg.SQL,
 		resultsTable,
 		time.Now(),
 		m.chatCfg.ExtraContext,
+		m.chatCfg.Language,
 	)
 
 	messages := []llm.Message{
@@ -1042,6 +1115,185 @@ func (m *Model) executeSQLQuery(sql string) tea.Cmd {
 	}
 }
 
+// recordCurrencyColumns maps the money columns whose amounts can be in a
+// record's own currency to the column that holds it.
+var recordCurrencyColumns = map[string]string{
+	data.ColBudgetCents: data.ColBudgetCurrency,
+	data.ColActualCents: data.ColBudgetCurrency,
+	data.ColCostCents:   data.ColCostCurrency,
+}
+
+// Money column kinds in formatQueryResults; other values index the row's
+// currency column.
+const (
+	queryNotMoney   = -2
+	queryHouseMoney = -1
+)
+
+// formatQueryResults renders typed query results for the summary prompt.
+// Integer "_cents" columns become amounts, with the suffix dropped from the
+// header so the model doesn't divide again: in the row's currency when the
+// result has the matching currency column, else in the configured
+// currency. Amounts that may be in a record's own currency are left as
+// cents when the result doesn't say which. Dates lose their zero time and
+// zone. Everything else is plain text.
+func (m *Model) formatQueryResults(
+	cols []data.ColumnMeta,
+	rows [][]any,
+) ([]string, [][]string) {
+	names := data.ColumnNames(cols)
+	money := make([]int, len(cols))
+	for i, c := range cols {
+		money[i] = queryNotMoney
+		label, ok := strings.CutSuffix(c.Name, "_cents")
+		if !ok || label == "" {
+			continue
+		}
+		money[i] = queryHouseMoney
+		if curCol, ok := recordCurrencyColumns[c.Name]; ok {
+			k := slices.IndexFunc(cols, func(c data.ColumnMeta) bool { return c.Name == curCol })
+			if k < 0 {
+				money[i] = queryNotMoney
+				continue
+			}
+			money[i] = k
+		}
+		names[i] = label
+	}
+	out := make([][]string, len(rows))
+	for i, row := range rows {
+		out[i] = make([]string, len(row))
+		for j, v := range row {
+			k := queryNotMoney
+			if j < len(money) {
+				k = money[j]
+			}
+			var cur *locale.Currency
+			switch {
+			case k == queryHouseMoney:
+				cur = &m.cur
+			case k >= 0 && k < len(row):
+				rec := recordCurrency(m.cur, data.FormatQueryValue(row[k]))
+				cur = &rec
+			}
+			out[i][j] = formatQueryValue(v, cur)
+		}
+	}
+	return names, out
+}
+
+// formatQueryValue renders one query result value, as money in cur when
+// cur is non-nil.
+func formatQueryValue(v any, cur *locale.Currency) string {
+	switch v := v.(type) {
+	case int64:
+		if cur != nil {
+			return cur.FormatCents(v)
+		}
+	case float64:
+		if cur != nil {
+			return cur.FormatCents(int64(math.Round(v)))
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case time.Time:
+		if v.Hour() == 0 && v.Minute() == 0 && v.Second() == 0 {
+			return v.Format(data.DateLayout)
+		}
+		return v.Format(data.DateLayout + " 15:04")
+	}
+	return data.FormatQueryValue(v)
+}
+
+// resultLinks maps the rows of a chat query to records the user can jump
+// to. An "id" column belongs to the table the query selects from; a foreign
+// key column like vendor_id to the table it names. The first column that
+// maps to a tab wins. Rows are labelled by their name or title column when
+// the ids are the query's own, and by kind and id otherwise.
+func resultLinks(sql string, cols []data.ColumnMeta, rows [][]any) []chatLink {
+	idCol, labelCol := -1, -1
+	var tab TabKind
+	for i, c := range cols {
+		if c.Name == data.ColID {
+			if t, ok := chatLinkTabs[sqlFromTable(sql)]; ok {
+				idCol, tab = i, t
+				labelCol = slices.IndexFunc(cols, func(c data.ColumnMeta) bool {
+					return c.Name == data.ColName || c.Name == data.ColTitle
+				})
+				break
+			}
+		}
+		if t, ok := chatLinkColumns[c.Name]; ok {
+			idCol, tab = i, t
+			break
+		}
+	}
+	if idCol < 0 {
+		return nil
+	}
+	var links []chatLink
+	seen := make(map[string]bool)
+	for _, row := range rows {
+		if len(links) == chatMaxLinks {
+			break
+		}
+		if idCol >= len(row) || row[idCol] == nil {
+			continue
+		}
+		id := data.FormatQueryValue(row[idCol])
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		label := tab.singular() + " " + id
+		if labelCol >= 0 && labelCol < len(row) {
+			if name := data.FormatQueryValue(row[labelCol]); name != "" {
+				label = name
+			}
+		}
+		links = append(links, chatLink{Tab: tab, ID: id, Label: label})
+	}
+	return links
+}
+
+// latestAnswerIndex returns the index of the most recent assistant message,
+// or -1.
+func (m *Model) latestAnswerIndex() int {
+	for i := len(m.chat.Messages) - 1; i >= 0; i-- {
+		if m.chat.Messages[i].Role == roleAssistant {
+			return i
+		}
+	}
+	return -1
+}
+
+// latestChatLinks returns the records of the most recent answer, or nil
+// when it has none or hasn't been written yet.
+func (m *Model) latestChatLinks() []chatLink {
+	if i := m.latestAnswerIndex(); i >= 0 && m.chat.Messages[i].Content != "" {
+		return m.chat.Messages[i].Links
+	}
+	return nil
+}
+
+// jumpToChatLink hides the chat and selects the record in its tab, the way
+// the dashboard jumps to an item.
+func (m *Model) jumpToChatLink(link chatLink) {
+	m.chat.Picking = false
+	m.hideChat()
+	m.showDashboard = false
+	m.closeAllDetails()
+	m.switchToTab(tabIndex(link.Tab))
+	tab := m.activeTab()
+	if tab == nil {
+		return
+	}
+	if !selectRowByID(tab, link.ID) {
+		m.setStatusError(fmt.Sprintf("%s not found (deleted?).", link.Label))
+		return
+	}
+	m.noteRecent()
+}
+
 func (m *Model) handleChatChunk(msg chatChunkMsg) tea.Cmd {
 	if m.chat == nil || !m.chat.Streaming {
 		return nil
@@ -1077,17 +1329,21 @@ func (m *Model) handleChatChunk(msg chatChunkMsg) tea.Cmd {
 
 // buildFallbackMessages assembles the full message list for the single-stage
 // fallback: system prompt with schema + full data dump, conversation history, then the question.
+// Questions about deleted items get soft-deleted rows in the dump.
 func (m *Model) buildFallbackMessages(question string) []llm.Message {
 	tables := m.buildTableInfo()
+	includeDeleted := llm.AsksAboutDeleted(question)
 	dataDump := ""
 	if m.store != nil {
-		dataDump = m.store.DataDump()
+		dataDump = m.store.DataDump(includeDeleted)
 	}
 	systemPrompt := llm.BuildSystemPrompt(
 		tables,
 		dataDump,
 		time.Now(),
 		m.chatCfg.ExtraContext,
+		includeDeleted,
+		m.chatCfg.Language,
 	)
 
 	messages := []llm.Message{
@@ -1210,10 +1466,44 @@ func (m *Model) handleChatKey(msg tea.KeyPressMsg) tea.Cmd {
 		}
 	}
 
+	// The record picker swallows every key until a jump or dismissal.
+	if m.chat.Picking {
+		links := m.latestChatLinks()
+		switch {
+		case key.Matches(msg, m.keys.ChatLinkCancel), key.Matches(msg, m.keys.ChatLinks):
+			m.chat.Picking = false
+		case key.Matches(msg, m.keys.ChatLinkUp):
+			if m.chat.LinkCursor > 0 {
+				m.chat.LinkCursor--
+			}
+		case key.Matches(msg, m.keys.ChatLinkDown):
+			if m.chat.LinkCursor < len(links)-1 {
+				m.chat.LinkCursor++
+			}
+		case key.Matches(msg, m.keys.ChatLinkJump):
+			if m.chat.LinkCursor < len(links) {
+				m.jumpToChatLink(links[m.chat.LinkCursor])
+				return nil
+			}
+			m.chat.Picking = false
+		case key.Matches(msg, m.keys.Quit):
+			return tea.Quit
+		}
+		m.refreshChatViewport()
+		return nil
+	}
+
 	switch {
 	case key.Matches(msg, m.keys.ChatHide):
 		m.hideChat()
 		return nil
+	case key.Matches(msg, m.keys.ChatLinks):
+		if !m.chat.Streaming && len(m.latestChatLinks()) > 0 {
+			m.chat.Picking = true
+			m.chat.LinkCursor = 0
+			m.refreshChatViewport()
+			return nil
+		}
 	case key.Matches(msg, m.keys.ChatSend):
 		if m.chat.Streaming {
 			return nil
@@ -5,6 +5,7 @@ package app
 
 import (
 	"errors"
+	"fmt"
 	"net"
 	"net/http"
 	"strings"
@@ -18,6 +19,7 @@ import (
 	"github.com/micasa-dev/micasa/internal/llm"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
 )
 
 // requireOllama skips the test when a live Ollama server is not reachable.
@@ -580,6 +582,29 @@ func TestBuildFallbackMessagesNoHistory(t *testing.T) {
 	assert.Equal(t, "question", msgs[1].Content)
 }
 
+func TestBuildFallbackMessagesIncludesDeletedWhenAsked(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	v := data.Vendor{Name: "Gone Plumbing"}
+	require.NoError(t, m.store.CreateVendor(&v))
+	require.NoError(t, m.store.DeleteVendor(v.ID))
+
+	system := m.buildFallbackMessages("who are my vendors?")[0].Content
+	assert.NotContains(t, system, "Gone Plumbing")
+
+	system = m.buildFallbackMessages("which vendors did I delete?")[0].Content
+	assert.Contains(t, system, "Gone Plumbing")
+	assert.Contains(t, system, "The user is asking about deleted items")
+}
+
+func TestBuildFallbackMessagesAnswerLanguage(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.chatCfg.Language = "French"
+	system := m.buildFallbackMessages("question")[0].Content
+	assert.Contains(t, system, "Write your answer in French")
+}
+
 // --- buildTableInfo / buildTableInfoFrom ---
 
 func TestBuildTableInfoFromRealStore(t *testing.T) {
@@ -1778,3 +1803,167 @@ func TestActivateCompleterLive(t *testing.T) {
 	require.True(t, ok, "expected modelsListMsg, got %T", msg)
 	assert.NoError(t, result.Err)
 }
+
+// --- formatQueryResults ---
+
+func TestFormatQueryResultsUsesLocale(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithCurrency(t, "EUR", language.German)
+	bought := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	cost := int64(129950)
+	require.NoError(t, m.store.CreateAppliance(&data.Appliance{
+		Name: "Fridge", CostCents: &cost, PurchaseDate: &bought,
+	}))
+
+	cols, rows, err := m.store.ReadOnlyQuery(t.Context(),
+		"SELECT name, cost_cents, purchase_date, cost_cents / 100.0 AS cost_dollars, cost_currency "+
+			"FROM appliances WHERE name = 'Fridge'")
+	require.NoError(t, err)
+	names, out := m.formatQueryResults(cols, rows)
+	assert.Equal(t, []string{"name", "cost", "purchase_date", "cost_dollars", "cost_currency"}, names,
+		"formatted money drops the _cents suffix")
+	require.Len(t, out, 1)
+	assert.Equal(t, "Fridge", out[0][0])
+	assert.Equal(t, m.cur.FormatCents(cost), out[0][1])
+	assert.Contains(t, out[0][1], "€")
+	assert.Equal(t, "2025-03-01", out[0][2])
+	assert.Equal(t, "1299.5", out[0][3])
+}
+
+func TestFormatQueryResultsUsesRowCurrency(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithCurrency(t, "EUR", language.German)
+	budget := int64(500000)
+	require.NoError(t, m.store.CreateProject(&data.Project{
+		Title: "Deck", ProjectTypeID: m.projectTypes[0].ID, Status: data.ProjectStatusPlanned,
+		BudgetCents: &budget, BudgetCurrency: "USD",
+	}))
+
+	cols, rows, err := m.store.ReadOnlyQuery(t.Context(),
+		"SELECT title, budget_cents, budget_currency FROM projects")
+	require.NoError(t, err)
+	names, out := m.formatQueryResults(cols, rows)
+	assert.Equal(t, "budget", names[1])
+	require.Len(t, out, 1)
+	assert.Equal(t, recordCurrency(m.cur, "USD").FormatCents(budget), out[0][1])
+	assert.NotContains(t, out[0][1], "€")
+
+	cols, rows, err = m.store.ReadOnlyQuery(t.Context(),
+		"SELECT title, budget_cents FROM projects")
+	require.NoError(t, err)
+	names, out = m.formatQueryResults(cols, rows)
+	assert.Equal(t, []string{"title", "budget_cents"}, names,
+		"without the currency column the amount stays in cents")
+	assert.Equal(t, "500000", out[0][1])
+}
+
+func TestFormatQueryValue(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	assert.Empty(t, formatQueryValue(nil, &m.cur))
+	assert.Equal(t, "42", formatQueryValue(int64(42), nil))
+	assert.Equal(t, "$0.42", formatQueryValue(int64(42), &m.cur))
+	assert.Equal(t, "$1.00", formatQueryValue(99.6, &m.cur), "averages of cents round")
+	assert.Equal(t, "2025-03-01 14:30",
+		formatQueryValue(time.Date(2025, 3, 1, 14, 30, 0, 0, time.UTC), nil))
+	assert.Equal(t, "x", formatQueryValue("x", &m.cur))
+}
+
+// --- record links ---
+
+func TestResultLinks(t *testing.T) {
+	t.Parallel()
+	cols := []data.ColumnMeta{{Name: "id"}, {Name: "title"}, {Name: "vendor_id"}}
+	rows := [][]any{{"p1", "Deck", "v1"}, {"p2", nil, "v1"}, {"p1", "Deck", "v1"}}
+
+	links := resultLinks("SELECT id, title, vendor_id FROM projects p", cols, rows)
+	assert.Equal(t, []chatLink{
+		{Tab: tabProjects, ID: "p1", Label: "Deck"},
+		{Tab: tabProjects, ID: "p2", Label: "project p2"},
+	}, links, "deduplicated, labelled by title")
+
+	links = resultLinks("SELECT id, title, vendor_id FROM house_profiles", cols, rows)
+	assert.Equal(t, []chatLink{{Tab: tabVendors, ID: "v1", Label: "vendor v1"}}, links,
+		"falls back to a foreign key when the table has no tab")
+
+	idTitle := []data.ColumnMeta{{Name: "id"}, {Name: "title"}}
+	for _, sql := range []string{
+		"SELECT v.id, p.title FROM projects p JOIN vendors v ON v.id = p.vendor_id",
+		"SELECT id, title FROM projects WHERE id IN (SELECT project_id FROM quotes)",
+		"SELECT id, title FROM (SELECT * FROM projects)",
+		"SELECT q.id, p.title FROM quotes q, projects p",
+	} {
+		assert.Nil(t, resultLinks(sql, idTitle, rows), sql)
+	}
+
+	assert.Nil(t, resultLinks("SELECT COUNT(*) AS n FROM projects",
+		[]data.ColumnMeta{{Name: "n"}}, [][]any{{int64(3)}}))
+
+	var many [][]any
+	for i := range chatMaxLinks + 5 {
+		many = append(many, []any{fmt.Sprint(i), "x", nil})
+	}
+	assert.Len(t, resultLinks("select id from appliances", cols, many), chatMaxLinks)
+}
+
+func TestChatLinkPickerJumpsToRecord(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	for _, name := range []string{"Dishwasher", "Fridge"} {
+		require.NoError(t, m.store.CreateAppliance(&data.Appliance{Name: name}))
+	}
+	require.NoError(t, m.reloadAllTabs())
+
+	m.openChat()
+	cols, rows, err := m.store.ReadOnlyQuery(t.Context(),
+		"SELECT id, name FROM appliances ORDER BY name")
+	require.NoError(t, err)
+	m.chat.Messages = append(m.chat.Messages, chatMessage{
+		Role:    roleAssistant,
+		Content: "You have a dishwasher and a fridge.",
+		Links:   resultLinks("SELECT id, name FROM appliances", cols, rows),
+	})
+	require.Len(t, m.chat.Messages[len(m.chat.Messages)-1].Links, 2)
+	m.refreshChatViewport()
+	assert.Contains(t, m.buildChatOverlay(), "records")
+
+	sendKey(m, "tab")
+	require.True(t, m.chat.Picking)
+	assert.Contains(t, m.buildChatOverlay(), "jump")
+	sendKey(m, "down")
+	sendKey(m, "enter")
+
+	assert.False(t, m.chat.Visible, "jumping hides the chat")
+	assert.False(t, m.chat.Picking)
+	tab := m.activeTab()
+	require.NotNil(t, tab)
+	assert.Equal(t, tabAppliances, tab.Kind)
+	assert.Equal(t, rows[1][0], tab.Rows[tab.Table.Cursor()].ID)
+}
+
+func TestChatLinkPickerEscKeepsChat(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.openChat()
+	m.chat.Messages = append(m.chat.Messages, chatMessage{
+		Role: roleAssistant, Content: "one project",
+		Links: []chatLink{{Tab: tabProjects, ID: "p1", Label: "Deck"}},
+	})
+
+	sendKey(m, "tab")
+	require.True(t, m.chat.Picking)
+	sendKey(m, "esc")
+	assert.False(t, m.chat.Picking)
+	assert.True(t, m.chat.Visible, "esc leaves the picker, not the chat")
+}
+
+func TestChatLinkPickerNeedsLinks(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.openChat()
+	m.chat.Messages = append(m.chat.Messages, chatMessage{Role: roleAssistant, Content: "3"})
+
+	sendKey(m, "tab")
+	assert.False(t, m.chat.Picking)
+	assert.NotContains(t, m.buildChatOverlay(), "records")
+}
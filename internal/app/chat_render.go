@@ -62,6 +62,10 @@ func (m *Model) renderChatMessages() string {
 					display = magTransformText(display, m.cur.Symbol())
 				}
 				parts = append(parts, m.chat.renderMarkdown(display, innerW-2))
+				if len(msg.Links) > 0 {
+					picking := m.chat.Picking && i == m.latestAnswerIndex()
+					parts = append(parts, m.renderChatLinks(msg.Links, picking, innerW))
+				}
 			}
 
 			// Join content parts, trimming glamour's leading whitespace.
@@ -113,6 +117,25 @@ func (m *Model) renderChatMessages() string {
 	return strings.Join(parts, "\n")
 }
 
+// renderChatLinks lists the records an answer returned, with the cursor
+// shown while the user is picking one.
+func (m *Model) renderChatLinks(links []chatLink, picking bool, innerW int) string {
+	pointer := m.styles.AccentBold()
+	lines := make([]string, 0, len(links))
+	for j, link := range links {
+		prefix := "  "
+		if picking && j == m.chat.LinkCursor {
+			prefix = pointer.Render("▸ ")
+		}
+		line := prefix + link.Label + " " + m.styles.TextDim().Render(link.Tab.String())
+		if lipgloss.Width(line) > innerW {
+			line = m.styles.Base().MaxWidth(innerW).Render(line)
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
 func (m *Model) llmModelLabel() string {
 	if m.llmClient != nil {
 		return m.llmClient.Model()
@@ -148,19 +171,29 @@ func (m *Model) buildChatOverlay() string {
 	completerView := m.renderModelCompleter(innerW)
 
 	var hintParts []string
-	if m.chat.Completer != nil {
+	switch {
+	case m.chat.Completer != nil:
 		hintParts = append(hintParts,
 			m.helpItem(keyUp+"/"+keyDown, "navigate"),
 			m.helpItem(symReturn, "select"),
 			m.helpItem(keyEsc, "dismiss"),
 		)
-	} else {
+	case m.chat.Picking:
+		hintParts = append(hintParts,
+			m.helpItem(keyUp+"/"+keyDown, "navigate"),
+			m.helpItem(symReturn, "jump"),
+			m.helpItem(keyEsc, "dismiss"),
+		)
+	default:
 		hintParts = append(hintParts,
 			m.helpItem(symReturn, "send"),
 			m.sqlHintItem(),
 			m.helpItem(symUp+"/"+symDown, "history"),
-			m.helpItem(keyEsc, "hide"),
 		)
+		if !m.chat.Streaming && len(m.latestChatLinks()) > 0 {
+			hintParts = append(hintParts, m.helpItem(keyTab, "records"))
+		}
+		hintParts = append(hintParts, m.helpItem(keyEsc, "hide"))
 	}
 	hints := joinWithSeparator(m.helpSeparator(), hintParts...)
 
@@ -0,0 +1,148 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/micasa-dev/micasa/internal/extract"
+)
+
+// errClipboardNoImage means the clipboard was readable but held no image
+// (text, files, or nothing at all).
+var errClipboardNoImage = errors.New("clipboard has no image -- copy a screenshot or image first")
+
+// clipboardImageMsg carries the bytes read from the system clipboard back to
+// the event loop.
+type clipboardImageMsg struct {
+	Data []byte
+	Err  error
+}
+
+// clipboardImageCommand returns the platform command that writes the
+// clipboard image to stdout as PNG. Terminals only pass text through
+// bracketed paste, so images have to come from the OS clipboard tools.
+func clipboardImageCommand() (*exec.Cmd, error) {
+	switch runtime.GOOS {
+	case "darwin":
+		return exec.Command( //nolint:noctx // no context in tea.Cmd
+			"pngpaste", "-",
+		), nil
+	case "windows":
+		return exec.Command( //nolint:noctx // no context in tea.Cmd
+			"powershell", "-NoProfile", "-Command",
+			"Add-Type -AssemblyName System.Windows.Forms,System.Drawing; "+
+				"$i = [System.Windows.Forms.Clipboard]::GetImage(); "+
+				"if (-not $i) { exit 1 }; "+
+				"$s = New-Object System.IO.MemoryStream; "+
+				"$i.Save($s, [System.Drawing.Imaging.ImageFormat]::Png); "+
+				"$o = [Console]::OpenStandardOutput(); $o.Write($s.ToArray(), 0, $s.Length)",
+		), nil
+	}
+	switch {
+	case os.Getenv("WAYLAND_DISPLAY") != "":
+		return exec.Command( //nolint:noctx // no context in tea.Cmd
+			"wl-paste", "--no-newline", "--type", "image/png",
+		), nil
+	case os.Getenv("DISPLAY") != "":
+		return exec.Command( //nolint:noctx // no context in tea.Cmd
+			"xclip", "-selection", "clipboard", "-target", "image/png", "-out",
+		), nil
+	}
+	return nil, errors.New(
+		"clipboard unavailable -- no display server (DISPLAY/WAYLAND_DISPLAY not set)",
+	)
+}
+
+// readClipboardImageCmd reads an image from the system clipboard in the
+// background. A clipboard tool that runs but exits non-zero is reported as
+// errClipboardNoImage: every supported tool fails that way when the
+// clipboard holds something other than an image.
+func readClipboardImageCmd() tea.Cmd {
+	return func() tea.Msg {
+		cmd, err := clipboardImageCommand()
+		if err != nil {
+			return clipboardImageMsg{Err: err}
+		}
+		out, err := cmd.Output()
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return clipboardImageMsg{Err: errClipboardNoImage}
+		}
+		return clipboardImageMsg{Data: out, Err: err}
+	}
+}
+
+// handleClipboardImage turns pasted image bytes into an unsaved document
+// and runs it through the extraction pipeline, like quick add does for a
+// picked file.
+func (m *Model) handleClipboardImage(msg clipboardImageMsg) tea.Cmd {
+	if msg.Err != nil {
+		m.setStatusError(humanizeError(msg.Err))
+		return nil
+	}
+	mime := detectMIMEType("", msg.Data)
+	if len(msg.Data) == 0 || !extract.IsImageMIME(mime) {
+		m.setStatusError(humanizeError(errClipboardNoImage))
+		return nil
+	}
+	if maxSize := m.store.MaxDocumentSize(); uint64(len(msg.Data)) > maxSize {
+		m.setStatusError(fmt.Sprintf(
+			"clipboard image is too large (%s) -- maximum allowed is %s",
+			formatFileSize(uint64(len(msg.Data))),
+			formatFileSize(maxSize),
+		))
+		return nil
+	}
+
+	now := time.Now()
+	doc := data.Document{
+		Title:          "Pasted image " + now.Format("2006-01-02 15:04"),
+		FileName:       "clipboard-" + now.Format("20060102-150405") + imageExtension(mime),
+		Data:           msg.Data,
+		SizeBytes:      int64(len(msg.Data)),
+		MIMEType:       mime,
+		ChecksumSHA256: fmt.Sprintf("%x", sha256.Sum256(msg.Data)),
+	}
+	if target, ok := m.importTarget(); ok {
+		doc.EntityKind, doc.EntityID = target.Kind, target.ID
+	}
+	cmd, extracting, err := m.extractDeferredDocument(doc)
+	if err != nil {
+		m.setStatusError(humanizeError(err))
+		return nil
+	}
+	if !extracting {
+		m.setStatusInfo("Pasted image saved; no extraction tools or LLM configured.")
+		return nil
+	}
+	if cmd == nil {
+		return nil // queued behind the open extraction
+	}
+	m.setStatusInfo("Extracting pasted image...")
+	return cmd
+}
+
+// imageExtension returns the file extension for an image MIME type.
+func imageExtension(mime string) string {
+	switch mime {
+	case "image/jpeg":
+		return ".jpg"
+	case "image/tiff":
+		return ".tif"
+	case "image/bmp":
+		return ".bmp"
+	case "image/webp":
+		return ".webp"
+	}
+	return ".png"
+}
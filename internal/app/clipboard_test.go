@@ -0,0 +1,117 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/png"
+	"os/exec"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testPNG returns the bytes of a tiny PNG image.
+func testPNG(t *testing.T) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, 2, 2))))
+	return buf.Bytes()
+}
+
+func TestClipboardImage_StartsExtraction(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	m.ex.extractionClient = testExtractionOllamaClient(t, "test-model")
+
+	cmd := m.handleClipboardImage(clipboardImageMsg{Data: testPNG(t)})
+
+	require.NotNil(t, cmd)
+	require.NotNil(t, m.ex.extraction, "extraction overlay should be open")
+	doc := m.ex.extraction.pendingDoc
+	require.NotNil(t, doc, "document should wait for the user to accept")
+	assert.Equal(t, "image/png", doc.MIMEType)
+	assert.Regexp(t, `^clipboard-\d{8}-\d{6}\.png$`, doc.FileName)
+	assert.Contains(t, doc.Title, "Pasted image")
+	assert.NotEmpty(t, doc.ChecksumSHA256)
+
+	docs, err := m.store.ListDocuments(false)
+	require.NoError(t, err)
+	assert.Empty(t, docs, "nothing is saved before accept")
+}
+
+func TestClipboardImage_SavesWithoutExtractionTools(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+
+	cmd := m.handleClipboardImage(clipboardImageMsg{Data: testPNG(t)})
+
+	assert.Nil(t, cmd)
+	assert.Nil(t, m.ex.extraction)
+	docs, err := m.store.ListDocuments(false)
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, "image/png", docs[0].MIMEType)
+	assert.Equal(t, statusInfo, m.status.Kind)
+}
+
+func TestClipboardImage_RejectsNonImage(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	m.ex.extractionClient = testExtractionOllamaClient(t, "test-model")
+
+	for _, payload := range [][]byte{nil, []byte("just some copied text")} {
+		cmd := m.handleClipboardImage(clipboardImageMsg{Data: payload})
+		assert.Nil(t, cmd)
+		assert.Nil(t, m.ex.extraction)
+		assert.Equal(t, statusError, m.status.Kind)
+		assert.Contains(t, m.status.Text, "clipboard has no image")
+	}
+}
+
+func TestClipboardImage_MissingTool(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+
+	err := &exec.Error{Name: "wl-paste", Err: exec.ErrNotFound}
+	m.handleClipboardImage(clipboardImageMsg{Err: err})
+
+	assert.Equal(t, statusError, m.status.Kind)
+	assert.Contains(t, m.status.Text, "wl-clipboard")
+}
+
+func TestClipboardImage_Unavailable(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+
+	m.handleClipboardImage(clipboardImageMsg{Err: errors.New("clipboard unavailable")})
+
+	assert.Equal(t, statusError, m.status.Kind)
+	assert.Contains(t, m.status.Text, "clipboard unavailable")
+}
+
+func TestPasteImageKey_DocumentsTabOnly(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	ctrlV := tea.KeyPressMsg{Code: 'v', Mod: tea.ModCtrl}
+
+	sendKey(m, "i")
+	_, cmd := m.Update(ctrlV)
+	assert.Nil(t, cmd, "ctrl+v should do nothing outside the Docs tab")
+
+	m.active = tabIndex(tabDocuments)
+	_, cmd = m.Update(ctrlV)
+	assert.NotNil(t, cmd, "ctrl+v should read the clipboard on the Docs tab")
+}
+
+func TestImageExtension(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, ".png", imageExtension("image/png"))
+	assert.Equal(t, ".jpg", imageExtension("image/jpeg"))
+	assert.Equal(t, ".webp", imageExtension("image/webp"))
+}
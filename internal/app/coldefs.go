@@ -39,6 +39,7 @@ var projectColumnDefs = []columnDef{
 	{"Status", columnSpec{Title: "Status", Min: 6, Max: 8, Kind: cellStatus}},
 	{"Budget", columnSpec{Title: "Budget", Min: 10, Max: 14, Align: alignRight, Kind: cellMoney}},
 	{"Actual", columnSpec{Title: "Actual", Min: 10, Max: 14, Align: alignRight, Kind: cellMoney}},
+	{"Spent", columnSpec{Title: "Spent", Min: 10, Max: 14, Align: alignRight, Kind: cellMoney}},
 	{"Start", columnSpec{Title: "Start", Min: 10, Max: 12, Kind: cellDate}},
 	{"End", columnSpec{Title: "End", Min: 10, Max: 12, Kind: cellDate}},
 	{
@@ -89,7 +90,9 @@ var quoteColumnDefs = []columnDef{
 	{"Labor", columnSpec{Title: "Labor", Min: 10, Max: 14, Align: alignRight, Kind: cellMoney}},
 	{"Mat", columnSpec{Title: "Mat", Min: 8, Max: 12, Align: alignRight, Kind: cellMoney}},
 	{"Other", columnSpec{Title: "Other", Min: 8, Max: 12, Align: alignRight, Kind: cellMoney}},
+	{"Tax", columnSpec{Title: "Tax", Min: 8, Max: 12, Align: alignRight, Kind: cellMoney}},
 	{"Recv", columnSpec{Title: "Recv", Min: 10, Max: 12, Kind: cellDate}},
+	{"Accepted", columnSpec{Title: "Accepted", Min: 10, Max: 12, Kind: cellDate}},
 	{
 		"Docs",
 		columnSpec{
@@ -113,6 +116,7 @@ var maintenanceColumnDefs = []columnDef{
 	{"Item", columnSpec{Title: "Item", Min: 12, Max: 26, Flex: true}},
 	{"Category", columnSpec{Title: "Category", Min: 10, Max: 14}},
 	{"Season", columnSpec{Title: "Season", Min: 6, Max: 8, Kind: cellStatus}},
+	{"Month", columnSpec{Title: "Month", Min: 5, Max: 5, Kind: cellText}},
 	{"Appliance", columnSpec{
 		Title: "Appliance",
 		Min:   10,
@@ -122,7 +126,8 @@ var maintenanceColumnDefs = []columnDef{
 	}},
 	{"Last", columnSpec{Title: "Last", Min: 10, Max: 12, Kind: cellDate}},
 	{"Next", columnSpec{Title: "Next", Min: 10, Max: 12, Kind: cellUrgency}},
-	{"Every", columnSpec{Title: "Every", Min: 6, Max: 10}},
+	{"Every", columnSpec{Title: "Every", Min: 6, Max: 10, Kind: cellDuration}},
+	{"Est", columnSpec{Title: "Est", Min: 8, Max: 12, Align: alignRight, Kind: cellMoney}},
 	{"Log", columnSpec{Title: "Log", Min: 4, Max: 6, Align: alignRight, Kind: cellDrilldown}},
 	{
 		"Docs",
@@ -304,7 +309,7 @@ var documentColumnDefs = []columnDef{
 	{"Title", columnSpec{Title: "Title", Min: 14, Max: 32, Flex: true}},
 	{"Entity", columnSpec{Title: "Entity", Min: 10, Max: 24, Flex: true, Kind: cellEntity}},
 	{"Type", columnSpec{Title: "Type", Min: 8, Max: 16}},
-	{"Size", columnSpec{Title: "Size", Min: 6, Max: 10, Align: alignRight, Kind: cellReadonly}},
+	{"Size", columnSpec{Title: "Size", Min: 6, Max: 10, Align: alignRight, Kind: cellNumber}},
 	{"Model", columnSpec{Title: "Model", Min: 8, Max: 20, Kind: cellReadonly}},
 	{"Ops", columnSpec{Title: "Ops", Min: 4, Max: 6, Align: alignRight, Kind: cellOps}},
 	{"Notes", columnSpec{Title: "Notes", Min: 12, Max: 40, Flex: true, Kind: cellNotes}},
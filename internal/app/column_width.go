@@ -0,0 +1,105 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Column width overrides: < and > narrow or widen the focused column, = goes
+// back to the computed width. Overrides are saved per tab by column title,
+// like saved views, so they survive column reordering.
+const (
+	colWidthStep = 2
+	colWidthMin  = 3
+	colWidthMax  = 80
+)
+
+// columnWidthsKey returns the settings key suffix for a tab's column widths.
+func columnWidthsKey(kind TabKind) string {
+	return strings.ToLower(kind.String())
+}
+
+// loadColumnWidths applies the saved width overrides to every top-level tab.
+// Titles that no longer match a column are ignored.
+func (m *Model) loadColumnWidths() error {
+	for i := range m.tabs {
+		tab := &m.tabs[i]
+		widths, err := m.store.GetColumnWidths(columnWidthsKey(tab.Kind))
+		if err != nil {
+			return err
+		}
+		for j := range tab.Specs {
+			if w, ok := widths[tab.Specs[j].Title]; ok {
+				tab.Specs[j].Width = clampColumnWidth(w)
+			}
+		}
+	}
+	return nil
+}
+
+func clampColumnWidth(w int) int {
+	return min(max(w, colWidthMin), colWidthMax)
+}
+
+// resizeColumn widens (delta > 0) or narrows the focused column, starting
+// from its current rendered width. Detail views have no saved widths.
+func (m *Model) resizeColumn(delta int) {
+	if m.inDetail() {
+		m.setStatusInfo("Column widths are not available in detail views.")
+		return
+	}
+	tab := m.activeTab()
+	if tab == nil || tab.ColCursor < 0 || tab.ColCursor >= len(tab.Specs) {
+		return
+	}
+	spec := &tab.Specs[tab.ColCursor]
+	current := spec.Width
+	if current == 0 {
+		vp := m.tabViewport(tab)
+		if vp.Cursor < 0 || vp.Cursor >= len(vp.Widths) {
+			return
+		}
+		current = vp.Widths[vp.Cursor]
+	}
+	spec.Width = clampColumnWidth(current + delta)
+	m.saveColumnWidths(tab)
+	m.setStatusInfo(fmt.Sprintf("%s width: %d.", spec.Title, spec.Width))
+}
+
+// resetColumnWidth drops the focused column's override so its width is
+// computed from content again.
+func (m *Model) resetColumnWidth() {
+	if m.inDetail() {
+		m.setStatusInfo("Column widths are not available in detail views.")
+		return
+	}
+	tab := m.activeTab()
+	if tab == nil || tab.ColCursor < 0 || tab.ColCursor >= len(tab.Specs) {
+		return
+	}
+	spec := &tab.Specs[tab.ColCursor]
+	if spec.Width == 0 {
+		return
+	}
+	spec.Width = 0
+	m.saveColumnWidths(tab)
+	m.setStatusInfo(fmt.Sprintf("%s width: auto.", spec.Title))
+}
+
+// saveColumnWidths persists the tab's overrides and redraws it.
+func (m *Model) saveColumnWidths(tab *Tab) {
+	m.updateTabViewport(tab)
+	if m.store == nil {
+		return
+	}
+	widths := make(map[string]int)
+	for _, s := range tab.Specs {
+		if s.Width > 0 {
+			widths[s.Title] = s.Width
+		}
+	}
+	m.surfaceError(m.store.PutColumnWidths(columnWidthsKey(tab.Kind), widths))
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"testing"
+
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newColumnWidthModel returns a model on the Appliances tab with the cursor
+// on the Name column.
+func newColumnWidthModel(t *testing.T) (*Model, *Tab) {
+	t.Helper()
+	m := newTestModelWithStore(t)
+	require.NoError(t, m.store.CreateAppliance(&data.Appliance{Name: "Fridge"}))
+	m.active = tabIndex(tabAppliances)
+	require.NoError(t, m.reloadActiveTab())
+	tab := m.activeTab()
+	tab.ColCursor = int(applianceColName)
+	return m, tab
+}
+
+func TestWidenColumnPersists(t *testing.T) {
+	t.Parallel()
+	m, tab := newColumnWidthModel(t)
+	vp := m.tabViewport(tab)
+	start := vp.Widths[vp.Cursor]
+
+	sendKey(m, ">")
+	assert.Equal(t, start+colWidthStep, tab.Specs[applianceColName].Width)
+	vp = m.tabViewport(tab)
+	assert.Equal(t, start+colWidthStep, vp.Widths[vp.Cursor], "layout honors the override")
+	assert.Contains(t, m.statusView(), "Name width")
+
+	widths, err := m.store.GetColumnWidths(columnWidthsKey(tabAppliances))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"Name": start + colWidthStep}, widths)
+
+	// A fresh session picks the override back up.
+	tab.Specs[applianceColName].Width = 0
+	require.NoError(t, m.loadColumnWidths())
+	assert.Equal(t, start+colWidthStep, tab.Specs[applianceColName].Width)
+}
+
+func TestNarrowColumnClampsAtMinimum(t *testing.T) {
+	t.Parallel()
+	m, tab := newColumnWidthModel(t)
+	tab.Specs[applianceColName].Width = colWidthMin + 1
+
+	sendKey(m, "<")
+	assert.Equal(t, colWidthMin, tab.Specs[applianceColName].Width)
+	sendKey(m, "<")
+	assert.Equal(t, colWidthMin, tab.Specs[applianceColName].Width)
+}
+
+func TestResetColumnWidth(t *testing.T) {
+	t.Parallel()
+	m, tab := newColumnWidthModel(t)
+	sendKey(m, ">")
+	require.Positive(t, tab.Specs[applianceColName].Width)
+
+	sendKey(m, "=")
+	assert.Zero(t, tab.Specs[applianceColName].Width)
+	widths, err := m.store.GetColumnWidths(columnWidthsKey(tabAppliances))
+	require.NoError(t, err)
+	assert.Empty(t, widths)
+}
+
+func TestLoadColumnWidthsClampsAndSkipsUnknownTitles(t *testing.T) {
+	t.Parallel()
+	m, tab := newColumnWidthModel(t)
+	require.NoError(t, m.store.PutColumnWidths(columnWidthsKey(tabAppliances), map[string]int{
+		"Serial": 500,
+		"Gone":   10,
+	}))
+
+	require.NoError(t, m.loadColumnWidths())
+	assert.Equal(t, colWidthMax, tab.Specs[applianceColSerial].Width)
+}
+
+func TestResizeColumnInDetailView(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.active = tabIndex(tabAppliances)
+	require.NoError(t, m.openApplianceMaintenanceDetail("01JTEST00000000000000005", "Dishwasher"))
+
+	sendKey(m, ">")
+	assert.Contains(t, m.statusView(), "not available in detail views")
+}
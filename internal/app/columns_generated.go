@@ -14,6 +14,7 @@ const (
 	projectColStatus
 	projectColBudget
 	projectColActual
+	projectColSpent
 	projectColStart
 	projectColEnd
 	projectColQuotes
@@ -30,7 +31,9 @@ const (
 	quoteColLabor
 	quoteColMat
 	quoteColOther
+	quoteColTax
 	quoteColRecv
+	quoteColAccepted
 	quoteColDocs
 )
 
@@ -41,10 +44,12 @@ const (
 	maintenanceColItem
 	maintenanceColCategory
 	maintenanceColSeason
+	maintenanceColMonth
 	maintenanceColAppliance
 	maintenanceColLast
 	maintenanceColNext
 	maintenanceColEvery
+	maintenanceColEst
 	maintenanceColLog
 	maintenanceColDocs
 )
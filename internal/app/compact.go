@@ -4,6 +4,7 @@
 package app
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/micasa-dev/micasa/internal/locale"
@@ -70,6 +71,51 @@ func compactMoneyCells(rows [][]cell, cur locale.Currency) [][]cell {
 	})
 }
 
+// exactMoneyCells is the full-precision counterpart of compactMoneyCells:
+// money values keep every digit but drop the currency symbol.
+func exactMoneyCells(rows [][]cell, cur locale.Currency) [][]cell {
+	return transformCells(rows, func(c cell) cell {
+		if c.Kind != cellMoney {
+			return c
+		}
+		c.Value = exactMoneyValue(c.Value, cur)
+		return c
+	})
+}
+
+// localizeCells returns a copy of the cell grid with dates and counts
+// rendered for the UI locale. Like compactMoneyCells this only affects
+// display; sorting and filtering keep using the ISO and plain values.
+func localizeCells(rows [][]cell, display locale.Display) [][]cell {
+	if display.Tag() == "" {
+		return rows
+	}
+	return transformCells(rows, func(c cell) cell {
+		if c.Kind == cellDate || c.Kind == cellWarranty || c.Kind == cellUrgency {
+			c.Value = display.DateString(c.Value)
+		} else if c.Kind == cellDrilldown {
+			if n, err := strconv.Atoi(strings.TrimSpace(c.Value)); err == nil {
+				c.Value = display.Count(n)
+			}
+		}
+		return c
+	})
+}
+
+// exactMoneyValue reformats a money string at full precision without the
+// currency symbol (e.g. "5,200.00").
+func exactMoneyValue(v string, cur locale.Currency) string {
+	v = strings.TrimSpace(v)
+	if v == "" || v == "—" {
+		return v
+	}
+	cents, err := cur.ParseRequiredCents(v)
+	if err != nil {
+		return v
+	}
+	return stripCurrencySymbol(cur.FormatCents(cents), cur)
+}
+
 // compactMoneyValue converts a full-precision money string to compact form
 // without the currency symbol (e.g. "5.2k", "100.00"). The symbol is
 // handled by the column header annotation instead.
@@ -82,10 +128,14 @@ func compactMoneyValue(v string, cur locale.Currency) string {
 	if err != nil {
 		return v
 	}
-	compact := cur.FormatCompactCents(cents)
-	compact = strings.TrimPrefix(compact, cur.Symbol())
-	compact = strings.TrimSuffix(compact, cur.Symbol())
-	compact = strings.TrimSpace(compact)
-	compact = strings.Trim(compact, "\u00a0")
-	return compact
+	return stripCurrencySymbol(cur.FormatCompactCents(cents), cur)
+}
+
+// stripCurrencySymbol removes a leading or trailing currency symbol and the
+// space that separates it from the amount.
+func stripCurrencySymbol(v string, cur locale.Currency) string {
+	v = strings.TrimPrefix(v, cur.Symbol())
+	v = strings.TrimSuffix(v, cur.Symbol())
+	v = strings.TrimSpace(v)
+	return strings.Trim(v, "\u00a0")
 }
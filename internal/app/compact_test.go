@@ -6,8 +6,10 @@ package app
 import (
 	"testing"
 
+	"github.com/micasa-dev/micasa/internal/data"
 	"github.com/micasa-dev/micasa/internal/locale"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // ---------------------------------------------------------------------------
@@ -161,6 +163,84 @@ func TestCompactMoneyCellsPreservesNull(t *testing.T) {
 	assert.Equal(t, cellMoney, out[0][0].Kind)
 }
 
+func TestExactMoneyCells(t *testing.T) {
+	t.Parallel()
+	cur := locale.DefaultCurrency()
+	rows := [][]cell{
+		{
+			{Value: "Kitchen", Kind: cellText},
+			{Value: "$5,234.23", Kind: cellMoney},
+			{Value: "", Kind: cellMoney, Null: true},
+		},
+	}
+	out := exactMoneyCells(rows, cur)
+	assert.Equal(t, "Kitchen", out[0][0].Value)
+	assert.Equal(t, "5,234.23", out[0][1].Value, "full precision, $ stripped")
+	assert.True(t, out[0][2].Null)
+	assert.Equal(t, "$5,234.23", rows[0][1].Value, "original rows not modified")
+}
+
+func TestToggleExactMoneyKey(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	types, err := m.store.ProjectTypes()
+	require.NoError(t, err)
+	budget := int64(523423)
+	require.NoError(t, m.store.CreateProject(&data.Project{
+		Title: "Kitchen", ProjectTypeID: types[0].ID,
+		Status: data.ProjectStatusPlanned, BudgetCents: &budget,
+	}))
+	m.active = tabIndex(tabProjects)
+	require.NoError(t, m.reloadActiveTab())
+
+	view := m.tableView(m.activeTab())
+	assert.Contains(t, view, "5.2k")
+	assert.NotContains(t, view, "5,234.23")
+
+	sendKey(m, "M")
+	assert.True(t, m.exactMoney)
+	assert.Contains(t, m.statusView(), "money: exact")
+	view = m.tableView(m.activeTab())
+	assert.Contains(t, view, "5,234.23")
+	assert.NotContains(t, view, "5.2k")
+
+	sendKey(m, "M")
+	assert.False(t, m.exactMoney)
+	assert.Contains(t, m.tableView(m.activeTab()), "5.2k")
+}
+
+func TestLocalizeCells(t *testing.T) {
+	t.Parallel()
+	display, err := locale.ParseDisplay("de-DE")
+	require.NoError(t, err)
+	rows := [][]cell{
+		{
+			{Value: "1234", Kind: cellReadonly},
+			{Value: "2026-03-07", Kind: cellDate},
+			{Value: "2027-01-31", Kind: cellWarranty},
+			{Value: "1234", Kind: cellDrilldown},
+			{Value: "2026-03-07", Kind: cellText},
+		},
+	}
+	out := localizeCells(rows, display)
+
+	assert.Equal(t, "1234", out[0][0].Value, "IDs are not grouped")
+	assert.Equal(t, "07.03.2026", out[0][1].Value)
+	assert.Equal(t, "31.01.2027", out[0][2].Value)
+	assert.Equal(t, "1.234", out[0][3].Value)
+	assert.Equal(t, "2026-03-07", out[0][4].Value, "text is left alone")
+
+	// Original rows keep ISO values for sorting and filtering.
+	assert.Equal(t, "2026-03-07", rows[0][1].Value)
+}
+
+func TestLocalizeCellsDefaultIsIdentity(t *testing.T) {
+	t.Parallel()
+	rows := [][]cell{{{Value: "2026-03-07", Kind: cellDate}}}
+	out := localizeCells(rows, locale.Display{})
+	assert.Equal(t, "2026-03-07", out[0][0].Value)
+}
+
 func TestAnnotateMoneyHeaders(t *testing.T) {
 	t.Parallel()
 	cur := locale.DefaultCurrency()
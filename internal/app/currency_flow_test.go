@@ -268,6 +268,32 @@ func TestCurrencyFlow_ResolutionOrder_EmptyFallsBackToUSD(t *testing.T) {
 	assert.Equal(t, "USD", store.Currency().Code())
 }
 
+func TestCurrencyFlow_CustomFormat(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "test.db")
+	require.NoError(t, os.WriteFile(path, templateBytes, 0o600))
+	f, err := locale.ParseCurrencyFormat("₦1,234.56")
+	require.NoError(t, err)
+	formats := map[string]locale.CurrencyFormat{"XNG": f}
+
+	store1, err := data.Open(path)
+	require.NoError(t, err)
+	store1.SetCurrencyFormats(formats)
+	require.NoError(t, store1.ResolveCurrency("XNG"),
+		"a custom format makes a non-ISO code resolvable")
+	assert.Equal(t, "₦1,234.56", store1.Currency().FormatCents(123456))
+	require.NoError(t, store1.Close())
+
+	// The persisted code needs the format again on the next open.
+	store2, err := data.Open(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store2.Close() })
+	require.Error(t, store2.ResolveCurrency(""))
+	store2.SetCurrencyFormats(formats)
+	require.NoError(t, store2.ResolveCurrency(""))
+	assert.Equal(t, "XNG", store2.Currency().Code())
+}
+
 // ---------------------------------------------------------------------------
 // 6. Form validation: parsing with different currencies
 // ---------------------------------------------------------------------------
@@ -420,7 +446,7 @@ func TestCurrencyFlow_ProjectRows(t *testing.T) {
 					BudgetCents: &budget,
 				},
 			}
-			_, _, cells := projectRows(projects, nil, nil, cur)
+			_, _, cells := projectRows(projects, nil, nil, nil, cur)
 			require.Len(t, cells, 1)
 			assert.Equal(t, cur.FormatCents(250000), cells[0][4].Value)
 		})
@@ -464,6 +490,115 @@ func TestCurrencyFlow_ApplianceRows(t *testing.T) {
 	}
 }
 
+func TestCurrencyFlow_RecordCurrencyRows(t *testing.T) {
+	t.Parallel()
+	house := locale.MustResolve("USD", language.AmericanEnglish)
+	eur, err := house.WithCode("EUR")
+	require.NoError(t, err)
+	cents := int64(123456)
+	now := time.Now()
+
+	_, _, cells := projectRows([]data.Project{{
+		ID: "01JTEST00000000000000001", Title: "Villa roof",
+		BudgetCents: &cents, ActualCents: &cents, BudgetCurrency: "EUR",
+	}}, nil, nil, nil, house)
+	require.Len(t, cells, 1)
+	assert.Equal(t, eur.FormatCents(cents), cells[0][int(projectColBudget)].Value)
+	assert.Equal(t, eur.FormatCents(cents), cells[0][int(projectColActual)].Value)
+
+	_, _, cells = applianceRows([]data.Appliance{
+		{ID: "01JTEST00000000000000001", Name: "Kettle", CostCents: &cents, CostCurrency: "EUR"},
+		{ID: "01JTEST00000000000000002", Name: "Fridge", CostCents: &cents},
+		{ID: "01JTEST00000000000000003", Name: "Odd", CostCents: &cents, CostCurrency: "NOPE"},
+	}, nil, nil, now, house)
+	require.Len(t, cells, 3)
+	assert.Equal(t, eur.FormatCents(cents), cells[0][int(applianceColCost)].Value)
+	assert.Equal(t, house.FormatCents(cents), cells[1][int(applianceColCost)].Value)
+	assert.Equal(t, house.FormatCents(cents), cells[2][int(applianceColCost)].Value,
+		"an unknown code falls back to the house currency")
+}
+
+func TestCurrencyFlow_ApplianceCurrencyEditCycle(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithCurrency(t, "USD", language.AmericanEnglish)
+	item := data.Appliance{Name: "Espresso machine"}
+	require.NoError(t, m.store.CreateAppliance(&item))
+
+	m.active = tabIndex(tabAppliances)
+	require.NoError(t, m.startEditApplianceForm(item.ID))
+	values, ok := m.fs.formData.(*applianceFormData)
+	require.True(t, ok)
+	assert.Equal(t, "USD", values.Currency, "form defaults to the house currency")
+
+	values.Currency = "eur"
+	values.Cost = "1234.56"
+	sendKey(m, "ctrl+s")
+	sendKey(m, "esc")
+
+	got, err := m.store.GetAppliance(item.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", got.CostCurrency)
+	require.NotNil(t, got.CostCents)
+	assert.Equal(t, int64(123456), *got.CostCents)
+
+	require.NoError(t, m.reloadActiveTab())
+	tab := m.activeTab()
+	require.NotEmpty(t, tab.CellRows)
+	assert.Contains(t, tab.CellRows[0][int(applianceColCost)].Value, "€")
+
+	require.NoError(t, m.startEditApplianceForm(item.ID))
+	values, ok = m.fs.formData.(*applianceFormData)
+	require.True(t, ok)
+	assert.Equal(t, "EUR", values.Currency)
+	require.NoError(t, m.recordMoney("cost", &values.Currency)(values.Cost),
+		"the cost shown in euros validates as euros")
+	values.Currency = "usd"
+	values.Cost = "99.00"
+	sendKey(m, "ctrl+s")
+	sendKey(m, "esc")
+
+	got, err = m.store.GetAppliance(item.ID)
+	require.NoError(t, err)
+	assert.Empty(t, got.CostCurrency, "the house currency is stored as blank")
+	require.NotNil(t, got.CostCents)
+	assert.Equal(t, int64(9900), *got.CostCents)
+}
+
+func TestCurrencyFlow_ProjectCurrencyForm(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithCurrency(t, "GBP", language.BritishEnglish)
+	id := seedProject(t, m)
+
+	m.active = tabIndex(tabProjects)
+	require.NoError(t, m.startEditProjectForm(id))
+	values, ok := m.fs.formData.(*projectFormData)
+	require.True(t, ok)
+	assert.Equal(t, "GBP", values.Currency)
+
+	values.Currency = "JPY"
+	values.Budget = "15000"
+	sendKey(m, "ctrl+s")
+	sendKey(m, "esc")
+
+	got, err := m.store.GetProject(id)
+	require.NoError(t, err)
+	assert.Equal(t, "JPY", got.BudgetCurrency)
+	require.NotNil(t, got.BudgetCents)
+	assert.Equal(t, int64(1500000), *got.BudgetCents)
+}
+
+func TestCurrencyFlow_RecordCurrencyValidator(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithCurrency(t, "USD", language.AmericanEnglish)
+	validate := m.recordCurrencyValidator()
+	require.NoError(t, validate(""))
+	require.NoError(t, validate("chf"))
+	require.Error(t, validate("NOPE"))
+
+	_, _, err := m.parseRecordCurrency("NOPE")
+	require.Error(t, err)
+}
+
 // ---------------------------------------------------------------------------
 // 9. Compact money cells strip symbol correctly
 // ---------------------------------------------------------------------------
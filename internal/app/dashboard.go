@@ -13,6 +13,7 @@ import (
 	"charm.land/lipgloss/v2"
 	"github.com/charmbracelet/x/ansi"
 	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/micasa-dev/micasa/internal/locale"
 )
 
 // Dashboard section title constants.
@@ -23,6 +24,7 @@ const (
 	dashSectionSeasonal  = "Seasonal"
 	dashSectionProjects  = "Active Projects"
 	dashSectionExpiring  = "Expiring Soon"
+	dashSectionTotals    = "Totals"
 )
 
 // ---------------------------------------------------------------------------
@@ -44,9 +46,20 @@ type dashboardData struct {
 	Upcoming           []maintenanceUrgency
 	Seasonal           []data.MaintenanceItem
 	ActiveProjects     []data.Project
+	ProjectSpent       map[string]int64
 	OpenIncidents      []data.Incident
 	ExpiringWarranties []warrantyStatus
 	InsuranceRenewal   *insuranceStatus
+	Totals             []data.CurrencyTotal // one per currency, house first
+	ApproxTotal        *approxTotal         // Totals in the base currency; nil without rates
+}
+
+// approxTotal is the dashboard totals converted into the base currency at
+// the configured rates. Missing lists the currencies without a rate, which
+// are left out of the sums.
+type approxTotal struct {
+	data.CurrencyTotal
+	Missing []string
 }
 
 func (d dashboardData) empty() bool {
@@ -250,7 +263,10 @@ func (m *Model) loadDashboardAt(now time.Time) error {
 		return fmt.Errorf("load maintenance: %w", err)
 	}
 	for _, item := range items {
-		nextDue := data.ComputeNextDue(item.LastServicedAt, item.IntervalMonths, item.DueDate)
+		if isSnoozed(now, item) {
+			continue
+		}
+		nextDue := data.ComputeNextDue(item.LastServicedAt, item.Interval(), item.DueDate)
 		if nextDue == nil {
 			continue
 		}
@@ -265,7 +281,7 @@ func (m *Model) loadDashboardAt(now time.Time) error {
 			DaysFromNow:   days,
 			ApplianceName: appName,
 		}
-		if days < 0 {
+		if days < -m.maintenanceGraceDays {
 			d.Overdue = append(d.Overdue, entry)
 		} else if days <= 30 {
 			d.Upcoming = append(d.Upcoming, entry)
@@ -282,12 +298,28 @@ func (m *Model) loadDashboardAt(now time.Time) error {
 	if err != nil {
 		return fmt.Errorf("load seasonal maintenance: %w", err)
 	}
+	d.Seasonal = slices.DeleteFunc(d.Seasonal, func(item data.MaintenanceItem) bool {
+		return isSnoozed(now, item)
+	})
 
 	// Active projects.
 	d.ActiveProjects, err = m.store.ListActiveProjects()
 	if err != nil {
 		return fmt.Errorf("load active projects: %w", err)
 	}
+	d.ProjectSpent, err = m.store.SpentCentsByProject(
+		entityIDs(d.ActiveProjects, func(p data.Project) string { return p.ID }),
+	)
+	if err != nil {
+		return fmt.Errorf("load project spending: %w", err)
+	}
+
+	totals, err := m.store.TotalsByCurrency()
+	if err != nil {
+		return fmt.Errorf("load totals: %w", err)
+	}
+	d.Totals = groupTotals(totals, m.cur)
+	d.ApproxTotal = convertTotals(d.Totals, m.cur, m.currencyRates)
 
 	// Open incidents.
 	d.OpenIncidents, err = m.store.ListOpenIncidents()
@@ -295,10 +327,9 @@ func (m *Model) loadDashboardAt(now time.Time) error {
 		return fmt.Errorf("load open incidents: %w", err)
 	}
 
-	// Expiring warranties (expired within 30 days or expiring within 90).
-	appliances, err := m.store.ListExpiringWarranties(
-		now, 30*24*time.Hour, 90*24*time.Hour,
-	)
+	// Recently expired and soon-expiring warranties.
+	window := m.effectiveWarrantyWindow()
+	appliances, err := m.store.ListExpiringWarranties(now, window)
 	if err != nil {
 		return fmt.Errorf("load warranties: %w", err)
 	}
@@ -316,7 +347,7 @@ func (m *Model) loadDashboardAt(now time.Time) error {
 	// Insurance renewal.
 	if m.hasHouse && m.house.InsuranceRenewal != nil {
 		days := daysUntil(now, *m.house.InsuranceRenewal)
-		if days >= -30 && days <= 90 {
+		if days >= -window.LookBackDays && days <= window.LookAheadDays {
 			d.InsuranceRenewal = &insuranceStatus{
 				Carrier:     m.house.InsuranceCarrier,
 				RenewalDate: *m.house.InsuranceRenewal,
@@ -391,6 +422,15 @@ func (m *Model) buildDashNav() {
 		func(p data.Project) string { return p.ID },
 	))
 
+	totals := make([]dashNavEntry, len(d.Totals))
+	if d.ApproxTotal != nil {
+		totals = append(totals, dashNavEntry{})
+	}
+	for i := range totals {
+		totals[i] = dashNavEntry{Section: dashSectionTotals, InfoOnly: true}
+	}
+	add(dashSectionTotals, totals)
+
 	// Expiring: warranties + optional insurance renewal row.
 	expiring := dashNavSection(
 		d.ExpiringWarranties, tabAppliances, dashSectionExpiring,
@@ -484,19 +524,31 @@ func (m *Model) dashboardView(budget, maxWidth int) string {
 	if seasonalRows := m.dashSeasonalRows(); len(seasonalRows) > 0 {
 		sections = append(sections, dashSection{
 			title:   dashSectionSeasonal,
-			headers: []string{"", "category"},
+			headers: []string{"", "category", "month"},
 			rows:    seasonalRows,
 		})
 	}
 
 	if projRows := m.dashProjectRows(); len(projRows) > 0 {
+		headers := []string{"", "status", "started"}
+		if m.houseHasArea() {
+			headers = append(headers, "per "+data.AreaUnit(m.unitSystem))
+		}
 		sections = append(sections, dashSection{
 			title:   dashSectionProjects,
-			headers: []string{"", "status", "started"},
+			headers: headers,
 			rows:    projRows,
 		})
 	}
 
+	if totalRows := m.dashTotalRows(); len(totalRows) > 0 {
+		sections = append(sections, dashSection{
+			title:   dashSectionTotals,
+			headers: []string{"", "budget", "actual", "appliances"},
+			rows:    totalRows,
+		})
+	}
+
 	if expRows := m.dashExpiringRows(); len(expRows) > 0 {
 		sections = append(sections, dashSection{
 			title:   dashSectionExpiring,
@@ -632,7 +684,7 @@ func (m *Model) dashSectionHeader(
 			Padding(0, 1)
 	}
 	badge := style.Render(title)
-	dim := m.styles.DashLabel().Render(fmt.Sprintf(" %d", count))
+	dim := m.styles.DashLabel().Render(" " + m.display.Count(count))
 	return badge + dim
 }
 
@@ -642,25 +694,30 @@ func (m *Model) dashSectionHeader(
 
 // dashMaintSplitRows returns overdue and upcoming rows as separate slices.
 // Duration cells use the section's accent color: warning for overdue,
-// upcoming style for due-soon.
+// upcoming style for due-soon. Items still inside the maintenance grace
+// period sit in the upcoming section with a "late" suffix.
 func (m *Model) dashMaintSplitRows() (overdue, upcoming []dashRow) {
-	overdue = m.maintUrgencyRows(m.dash.data.Overdue, m.styles.DashOverdue())
-	upcoming = m.maintUrgencyRows(m.dash.data.Upcoming, m.styles.DashUpcoming())
+	overdue = m.maintUrgencyRows(m.dash.data.Overdue, m.styles.DashOverdue(), false)
+	upcoming = m.maintUrgencyRows(m.dash.data.Upcoming, m.styles.DashUpcoming(), true)
 	return overdue, upcoming
 }
 
 func (m *Model) maintUrgencyRows(
-	items []maintenanceUrgency, durStyle lipgloss.Style,
+	items []maintenanceUrgency, durStyle lipgloss.Style, markLate bool,
 ) []dashRow {
 	if len(items) == 0 {
 		return nil
 	}
 	rows := make([]dashRow, 0, len(items))
 	for _, e := range items {
+		dur := daysText(e.DaysFromNow)
+		if markLate && e.DaysFromNow < 0 {
+			dur += " late"
+		}
 		rows = append(rows, dashRow{
 			Cells: []dashCell{
 				{Text: e.Item.Name, Style: m.styles.DashValue()},
-				{Text: daysText(e.DaysFromNow), Style: durStyle, Align: alignRight},
+				{Text: dur, Style: durStyle, Align: alignRight},
 			},
 			Target: &dashNavEntry{Tab: tabMaintenance, ID: e.Item.ID},
 		})
@@ -672,10 +729,15 @@ func (m *Model) dashSeasonalRows() []dashRow {
 	d := m.dash.data
 	rows := make([]dashRow, 0, len(d.Seasonal))
 	for _, item := range d.Seasonal {
+		var month string
+		if item.Month >= 1 && item.Month <= 12 {
+			month = shortMonthName(item.Month)
+		}
 		rows = append(rows, dashRow{
 			Cells: []dashCell{
 				{Text: item.Name, Style: m.styles.DashValue()},
 				{Text: item.Category.Name, Style: m.styles.DashLabel()},
+				{Text: month, Style: m.styles.DashLabel()},
 			},
 			Target: &dashNavEntry{Tab: tabMaintenance, ID: item.ID},
 		})
@@ -691,18 +753,158 @@ func (m *Model) dashProjectRows() []dashRow {
 		statusStyle, _ := m.styles.StatusStyle(p.Status)
 		statusText := statusLabel(p.Status)
 		started := pastDur(now.Sub(p.CreatedAt))
+		cells := []dashCell{
+			{Text: p.Title, Style: m.styles.DashValue()},
+			{Text: statusText, Style: statusStyle},
+			{Text: started, Style: m.styles.DashLabel(), Align: alignRight},
+		}
+		if m.houseHasArea() {
+			cells = append(cells, dashCell{
+				Text: m.projectCostPerArea(p), Style: m.styles.Money(), Align: alignRight,
+			})
+		}
 		rows = append(rows, dashRow{
-			Cells: []dashCell{
-				{Text: p.Title, Style: m.styles.DashValue()},
-				{Text: statusText, Style: statusStyle},
-				{Text: started, Style: m.styles.DashLabel(), Align: alignRight},
-			},
+			Cells:  cells,
 			Target: &dashNavEntry{Tab: tabProjects, ID: p.ID},
 		})
 	}
 	return rows
 }
 
+// houseHasArea reports whether the house profile has a building area to
+// normalize project costs against.
+func (m *Model) houseHasArea() bool {
+	return m.hasHouse && m.house.SquareFeet > 0
+}
+
+// projectCostPerArea renders a project's cost per unit of house area, e.g.
+// "$18.75/ft²". Uses the recorded actual cost, falling back to the sum of
+// accepted quotes. Empty when either the cost or the house area is unknown.
+func (m *Model) projectCostPerArea(p data.Project) string {
+	var cost int64
+	cur := m.cur
+	switch spent, ok := m.dash.data.ProjectSpent[p.ID]; {
+	case p.ActualCents != nil:
+		cost = *p.ActualCents
+		cur = recordCurrency(m.cur, p.BudgetCurrency)
+	case ok:
+		cost = spent
+	default:
+		return ""
+	}
+	per, ok := data.CentsPerArea(cost, m.house.SquareFeet, m.unitSystem)
+	if !ok {
+		return ""
+	}
+	return cur.FormatCents(per) + "/" + data.AreaUnit(m.unitSystem)
+}
+
+// groupTotals merges totals whose stored codes name the same currency --
+// blank and the house code both mean the house currency -- and puts the
+// house currency first.
+func groupTotals(totals []data.CurrencyTotal, house locale.Currency) []data.CurrencyTotal {
+	var grouped []data.CurrencyTotal
+	index := make(map[string]int)
+	for _, t := range totals {
+		code := recordCurrency(house, t.Currency).Code()
+		i, ok := index[code]
+		if !ok {
+			i = len(grouped)
+			index[code] = i
+			grouped = append(grouped, data.CurrencyTotal{Currency: code})
+		}
+		grouped[i].BudgetCents += t.BudgetCents
+		grouped[i].ActualCents += t.ActualCents
+		grouped[i].ApplianceCents += t.ApplianceCents
+	}
+	slices.SortStableFunc(grouped, func(a, b data.CurrencyTotal) int {
+		switch {
+		case a.Currency == b.Currency:
+			return 0
+		case a.Currency == house.Code():
+			return -1
+		case b.Currency == house.Code():
+			return 1
+		}
+		return strings.Compare(a.Currency, b.Currency)
+	})
+	return grouped
+}
+
+// convertTotals adds up per-currency totals in the base currency the rates
+// are quoted in, the house currency unless configured otherwise. Nil
+// without rates, or when everything is already in the base currency.
+func convertTotals(
+	totals []data.CurrencyTotal,
+	house locale.Currency,
+	rates locale.Rates,
+) *approxTotal {
+	rates = rates.WithDefaultBase(house.Code())
+	base := rates.Base()
+	if rates.IsZero() || len(totals) == 0 ||
+		(len(totals) == 1 && totals[0].Currency == base) {
+		return nil
+	}
+	approx := &approxTotal{CurrencyTotal: data.CurrencyTotal{Currency: base}}
+	for _, t := range totals {
+		if _, ok := rates.Convert(0, t.Currency, base); !ok {
+			approx.Missing = append(approx.Missing, t.Currency)
+			continue
+		}
+		convert := func(cents int64) int64 {
+			converted, _ := rates.Convert(cents, t.Currency, base)
+			return converted
+		}
+		approx.BudgetCents += convert(t.BudgetCents)
+		approx.ActualCents += convert(t.ActualCents)
+		approx.ApplianceCents += convert(t.ApplianceCents)
+	}
+	return approx
+}
+
+// dashTotalRows renders one row of totals per currency. Amounts recorded
+// in different currencies are only added together in the trailing
+// approximate row, when rates are configured.
+func (m *Model) dashTotalRows() []dashRow {
+	rows := make([]dashRow, 0, len(m.dash.data.Totals)+1)
+	totalRow := func(label dashCell, t data.CurrencyTotal) dashRow {
+		cur := recordCurrency(m.cur, t.Currency)
+		money := func(cents int64) dashCell {
+			if cents == 0 {
+				return dashCell{Text: "", Align: alignRight}
+			}
+			return dashCell{Text: cur.FormatCents(cents), Style: m.styles.Money(), Align: alignRight}
+		}
+		return dashRow{
+			Cells: []dashCell{
+				label,
+				money(t.BudgetCents),
+				money(t.ActualCents),
+				money(t.ApplianceCents),
+			},
+			Target: &dashNavEntry{InfoOnly: true},
+		}
+	}
+	for _, t := range m.dash.data.Totals {
+		rows = append(rows, totalRow(dashCell{Text: t.Currency, Style: m.styles.DashValue()}, t))
+	}
+	if approx := m.dash.data.ApproxTotal; approx != nil {
+		label := dashCell{
+			Text:  symApprox + " " + approx.Currency + " approx, at configured rates",
+			Style: m.styles.DashLabel(),
+		}
+		if len(approx.Missing) > 0 {
+			label = dashCell{
+				Text: fmt.Sprintf("%s %s approx, no rate for %s",
+					symApprox, approx.Currency, strings.Join(approx.Missing, ", ")),
+				Style: m.styles.DashOverdue(),
+			}
+		}
+		rows = append(rows, totalRow(label, approx.CurrencyTotal))
+	}
+	return rows
+}
+
 func (m *Model) dashIncidentRows() []dashRow {
 	d := m.dash.data
 	now := time.Now()
@@ -841,8 +1043,8 @@ func (m *Model) dashJump() {
 	m.showDashboard = false
 	m.closeAllDetails()
 	m.switchToTab(tabIndex(entry.Tab))
-	if tab := m.activeTab(); tab != nil {
-		selectRowByID(tab, entry.ID)
+	if tab := m.activeTab(); tab != nil && selectRowByID(tab, entry.ID) {
+		m.noteRecent()
 	}
 }
 
@@ -947,6 +1149,22 @@ func daysUntil(now, target time.Time) int {
 	return dateDiffDays(now, target)
 }
 
+// effectiveWarrantyWindow returns the configured warranty window, or the
+// default when none was configured. Configured look-aheads are at least a
+// day, so a zero look-ahead only comes from an unset window.
+func (m *Model) effectiveWarrantyWindow() data.WarrantyWindow {
+	if m.warrantyWindow.LookAheadDays == 0 {
+		return data.DefaultWarrantyWindow
+	}
+	return m.warrantyWindow
+}
+
+// isSnoozed reports whether item is snoozed past the day of now. Snoozed
+// items stay off the dashboard until their snooze date arrives.
+func isSnoozed(now time.Time, item data.MaintenanceItem) bool {
+	return item.SnoozedUntil != nil && daysUntil(now, *item.SnoozedUntil) > 0
+}
+
 func sortByDays(items []maintenanceUrgency) {
 	slices.SortFunc(items, func(a, b maintenanceUrgency) int {
 		return cmp.Compare(a.DaysFromNow, b.DaysFromNow)
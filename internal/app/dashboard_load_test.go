@@ -82,6 +82,42 @@ func TestLoadDashboardAtUpcomingWithin30Days(t *testing.T) {
 	assert.LessOrEqual(t, m.dash.data.Upcoming[0].DaysFromNow, 30)
 }
 
+func TestLoadDashboardAtSkipsSnoozedMaintenance(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	cats, _ := m.store.MaintenanceCategories()
+
+	lastSrv := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	overdue := data.MaintenanceItem{
+		Name:           "Replace Filter",
+		CategoryID:     cats[0].ID,
+		LastServicedAt: &lastSrv,
+		IntervalMonths: 3,
+	}
+	require.NoError(t, m.store.CreateMaintenance(&overdue))
+	seasonal := data.MaintenanceItem{
+		Name:       "Check Heat Tape",
+		CategoryID: cats[0].ID,
+		Season:     data.SeasonWinter,
+	}
+	require.NoError(t, m.store.CreateMaintenance(&seasonal))
+
+	until := time.Date(2026, 2, 8, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, m.store.SnoozeMaintenance(overdue.ID, &until))
+	require.NoError(t, m.store.SnoozeMaintenance(seasonal.ID, &until))
+
+	require.NoError(t, m.loadDashboardAt(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Empty(t, m.dash.data.Overdue)
+	assert.Empty(t, m.dash.data.Seasonal)
+
+	// Back on the dashboard once the snooze date arrives.
+	require.NoError(t, m.loadDashboardAt(until))
+	require.Len(t, m.dash.data.Overdue, 1)
+	assert.Equal(t, "Replace Filter", m.dash.data.Overdue[0].Item.Name)
+	require.Len(t, m.dash.data.Seasonal, 1)
+	assert.Equal(t, "Check Heat Tape", m.dash.data.Seasonal[0].Name)
+}
+
 func TestLoadDashboardAtActiveProjects(t *testing.T) {
 	t.Parallel()
 	m := newTestModelWithStore(t)
@@ -123,6 +159,30 @@ func TestLoadDashboardAtExpiringWarranties(t *testing.T) {
 	assert.Equal(t, "Dishwasher", m.dash.data.ExpiringWarranties[0].Appliance.Name)
 }
 
+func TestLoadDashboardAtConfiguredWarrantyWindow(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	m.warrantyWindow = data.WarrantyWindow{LookBackDays: 0, LookAheadDays: 14}
+
+	now := time.Date(2026, 2, 1, 9, 0, 0, 0, time.UTC)
+	for name, offset := range map[string]int{
+		"Expired": -1, "Today": 0, "Edge": 14, "Beyond": 15,
+	} {
+		expiry := now.AddDate(0, 0, offset).Truncate(24 * time.Hour)
+		require.NoError(t, m.store.CreateAppliance(&data.Appliance{
+			Name:           name,
+			WarrantyExpiry: &expiry,
+		}))
+	}
+	require.NoError(t, m.loadDashboardAt(now))
+
+	var names []string
+	for _, w := range m.dash.data.ExpiringWarranties {
+		names = append(names, w.Appliance.Name)
+	}
+	assert.Equal(t, []string{"Today", "Edge"}, names)
+}
+
 func TestLoadDashboardAtInsuranceRenewal(t *testing.T) {
 	t.Parallel()
 	m := newTestModelWithStore(t)
@@ -267,6 +327,41 @@ func TestLoadDashboardAtDueDateUpcoming(t *testing.T) {
 	assert.Equal(t, 10, m.dash.data.Upcoming[0].DaysFromNow)
 }
 
+func TestLoadDashboardAtMaintenanceGraceDays(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	m.maintenanceGraceDays = 7
+	cats, _ := m.store.MaintenanceCategories()
+
+	// 3 days past due -> inside the grace period, still upcoming.
+	recent := time.Date(2026, 1, 29, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, m.store.CreateMaintenance(&data.MaintenanceItem{
+		Name:       "Test Smoke Alarms",
+		CategoryID: cats[0].ID,
+		DueDate:    &recent,
+	}))
+	// 17 days past due -> beyond the grace period, overdue.
+	stale := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, m.store.CreateMaintenance(&data.MaintenanceItem{
+		Name:       "Inspect Roof",
+		CategoryID: cats[0].ID,
+		DueDate:    &stale,
+	}))
+
+	now := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, m.loadDashboardAt(now))
+
+	require.Len(t, m.dash.data.Overdue, 1)
+	assert.Equal(t, "Inspect Roof", m.dash.data.Overdue[0].Item.Name)
+	require.Len(t, m.dash.data.Upcoming, 1)
+	assert.Equal(t, "Test Smoke Alarms", m.dash.data.Upcoming[0].Item.Name)
+	assert.Equal(t, -3, m.dash.data.Upcoming[0].DaysFromNow)
+
+	_, upcoming := m.dashMaintSplitRows()
+	require.Len(t, upcoming, 1)
+	assert.Equal(t, "3d late", upcoming[0].Cells[1].Text)
+}
+
 func TestLoadDashboardAtDueDateFarFuture(t *testing.T) {
 	t.Parallel()
 	m := newTestModelWithStore(t)
@@ -360,3 +455,38 @@ func TestLoadDashboardAtOverdueCapDoesNotHideUpcoming(t *testing.T) {
 	// Upcoming must NOT be empty — a full overdue list should not hide upcoming.
 	assert.Len(t, m.dash.data.Upcoming, 5)
 }
+
+func TestShowDueSummary(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	now := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	m.showDueSummary(now)
+	assert.Empty(t, m.status.Text, "nothing due leaves the status bar alone")
+
+	cats, err := m.store.MaintenanceCategories()
+	require.NoError(t, err)
+	last := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, m.store.CreateMaintenance(&data.MaintenanceItem{
+		Name: "Replace Filter", CategoryID: cats[0].ID,
+		LastServicedAt: &last, IntervalMonths: 3,
+	}))
+
+	m.showDueSummary(now)
+	assert.Equal(t, statusInfo, m.status.Kind)
+	assert.Equal(t, "Needs attention: 1 overdue.", m.status.Text)
+}
+
+func TestShowNetworkFSWarning(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	_, onNetwork := m.store.NetworkFilesystem()
+	assert.False(t, onNetwork, "test databases live in a local temp dir")
+
+	m.showNetworkFSWarning("nfs")
+	assert.Equal(t, statusError, m.status.Kind)
+	assert.Equal(t,
+		"Database on a network share (nfs) -- locking may be unreliable; consider a local path.",
+		m.status.Text,
+	)
+}
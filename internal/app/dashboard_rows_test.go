@@ -87,6 +87,49 @@ func TestDashProjectRowsColumns(t *testing.T) {
 	assert.NotEmpty(t, rows[0].Cells[2].Text, "expected started duration")
 }
 
+func TestDashProjectRowsCostPerArea(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.styles = appStyles
+	m.hasHouse = true
+	m.house = data.HouseProfile{SquareFeet: 2000}
+
+	actual := int64(4500000)
+	m.dash.data = dashboardData{
+		ActiveProjects: []data.Project{
+			{ID: "p1", Title: "Kitchen", Status: data.ProjectStatusInProgress, ActualCents: &actual},
+			{ID: "p2", Title: "Roof", Status: data.ProjectStatusInProgress},
+			{ID: "p3", Title: "Deck", Status: data.ProjectStatusInProgress},
+		},
+		ProjectSpent: map[string]int64{"p2": 1000000},
+	}
+
+	rows := m.dashProjectRows()
+	require.Len(t, rows, 3)
+	assert.Equal(t, "$22.50/ft\u00B2", rows[0].Cells[3].Text, "actual cost wins")
+	assert.Equal(t, "$5.00/ft\u00B2", rows[1].Cells[3].Text, "falls back to accepted quotes")
+	assert.Empty(t, rows[2].Cells[3].Text, "no cost yet")
+}
+
+func TestDashProjectRowsOmitCostPerAreaWithoutHouseArea(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.styles = appStyles
+	m.hasHouse = true
+	m.house = data.HouseProfile{}
+
+	actual := int64(4500000)
+	m.dash.data = dashboardData{
+		ActiveProjects: []data.Project{
+			{ID: "p1", Title: "Kitchen", Status: data.ProjectStatusInProgress, ActualCents: &actual},
+		},
+	}
+
+	rows := m.dashProjectRows()
+	require.Len(t, rows, 1)
+	assert.Len(t, rows[0].Cells, 3)
+}
+
 func TestDashExpiringRowsOverdueAndUpcoming(t *testing.T) {
 	t.Parallel()
 	m := newTestModel(t)
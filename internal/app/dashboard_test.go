@@ -11,8 +11,10 @@ import (
 
 	"charm.land/lipgloss/v2"
 	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/micasa-dev/micasa/internal/locale"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
 )
 
 const testIncidentID = "01JTEST00000000000000005"
@@ -360,6 +362,121 @@ func TestDashboardViewWithData(t *testing.T) {
 	assert.Contains(t, view, "overdue")
 }
 
+func TestGroupTotals(t *testing.T) {
+	t.Parallel()
+	house := locale.MustResolve("USD", language.AmericanEnglish)
+	got := groupTotals([]data.CurrencyTotal{
+		{Currency: "", BudgetCents: 100, ApplianceCents: 5},
+		{Currency: "AUD", ActualCents: 7},
+		{Currency: "EUR", BudgetCents: 30},
+		{Currency: "USD", BudgetCents: 20, ActualCents: 1},
+	}, house)
+	assert.Equal(t, []data.CurrencyTotal{
+		{Currency: "USD", BudgetCents: 120, ActualCents: 1, ApplianceCents: 5},
+		{Currency: "AUD", ActualCents: 7},
+		{Currency: "EUR", BudgetCents: 30},
+	}, got, "blank and the house code merge, and the house currency leads")
+}
+
+func TestDashboardTotalsByCurrency(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.width = 120
+	m.height = 40
+	types, err := m.store.ProjectTypes()
+	require.NoError(t, err)
+	budget, euros := int64(500000), int64(120000)
+	require.NoError(t, m.store.CreateProject(&data.Project{
+		Title: "Deck", ProjectTypeID: types[0].ID, Status: data.ProjectStatusPlanned,
+		BudgetCents: &budget,
+	}))
+	require.NoError(t, m.store.CreateAppliance(&data.Appliance{
+		Name: "Villa fridge", CostCents: &euros, CostCurrency: "EUR",
+	}))
+
+	require.NoError(t, m.loadDashboardAt(time.Now()))
+	require.Len(t, m.dash.data.Totals, 2)
+	m.dash.expanded = map[string]bool{dashSectionTotals: true}
+	m.prepareDashboardView()
+
+	view := m.dashboardView(50, 120)
+	assert.Contains(t, view, dashSectionTotals)
+	assert.Contains(t, view, "$5,000.00")
+	assert.Contains(t, view, "€1,200.00")
+	assert.NotContains(t, view, "6,200", "currencies are never added together")
+}
+
+func TestConvertTotals(t *testing.T) {
+	t.Parallel()
+	house := locale.MustResolve("USD", language.AmericanEnglish)
+	totals := []data.CurrencyTotal{
+		{Currency: "USD", BudgetCents: 10000, ApplianceCents: 500},
+		{Currency: "EUR", BudgetCents: 10000},
+		{Currency: "GBP", ActualCents: 100},
+	}
+
+	assert.Nil(t, convertTotals(totals, house, locale.Rates{}), "no rates, no total")
+	assert.Nil(t, convertTotals(totals[:1], house, locale.NewRates("", map[string]float64{"EUR": 1.1})),
+		"everything in the base currency needs no total")
+
+	got := convertTotals(totals, house, locale.NewRates("", map[string]float64{"EUR": 1.1}))
+	require.NotNil(t, got)
+	assert.Equal(t, data.CurrencyTotal{
+		Currency: "USD", BudgetCents: 21000, ApplianceCents: 500,
+	}, got.CurrencyTotal)
+	assert.Equal(t, []string{"GBP"}, got.Missing)
+
+	// A euro base adds everything up in euros.
+	got = convertTotals(totals, house, locale.NewRates("EUR", map[string]float64{
+		"USD": 0.5, "GBP": 2,
+	}))
+	require.NotNil(t, got)
+	assert.Equal(t, data.CurrencyTotal{
+		Currency: "EUR", BudgetCents: 15000, ActualCents: 200, ApplianceCents: 250,
+	}, got.CurrencyTotal)
+	assert.Empty(t, got.Missing)
+
+	// A single house-currency total still converts into another base.
+	got = convertTotals(totals[:1], house, locale.NewRates("CAD", map[string]float64{"USD": 1.25}))
+	require.NotNil(t, got)
+	assert.Equal(t, data.CurrencyTotal{
+		Currency: "CAD", BudgetCents: 12500, ApplianceCents: 625,
+	}, got.CurrencyTotal)
+}
+
+func TestDashboardApproxTotal(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.width = 160
+	m.height = 40
+	types, err := m.store.ProjectTypes()
+	require.NoError(t, err)
+	budget, euros, pounds := int64(500000), int64(120000), int64(1000)
+	require.NoError(t, m.store.CreateProject(&data.Project{
+		Title: "Deck", ProjectTypeID: types[0].ID, Status: data.ProjectStatusPlanned,
+		BudgetCents: &budget,
+	}))
+	require.NoError(t, m.store.CreateAppliance(&data.Appliance{
+		Name: "Villa fridge", CostCents: &euros, CostCurrency: "EUR",
+	}))
+	m.currencyRates = locale.NewRates("", map[string]float64{"EUR": 1.5})
+	m.dash.expanded = map[string]bool{dashSectionTotals: true}
+
+	require.NoError(t, m.loadDashboardAt(time.Now()))
+	m.prepareDashboardView()
+	view := m.dashboardView(50, 160)
+	assert.Contains(t, view, "approx, at configured rates")
+	assert.Contains(t, view, "$1,800.00", "the euro appliance cost, converted")
+
+	require.NoError(t, m.store.CreateAppliance(&data.Appliance{
+		Name: "Kettle", CostCents: &pounds, CostCurrency: "GBP",
+	}))
+	require.NoError(t, m.loadDashboardAt(time.Now()))
+	m.prepareDashboardView()
+	view = m.dashboardView(50, 160)
+	assert.Contains(t, view, "no rate for GBP")
+}
+
 func TestDashboardViewSeasonalSection(t *testing.T) {
 	t.Parallel()
 	m := newTestModel(t)
@@ -403,6 +403,30 @@ func TestApplianceMaintenanceHandlerFormKind(t *testing.T) {
 	assert.Equal(t, formMaintenance, h.FormKind())
 }
 
+func TestApplianceMaintenanceAddPresetsAppliance(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	app := data.Appliance{Name: "Dishwasher"}
+	require.NoError(t, m.store.CreateAppliance(&app))
+
+	m.active = tabIndex(tabAppliances)
+	require.NoError(t, m.openDetailFromDef(applianceMaintenanceDef, app.ID, app.Name))
+	openAddForm(m)
+
+	values, ok := m.fs.formData.(*maintenanceFormData)
+	require.True(t, ok, "add from appliance detail should open the maintenance form")
+	assert.Equal(t, app.ID, values.ApplianceID)
+
+	values.Name = "Clean filter"
+	m.checkFormDirty()
+	sendKey(m, "ctrl+s")
+
+	items, err := m.store.ListMaintenanceByAppliance(app.ID, false)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "Clean filter", items[0].Name)
+}
+
 func TestApplianceMaintenanceColumnSpecsNoAppliance(t *testing.T) {
 	t.Parallel()
 	specs := applianceMaintenanceColumnSpecs()
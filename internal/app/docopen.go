@@ -40,7 +40,7 @@ func (m *Model) openSelectedDocument() tea.Cmd {
 
 	cachePath, err := m.store.ExtractDocument(meta.ID)
 	if err != nil {
-		m.setStatusError(fmt.Sprintf("extract: %s", err))
+		m.setStatusError(fmt.Sprintf("extract: %s", humanizeError(err)))
 		return nil
 	}
 
@@ -61,14 +61,19 @@ func (m *Model) extractSelectedDocument() tea.Cmd {
 
 	doc, err := m.store.GetDocument(meta.ID)
 	if err != nil {
-		m.setStatusError(fmt.Sprintf("load document: %s", err))
+		m.setStatusError(fmt.Sprintf("load document: %s", humanizeError(err)))
 		return nil
 	}
 
-	cmd := m.startExtractionOverlay(
-		doc.ID, doc.FileName, doc.Data, doc.MIMEType, doc.ExtractedText, doc.ExtractData,
-	)
-	if cmd == nil {
+	cmd, extracting := m.queueExtraction(queuedExtraction{
+		docID:         doc.ID,
+		filename:      doc.FileName,
+		fileData:      doc.Data,
+		mime:          doc.MIMEType,
+		extractedText: doc.ExtractedText,
+		extractData:   doc.ExtractData,
+	})
+	if !extracting {
 		m.setStatusError("no extraction tools or LLM configured")
 		return nil
 	}
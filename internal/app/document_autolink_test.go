@@ -0,0 +1,104 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"testing"
+
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/micasa-dev/micasa/internal/extract"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newAutoLinkModel returns a model on the Appliances tab with one appliance
+// selected.
+func newAutoLinkModel(t *testing.T, autoLink bool) (*Model, data.Appliance) {
+	t.Helper()
+	m := newTestModelWithStore(t)
+	m.autoLinkDocuments = autoLink
+	app := data.Appliance{Name: "Fridge"}
+	require.NoError(t, m.store.CreateAppliance(&app))
+	m.active = tabIndex(tabAppliances)
+	require.NoError(t, m.reloadActiveTab())
+	return m, app
+}
+
+func TestQuickAddOnApplianceLinksDocument(t *testing.T) {
+	t.Parallel()
+	m, app := newAutoLinkModel(t, true)
+
+	sendKey(m, "i")
+	sendKey(m, "A")
+
+	values, ok := m.fs.formData.(*documentFormData)
+	require.True(t, ok, "A on an appliance row should open the quick document form")
+	assert.True(t, values.DeferCreate)
+	assert.Equal(t, entityRef{Kind: data.DocumentEntityAppliance, ID: app.ID}, values.EntityRef)
+}
+
+func TestQuickAddOnApplianceNeedsAutoLink(t *testing.T) {
+	t.Parallel()
+	m, _ := newAutoLinkModel(t, false)
+
+	sendKey(m, "i")
+	sendKey(m, "A")
+
+	assert.Nil(t, m.fs.formData, "without auto-link A does nothing on entity tabs")
+}
+
+func TestImportTargetInScopedDocumentList(t *testing.T) {
+	t.Parallel()
+	m, app := newAutoLinkModel(t, false)
+	require.NoError(t, m.openApplianceDocumentDetail(app.ID, app.Name))
+
+	target, ok := m.importTarget()
+	require.True(t, ok, "a scoped document list always links to its parent")
+	assert.Equal(t, entityRef{Kind: data.DocumentEntityAppliance, ID: app.ID}, target)
+}
+
+func TestImportTargetOnDocumentsTab(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	m.autoLinkDocuments = true
+	m.active = tabIndex(tabDocuments)
+
+	_, ok := m.importTarget()
+	assert.False(t, ok)
+	assert.True(t, m.canImportDocument())
+}
+
+func TestAcceptDeferredExtractionKeepsFocusedLink(t *testing.T) {
+	t.Parallel()
+	m, app := newAutoLinkModel(t, true)
+	project := data.Project{Title: "Kitchen remodel", Status: data.ProjectStatusPlanned}
+	types, err := m.store.ProjectTypes()
+	require.NoError(t, err)
+	project.ProjectTypeID = types[0].ID
+	require.NoError(t, m.store.CreateProject(&project))
+
+	m.ex.extraction = &extractionLogState{
+		Done: true,
+		pendingDoc: &data.Document{
+			FileName:   "manual.pdf",
+			MIMEType:   "application/pdf",
+			Data:       []byte("pdf-bytes"),
+			EntityKind: data.DocumentEntityAppliance,
+			EntityID:   app.ID,
+		},
+		operations: []extract.Operation{
+			{Action: "create", Table: data.TableDocuments, Data: map[string]any{
+				"title":       "Fridge manual",
+				"entity_kind": data.DocumentEntityProject,
+				"entity_id":   project.ID,
+			}},
+		},
+	}
+	m.acceptExtraction()
+
+	docs, err := m.store.ListDocumentsByEntity(data.DocumentEntityAppliance, app.ID, false)
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, "Fridge manual", docs[0].Title, "other LLM fields still apply")
+}
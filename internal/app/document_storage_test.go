@@ -0,0 +1,45 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"testing"
+
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocumentsTabShowsStorageTotal(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	m.width, m.height = 160, 40
+	for _, size := range []int{3 << 10, 1 << 10} {
+		require.NoError(t, m.store.CreateDocument(&data.Document{
+			Title:     "Scan",
+			SizeBytes: int64(size),
+			Data:      make([]byte, size),
+		}))
+	}
+	m.active = tabIndex(tabDocuments)
+	require.NoError(t, m.reloadActiveTab())
+
+	assert.Equal(t, data.DocumentStorage{Bytes: 4 << 10}, m.docStorage)
+	view := m.tableView(m.activeTab())
+	assert.Contains(t, view, "2 rows · 4.0 KB stored")
+	assert.NotContains(t, view, "over")
+}
+
+func TestDocumentStorageLabelWarnsOverLimit(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	assert.Empty(t, m.documentStorageLabel(), "nothing stored, nothing shown")
+
+	m.docStorage = data.DocumentStorage{Bytes: 3 << 20, DeletedBytes: 1 << 20}
+	assert.Equal(t, "3.0 MB stored (1.0 MB deleted)", m.documentStorageLabel(),
+		"no limit configured means no warning")
+
+	m.docStorageWarn = 2 << 20
+	assert.Contains(t, m.documentStorageLabel(), "3.0 MB stored (1.0 MB deleted) · over 2.0 MB")
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"log/slog"
 	"slices"
 	"strings"
 	"sync/atomic"
@@ -126,6 +127,15 @@ type extractionLogState struct {
 	previewRow    int                 // row cursor within active tab
 	previewCol    int                 // column cursor within active tab
 
+	// Document text budget: set when the LLM step starts so the overlay
+	// can warn that the model saw a truncated document.
+	inputChars   int // characters of source text before truncation
+	inputOmitted int // characters dropped to fit the budget
+
+	// contextTokens is the model's context window, learned alongside the
+	// ping; 0 until then, when only maxInputChars caps the text.
+	contextTokens int
+
 	// LLM ping state: ping runs concurrently with earlier steps.
 	llmPingDone bool  // true once ping completed (success or fail)
 	llmPingErr  error // non-nil if LLM was unreachable
@@ -244,8 +254,9 @@ type extractionLLMChunkMsg struct {
 
 // extractionLLMPingMsg delivers the result of a background LLM ping.
 type extractionLLMPingMsg struct {
-	ID  uint64
-	Err error // nil = reachable, non-nil = unreachable
+	ID            uint64
+	Err           error // nil = reachable, non-nil = unreachable
+	ContextTokens int   // model context window; 0 when unreachable
 }
 
 // --- Overlay lifecycle ---
@@ -259,9 +270,12 @@ func (m *Model) startExtractionOverlay(
 	mime string,
 	extractedText string,
 	extractData []byte,
+	skipLLM bool,
 ) tea.Cmd {
 	needsExtract := extract.NeedsOCR(m.ex.extractors, mime)
 	needsLLM := m.extractionLLMClient() != nil
+	// A skipped LLM step stays in the pipeline so r can still run it.
+	runLLM := needsLLM && !skipLLM
 
 	// Skip OCR when the document already has extracted text from a
 	// previous run -- feed existing text directly to the LLM.
@@ -270,7 +284,7 @@ func (m *Model) startExtractionOverlay(
 		needsExtract = false
 	}
 
-	if !needsExtract && !needsLLM {
+	if !needsExtract && !runLLM {
 		return nil
 	}
 
@@ -331,6 +345,8 @@ func (m *Model) startExtractionOverlay(
 		toolCursor:    -1,
 		expanded:      make(map[extractionStep]bool),
 	}
+	slog.Info("extraction started",
+		"doc_id", docID, "file", filename, "ocr", needsExtract, "llm", runLLM)
 	if hasText {
 		nChars := len(strings.TrimSpace(extractedText))
 		var textTool string
@@ -354,6 +370,9 @@ func (m *Model) startExtractionOverlay(
 		}
 		state.Steps[stepText] = textStep
 	}
+	if needsLLM && skipLLM {
+		state.skipLLMStep(m.extractionModelLabel())
+	}
 
 	// Background any existing foreground extraction instead of cancelling.
 	if m.ex.extraction != nil {
@@ -368,10 +387,10 @@ func (m *Model) startExtractionOverlay(
 		cmd = asyncExtractCmd(ctx, state)
 		// Ping LLM concurrently so we know before OCR finishes whether
 		// the LLM endpoint is reachable.
-		if needsLLM {
+		if runLLM {
 			return tea.Batch(cmd, m.llmPingCmd(state), state.Spinner.Tick)
 		}
-	} else if needsLLM {
+	} else if runLLM {
 		state.Steps[stepLLM].Status = stepRunning
 		state.Steps[stepLLM].Started = time.Now()
 		state.Steps[stepLLM].Detail = m.extractionModelLabel()
@@ -395,6 +414,24 @@ func (m *Model) findExtraction(id uint64) *extractionLogState {
 	return nil
 }
 
+// logExtractionDone records how a finished extraction turned out. Failures
+// carry the last log line of the step that failed.
+func logExtractionDone(ex *extractionLogState) {
+	attrs := []any{"doc_id", ex.DocID, "file", ex.Filename, "ops", len(ex.operations)}
+	if !ex.HasError {
+		slog.Info("extraction finished", attrs...)
+		return
+	}
+	for _, si := range ex.activeSteps() {
+		step := ex.Steps[si]
+		if step.Status == stepFailed && len(step.Logs) > 0 {
+			attrs = append(attrs, "step", stepName(si), "error", step.Logs[len(step.Logs)-1])
+			break
+		}
+	}
+	slog.Error("extraction failed", attrs...)
+}
+
 // isBgExtraction returns true when the given extraction is in bgExtractions.
 func (m *Model) isBgExtraction(ex *extractionLogState) bool {
 	return slices.Contains(m.ex.bgExtractions, ex)
@@ -434,9 +471,11 @@ func (m *Model) interruptExtraction() {
 	ex.Done = true
 	ex.HasError = true
 	ex.advanceCursor()
+	logExtractionDone(ex)
 }
 
-// cancelAllExtractions cancels the foreground and all background extractions.
+// cancelAllExtractions cancels the foreground and all background
+// extractions and drops the queue.
 func (m *Model) cancelAllExtractions() {
 	m.cancelExtraction()
 	for _, ex := range m.ex.bgExtractions {
@@ -447,6 +486,7 @@ func (m *Model) cancelAllExtractions() {
 		ex.closeShadowDB()
 	}
 	m.ex.bgExtractions = nil
+	m.ex.queue = nil
 }
 
 // backgroundExtraction moves the foreground extraction to bgExtractions.
@@ -508,8 +548,10 @@ func (m *Model) llmPingCmd(state *extractionLogState) tea.Cmd {
 	return func() tea.Msg {
 		ctx, cancel := context.WithTimeout(appCtx, quickOpTimeout)
 		defer cancel()
-		err := client.Ping(ctx)
-		return extractionLLMPingMsg{ID: id, Err: err}
+		if err := client.Ping(ctx); err != nil {
+			return extractionLLMPingMsg{ID: id, Err: err}
+		}
+		return extractionLLMPingMsg{ID: id, ContextTokens: client.ContextTokens(ctx)}
 	}
 }
 
@@ -536,17 +578,11 @@ func (m *Model) llmExtractCmd(ctx context.Context, ex *extractionLogState) tea.C
 		ex.llmCancelFn = cancel
 	}
 
+	in := m.extractionPromptInput(ex, schemaCtx)
+	ex.inputChars, ex.inputOmitted = extract.PromptTruncation(in)
+
 	return func() tea.Msg {
-		messages := extract.BuildExtractionPrompt(extract.ExtractionPromptInput{
-			DocID:         ex.DocID,
-			Filename:      ex.Filename,
-			MIME:          ex.mime,
-			SizeBytes:     int64(len(ex.fileData)),
-			Schema:        schemaCtx,
-			Sources:       ex.sources,
-			SendTSV:       m.ex.ocrTSV,
-			ConfThreshold: m.ex.ocrConfThreshold,
-		})
+		messages := extract.BuildExtractionPrompt(in)
 		ch, err := client.ExtractStream(
 			llmCtx,
 			messages,
@@ -559,6 +595,54 @@ func (m *Model) llmExtractCmd(ctx context.Context, ex *extractionLogState) tea.C
 	}
 }
 
+// extractionPromptInput assembles the prompt inputs for ex. The prompt
+// variant follows the extraction model, so switching models in the picker
+// also switches variants.
+func (m *Model) extractionPromptInput(
+	ex *extractionLogState,
+	schemaCtx extract.SchemaContext,
+) extract.ExtractionPromptInput {
+	return extract.ExtractionPromptInput{
+		DocID:         ex.DocID,
+		Filename:      ex.Filename,
+		MIME:          ex.mime,
+		SizeBytes:     int64(len(ex.fileData)),
+		Schema:        schemaCtx,
+		Sources:       ex.sources,
+		SendTSV:       m.ex.ocrTSV,
+		ConfThreshold: m.ex.ocrConfThreshold,
+		MaxChars:      extractionInputChars(m.ex.maxInputChars, ex.contextTokens),
+		Variant:       m.extractionPromptVariant(),
+	}
+}
+
+// strictExtractionSeed is the sampling seed strict extraction sends. Any
+// fixed value works; it only has to be the same every run.
+const strictExtractionSeed = 42
+
+// extractionPromptVariant returns the prompt variant for the extraction
+// model: minimal in strict mode, else as configured per model.
+func (m *Model) extractionPromptVariant() string {
+	if m.ex.strict {
+		return extract.PromptMinimal
+	}
+	return m.ex.promptVariants[m.ex.extractionModel]
+}
+
+// extractionInputChars is the document text budget: maxChars, further
+// capped to a quarter of the model's context window when that's known so
+// the text, schema, and reply all fit. 0 means no limit.
+func extractionInputChars(maxChars, contextTokens int) int {
+	if contextTokens <= 0 {
+		return maxChars
+	}
+	fit := llm.CharsForTokens(llm.PromptBudget(contextTokens) / 2)
+	if maxChars <= 0 {
+		return fit
+	}
+	return min(maxChars, fit)
+}
+
 // buildSchemaContext gathers DDL and entity rows for the extraction prompt.
 func (m *Model) buildSchemaContext() extract.SchemaContext {
 	var ctx extract.SchemaContext
@@ -623,6 +707,7 @@ func (m *Model) handleExtractionProgress(msg extractionProgressMsg) tea.Cmd {
 			return cmd
 		}
 		ex.Done = true
+		logExtractionDone(ex)
 		if m.isBgExtraction(ex) {
 			m.setStatusError("Extraction failed: " + ex.Filename)
 		}
@@ -683,6 +768,7 @@ func (m *Model) handleExtractionProgress(msg extractionProgressMsg) tea.Cmd {
 	}
 
 	ex.Done = true
+	logExtractionDone(ex)
 	if m.isBgExtraction(ex) {
 		m.setStatusInfo("Extracted: " + ex.Filename)
 	}
@@ -718,6 +804,7 @@ func (m *Model) handleExtractionLLMPing(msg extractionLLMPingMsg) {
 	}
 	ex.llmPingDone = true
 	ex.llmPingErr = msg.Err
+	ex.contextTokens = msg.ContextTokens
 
 	if msg.Err != nil {
 		// Mark LLM as skipped immediately so the strikethrough renders
@@ -729,6 +816,7 @@ func (m *Model) handleExtractionLLMPing(msg extractionLLMPingMsg) {
 		// If extraction already finished, the pipeline is done.
 		if ex.Steps[stepExtract].Status == stepDone || ex.Steps[stepExtract].Status == stepFailed {
 			ex.Done = true
+			logExtractionDone(ex)
 			ex.advanceCursor()
 			if m.isBgExtraction(ex) {
 				m.setStatusInfo(fmt.Sprintf("Extracted: %s (LLM skipped)", ex.Filename))
@@ -770,6 +858,7 @@ func (m *Model) handleExtractionLLMChunk(msg extractionLLMChunkMsg) tea.Cmd {
 		step.Logs = append(step.Logs, errMsg)
 		ex.HasError = true
 		ex.Done = true
+		logExtractionDone(ex)
 		ex.advanceCursor()
 		if m.isBgExtraction(ex) {
 			m.setStatusError("Extraction failed: " + ex.Filename)
@@ -816,6 +905,7 @@ func (m *Model) handleExtractionLLMChunk(msg extractionLLMChunkMsg) tea.Cmd {
 		step.Metric = fmt.Sprintf("%d ops", len(ex.operations))
 
 		ex.Done = true
+		logExtractionDone(ex)
 		ex.advanceCursor()
 		if m.isBgExtraction(ex) {
 			if ex.HasError {
@@ -850,12 +940,12 @@ func (m *Model) acceptExtraction() {
 
 	if ex.pendingDoc != nil {
 		if err := m.acceptDeferredExtraction(); err != nil {
-			m.setStatusError(err.Error())
+			m.setStatusError(humanizeError(err))
 			return
 		}
 	} else {
 		if err := m.acceptExistingExtraction(); err != nil {
-			m.setStatusError(err.Error())
+			m.setStatusError(humanizeError(err))
 			return
 		}
 	}
@@ -871,10 +961,16 @@ func (m *Model) acceptDeferredExtraction() error {
 	doc := ex.pendingDoc
 
 	// Apply fields from "create documents" operations to the pending doc.
+	// A document imported against a focused entity keeps that link; the
+	// LLM's entity hint only fills in an unlinked document.
+	linked := doc.EntityKind != ""
 	for _, op := range ex.operations {
 		if op.Table == tableDocuments {
 			applyStringField(op.Data, "title", &doc.Title)
 			applyStringField(op.Data, "notes", &doc.Notes)
+			if linked {
+				continue
+			}
 			applyStringField(op.Data, "entity_kind", &doc.EntityKind)
 			if v, ok := op.Data["entity_id"]; ok {
 				if n := extract.ParseStringID(v); n != "" {
@@ -990,10 +1086,79 @@ func (m *Model) rerunLLMExtraction() tea.Cmd {
 		return nil
 	}
 
+	m.reviveExtractionContext(ex)
+	ex.resetLLM()
+	ex.Steps[stepLLM] = extractionStepInfo{
+		Status:  stepRunning,
+		Started: time.Now(),
+		Detail:  m.extractionModelLabel(),
+	}
+	ex.Done = false
+	ex.recheckErrors()
+	ex.focusStep(stepLLM)
+
+	return tea.Batch(m.llmExtractCmd(ex.ctx, ex), ex.Spinner.Tick)
+}
+
+// rerunExtractStep retries a failed OCR step. The LLM step goes back to
+// pending so it runs again on the new text once OCR finishes, the same way
+// it follows OCR on the first pass.
+func (m *Model) rerunExtractStep() tea.Cmd {
+	ex := m.ex.extraction
+	if ex == nil || !ex.hasExtract || ex.Steps[stepExtract].Status != stepFailed {
+		return nil
+	}
+
+	m.reviveExtractionContext(ex)
+	ex.acquireTools = nil
+	ex.docPages = 0
+	ex.extractedPages = 0
+	ex.toolCursor = -1
+	ex.Steps[stepExtract] = extractionStepInfo{
+		Status:  stepRunning,
+		Started: time.Now(),
+	}
+	delete(ex.expanded, stepExtract)
+	if ex.hasLLM {
+		ex.resetLLM()
+		ex.Steps[stepLLM] = extractionStepInfo{}
+	}
+	ex.Done = false
+	ex.recheckErrors()
+	ex.focusStep(stepExtract)
+
+	cmds := []tea.Cmd{asyncExtractCmd(ex.ctx, ex), ex.Spinner.Tick}
+	if ex.hasLLM {
+		cmds = append(cmds, m.llmPingCmd(ex))
+	}
+	return tea.Batch(cmds...)
+}
+
+// rerunExtractionStep reruns the step under the cursor: the LLM step opens
+// the model picker first, a failed OCR step is retried in place. The text
+// step runs synchronously at import time and has nothing to retry.
+func (m *Model) rerunExtractionStep() tea.Cmd {
+	ex := m.ex.extraction
+	if ex == nil || !ex.Done {
+		return nil
+	}
+	switch ex.cursorStep() {
+	case stepLLM:
+		if ex.hasLLM {
+			return m.activateExtractionModelPicker()
+		}
+	case stepExtract:
+		return m.rerunExtractStep()
+	case stepText:
+	}
+	return nil
+}
+
+// reviveExtractionContext replaces a cancelled context (e.g. after an
+// interrupt) so a rerun has a live one.
+func (m *Model) reviveExtractionContext(ex *extractionLogState) {
 	// Cancel any previous LLM timeout before restarting.
 	ex.cancelLLMTimeout()
-
-	// Replace a cancelled context so the rerun has a live one.
 	if ex.ctx.Err() != nil {
 		ctx, cancel := context.WithCancel( //nolint:gosec // cancel stored in ex.CancelFn, called on extraction close
 			m.lifecycleCtx(),
@@ -1001,8 +1166,10 @@ func (m *Model) rerunLLMExtraction() tea.Cmd {
 		ex.ctx = ctx
 		ex.CancelFn = cancel
 	}
+}
 
-	// Reset LLM state (including any prior ping failure).
+// resetLLM clears LLM output and any prior ping failure.
+func (ex *extractionLogState) resetLLM() {
 	ex.llmAccum.Reset()
 	ex.llmPingDone = false
 	ex.llmPingErr = nil
@@ -1010,32 +1177,35 @@ func (m *Model) rerunLLMExtraction() tea.Cmd {
 	ex.closeShadowDB()
 	ex.previewGroups = nil
 	ex.exploring = false
-	ex.Steps[stepLLM] = extractionStepInfo{
-		Status:  stepRunning,
-		Started: time.Now(),
-		Detail:  m.extractionModelLabel(),
-	}
-	ex.Done = false
-	ex.HasError = false
 	delete(ex.expanded, stepLLM)
+}
+
+// skipLLMStep marks the LLM step skipped before it starts so the pipeline
+// ends after OCR. Rerunning the step with r still runs the LLM.
+func (ex *extractionLogState) skipLLMStep(model string) {
+	ex.Steps[stepLLM].Status = stepSkipped
+	ex.Steps[stepLLM].Detail = model
+	ex.Steps[stepLLM].Logs = []string{"skipped -- press r to run"}
+}
 
-	// Re-check other steps for errors (they stay as-is).
+// recheckErrors recomputes HasError from the steps that are not running.
+func (ex *extractionLogState) recheckErrors() {
+	ex.HasError = false
 	for _, si := range ex.activeSteps() {
-		if si != stepLLM && ex.Steps[si].Status == stepFailed {
+		if ex.Steps[si].Status == stepFailed {
 			ex.HasError = true
 		}
 	}
+}
 
-	// Position cursor on the LLM step being rerun.
-	active := ex.activeSteps()
-	for i, s := range active {
-		if s == stepLLM {
+// focusStep moves the cursor to the given step.
+func (ex *extractionLogState) focusStep(step extractionStep) {
+	for i, s := range ex.activeSteps() {
+		if s == step {
 			ex.cursor = i
-			break
+			return
 		}
 	}
-
-	return tea.Batch(m.llmExtractCmd(ex.ctx, ex), ex.Spinner.Tick)
 }
 
 // --- Keyboard handler ---
@@ -1132,10 +1302,8 @@ func (m *Model) handleExtractionPipelineKey(msg tea.KeyPressMsg) tea.Cmd {
 		if si != stepExtract || len(ex.acquireTools) == 0 || ex.toolCursor == -1 {
 			ex.expanded[si] = !ex.stepExpanded(si)
 		}
-	case key.Matches(msg, m.keys.ExtRemodel):
-		if ex.Done && ex.hasLLM && ex.cursorStep() == stepLLM {
-			return m.activateExtractionModelPicker()
-		}
+	case key.Matches(msg, m.keys.ExtRerun):
+		return m.rerunExtractionStep()
 	case key.Matches(msg, m.keys.MagToggle):
 		m.toggleMagMode()
 	case key.Matches(msg, m.keys.ExtToggleTSV):
@@ -1154,6 +1322,10 @@ func (m *Model) handleExtractionPipelineKey(msg tea.KeyPressMsg) tea.Cmd {
 		if !ex.Done {
 			m.backgroundExtraction()
 		}
+	case key.Matches(msg, m.keys.ExtSkipLLM):
+		if !ex.Done && ex.hasLLM && ex.Steps[stepLLM].Status == stepPending {
+			ex.skipLLMStep(m.extractionModelLabel())
+		}
 	default:
 		vp, cmd := ex.Viewport.Update(msg)
 		ex.Viewport = vp
@@ -0,0 +1,117 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/micasa-dev/micasa/internal/extract"
+)
+
+// queuedExtraction is a document waiting for its turn in the extraction
+// overlay. pendingDoc is set for documents that are only created once the
+// user accepts the results; skipLLM stops the pipeline after OCR.
+type queuedExtraction struct {
+	docID         string
+	filename      string
+	fileData      []byte
+	mime          string
+	extractedText string
+	extractData   []byte
+	pendingDoc    *data.Document
+	skipLLM       bool
+}
+
+// extractionNeeded reports whether a document would run any extraction
+// step: OCR for scans without stored text, or the LLM when configured and
+// not skipped.
+func (m *Model) extractionNeeded(mime, extractedText string, skipLLM bool) bool {
+	if !skipLLM && m.extractionLLMClient() != nil {
+		return true
+	}
+	return extract.NeedsOCR(m.ex.extractors, mime) && strings.TrimSpace(extractedText) == ""
+}
+
+// extractionBusy reports whether a new extraction has to wait: the overlay
+// is open, or a backgrounded extraction is still running.
+func (m *Model) extractionBusy() bool {
+	if m.ex.extraction != nil {
+		return true
+	}
+	for _, ex := range m.ex.bgExtractions {
+		if !ex.Done {
+			return true
+		}
+	}
+	return false
+}
+
+// queueExtraction opens q in the extraction overlay, or queues it behind
+// the extraction in progress so documents imported in quick succession
+// are reviewed one at a time. The bool is false when the document needs
+// no extraction at all.
+func (m *Model) queueExtraction(q queuedExtraction) (tea.Cmd, bool) {
+	if !m.extractionNeeded(q.mime, q.extractedText, q.skipLLM) {
+		return nil, false
+	}
+	if m.extractionBusy() {
+		m.ex.queue = append(m.ex.queue, q)
+		m.setStatusInfo(fmt.Sprintf(
+			"Queued %s for extraction (%d waiting).", q.filename, len(m.ex.queue),
+		))
+		return nil, true
+	}
+	return m.startQueuedExtraction(q), true
+}
+
+// startQueuedExtraction opens the overlay for q. A deferred document whose
+// extraction can no longer run (the LLM was switched off while it waited)
+// is created as-is rather than dropped.
+func (m *Model) startQueuedExtraction(q queuedExtraction) tea.Cmd {
+	cmd := m.startExtractionOverlay(
+		q.docID, q.filename, q.fileData, q.mime, q.extractedText, q.extractData,
+		q.skipLLM,
+	)
+	if cmd == nil {
+		if q.pendingDoc != nil {
+			m.surfaceError(m.createDeferredDocument(q.pendingDoc))
+		}
+		return nil
+	}
+	m.ex.extraction.pendingDoc = q.pendingDoc
+	return cmd
+}
+
+// advanceExtractionQueue starts the next queued extraction once the overlay
+// is closed and nothing runs in the background. It runs after every update,
+// so accepting, discarding, or a background run finishing all move the
+// queue along.
+func (m *Model) advanceExtractionQueue() tea.Cmd {
+	for len(m.ex.queue) > 0 && !m.extractionBusy() {
+		q := m.ex.queue[0]
+		m.ex.queue = m.ex.queue[1:]
+		if cmd := m.startQueuedExtraction(q); cmd != nil {
+			return cmd
+		}
+	}
+	return nil
+}
+
+// renderExtractionQueue lists the documents waiting behind the open
+// extraction, or returns "" when none are.
+func (m *Model) renderExtractionQueue(innerW int) string {
+	if len(m.ex.queue) == 0 {
+		return ""
+	}
+	names := make([]string, len(m.ex.queue))
+	for i, q := range m.ex.queue {
+		names[i] = q.filename
+	}
+	return appStyles.TextDim().Render(
+		truncateRight("next: "+strings.Join(names, ", "), innerW),
+	)
+}
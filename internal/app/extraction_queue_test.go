@@ -0,0 +1,127 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func deferredTextDoc(name string) data.Document {
+	return data.Document{
+		Title:         name,
+		FileName:      name,
+		Data:          []byte("notes"),
+		MIMEType:      "text/plain",
+		ExtractedText: "notes",
+	}
+}
+
+func TestExtractionQueue_ImportsWaitForOverlay(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	m.ex.extractionClient = testExtractionOllamaClient(t, "test-model")
+
+	for _, name := range []string{"a.txt", "b.txt", "c.txt"} {
+		_, extracting, err := m.extractDeferredDocument(deferredTextDoc(name))
+		require.NoError(t, err)
+		require.True(t, extracting)
+	}
+
+	require.NotNil(t, m.ex.extraction)
+	assert.Equal(t, "a.txt", m.ex.extraction.Filename)
+	assert.Empty(t, m.ex.bgExtractions, "later imports don't push the first to the background")
+	require.Len(t, m.ex.queue, 2)
+	assert.Contains(t, m.status.Text, "Queued c.txt for extraction (2 waiting).")
+	assert.Contains(t, ansi.Strip(m.buildExtractionOverlay()), "next: b.txt, c.txt")
+
+	// Cancelling skips to the next document, which keeps its pending doc.
+	sendExtractionKey(m, "esc")
+	require.NotNil(t, m.ex.extraction)
+	assert.Equal(t, "b.txt", m.ex.extraction.Filename)
+	require.NotNil(t, m.ex.extraction.pendingDoc)
+	assert.Equal(t, "b.txt", m.ex.extraction.pendingDoc.Title)
+	require.Len(t, m.ex.queue, 1)
+	assert.Contains(t, ansi.Strip(m.buildExtractionOverlay()), "next: c.txt")
+
+	sendExtractionKey(m, "esc")
+	require.NotNil(t, m.ex.extraction)
+	assert.Equal(t, "c.txt", m.ex.extraction.Filename)
+	assert.Empty(t, m.ex.queue)
+	assert.NotContains(t, ansi.Strip(m.buildExtractionOverlay()), "next:")
+}
+
+func TestExtractionQueue_WaitsForBackgroundRun(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	m.ex.extractionClient = testExtractionOllamaClient(t, "test-model")
+
+	_, _, err := m.extractDeferredDocument(deferredTextDoc("a.txt"))
+	require.NoError(t, err)
+	_, _, err = m.extractDeferredDocument(deferredTextDoc("b.txt"))
+	require.NoError(t, err)
+
+	sendExtractionKey(m, keyCtrlB)
+	assert.Nil(t, m.ex.extraction, "running extraction moved to the background")
+	require.Len(t, m.ex.queue, 1, "the queue waits while it runs")
+	assert.Contains(t, m.statusView(), "1 queued")
+
+	m.ex.bgExtractions[0].Done = true
+	sendKey(m, "j")
+	require.NotNil(t, m.ex.extraction)
+	assert.Equal(t, "b.txt", m.ex.extraction.Filename)
+	assert.Empty(t, m.ex.queue)
+}
+
+func TestExtractionQueue_NotNeededCreatesRightAway(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+
+	cmd, extracting, err := m.extractDeferredDocument(deferredTextDoc("a.txt"))
+	require.NoError(t, err)
+	assert.Nil(t, cmd)
+	assert.False(t, extracting)
+	assert.Nil(t, m.ex.extraction)
+
+	docs, err := m.store.ListDocuments(false)
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, "a.txt", docs[0].Title)
+}
+
+func TestExtractionQueue_SkipLLMOnImport(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	m.ex.extractionClient = testExtractionOllamaClient(t, "test-model")
+	m.ex.skipLLMOnImport = true
+
+	cmd, extracting, err := m.extractDeferredDocument(deferredTextDoc("a.txt"))
+	require.NoError(t, err)
+	assert.Nil(t, cmd)
+	assert.False(t, extracting, "text documents need only the LLM, which is skipped")
+
+	docs, err := m.store.ListDocuments(false)
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, "a.txt", docs[0].Title)
+}
+
+func TestCancelAllExtractionsDropsQueue(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	m.ex.extractionClient = testExtractionOllamaClient(t, "test-model")
+	for _, name := range []string{"a.txt", "b.txt"} {
+		_, _, err := m.extractDeferredDocument(deferredTextDoc(name))
+		require.NoError(t, err)
+	}
+	require.Len(t, m.ex.queue, 1)
+
+	m.cancelAllExtractions()
+	assert.Nil(t, m.ex.extraction)
+	assert.Empty(t, m.ex.queue)
+}
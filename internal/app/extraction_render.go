@@ -31,8 +31,12 @@ func (m *Model) buildExtractionOverlay() string {
 	// Title line.
 	title := m.styles.HeaderSection().Render(" Extracting ")
 	filename := m.styles.HeaderHint().Render(" " + truncateRight(ex.Filename, innerW-16))
+	titleLine := title + filename
+	if queued := m.renderExtractionQueue(innerW); queued != "" {
+		titleLine += "\n" + queued
+	}
 
-	return m.buildExtractionPipelineOverlay(contentW, innerW, title+filename)
+	return m.buildExtractionPipelineOverlay(contentW, innerW, titleLine)
 }
 
 // previewNaturalWidth returns the minimum inner width needed to display
@@ -40,7 +44,7 @@ func (m *Model) buildExtractionOverlay() string {
 func previewNaturalWidth(groups []previewTableGroup, sepW int, currencySymbol string) int {
 	var maxW int
 	for _, g := range groups {
-		nw := naturalWidths(g.specs, g.cells, currencySymbol)
+		nw := naturalWidths(g.specs, g.cells, currencySymbol, 1)
 		w := 0
 		for _, cw := range nw {
 			w += cw
@@ -222,6 +226,9 @@ func (m *Model) buildExtractionPipelineOverlay(
 			}
 			hints = append(hints, m.helpItem(keyA, "accept"), m.helpItem(keyEsc, "discard"))
 		} else {
+			if ex.hasLLM && ex.Steps[stepLLM].Status == stepPending {
+				hints = append(hints, m.helpItem(keyS, "skip llm"))
+			}
 			hints = append(hints,
 				m.helpItem(symCtrlC, "int"),
 				m.helpItem(symCtrlB, "bg"),
@@ -346,7 +353,7 @@ func (m *Model) renderPreviewTable(
 	}
 	rows := renderRows(
 		g.specs, displayCells, g.meta, widths,
-		seps, seps, rowCursor, colCursor, 0, pinRenderContext{}, m.zones, zoneExtRow,
+		seps, seps, rowCursor, colCursor, 0, 1, pinRenderContext{}, m.zones, zoneExtRow,
 	)
 
 	parts := []string{header, divider}
@@ -465,6 +472,18 @@ func (m *Model) renderExtractionStep(
 		hdr.WriteString("  ")
 		hdr.WriteString(m.styles.ExtRerun().Render("r model"))
 	}
+	if si == stepExtract && info.Status == stepFailed && ex.Done && focused {
+		hdr.WriteString("  ")
+		hdr.WriteString(m.styles.ExtRerun().Render("r retry"))
+	}
+	if si == stepLLM && ex.inputOmitted > 0 && info.Status != stepPending &&
+		info.Status != stepSkipped {
+		hdr.WriteString("\n     ")
+		hdr.WriteString(m.styles.Warning().Render(truncateRight(fmt.Sprintf(
+			"input truncated: sent %s of %s chars (max_input_chars)",
+			m.display.Count(ex.inputChars-ex.inputOmitted), m.display.Count(ex.inputChars),
+		), innerW-5)))
+	}
 	header := hdr.String()
 
 	// Render parent + children for the ext step.
@@ -727,15 +746,16 @@ func previewColumns(tableName string, cur locale.Currency) []previewColDef {
 			{data.ColLaborCents, s[4], fmtAnyCents},
 			{data.ColMaterialsCents, s[5], fmtAnyCents},
 			{data.ColOtherCents, s[6], fmtAnyCents},
-			{data.ColReceivedDate, s[7], fmtAnyText},
+			{data.ColTaxCents, s[7], fmtAnyCents},
+			{data.ColReceivedDate, s[8], fmtAnyText},
 		}
 	case data.TableMaintenanceItems:
 		s := maintenanceColumnSpecs()
 		return []previewColDef{
-			{data.ColName, s[1], fmtAnyText},
-			{data.ColCategoryID, s[2], fmtAnyFK},
-			{data.ColApplianceID, s[3], fmtAnyFK},
-			{data.ColIntervalMonths, s[6], fmtAnyInterval},
+			{data.ColName, s[maintenanceColItem], fmtAnyText},
+			{data.ColCategoryID, s[maintenanceColCategory], fmtAnyFK},
+			{data.ColApplianceID, s[maintenanceColAppliance], fmtAnyFK},
+			{data.ColIntervalMonths, s[maintenanceColEvery], fmtAnyInterval},
 		}
 	case data.TableAppliances:
 		s := applianceColumnSpecs()
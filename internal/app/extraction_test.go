@@ -6,6 +6,7 @@ package app
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -1030,7 +1031,7 @@ func TestStartExtraction_ImageWithExistingText_SkipsOCR(t *testing.T) {
 
 	existingText := "Previously extracted invoice text"
 	cmd := m.startExtractionOverlay(
-		"01JTEST00000000000000001", "receipt.png", []byte("fake"), "image/png", existingText, nil,
+		"01JTEST00000000000000001", "receipt.png", []byte("fake"), "image/png", existingText, nil, false,
 	)
 
 	require.NotNil(t, cmd, "should return a command for LLM step")
@@ -1062,6 +1063,7 @@ func TestStartExtraction_PDFWithExistingText_SkipsOCR(t *testing.T) {
 		extract.MIMEApplicationPDF,
 		existingText,
 		nil,
+		false,
 	)
 
 	require.NotNil(t, cmd)
@@ -1093,6 +1095,7 @@ func TestStartExtraction_ExistingTextPreservesExtractData(t *testing.T) {
 		"image/png",
 		existingText,
 		tsvData,
+		false,
 	)
 
 	require.NotNil(t, cmd)
@@ -1114,7 +1117,7 @@ func TestStartExtraction_EmptyText_RunsOCRNormally(t *testing.T) {
 	}
 
 	cmd := m.startExtractionOverlay(
-		"01JTEST00000000000000001", "receipt.png", []byte("fake"), "image/png", "", nil,
+		"01JTEST00000000000000001", "receipt.png", []byte("fake"), "image/png", "", nil, false,
 	)
 
 	require.NotNil(t, cmd)
@@ -1138,7 +1141,7 @@ func TestStartExtraction_WhitespaceOnlyText_RunsOCR(t *testing.T) {
 	}
 
 	cmd := m.startExtractionOverlay(
-		"01JTEST00000000000000001", "receipt.png", []byte("fake"), "image/png", "   \n\t  ", nil,
+		"01JTEST00000000000000001", "receipt.png", []byte("fake"), "image/png", "   \n\t  ", nil, false,
 	)
 
 	require.NotNil(t, cmd)
@@ -1155,7 +1158,7 @@ func TestStartExtraction_PlainTextWithExistingText_SkipsToLLM(t *testing.T) {
 
 	existingText := "Some previously extracted content"
 	cmd := m.startExtractionOverlay(
-		"01JTEST00000000000000001", "notes.txt", []byte("fake"), "text/plain", existingText, nil,
+		"01JTEST00000000000000001", "notes.txt", []byte("fake"), "text/plain", existingText, nil, false,
 	)
 
 	require.NotNil(t, cmd)
@@ -1170,6 +1173,67 @@ func TestStartExtraction_PlainTextWithExistingText_SkipsToLLM(t *testing.T) {
 	assert.Equal(t, "plaintext", ex.sources[0].Tool)
 }
 
+func TestStartExtraction_SkipLLM_StopsAfterOCR(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.ex.extractors = extract.DefaultExtractors(0, 0, true)
+	m.ex.extractionClient = testExtractionOllamaClient(t, "test-model")
+
+	if !extract.NeedsOCR(m.ex.extractors, "image/png") {
+		t.Skip("OCR tools not available")
+	}
+
+	cmd := m.startExtractionOverlay(
+		"01JTEST00000000000000001", "receipt.png", []byte("fake"), "image/png", "", nil, true,
+	)
+
+	require.NotNil(t, cmd)
+	ex := m.ex.extraction
+	require.NotNil(t, ex)
+	assert.True(t, ex.hasExtract)
+	assert.True(t, ex.hasLLM, "skipped LLM step stays visible so it can be rerun")
+	assert.Equal(t, stepSkipped, ex.Steps[stepLLM].Status)
+	assert.Nil(t, m.maybeStartLLMStep(ex))
+}
+
+func TestStartExtraction_SkipLLM_NothingElseToRun(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.ex.extractionClient = testExtractionOllamaClient(t, "test-model")
+
+	cmd := m.startExtractionOverlay(
+		"01JTEST00000000000000001", "notes.txt", []byte("fake"), "text/plain", "text", nil, true,
+	)
+
+	assert.Nil(t, cmd, "text documents have nothing to do without the LLM")
+	assert.Nil(t, m.ex.extraction)
+}
+
+func TestExtractionSkipLLMKey(t *testing.T) {
+	t.Parallel()
+	m := newExtractionModel(t, map[extractionStep]stepStatus{
+		stepExtract: stepRunning,
+		stepLLM:     stepPending,
+	})
+	ex := m.ex.extraction
+	assert.Contains(t, m.buildExtractionOverlay(), "skip llm")
+
+	sendExtractionKey(m, "s")
+	assert.Equal(t, stepSkipped, ex.Steps[stepLLM].Status)
+	assert.NotContains(t, m.buildExtractionOverlay(), "skip llm")
+}
+
+func TestExtractionSkipLLMKeyIgnoredOnceRunning(t *testing.T) {
+	t.Parallel()
+	m := newExtractionModel(t, map[extractionStep]stepStatus{
+		stepExtract: stepDone,
+		stepLLM:     stepRunning,
+	})
+
+	sendExtractionKey(m, "s")
+	assert.Equal(t, stepRunning, m.ex.extraction.Steps[stepLLM].Status)
+}
+
 // --- Background extraction ---
 
 func TestBackground_CtrlBMovesExtractionToBg(t *testing.T) {
@@ -2232,10 +2296,10 @@ func TestDispatch_InvalidProjectIDShowsError(t *testing.T) {
 	ex.hasLLM = true
 	m.ex.extraction = ex
 
-	// Accept should fail with an error (FK constraint on project_id).
+	// Accept should fail with the humanized FK constraint error on project_id.
 	sendExtractionKey(m, "a")
 	assert.NotNil(t, m.ex.extraction, "extraction stays open on dispatch error")
-	assert.Contains(t, m.status.Text, "FOREIGN KEY constraint failed")
+	assert.Contains(t, m.status.Text, "linked record is missing")
 }
 
 // TestDispatch_OffsetCrossReference verifies that when the real DB already
@@ -2912,6 +2976,184 @@ func TestExtractionRerun_ClearsPingState(t *testing.T) {
 	assert.NoError(t, ex.llmPingErr, "ping error should be cleared on rerun")
 }
 
+func TestExtractionRerunExtract_RetriesFailedOCR(t *testing.T) {
+	t.Parallel()
+	m := newExtractionModel(t, map[extractionStep]stepStatus{
+		stepText:    stepDone,
+		stepExtract: stepFailed,
+		stepLLM:     stepDone,
+	})
+	ex := m.ex.extraction
+	ex.Done = true
+	ex.HasError = true
+	ex.llmPingDone = true
+	ex.Steps[stepExtract].Logs = []string{"interrupted"}
+	ex.CancelFn() // interrupted runs leave a cancelled context
+	ex.cursor = 1
+	ex.cursorManual = true
+
+	sendExtractionKey(m, "r")
+
+	assert.Equal(t, stepRunning, ex.Steps[stepExtract].Status)
+	assert.Empty(t, ex.Steps[stepExtract].Logs, "old failure logs should be cleared")
+	assert.Equal(t, stepPending, ex.Steps[stepLLM].Status,
+		"LLM step should wait for the new OCR text")
+	assert.False(t, ex.llmPingDone, "LLM should be pinged again")
+	assert.False(t, ex.Done)
+	assert.False(t, ex.HasError)
+	require.NoError(t, ex.ctx.Err(), "rerun needs a live context")
+	assert.Nil(t, ex.modelPicker, "r on OCR should not open the model picker")
+}
+
+func TestExtractionRerunExtract_CompletesPipeline(t *testing.T) {
+	t.Parallel()
+	m := newExtractionModel(t, map[extractionStep]stepStatus{
+		stepText:    stepDone,
+		stepExtract: stepFailed,
+	})
+	ex := m.ex.extraction
+	ex.Done = true
+	ex.cursor = 1
+
+	m.rerunExtractStep()
+	m.Update(extractionProgressMsg{ID: ex.ID, Progress: extract.ExtractProgress{
+		Done: true, Tool: "tesseract", Text: "receipt total 42",
+	}})
+
+	assert.Equal(t, stepDone, ex.Steps[stepExtract].Status)
+	assert.True(t, ex.Done)
+	assert.Equal(t, "receipt total 42", ex.pendingText)
+}
+
+func TestExtractionRerunExtract_IgnoresSucceededOCR(t *testing.T) {
+	t.Parallel()
+	m := newExtractionModel(t, map[extractionStep]stepStatus{
+		stepText:    stepDone,
+		stepExtract: stepDone,
+	})
+	ex := m.ex.extraction
+	ex.Done = true
+	ex.cursor = 1
+
+	sendExtractionKey(m, "r")
+
+	assert.Equal(t, stepDone, ex.Steps[stepExtract].Status)
+	assert.True(t, ex.Done)
+}
+
+func TestExtractionFailedOCR_RetryHintShows(t *testing.T) {
+	t.Parallel()
+	m := newExtractionModel(t, map[extractionStep]stepStatus{
+		stepText:    stepDone,
+		stepExtract: stepFailed,
+	})
+	ex := m.ex.extraction
+	ex.Done = true
+	ex.cursor = 1
+
+	view := m.buildExtractionOverlay()
+	assert.Contains(t, view, "r retry",
+		"retry hint should appear for a failed OCR step")
+}
+
+func TestExtractionLLM_WarnsWhenInputTruncated(t *testing.T) {
+	t.Parallel()
+	m := newExtractionModel(t, map[extractionStep]stepStatus{
+		stepText: stepDone,
+		stepLLM:  stepPending,
+	})
+	m.ex.extractionClient = testExtractionOllamaClient(t, "test-model")
+	m.ex.maxInputChars = 100
+	ex := m.ex.extraction
+	ex.sources = []extract.TextSource{
+		{Tool: "pdftotext", Text: strings.Repeat("manual text\n", 50)},
+	}
+
+	ex.Steps[stepLLM].Status = stepRunning
+	require.NotNil(t, m.llmExtractCmd(ex.ctx, ex))
+
+	assert.Positive(t, ex.inputOmitted)
+	assert.Greater(t, ex.inputChars, ex.inputOmitted)
+	view := ansi.Strip(m.buildExtractionOverlay())
+	assert.Contains(t, view, "input truncated")
+}
+
+func TestExtractionLLM_NoWarningWithinBudget(t *testing.T) {
+	t.Parallel()
+	m := newExtractionModel(t, map[extractionStep]stepStatus{
+		stepText: stepDone,
+		stepLLM:  stepRunning,
+	})
+	m.ex.extractionClient = testExtractionOllamaClient(t, "test-model")
+	m.ex.maxInputChars = 10000
+	ex := m.ex.extraction
+	ex.sources = []extract.TextSource{{Tool: "pdftotext", Text: "short receipt"}}
+
+	require.NotNil(t, m.llmExtractCmd(ex.ctx, ex))
+
+	assert.Zero(t, ex.inputOmitted)
+	assert.NotContains(t, ansi.Strip(m.buildExtractionOverlay()), "input truncated")
+}
+
+func TestExtractionInputCharsFitsContextWindow(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, 40000, extractionInputChars(40000, 0), "unknown window")
+	assert.Equal(t, 8192, extractionInputChars(40000, 8192))
+	assert.Equal(t, 40000, extractionInputChars(40000, 200_000))
+	assert.Equal(t, 8192, extractionInputChars(0, 8192))
+	assert.Zero(t, extractionInputChars(0, 0))
+}
+
+func TestExtractionLLM_TruncatesToContextWindow(t *testing.T) {
+	t.Parallel()
+	m := newExtractionModel(t, map[extractionStep]stepStatus{
+		stepText: stepDone,
+		stepLLM:  stepRunning,
+	})
+	m.ex.extractionClient = testExtractionOllamaClient(t, "test-model")
+	m.ex.maxInputChars = 40000
+	ex := m.ex.extraction
+	ex.sources = []extract.TextSource{
+		{Tool: "pdftotext", Text: strings.Repeat("manual text\n", 1000)},
+	}
+	m.handleExtractionLLMPing(extractionLLMPingMsg{ID: ex.ID, ContextTokens: 4096})
+
+	require.NotNil(t, m.llmExtractCmd(ex.ctx, ex))
+	assert.Positive(t, ex.inputOmitted, "12000 characters don't fit a 4096-token window")
+}
+
+func TestExtractionPromptVariantFollowsModel(t *testing.T) {
+	t.Parallel()
+	m := newExtractionModel(t, map[extractionStep]stepStatus{
+		stepText: stepDone,
+		stepLLM:  stepPending,
+	})
+	m.ex.promptVariants = map[string]string{"qwen3:0.6b": extract.PromptMinimal}
+	ex := m.ex.extraction
+
+	m.ex.extractionModel = "qwen3"
+	assert.Empty(t, m.extractionPromptInput(ex, extract.SchemaContext{}).Variant,
+		"unlisted models get the full prompt")
+
+	m.ex.extractionModel = "qwen3:0.6b"
+	assert.Equal(t, extract.PromptMinimal,
+		m.extractionPromptInput(ex, extract.SchemaContext{}).Variant)
+}
+
+func TestExtractionStrictUsesMinimalPrompt(t *testing.T) {
+	t.Parallel()
+	m := newExtractionModel(t, map[extractionStep]stepStatus{
+		stepText: stepDone,
+		stepLLM:  stepPending,
+	})
+	m.ex.promptVariants = map[string]string{"qwen3": extract.PromptFull}
+	m.ex.extractionModel = "qwen3"
+	m.ex.strict = true
+	assert.Equal(t, extract.PromptMinimal,
+		m.extractionPromptInput(m.ex.extraction, extract.SchemaContext{}).Variant,
+		"strict overrides the per-model variant")
+}
+
 func TestExtractionLLMPing_FailAfterExtractFailed(t *testing.T) {
 	t.Parallel()
 	m := newExtractionModel(t, map[extractionStep]stepStatus{
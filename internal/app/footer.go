@@ -0,0 +1,132 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/micasa-dev/micasa/internal/locale"
+)
+
+// hasMoneyFooter reports whether the tab shows a totals row: it has rows and
+// at least one visible money column. Used by both layout and rendering so
+// the body height accounts for the footer line.
+func hasMoneyFooter(tab *Tab) bool {
+	if tab == nil || len(tab.Rows) == 0 {
+		return false
+	}
+	for _, spec := range tab.Specs {
+		if spec.Kind == cellMoney && spec.HideOrder == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// moneyFooterCells sums each money column over the rows the user is looking
+// at. Deleted rows and rows dimmed by a pin preview are left out, so the
+// totals follow the filter. A column mixing currencies gets one total per
+// currency, house currency first, rather than adding unlike amounts.
+// Non-money columns, and money columns with no values, get an empty cell.
+func moneyFooterCells(
+	specs []columnSpec,
+	rows [][]cell,
+	meta []rowMeta,
+	cur locale.Currency,
+) []cell {
+	footer := make([]cell, len(specs))
+	for col, spec := range specs {
+		if spec.Kind != cellMoney {
+			continue
+		}
+		totals := []currencySum{{cur: cur}}
+		for i, row := range rows {
+			if i < len(meta) && (meta[i].Deleted || meta[i].Dimmed) {
+				continue
+			}
+			if col >= len(row) || row[col].Null {
+				continue
+			}
+			v := strings.TrimSpace(row[col].Value)
+			if v == "" {
+				continue
+			}
+			idx := currencySumIndex(&totals, cur, row[col].Currency)
+			cents, err := totals[idx].cur.ParseRequiredCents(v)
+			if err != nil {
+				continue
+			}
+			totals[idx].cents += cents
+			totals[idx].n++
+		}
+		footer[col] = footerTotal(totals)
+	}
+	return footer
+}
+
+// currencySum accumulates one currency's total in a footer column.
+type currencySum struct {
+	cur   locale.Currency
+	cents int64
+	n     int
+}
+
+// currencySumIndex returns the index in totals of the sum for code,
+// appending one if this is the column's first amount in that currency.
+func currencySumIndex(totals *[]currencySum, house locale.Currency, code string) int {
+	if code == "" || code == house.Code() {
+		return 0
+	}
+	for i, t := range *totals {
+		if t.cur.Code() == code {
+			return i
+		}
+	}
+	rec, err := house.WithCode(code)
+	if err != nil {
+		return 0
+	}
+	*totals = append(*totals, currencySum{cur: rec})
+	return len(*totals) - 1
+}
+
+// footerTotal renders the non-empty sums, joined with " + " when there is
+// more than one currency.
+func footerTotal(totals []currencySum) cell {
+	parts := make([]string, 0, len(totals))
+	for _, t := range totals {
+		if t.n > 0 {
+			parts = append(parts, t.cur.FormatCents(t.cents))
+		}
+	}
+	if len(parts) == 0 {
+		return cell{}
+	}
+	return cell{Value: strings.Join(parts, " + "), Kind: cellMoney}
+}
+
+// renderFooterRow renders the totals row aligned under the table columns.
+// Cells arrive already display-transformed (compact or mag).
+func renderFooterRow(
+	specs []columnSpec,
+	footer []cell,
+	widths []int,
+	separators []string,
+) string {
+	cells := make([]string, 0, len(specs))
+	for i, spec := range specs {
+		width := max(safeWidth(widths, i), 1)
+		var value string
+		if i < len(footer) && footer[i].Value != "" {
+			// Drop the sigma before truncating the total itself.
+			value = footer[i].Value
+			if labeled := symSigma + " " + value; lipgloss.Width(labeled) <= width {
+				value = labeled
+			}
+		}
+		cells = append(cells, appStyles.TableHeader().Render(formatCell(value, width, spec.Align)))
+	}
+	return joinCells(cells, separators)
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/micasa-dev/micasa/internal/locale"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoneyFooterCellsSkipsDeletedAndDimmed(t *testing.T) {
+	t.Parallel()
+	cur := locale.DefaultCurrency()
+	specs := []columnSpec{{Title: "Name"}, {Title: "Cost", Kind: cellMoney}}
+	rows := [][]cell{
+		{{Value: "a"}, {Value: "$1,000.00", Kind: cellMoney}},
+		{{Value: "b"}, {Value: "$250.50", Kind: cellMoney}},
+		{{Value: "c"}, {Value: "$99.00", Kind: cellMoney}},
+		{{Value: "d"}, {Value: "$5.00", Kind: cellMoney}},
+		{{Value: "e"}, {Null: true, Kind: cellMoney}},
+	}
+	meta := []rowMeta{{}, {}, {Deleted: true}, {Dimmed: true}, {}}
+
+	footer := moneyFooterCells(specs, rows, meta, cur)
+	require.Len(t, footer, 2)
+	assert.Empty(t, footer[0].Value)
+	assert.Equal(t, "$1,250.50", footer[1].Value)
+}
+
+func TestMoneyFooterCellsEmptyColumn(t *testing.T) {
+	t.Parallel()
+	specs := []columnSpec{{Title: "Cost", Kind: cellMoney}}
+	rows := [][]cell{{{Null: true, Kind: cellMoney}}}
+	footer := moneyFooterCells(specs, rows, nil, locale.DefaultCurrency())
+	assert.Empty(t, footer[0].Value)
+}
+
+func TestMoneyFooterCellsMixedCurrencies(t *testing.T) {
+	t.Parallel()
+	cur := locale.DefaultCurrency()
+	eur, err := cur.WithCode("EUR")
+	require.NoError(t, err)
+	specs := []columnSpec{{Title: "Cost", Kind: cellMoney}}
+	rows := [][]cell{
+		{centsCell(ptr(int64(100000)), cur)},
+		{centsCell(ptr(int64(30000)), eur)},
+		{centsCell(ptr(int64(25050)), cur)},
+		{centsCell(ptr(int64(1000)), eur)},
+	}
+
+	footer := moneyFooterCells(specs, rows, nil, cur)
+	assert.Equal(t,
+		cur.FormatCents(125050)+" + "+eur.FormatCents(31000), footer[0].Value,
+		"each currency is totaled on its own, house first",
+	)
+
+	footer = moneyFooterCells(specs, rows[1:2], nil, cur)
+	assert.Equal(t, eur.FormatCents(30000), footer[0].Value)
+}
+
+func TestRenderFooterRowDropsSigmaWhenNarrow(t *testing.T) {
+	t.Parallel()
+	specs := []columnSpec{{Title: "Cost", Kind: cellMoney, Align: alignRight}}
+	footer := []cell{{Value: "12.5k", Kind: cellMoney}}
+	assert.Equal(t, "Σ 12.5k", ansi.Strip(renderFooterRow(specs, footer, []int{7}, nil)))
+	assert.Equal(t, " 12.5k", ansi.Strip(renderFooterRow(specs, footer, []int{6}, nil)))
+}
+
+func TestMoneyFooterFollowsFilter(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithDemoData(t, testSeed)
+	m.switchToTab(tabIndex(tabQuotes))
+	tab := m.activeTab()
+	require.True(t, hasMoneyFooter(tab))
+	require.Greater(t, len(tab.Rows), 1)
+
+	full := ansi.Strip(m.buildView())
+	assert.Contains(t, full, symSigma)
+
+	// Filter down to one vendor; the total shrinks with it.
+	vendorCol := -1
+	for i, s := range tab.Specs {
+		if s.Title == "Vendor" {
+			vendorCol = i
+		}
+	}
+	require.GreaterOrEqual(t, vendorCol, 0)
+	tab.ColCursor = vendorCol
+	m.togglePinAtCursor()
+	m.toggleFilterActivation()
+	require.Less(t, len(tab.Rows), len(tab.FullRows))
+
+	before := moneyFooterCells(tab.Specs, tab.FullCellRows, tab.FullMeta, m.cur)
+	after := moneyFooterCells(tab.Specs, tab.CellRows, tab.Rows, m.cur)
+	assert.NotEqual(t, before, after)
+}
+
+func TestMoneyFooterHiddenWithoutMoneyColumns(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithDemoData(t, testSeed)
+	m.switchToTab(tabIndex(tabVendors))
+	assert.False(t, hasMoneyFooter(m.activeTab()))
+	assert.NotContains(t, ansi.Strip(m.buildView()), symSigma)
+}
@@ -0,0 +1,314 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"charm.land/huh/v2"
+)
+
+// FormFields tailors one record type's forms from its [forms] config:
+// the fields the add form shows besides required ones, and the fields
+// that must be filled in. Nil keeps the built-in choice.
+type FormFields struct {
+	Add      []string
+	Required []string
+}
+
+// recordFormKinds maps [forms] config keys to the forms they tailor.
+var recordFormKinds = map[string]FormKind{
+	"projects":    formProject,
+	"quotes":      formQuote,
+	"maintenance": formMaintenance,
+	"incidents":   formIncident,
+	"appliances":  formAppliance,
+	"vendors":     formVendor,
+}
+
+// formField is one field of a record form. key is the field's name in
+// [forms] config: its form data field in snake_case, e.g. "model_number"
+// for ModelNumber.
+type formField struct {
+	key string
+	// essential fields are on the add form unless [forms] lists others.
+	essential bool
+	// needed fields are required by the database; [forms] can't make
+	// them optional.
+	needed bool
+	// build creates the field, marked and validated as required when the
+	// user must fill it in.
+	build func(required bool) huh.Field
+}
+
+// formGroup is a titled group of record form fields. hide, when set,
+// hides the group while it returns true.
+type formGroup struct {
+	title  string
+	fields []formField
+	hide   func() bool
+}
+
+// formFieldPrefs is a record type's resolved [forms] config. Nil sets
+// keep the built-in essentials and database requirements.
+type formFieldPrefs struct {
+	add      map[string]bool
+	required map[string]bool
+}
+
+// isRequired reports whether f must be filled in.
+func (p formFieldPrefs) isRequired(f formField) bool {
+	return f.needed || p.required[f.key]
+}
+
+// onAddForm reports whether the add form shows f.
+func (p formFieldPrefs) onAddForm(f formField) bool {
+	if p.isRequired(f) {
+		return true
+	}
+	if p.add == nil {
+		return f.essential
+	}
+	return p.add[f.key]
+}
+
+// recordForm builds a record's add or edit form from its groups. The
+// edit form shows every field. The add form shows only required,
+// essential, or configured ones, on as few pages as it can: neighboring
+// groups merge untitled, and only groups that hide stay apart.
+func (m *Model) recordForm(kind FormKind, groups []formGroup, adding bool) *huh.Form {
+	prefs := m.formPrefs[kind]
+	var huhGroups []*huh.Group
+	var pending []huh.Field
+	flush := func() {
+		if len(pending) > 0 {
+			huhGroups = append(huhGroups, huh.NewGroup(pending...))
+			pending = nil
+		}
+	}
+	for _, g := range groups {
+		var fields []huh.Field
+		for _, f := range g.fields {
+			if adding && !prefs.onAddForm(f) {
+				continue
+			}
+			fields = append(fields, f.build(prefs.isRequired(f)))
+		}
+		switch {
+		case len(fields) == 0:
+		case adding && g.hide == nil:
+			pending = append(pending, fields...)
+		default:
+			flush()
+			group := huh.NewGroup(fields...)
+			if g.title != "" {
+				group.Title(g.title)
+			}
+			if g.hide != nil {
+				group.WithHideFunc(g.hide)
+			}
+			huhGroups = append(huhGroups, group)
+		}
+	}
+	flush()
+	return huh.NewForm(huhGroups...)
+}
+
+// recordFormGroups returns the field groups of kind's form bound to
+// values, or nil for forms [forms] doesn't cover. Select options are left
+// empty; callers that render the form use the per-kind builders.
+func (m *Model) recordFormGroups(kind FormKind) []formGroup {
+	switch kind {
+	case formProject:
+		return m.projectFormGroups(&projectFormData{}, nil)
+	case formQuote:
+		return m.quoteFormGroups(&quoteFormData{}, nil)
+	case formMaintenance:
+		return m.maintenanceFormGroups(&maintenanceFormData{}, nil, nil)
+	case formIncident:
+		return m.incidentFormGroups(&incidentFormData{}, nil, nil)
+	case formAppliance:
+		return m.applianceFormGroups(&applianceFormData{})
+	case formVendor:
+		return m.vendorFormGroups(&vendorFormData{})
+	case formNone, formHouse, formServiceLog, formDocument:
+		return nil
+	default:
+		panic(fmt.Sprintf("unhandled FormKind: %d", kind))
+	}
+}
+
+// applyFormFields resolves the [forms] config, rejecting unknown record
+// types and fields and required lists that leave out a field the database
+// needs.
+func (m *Model) applyFormFields(forms map[string]FormFields) error {
+	for _, k := range slices.Sorted(maps.Keys(forms)) {
+		kind, ok := recordFormKinds[k]
+		if !ok {
+			return fmt.Errorf("forms.%s: unknown record type", k)
+		}
+		known := make(map[string]formField)
+		var needed, conditional []string
+		for _, g := range m.recordFormGroups(kind) {
+			for _, f := range g.fields {
+				known[f.key] = f
+				if f.needed {
+					needed = append(needed, f.key)
+				}
+				if g.hide != nil {
+					conditional = append(conditional, f.key)
+				}
+			}
+		}
+		var prefs formFieldPrefs
+		var err error
+		if add := forms[k].Add; add != nil {
+			if prefs.add, err = formFieldSet(known, add); err != nil {
+				return fmt.Errorf("forms.%s.add: %w", k, err)
+			}
+		}
+		if required := forms[k].Required; required != nil {
+			if prefs.required, err = formFieldSet(known, required); err != nil {
+				return fmt.Errorf("forms.%s.required: %w", k, err)
+			}
+			for _, name := range conditional {
+				if prefs.required[name] {
+					return fmt.Errorf("forms.%s.required: %s only shows for some choices and can't be required", k, name)
+				}
+			}
+			for _, name := range needed {
+				if !prefs.required[name] {
+					return fmt.Errorf("forms.%s.required: %s is always required", k, name)
+				}
+			}
+		}
+		if m.formPrefs == nil {
+			m.formPrefs = make(map[FormKind]formFieldPrefs)
+		}
+		m.formPrefs[kind] = prefs
+	}
+	return nil
+}
+
+// formFieldSet turns a [forms] field list into a set, rejecting names
+// that aren't fields of the form.
+func formFieldSet(known map[string]formField, names []string) (map[string]bool, error) {
+	set := make(map[string]bool, len(names))
+	for _, name := range names {
+		if _, ok := known[name]; !ok {
+			return nil, fmt.Errorf("unknown field %q (want one of %s)",
+				name, strings.Join(slices.Sorted(maps.Keys(known)), ", "))
+		}
+		set[name] = true
+	}
+	return set, nil
+}
+
+// checkRequiredFields rejects a submit that leaves a [forms]-required
+// field blank. Forms already refuse to finish without them; this covers
+// cell edits, which change one field at a time.
+func (m *Model) checkRequiredFields() error {
+	prefs := m.formPrefs[m.fs.formKind()]
+	if len(prefs.required) == 0 || m.fs.formData == nil {
+		return nil
+	}
+	v := reflect.ValueOf(m.fs.formData).Elem()
+	for i := range v.NumField() {
+		name := v.Type().Field(i).Name
+		if !prefs.required[formFieldKey(name)] {
+			continue
+		}
+		field := v.Field(i)
+		blank := field.IsZero()
+		if field.Kind() == reflect.String {
+			blank = strings.TrimSpace(field.String()) == ""
+		}
+		if blank {
+			return fmt.Errorf("%s is required", strings.ToLower(fieldLabel(name)))
+		}
+	}
+	return nil
+}
+
+// formFieldKey returns the [forms] config name of a form data field:
+// "ModelNumber" becomes "model_number" and "VendorID" "vendor".
+func formFieldKey(name string) string {
+	return strings.ReplaceAll(strings.ToLower(fieldLabel(name)), " ", "_")
+}
+
+// fieldTitle marks title as required when the user must fill it in.
+func fieldTitle(title string, required bool) string {
+	if required {
+		return requiredTitle(title)
+	}
+	return title
+}
+
+// fieldCheck rejects blanks for required fields before running validate,
+// which may be nil.
+func fieldCheck(label string, required bool, validate func(string) error) func(string) error {
+	return func(input string) error {
+		if required && strings.TrimSpace(input) == "" {
+			return fmt.Errorf("%s is required", label)
+		}
+		if validate == nil {
+			return nil
+		}
+		return validate(input)
+	}
+}
+
+// inputField is a plain text input titled title, validated by validate
+// (nil for none).
+func inputField(key, title string, value *string, validate func(string) error) formField {
+	return formField{key: key, build: func(required bool) huh.Field {
+		return huh.NewInput().
+			Title(fieldTitle(title, required)).
+			Value(value).
+			Validate(fieldCheck(strings.ToLower(title), required, validate))
+	}}
+}
+
+// dateField is a YYYY-MM-DD date input.
+func dateField(key, title string, value *string) formField {
+	label := strings.ToLower(title)
+	return formField{key: key, build: func(required bool) huh.Field {
+		return huh.NewInput().
+			Title(fieldTitle(title, required) + " (YYYY-MM-DD)").
+			Value(value).
+			Validate(fieldCheck(label, required, optionalDate(label)))
+	}}
+}
+
+// textField is a multi-line text area.
+func textField(key, title string, value *string) formField {
+	return formField{key: key, build: func(required bool) huh.Field {
+		return huh.NewText().
+			Title(fieldTitle(title, required)).
+			Value(value).
+			Validate(fieldCheck(strings.ToLower(title), required, nil))
+	}}
+}
+
+// selectField picks one of options. A required select rejects the empty
+// choice, such as "(none)".
+func selectField(key, title string, options []huh.Option[string], value *string) formField {
+	return formField{key: key, build: func(required bool) huh.Field {
+		return huh.NewSelect[string]().
+			Title(fieldTitle(title, required)).
+			Options(options...).
+			Value(value).
+			Validate(fieldCheck(strings.ToLower(title), required, nil))
+	}}
+}
+
+// essential marks f as shown on the add form by default.
+func essential(f formField) formField {
+	f.essential = true
+	return f
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormFieldKeysMatchFormData(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	dataTypes := map[FormKind]any{
+		formProject:     projectFormData{},
+		formQuote:       quoteFormData{},
+		formMaintenance: maintenanceFormData{},
+		formIncident:    incidentFormData{},
+		formAppliance:   applianceFormData{},
+		formVendor:      vendorFormData{},
+	}
+	for name, kind := range recordFormKinds {
+		typ := reflect.TypeOf(dataTypes[kind])
+		keys := make(map[string]bool, typ.NumField())
+		for i := range typ.NumField() {
+			keys[formFieldKey(typ.Field(i).Name)] = true
+		}
+		for _, g := range m.recordFormGroups(kind) {
+			for _, f := range g.fields {
+				assert.Truef(t, keys[f.key], "%s field %q has no form data field", name, f.key)
+			}
+		}
+	}
+}
+
+func TestApplyFormFieldsErrors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		forms  map[string]FormFields
+		errMsg string
+	}{
+		{
+			name:   "unknown record type",
+			forms:  map[string]FormFields{"houses": {Add: []string{"nickname"}}},
+			errMsg: "forms.houses: unknown record type",
+		},
+		{
+			name:   "unknown field",
+			forms:  map[string]FormFields{"appliances": {Add: []string{"colour"}}},
+			errMsg: `forms.appliances.add: unknown field "colour"`,
+		},
+		{
+			name:   "database field left out",
+			forms:  map[string]FormFields{"vendors": {Required: []string{"email"}}},
+			errMsg: "forms.vendors.required: name is always required",
+		},
+		{
+			name: "conditional field",
+			forms: map[string]FormFields{
+				"maintenance": {Required: []string{"name", "interval_months"}},
+			},
+			errMsg: "forms.maintenance.required: interval_months only shows for some choices",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newTestModel(t)
+			err := m.applyFormFields(tt.forms)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestAddFormShowsConfiguredFields(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	require.NoError(t, m.applyFormFields(map[string]FormFields{
+		"appliances": {Add: []string{"serial_number"}, Required: []string{"name", "brand"}},
+	}))
+	m.active = tabIndex(tabAppliances)
+	openAddForm(m)
+	view := formFieldLabels(m)
+	for _, want := range []string{"Name", "Brand", "Serial number"} {
+		assert.Containsf(t, view, want, "add appliance form should contain %q", want)
+	}
+	for _, absent := range []string{"Model number", "Location", "Purchase date"} {
+		assert.NotContainsf(t, view, absent, "add appliance form should NOT contain %q", absent)
+	}
+	assert.Contains(t, view, requiredTitle("Brand"))
+
+	values, ok := m.fs.formData.(*applianceFormData)
+	require.True(t, ok)
+	values.Name = "Dishwasher"
+	require.ErrorContains(t, m.handleFormSubmit(), "brand is required")
+	values.Brand = "Bosch"
+	require.NoError(t, m.handleFormSubmit())
+}
+
+func TestRequiredFieldBlocksInlineClear(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	require.NoError(t, m.applyFormFields(map[string]FormFields{
+		"vendors": {Required: []string{"name", "phone"}},
+	}))
+	vendor := data.Vendor{Name: "Acme Plumbing", Phone: "555-0100"}
+	require.NoError(t, m.store.CreateVendor(&vendor))
+	require.NoError(t, m.inlineEditVendor(vendor.ID, vendorColPhone))
+	values, ok := m.fs.formData.(*vendorFormData)
+	require.True(t, ok)
+	values.Phone = "  "
+	require.ErrorContains(t, m.handleFormSubmit(), "phone is required")
+
+	got, err := m.store.GetVendor(vendor.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "555-0100", got.Phone)
+}
@@ -0,0 +1,182 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/huh/v2"
+)
+
+// newRecordOption is the value of the "+ New …" option that linked selects
+// end with. Choosing it opens the linked record's form on top of the
+// current one.
+const newRecordOption = "\x00new"
+
+// recordLink describes a select whose records can be added from the form
+// it's on.
+type recordLink struct {
+	kind FormKind
+	noun string
+	// options lists the records to pick from, without the "+ New …"
+	// option. Called again after a record is added so it shows up.
+	options func() ([]huh.Option[string], error)
+}
+
+// linkedSelect is a registered select on the active form.
+type linkedSelect struct {
+	recordLink
+	field *huh.Select[string]
+	value *string
+}
+
+// formFrame is a form set aside while a record it links to is added.
+type formFrame struct {
+	fs       formState
+	prevMode Mode
+	link     linkedSelect
+}
+
+func (m *Model) projectLink() recordLink {
+	return recordLink{kind: formProject, noun: "project", options: func() ([]huh.Option[string], error) {
+		projects, err := m.store.ListProjects(false)
+		if err != nil {
+			return nil, fmt.Errorf("list projects: %w", err)
+		}
+		return projectOptions(projects), nil
+	}}
+}
+
+func (m *Model) applianceLink() recordLink {
+	return recordLink{kind: formAppliance, noun: "appliance", options: func() ([]huh.Option[string], error) {
+		appliances, err := m.store.ListAppliances(false)
+		if err != nil {
+			return nil, fmt.Errorf("list appliances: %w", err)
+		}
+		return applianceOptions(appliances), nil
+	}}
+}
+
+// vendorLink offers m.vendors, led by a noneLabel option.
+func (m *Model) vendorLink(noneLabel string) recordLink {
+	return recordLink{kind: formVendor, noun: "vendor", options: func() ([]huh.Option[string], error) {
+		return vendorOpts(noneLabel, m.vendors), nil
+	}}
+}
+
+// linkSelect sets sel's options, followed by "+ New …", and registers it
+// with the form being built.
+func (m *Model) linkSelect(
+	sel *huh.Select[string],
+	link recordLink,
+	options []huh.Option[string],
+	value *string,
+) *huh.Select[string] {
+	sel.Options(withNewRecordOption(link, options)...).Value(value)
+	if m.fs.links == nil {
+		m.fs.links = make(map[huh.Field]linkedSelect)
+	}
+	m.fs.links[sel] = linkedSelect{recordLink: link, field: sel, value: value}
+	return sel
+}
+
+// linkedSelectField is selectField for a linked select.
+func (m *Model) linkedSelectField(
+	key, title string,
+	link recordLink,
+	options []huh.Option[string],
+	value *string,
+) formField {
+	return formField{key: key, build: func(required bool) huh.Field {
+		return m.linkSelect(
+			huh.NewSelect[string]().
+				Title(fieldTitle(title, required)).
+				Validate(fieldCheck(strings.ToLower(title), required, nil)),
+			link, options, value,
+		)
+	}}
+}
+
+func withNewRecordOption(link recordLink, options []huh.Option[string]) []huh.Option[string] {
+	out := make([]huh.Option[string], 0, len(options)+1)
+	out = append(out, options...)
+	return append(out, huh.NewOption("+ New "+link.noun+"…", newRecordOption))
+}
+
+// focusedNewRecord returns the focused linked select when its cursor is on
+// "+ New …".
+func (m *Model) focusedNewRecord() (linkedSelect, bool) {
+	if m.fs.form == nil {
+		return linkedSelect{}, false
+	}
+	link, ok := m.fs.links[m.fs.form.GetFocusedField()]
+	if !ok || *link.value != newRecordOption {
+		return linkedSelect{}, false
+	}
+	return link, true
+}
+
+// pendingNewRecord reports a linked select left on "+ New …", which has
+// no record to save.
+func (fs *formState) pendingNewRecord() error {
+	for _, link := range fs.links {
+		if *link.value == newRecordOption {
+			return fmt.Errorf(
+				"no %s picked: choose one, or press enter on \"+ New %s…\"", link.noun, link.noun,
+			)
+		}
+	}
+	return nil
+}
+
+// openLinkedForm sets the current form aside and opens an add form for the
+// select's record. Saving it returns to the form with the new record
+// picked; cancelling returns without one.
+func (m *Model) openLinkedForm(link linkedSelect) {
+	m.formStack = append(m.formStack, formFrame{fs: m.fs, prevMode: m.prevMode, link: link})
+	m.fs = formState{}
+	switch link.kind {
+	case formProject:
+		m.startProjectForm()
+	case formAppliance:
+		m.startApplianceForm()
+	case formVendor:
+		m.startVendorForm()
+	default:
+		panic(fmt.Sprintf("no linked form for FormKind %d", link.kind))
+	}
+	m.setStatusInfo(fmt.Sprintf(
+		"New %s: save to pick it, %s to go back.", link.noun, keyEsc,
+	))
+}
+
+// closeLinkedForm returns to the form set aside by openLinkedForm, with
+// the record just added, if any, picked in its select.
+func (m *Model) closeLinkedForm() {
+	addedID := m.fs.editID
+	m.resetFormState()
+	frame := m.formStack[len(m.formStack)-1]
+	m.formStack = m.formStack[:len(m.formStack)-1]
+	m.fs = frame.fs
+	m.prevMode = frame.prevMode
+	m.mode = modeForm
+
+	link := frame.link
+	options, err := link.options()
+	if err != nil {
+		m.setStatusError(humanizeError(err))
+		return
+	}
+	*link.value = ""
+	if len(options) > 0 {
+		*link.value = options[0].Value
+	}
+	if addedID != nil {
+		*link.value = *addedID
+		m.setStatusInfo(fmt.Sprintf("Added the %s.", link.noun))
+	}
+	link.field.Options(withNewRecordOption(link.recordLink, options)...)
+	m.checkFormDirty()
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"testing"
+
+	"charm.land/huh/v2"
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// focusNewRecord moves the form's focus to the linked select for noun and
+// puts its cursor on "+ New …", the way a user would before pressing
+// enter.
+func focusNewRecord(t *testing.T, m *Model, noun string) linkedSelect {
+	t.Helper()
+	var link linkedSelect
+	for _, l := range m.fs.links {
+		if l.noun == noun {
+			link = l
+		}
+	}
+	require.NotNil(t, link.field, "no linked %s select", noun)
+	for range 20 {
+		if m.fs.form.GetFocusedField() == huh.Field(link.field) {
+			break
+		}
+		m.formUpdate(huh.NextField())
+	}
+	require.Equal(t, huh.Field(link.field), m.fs.form.GetFocusedField())
+	for range 20 {
+		if *link.value == newRecordOption {
+			break
+		}
+		sendKey(m, "down")
+	}
+	require.Equal(t, newRecordOption, *link.value)
+	return link
+}
+
+func TestAddVendorFromIncidentForm(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	require.NoError(t, m.startIncidentForm())
+	incident := m.fs.formData.(*incidentFormData)
+
+	focusNewRecord(t, m, "vendor")
+	sendKey(m, "enter")
+	require.Len(t, m.formStack, 1)
+	require.Equal(t, formVendor, m.fs.formKind())
+	assert.Contains(t, m.statusView(), "New vendor")
+
+	m.fs.formData.(*vendorFormData).Name = "Drip Fixers"
+	m.checkFormDirty()
+	sendKey(m, "ctrl+s")
+	sendKey(m, "esc")
+
+	require.Empty(t, m.formStack)
+	require.Equal(t, formIncident, m.fs.formKind(), "back on the incident form")
+	assert.Equal(t, modeForm, m.mode)
+	assert.Same(t, incident, m.fs.formData, "incident values are kept")
+
+	vendors, err := m.store.ListVendors(false)
+	require.NoError(t, err)
+	require.Len(t, vendors, 1)
+	assert.Equal(t, vendors[0].ID, incident.VendorID, "the new vendor is picked")
+}
+
+func TestCancelLinkedFormLeavesSelectEmpty(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	require.NoError(t, m.startServiceLogForm(""))
+
+	focusNewRecord(t, m, "vendor")
+	sendKey(m, "enter")
+	require.Equal(t, formVendor, m.fs.formKind())
+	sendKey(m, "esc")
+
+	require.Empty(t, m.formStack)
+	require.Equal(t, formServiceLog, m.fs.formKind())
+	assert.Empty(t, m.fs.formData.(*serviceLogFormData).VendorID, "back on Self")
+}
+
+func TestSaveWithNewRecordOptionPicked(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	require.NoError(t, m.store.CreateAppliance(&data.Appliance{Name: "Furnace"}))
+	require.NoError(t, m.startMaintenanceForm())
+	values := m.fs.formData.(*maintenanceFormData)
+	values.Name = "Change filter"
+	focusNewRecord(t, m, "appliance")
+
+	sendKey(m, "ctrl+s")
+	assert.Contains(t, m.statusView(), "no appliance picked")
+	items, err := m.store.ListMaintenance(false)
+	require.NoError(t, err)
+	assert.Empty(t, items)
+}
@@ -4,6 +4,7 @@
 package app
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -219,6 +220,37 @@ func TestUserCreatesMaintenanceWithIntervalOnly(t *testing.T) {
 	assert.Equal(t, "3m", cells[int(maintenanceColEvery)].Value)
 }
 
+func TestUserCreatesMaintenanceWithConfiguredIntervalUnit(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	m.intervalUnit = data.IntervalUnitWeeks
+	m.active = tabIndex(tabMaintenance)
+	openAddForm(m)
+
+	values, ok := m.fs.formData.(*maintenanceFormData)
+	require.True(t, ok)
+	values.Name = "Clean Humidifier"
+	values.ScheduleType = schedInterval
+	values.IntervalMonths = "2"
+	sendKey(m, "ctrl+s")
+	sendKey(m, "esc")
+
+	items, err := m.store.ListMaintenance(false)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Zero(t, items[0].IntervalMonths)
+	assert.Equal(t, 14, items[0].IntervalDays, "a bare number is read in weeks")
+	assert.Equal(t, "2w",
+		maintenanceFormValues(items[0], locale.DefaultCurrency()).IntervalMonths)
+
+	m.reloadAll()
+	require.NoError(t, m.reloadActiveTab())
+	tab := m.activeTab()
+	require.NotNil(t, tab)
+	require.NotEmpty(t, tab.CellRows)
+	assert.Equal(t, "2w", tab.CellRows[0][int(maintenanceColEvery)].Value)
+}
+
 // Step 2: Create maintenance with due date.
 func TestUserCreatesMaintenanceWithDueDate(t *testing.T) {
 	t.Parallel()
@@ -895,6 +927,25 @@ func TestCtrlQDirtyFormConfirmQuits(t *testing.T) {
 	assert.Equal(t, "Test House", m.house.Nickname)
 }
 
+func TestCtrlQDirtyFormConfirmCancelsSync(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	openHouseForm(m)
+	ctx, cancel := context.WithCancel(context.Background())
+	m.syncCancel = cancel
+
+	values, ok := m.fs.formData.(*houseFormData)
+	require.True(t, ok)
+	values.Nickname = "Sync Quit"
+	m.checkFormDirty()
+
+	m.Update(tea.KeyPressMsg{Code: 'q', Mod: tea.ModCtrl})
+	require.Equal(t, confirmFormQuitDiscard, m.confirm)
+	_, cmd := m.Update(keyPress("y"))
+	require.NotNil(t, cmd)
+	assert.Error(t, ctx.Err(), "confirmed quit should cancel background sync")
+}
+
 func TestCtrlQDirtyFormCancelStaysInForm(t *testing.T) {
 	t.Parallel()
 	m := newTestModelWithStore(t)
@@ -1079,3 +1130,59 @@ func TestDateParserRejectsGarbageInput(t *testing.T) {
 	assert.Contains(t, m.status.Text, "Date Noticed",
 		"error should name the offending field")
 }
+
+func TestUserClonesLastServiceLogEntry(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	cats, err := m.store.MaintenanceCategories()
+	require.NoError(t, err)
+	item := data.MaintenanceItem{Name: "Filter Change", CategoryID: cats[0].ID}
+	require.NoError(t, m.store.CreateMaintenance(&item))
+	vendor := data.Vendor{Name: "Filter Co"}
+	older := time.Now().AddDate(0, -2, 0)
+	newer := time.Now().AddDate(0, -1, 0)
+	require.NoError(t, m.store.CreateServiceLog(&data.ServiceLogEntry{
+		MaintenanceItemID: item.ID, ServicedAt: older, Notes: "first",
+	}, data.Vendor{}))
+	cost := int64(4500)
+	require.NoError(t, m.store.CreateServiceLog(&data.ServiceLogEntry{
+		MaintenanceItemID: item.ID, ServicedAt: newer, CostCents: &cost, Notes: "monthly",
+	}, vendor))
+	m.reloadAll()
+
+	require.NoError(t, m.openServiceLogDetail(item.ID, item.Name))
+	m.mode = modeEdit
+	sendKey(m, keyC)
+	require.Equal(t, modeForm, m.mode, "status: %s", m.status.Text)
+
+	values, ok := m.fs.formData.(*serviceLogFormData)
+	require.True(t, ok)
+	assert.Equal(t, time.Now().Format(data.DateLayout), values.ServicedAt)
+	assert.Equal(t, "monthly", values.Notes)
+	assert.NotEmpty(t, values.VendorID, "the vendor is carried over")
+	assert.Equal(t, m.cur.FormatOptionalCents(&cost), values.Cost)
+
+	sendKey(m, "ctrl+s")
+	entries, err := m.store.ListServiceLog(item.ID, false)
+	require.NoError(t, err)
+	require.Len(t, entries, 3, "the clone is a new entry")
+	assert.Equal(t, time.Now().Format(data.DateLayout), entries[0].ServicedAt.Format(data.DateLayout))
+	assert.Equal(t, "monthly", entries[0].Notes)
+	assert.Equal(t, "monthly", entries[1].Notes, "the source entry is untouched")
+}
+
+func TestCloneServiceLogWithNoEntries(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	cats, err := m.store.MaintenanceCategories()
+	require.NoError(t, err)
+	item := data.MaintenanceItem{Name: "Never Done", CategoryID: cats[0].ID}
+	require.NoError(t, m.store.CreateMaintenance(&item))
+
+	require.NoError(t, m.openServiceLogDetail(item.ID, item.Name))
+	m.mode = modeEdit
+	sendKey(m, keyC)
+	assert.Equal(t, modeEdit, m.mode)
+	assert.Equal(t, statusError, m.status.Kind)
+	assert.Contains(t, m.status.Text, "no service logged yet")
+}
@@ -69,7 +69,7 @@ func TestOptionalIntervalRejectsInvalid(t *testing.T) {
 
 func TestOptionalFloatAcceptsValid(t *testing.T) {
 	t.Parallel()
-	validate := optionalFloat("bathrooms")
+	validate := optionalFloat("bathrooms", data.BathroomStep)
 	for _, input := range []string{"", "0", "2.5", "  3  "} {
 		assert.NoErrorf(t, validate(input), "optionalFloat(%q)", input)
 	}
@@ -77,12 +77,20 @@ func TestOptionalFloatAcceptsValid(t *testing.T) {
 
 func TestOptionalFloatRejectsInvalid(t *testing.T) {
 	t.Parallel()
-	validate := optionalFloat("bathrooms")
+	validate := optionalFloat("bathrooms", data.BathroomStep)
 	for _, input := range []string{"abc", "-1.5"} {
 		assert.Errorf(t, validate(input), "optionalFloat(%q) expected error", input)
 	}
 }
 
+func TestOptionalFloatRejectsOffStep(t *testing.T) {
+	t.Parallel()
+	validate := optionalFloat("bathrooms", data.BathroomStep)
+	err := validate("2.33")
+	require.Error(t, err)
+	assert.Equal(t, "bathrooms should be in steps of 0.5, like 2 or 2.5", err.Error())
+}
+
 func TestOptionalDateAcceptsValid(t *testing.T) {
 	t.Parallel()
 	validate := optionalDate("start date")
@@ -68,8 +68,10 @@ type projectFormData struct {
 	Status        string `default:"planned"`
 	Budget        string
 	Actual        string
+	Currency      string
 	StartDate     string
 	EndDate       string
+	Recurrence    string
 	Description   string
 }
 
@@ -85,7 +87,9 @@ type quoteFormData struct {
 	Labor        string
 	Materials    string
 	Other        string
+	Tax          string
 	ReceivedDate string
+	AcceptedDate string
 	Notes        string
 }
 
@@ -102,6 +106,7 @@ type maintenanceFormData struct {
 	CategoryID     string
 	ApplianceID    string // "" means none
 	Season         string
+	Month          string // "" or "1"-"12"
 	ScheduleType   scheduleType
 	LastServiced   string
 	IntervalMonths string
@@ -176,6 +181,7 @@ type applianceFormData struct {
 	WarrantyExpiry string
 	Location       string
 	Cost           string
+	Currency       string
 	Notes          string
 }
 
@@ -196,7 +202,10 @@ func (m *Model) startHouseForm() {
 	if m.hasHouse {
 		values = m.houseFormValues(m.house)
 	}
+	m.openHouseForm(values)
+}
 
+func (m *Model) openHouseForm(values *houseFormData) {
 	defs := houseFieldDefs()
 
 	// Build fields grouped by section, capturing autofill references.
@@ -241,22 +250,7 @@ func (m *Model) startProjectForm() {
 	if len(options) > 0 {
 		values.ProjectTypeID = options[0].Value
 	}
-	form := huh.NewForm(
-		huh.NewGroup(
-			huh.NewInput().
-				Title(requiredTitle("Title")).
-				Value(&values.Title).
-				Validate(requiredText("title")),
-			huh.NewSelect[string]().
-				Title("Project type").
-				Options(options...).
-				Value(&values.ProjectTypeID),
-			huh.NewSelect[string]().
-				Title("Status").
-				Options(statusOptions()...).
-				Value(&values.Status),
-		),
-	)
+	form := m.recordForm(formProject, m.projectFormGroups(values, options), true)
 	m.activateForm(form, values)
 }
 
@@ -273,46 +267,71 @@ func (m *Model) startEditProjectForm(id string) error {
 }
 
 func (m *Model) openProjectForm(values *projectFormData, options []huh.Option[string]) {
-	form := huh.NewForm(
-		huh.NewGroup(
-			huh.NewInput().
-				Title(requiredTitle("Title")).
-				Value(&values.Title).
-				Validate(requiredText("title")),
-			huh.NewSelect[string]().
-				Title("Project type").
-				Options(options...).
-				Value(&values.ProjectTypeID),
-			huh.NewSelect[string]().
-				Title("Status").
-				Options(statusOptions()...).
-				Value(&values.Status),
-			huh.NewInput().
-				Title("Budget").
-				Placeholder("1250.00").
-				Value(&values.Budget).
-				Validate(optionalMoney("budget", m.cur)),
-			huh.NewInput().
-				Title("Actual cost").
-				Placeholder("1400.00").
-				Value(&values.Actual).
-				Validate(optionalMoney("actual cost", m.cur)),
-		),
-		huh.NewGroup(
-			huh.NewInput().
-				Title("Start date (YYYY-MM-DD)").
-				Value(&values.StartDate).
-				Validate(optionalDate("start date")),
-			huh.NewInput().
-				Title("End date (YYYY-MM-DD)").
-				Value(&values.EndDate).
-				Validate(endDateAfterStart(&values.StartDate, &values.EndDate)),
-			huh.NewText().
-				Title("Description").
-				Value(&values.Description),
-		).Title("Timeline"),
-	)
-	m.activateForm(form, values)
+	if values.Currency == "" {
+		values.Currency = m.cur.Code()
+	}
+	m.activateForm(m.recordForm(formProject, m.projectFormGroups(values, options), false), values)
+}
+
+// projectFormGroups lays out the project form's fields.
+func (m *Model) projectFormGroups(
+	values *projectFormData,
+	options []huh.Option[string],
+) []formGroup {
+	return []formGroup{
+		{fields: []formField{
+			{key: "title", essential: true, needed: true, build: func(bool) huh.Field {
+				return huh.NewInput().
+					Title(requiredTitle("Title")).
+					Value(&values.Title).
+					Validate(requiredText("title"))
+			}},
+			essential(selectField("project_type", "Project type", options, &values.ProjectTypeID)),
+			essential(selectField("status", "Status", statusOptions(), &values.Status)),
+			{key: "budget", build: func(required bool) huh.Field {
+				return huh.NewInput().
+					Title(fieldTitle("Budget", required)).
+					Placeholder("1250.00").
+					Value(&values.Budget).
+					Validate(fieldCheck("budget", required,
+						m.recordMoney("budget", &values.Currency)))
+			}},
+			{key: "actual", build: func(required bool) huh.Field {
+				return huh.NewInput().
+					Title(fieldTitle("Actual cost", required)).
+					Placeholder("1400.00").
+					Value(&values.Actual).
+					Validate(fieldCheck("actual cost", required,
+						m.recordMoney("actual cost", &values.Currency)))
+			}},
+			{key: "currency", build: func(required bool) huh.Field {
+				return huh.NewInput().
+					Title(fieldTitle("Currency", required)).
+					Description("Budget and actual cost are in this currency").
+					Value(&values.Currency).
+					Validate(fieldCheck("currency", required, m.recordCurrencyValidator()))
+			}},
+		}},
+		{title: "Timeline", fields: []formField{
+			dateField("start_date", "Start date", &values.StartDate),
+			{key: "end_date", build: func(required bool) huh.Field {
+				return huh.NewInput().
+					Title(fieldTitle("End date", required) + " (YYYY-MM-DD)").
+					Value(&values.EndDate).
+					Validate(fieldCheck("end date", required,
+						endDateAfterStart(&values.StartDate, &values.EndDate)))
+			}},
+			{key: "recurrence", build: func(required bool) huh.Field {
+				return huh.NewInput().
+					Title(fieldTitle("Repeats every", required)).
+					Description("Offer to schedule the next one on completion").
+					Placeholder("1y").
+					Value(&values.Recurrence).
+					Validate(fieldCheck("repeats every", required, optionalInterval()))
+			}},
+			textField("description", "Description", &values.Description),
+		}},
+	}
 }
 
 func (m *Model) startQuoteForm() error {
@@ -326,23 +345,7 @@ func (m *Model) startQuoteForm() error {
 	values := &quoteFormData{}
 	options := projectOptions(projects)
 	values.ProjectID = options[0].Value
-	form := huh.NewForm(
-		huh.NewGroup(
-			huh.NewSelect[string]().
-				Title("Project").
-				Options(options...).
-				Value(&values.ProjectID),
-			huh.NewInput().
-				Title(requiredTitle("Vendor name")).
-				Value(&values.VendorName).
-				Validate(requiredText("vendor name")),
-			huh.NewInput().
-				Title(requiredTitle("Total")).
-				Placeholder("3250.00").
-				Value(&values.Total).
-				Validate(requiredMoney(m.cur)),
-		),
-	)
+	form := m.recordForm(formQuote, m.quoteFormGroups(values, options), true)
 	m.activateForm(form, values)
 	return nil
 }
@@ -367,50 +370,54 @@ func (m *Model) startEditQuoteForm(id string) error {
 }
 
 func (m *Model) openQuoteForm(values *quoteFormData, projectOpts []huh.Option[string]) {
-	form := huh.NewForm(
-		huh.NewGroup(
-			huh.NewSelect[string]().
-				Title("Project").
-				Options(projectOpts...).
-				Value(&values.ProjectID),
-			huh.NewInput().
-				Title(requiredTitle("Vendor name")).
-				Value(&values.VendorName).
-				Validate(requiredText("vendor name")),
-			huh.NewInput().Title("Contact name").Value(&values.ContactName),
-			huh.NewInput().Title("Email").Value(&values.Email),
-			huh.NewInput().Title("Phone").Value(&values.Phone),
-			huh.NewInput().Title("Website").Value(&values.Website),
-		).Title("Vendor"),
-		huh.NewGroup(
-			huh.NewInput().
-				Title(requiredTitle("Total")).
-				Placeholder("3250.00").
-				Value(&values.Total).
-				Validate(requiredMoney(m.cur)),
-			huh.NewInput().
-				Title("Labor").
-				Placeholder("2000.00").
-				Value(&values.Labor).
-				Validate(optionalMoney("labor", m.cur)),
-			huh.NewInput().
-				Title("Materials").
-				Placeholder("1000.00").
-				Value(&values.Materials).
-				Validate(optionalMoney("materials", m.cur)),
-			huh.NewInput().
-				Title("Other").
-				Placeholder("250.00").
-				Value(&values.Other).
-				Validate(optionalMoney("other costs", m.cur)),
-			huh.NewInput().
-				Title("Received date (YYYY-MM-DD)").
-				Value(&values.ReceivedDate).
-				Validate(optionalDate("received date")),
-			huh.NewText().Title("Notes").Value(&values.Notes),
-		).Title("Quote"),
-	)
-	m.activateForm(form, values)
+	m.activateForm(m.recordForm(formQuote, m.quoteFormGroups(values, projectOpts), false), values)
+}
+
+// quoteFormGroups lays out the quote form's fields.
+func (m *Model) quoteFormGroups(
+	values *quoteFormData,
+	projectOpts []huh.Option[string],
+) []formGroup {
+	money := func(key, title, label, placeholder string, value *string) formField {
+		return formField{key: key, build: func(required bool) huh.Field {
+			return huh.NewInput().
+				Title(fieldTitle(title, required)).
+				Placeholder(placeholder).
+				Value(value).
+				Validate(fieldCheck(label, required, optionalMoney(label, m.cur)))
+		}}
+	}
+	return []formGroup{
+		{title: "Vendor", fields: []formField{
+			essential(m.linkedSelectField("project", "Project", m.projectLink(), projectOpts, &values.ProjectID)),
+			{key: "vendor_name", essential: true, needed: true, build: func(bool) huh.Field {
+				return huh.NewInput().
+					Title(requiredTitle("Vendor name")).
+					Value(&values.VendorName).
+					Validate(requiredText("vendor name"))
+			}},
+			inputField("contact_name", "Contact name", &values.ContactName, nil),
+			inputField("email", "Email", &values.Email, nil),
+			inputField("phone", "Phone", &values.Phone, nil),
+			inputField("website", "Website", &values.Website, nil),
+		}},
+		{title: "Quote", fields: []formField{
+			{key: "total", essential: true, needed: true, build: func(bool) huh.Field {
+				return huh.NewInput().
+					Title(requiredTitle("Total")).
+					Placeholder("3250.00").
+					Value(&values.Total).
+					Validate(requiredMoney(m.cur))
+			}},
+			money("labor", "Labor", "labor", "2000.00", &values.Labor),
+			money("materials", "Materials", "materials", "1000.00", &values.Materials),
+			money("other", "Other", "other costs", "250.00", &values.Other),
+			money("tax", "Tax", "tax", "260.00", &values.Tax),
+			dateField("received_date", "Received date", &values.ReceivedDate),
+			dateField("accepted_date", "Accepted date", &values.AcceptedDate),
+			textField("notes", "Notes", &values.Notes),
+		}},
+	}
 }
 
 func scheduleTypeOptions() []huh.Option[scheduleType] {
@@ -422,7 +429,13 @@ func scheduleTypeOptions() []huh.Option[scheduleType] {
 }
 
 func (m *Model) startMaintenanceForm() error {
-	values := &maintenanceFormData{ScheduleType: schedNone}
+	return m.startApplianceMaintenanceForm("")
+}
+
+// startApplianceMaintenanceForm opens the add-maintenance form with the
+// Appliance select preset to applianceID (empty for none).
+func (m *Model) startApplianceMaintenanceForm(applianceID string) error {
+	values := &maintenanceFormData{ScheduleType: schedNone, ApplianceID: applianceID}
 	catOptions := maintenanceOptions(m.maintenanceCategories)
 	if len(catOptions) > 0 {
 		values.CategoryID = catOptions[0].Value
@@ -432,43 +445,7 @@ func (m *Model) startMaintenanceForm() error {
 		return fmt.Errorf("list appliances: %w", err)
 	}
 	appOpts := applianceOptions(appliances)
-	form := huh.NewForm(
-		huh.NewGroup(
-			huh.NewInput().
-				Title(requiredTitle("Item")).
-				Value(&values.Name).
-				Validate(requiredText("item")),
-			huh.NewSelect[string]().
-				Title("Category").
-				Options(catOptions...).
-				Value(&values.CategoryID),
-			huh.NewSelect[string]().
-				Title("Season").
-				Options(seasonOptions()...).
-				Value(&values.Season),
-			huh.NewSelect[string]().
-				Title("Appliance").
-				Options(appOpts...).
-				Value(&values.ApplianceID),
-			huh.NewSelect[scheduleType]().
-				Title("Schedule").
-				Options(scheduleTypeOptions()...).
-				Value(&values.ScheduleType),
-		),
-		huh.NewGroup(
-			huh.NewInput().
-				Title("Interval").
-				Placeholder("6m").
-				Value(&values.IntervalMonths).
-				Validate(optionalInterval()),
-		).WithHideFunc(func() bool { return values.ScheduleType != schedInterval }),
-		huh.NewGroup(
-			huh.NewInput().
-				Title("Due date (YYYY-MM-DD)").
-				Value(&values.DueDate).
-				Validate(optionalDate("due date")),
-		).WithHideFunc(func() bool { return values.ScheduleType != schedDueDate }),
-	)
+	form := m.recordForm(formMaintenance, m.maintenanceFormGroups(values, catOptions, appOpts), true)
 	m.activateForm(form, values)
 	return nil
 }
@@ -495,58 +472,72 @@ func (m *Model) openMaintenanceForm(
 	catOptions []huh.Option[string],
 	appOptions []huh.Option[string],
 ) {
-	form := huh.NewForm(
-		huh.NewGroup(
-			huh.NewInput().
-				Title(requiredTitle("Item")).
-				Value(&values.Name).
-				Validate(requiredText("item")),
-			huh.NewSelect[string]().
-				Title("Category").
-				Options(catOptions...).
-				Value(&values.CategoryID),
-			huh.NewSelect[string]().
-				Title("Season").
-				Options(seasonOptions()...).
-				Value(&values.Season),
-			huh.NewSelect[string]().
-				Title("Appliance").
-				Options(appOptions...).
-				Value(&values.ApplianceID),
-			huh.NewInput().
-				Title("Last serviced (YYYY-MM-DD)").
-				Value(&values.LastServiced).
-				Validate(optionalDate("last serviced")),
-			huh.NewSelect[scheduleType]().
-				Title("Schedule").
-				Options(scheduleTypeOptions()...).
-				Value(&values.ScheduleType),
-		).Title("Schedule"),
-		huh.NewGroup(
-			huh.NewInput().
-				Title("Interval").
-				Placeholder("6m").
-				Value(&values.IntervalMonths).
-				Validate(optionalInterval()),
-		).WithHideFunc(func() bool { return values.ScheduleType != schedInterval }),
-		huh.NewGroup(
-			huh.NewInput().
-				Title("Due date (YYYY-MM-DD)").
-				Value(&values.DueDate).
-				Validate(optionalDate("due date")),
-		).WithHideFunc(func() bool { return values.ScheduleType != schedDueDate }),
-		huh.NewGroup(
-			huh.NewInput().Title("Manual URL").Value(&values.ManualURL),
-			huh.NewText().Title("Manual notes").Value(&values.ManualText),
-			huh.NewInput().
-				Title("Cost").
-				Placeholder("125.00").
-				Value(&values.Cost).
-				Validate(optionalMoney("cost", m.cur)),
-			huh.NewText().Title("Notes").Value(&values.Notes),
-		).Title("Details"),
-	)
-	m.activateForm(form, values)
+	groups := m.maintenanceFormGroups(values, catOptions, appOptions)
+	m.activateForm(m.recordForm(formMaintenance, groups, false), values)
+}
+
+// maintenanceFormGroups lays out the maintenance form's fields. The
+// interval and due date groups show only for their schedule type.
+func (m *Model) maintenanceFormGroups(
+	values *maintenanceFormData,
+	catOptions []huh.Option[string],
+	appOptions []huh.Option[string],
+) []formGroup {
+	return []formGroup{
+		{title: "Schedule", fields: []formField{
+			{key: "name", essential: true, needed: true, build: func(bool) huh.Field {
+				return huh.NewInput().
+					Title(requiredTitle("Item")).
+					Value(&values.Name).
+					Validate(requiredText("item"))
+			}},
+			essential(selectField("category", "Category", catOptions, &values.CategoryID)),
+			essential(selectField("season", "Season", seasonOptions(), &values.Season)),
+			essential(selectField("month", "Month", monthOptions(), &values.Month)),
+			essential(m.linkedSelectField(
+				"appliance", "Appliance", m.applianceLink(), appOptions, &values.ApplianceID,
+			)),
+			dateField("last_serviced", "Last serviced", &values.LastServiced),
+			{key: "schedule_type", essential: true, build: func(required bool) huh.Field {
+				return huh.NewSelect[scheduleType]().
+					Title(fieldTitle("Schedule", required)).
+					Options(scheduleTypeOptions()...).
+					Value(&values.ScheduleType).
+					Validate(func(t scheduleType) error {
+						if required && t == schedNone {
+							return errors.New("schedule is required")
+						}
+						return nil
+					})
+			}},
+		}},
+		{
+			fields: []formField{{key: "interval_months", essential: true, build: func(required bool) huh.Field {
+				return huh.NewInput().
+					Title(fieldTitle("Interval", required)).
+					Placeholder("6m").
+					Value(&values.IntervalMonths).
+					Validate(fieldCheck("interval", required, m.optionalScheduleInterval()))
+			}}},
+			hide: func() bool { return values.ScheduleType != schedInterval },
+		},
+		{
+			fields: []formField{essential(dateField("due_date", "Due date", &values.DueDate))},
+			hide:   func() bool { return values.ScheduleType != schedDueDate },
+		},
+		{title: "Details", fields: []formField{
+			inputField("manual_url", "Manual URL", &values.ManualURL, nil),
+			textField("manual_text", "Manual notes", &values.ManualText),
+			{key: "cost", build: func(required bool) huh.Field {
+				return huh.NewInput().
+					Title(fieldTitle("Cost", required)).
+					Placeholder("125.00").
+					Value(&values.Cost).
+					Validate(fieldCheck("cost", required, optionalMoney("cost", m.cur)))
+			}},
+			textField("notes", "Notes", &values.Notes),
+		}},
+	}
 }
 
 func (m *Model) startIncidentForm() error {
@@ -558,36 +549,7 @@ func (m *Model) startIncidentForm() error {
 	}
 	appOpts := applianceOptions(appliances)
 	vendorOpts := vendorOpts("(none)", m.vendors)
-	form := huh.NewForm(
-		huh.NewGroup(
-			huh.NewInput().
-				Title(requiredTitle("Title")).
-				Value(&values.Title).
-				Validate(requiredText("title")),
-			huh.NewSelect[string]().
-				Title("Severity").
-				Options(incidentSeverityOptions()...).
-				Value(&values.Severity),
-			huh.NewInput().
-				Title(requiredTitle("Date noticed")+" (YYYY-MM-DD)").
-				Value(&values.DateNoticed).
-				Validate(requiredDate("date noticed")),
-			huh.NewInput().
-				Title("Location").
-				Placeholder("Kitchen").
-				Value(&values.Location),
-		).Title("Details"),
-		huh.NewGroup(
-			huh.NewSelect[string]().
-				Title("Appliance").
-				Options(appOpts...).
-				Value(&values.ApplianceID),
-			huh.NewSelect[string]().
-				Title("Vendor").
-				Options(vendorOpts...).
-				Value(&values.VendorID),
-		).Title("Links"),
-	)
+	form := m.recordForm(formIncident, m.incidentFormGroups(values, appOpts, vendorOpts), true)
 	m.activateForm(form, values)
 	return nil
 }
@@ -614,52 +576,59 @@ func (m *Model) openIncidentForm(
 	appOptions []huh.Option[string],
 	vendorOptions []huh.Option[string],
 ) {
-	form := huh.NewForm(
-		huh.NewGroup(
-			huh.NewInput().
-				Title(requiredTitle("Title")).
-				Value(&values.Title).
-				Validate(requiredText("title")),
-			huh.NewSelect[string]().
-				Title("Status").
-				Options(incidentStatusOptions()...).
-				Value(&values.Status),
-			huh.NewSelect[string]().
-				Title("Severity").
-				Options(incidentSeverityOptions()...).
-				Value(&values.Severity),
-			huh.NewInput().
-				Title(requiredTitle("Date noticed")+" (YYYY-MM-DD)").
-				Value(&values.DateNoticed).
-				Validate(requiredDate("date noticed")),
-			huh.NewInput().
-				Title("Date resolved (YYYY-MM-DD)").
-				Value(&values.DateResolved).
-				Validate(optionalDate("date resolved")),
-			huh.NewInput().
-				Title("Location").
-				Placeholder("Kitchen").
-				Value(&values.Location),
-		).Title("Details"),
-		huh.NewGroup(
-			huh.NewSelect[string]().
-				Title("Appliance").
-				Options(appOptions...).
-				Value(&values.ApplianceID),
-			huh.NewSelect[string]().
-				Title("Vendor").
-				Options(vendorOptions...).
-				Value(&values.VendorID),
-			huh.NewInput().
-				Title("Cost").
-				Placeholder("250.00").
-				Value(&values.Cost).
-				Validate(optionalMoney("cost", m.cur)),
-			huh.NewText().Title("Description").Value(&values.Description),
-			huh.NewText().Title("Notes").Value(&values.Notes),
-		).Title("Context"),
-	)
-	m.activateForm(form, values)
+	groups := m.incidentFormGroups(values, appOptions, vendorOptions)
+	m.activateForm(m.recordForm(formIncident, groups, false), values)
+}
+
+// incidentFormGroups lays out the incident form's fields.
+func (m *Model) incidentFormGroups(
+	values *incidentFormData,
+	appOptions []huh.Option[string],
+	vendorOptions []huh.Option[string],
+) []formGroup {
+	return []formGroup{
+		{title: "Details", fields: []formField{
+			{key: "title", essential: true, needed: true, build: func(bool) huh.Field {
+				return huh.NewInput().
+					Title(requiredTitle("Title")).
+					Value(&values.Title).
+					Validate(requiredText("title"))
+			}},
+			selectField("status", "Status", incidentStatusOptions(), &values.Status),
+			essential(selectField("severity", "Severity", incidentSeverityOptions(), &values.Severity)),
+			{key: "date_noticed", essential: true, needed: true, build: func(bool) huh.Field {
+				return huh.NewInput().
+					Title(requiredTitle("Date noticed") + " (YYYY-MM-DD)").
+					Value(&values.DateNoticed).
+					Validate(requiredDate("date noticed"))
+			}},
+			dateField("date_resolved", "Date resolved", &values.DateResolved),
+			{key: "location", essential: true, build: func(required bool) huh.Field {
+				return huh.NewInput().
+					Title(fieldTitle("Location", required)).
+					Placeholder("Kitchen").
+					Value(&values.Location).
+					Validate(fieldCheck("location", required, nil))
+			}},
+		}},
+		{title: "Context", fields: []formField{
+			essential(m.linkedSelectField(
+				"appliance", "Appliance", m.applianceLink(), appOptions, &values.ApplianceID,
+			)),
+			essential(m.linkedSelectField(
+				"vendor", "Vendor", m.vendorLink("(none)"), vendorOptions, &values.VendorID,
+			)),
+			{key: "cost", build: func(required bool) huh.Field {
+				return huh.NewInput().
+					Title(fieldTitle("Cost", required)).
+					Placeholder("250.00").
+					Value(&values.Cost).
+					Validate(fieldCheck("cost", required, optionalMoney("cost", m.cur)))
+			}},
+			textField("description", "Description", &values.Description),
+			textField("notes", "Notes", &values.Notes),
+		}},
+	}
 }
 
 func (m *Model) submitIncidentForm() error {
@@ -724,7 +693,7 @@ var incidentInlineSpecs = map[int]inlineColSpec{
 	int(incidentColTitle): {
 		kind: ieText, title: "Title",
 		fieldPtr: func(d formData) *string { return &mustAssert[*incidentFormData](d).Title },
-		validate: func(*Model) func(string) error { return requiredText("title") },
+		validate: func(*Model, formData) func(string) error { return requiredText("title") },
 	},
 	int(incidentColStatus): {
 		kind: ieSelect, title: "Status",
@@ -773,7 +742,7 @@ var incidentInlineSpecs = map[int]inlineColSpec{
 	int(incidentColCost): {
 		kind: ieMoney, title: "Cost", placeholder: "250.00",
 		fieldPtr: func(d formData) *string { return &mustAssert[*incidentFormData](d).Cost },
-		validate: func(m *Model) func(string) error { return optionalMoney("cost", m.cur) },
+		validate: func(m *Model, _ formData) func(string) error { return optionalMoney("cost", m.cur) },
 	},
 }
 
@@ -866,6 +835,30 @@ func seasonOptions() []huh.Option[string] {
 	})
 }
 
+// monthOptions lists the calendar months for tagging calendar-driven
+// maintenance ("open the pool in May"), with "(none)" first.
+func monthOptions() []huh.Option[string] {
+	opts := make([]huh.Option[string], 0, 13)
+	opts = append(opts, huh.NewOption("(none)", ""))
+	for m := time.January; m <= time.December; m++ {
+		opts = append(opts, huh.NewOption(m.String(), strconv.Itoa(int(m))))
+	}
+	return opts
+}
+
+// monthFormValue converts a stored month tag to its select value.
+func monthFormValue(month int) string {
+	if month < 1 || month > 12 {
+		return ""
+	}
+	return strconv.Itoa(month)
+}
+
+// shortMonthName returns the three-letter name of a 1-12 month.
+func shortMonthName(month int) string {
+	return time.Month(month).String()[:3]
+}
+
 // optionalVendorOptions is like vendorOptions but with "(none)" instead of "Self".
 // labelWithDetail returns "name (detail)" when detail is non-empty,
 // otherwise just "name".
@@ -889,16 +882,7 @@ func vendorOpts(noneLabel string, vendors []data.Vendor) []huh.Option[string] {
 
 func (m *Model) startApplianceForm() {
 	values := &applianceFormData{}
-	form := huh.NewForm(
-		huh.NewGroup(
-			huh.NewInput().
-				Title(requiredTitle("Name")).
-				Placeholder("Kitchen Refrigerator").
-				Value(&values.Name).
-				Validate(requiredText("name")),
-		),
-	)
-	m.activateForm(form, values)
+	m.activateForm(m.recordForm(formAppliance, m.applianceFormGroups(values), true), values)
 }
 
 func (m *Model) startEditApplianceForm(id string) error {
@@ -913,36 +897,49 @@ func (m *Model) startEditApplianceForm(id string) error {
 }
 
 func (m *Model) openApplianceForm(values *applianceFormData) {
-	form := huh.NewForm(
-		huh.NewGroup(
-			huh.NewInput().
-				Title(requiredTitle("Name")).
-				Placeholder("Kitchen Refrigerator").
-				Value(&values.Name).
-				Validate(requiredText("name")),
-			huh.NewInput().Title("Brand").Value(&values.Brand),
-			huh.NewInput().Title("Model number").Value(&values.ModelNumber),
-			huh.NewInput().Title("Serial number").Value(&values.SerialNumber),
-			huh.NewInput().Title("Location").Placeholder("Kitchen").Value(&values.Location),
-		).Title("Identity"),
-		huh.NewGroup(
-			huh.NewInput().
-				Title("Purchase date (YYYY-MM-DD)").
-				Value(&values.PurchaseDate).
-				Validate(optionalDate("purchase date")),
-			huh.NewInput().
-				Title("Warranty expiry (YYYY-MM-DD)").
-				Value(&values.WarrantyExpiry).
-				Validate(optionalDate("warranty expiry")),
-			huh.NewInput().
-				Title("Cost").
-				Placeholder("899.00").
-				Value(&values.Cost).
-				Validate(optionalMoney("cost", m.cur)),
-			huh.NewText().Title("Notes").Value(&values.Notes),
-		).Title("Details"),
-	)
-	m.activateForm(form, values)
+	if values.Currency == "" {
+		values.Currency = m.cur.Code()
+	}
+	m.activateForm(m.recordForm(formAppliance, m.applianceFormGroups(values), false), values)
+}
+
+// applianceFormGroups lays out the appliance form's fields.
+func (m *Model) applianceFormGroups(values *applianceFormData) []formGroup {
+	return []formGroup{
+		{title: "Identity", fields: []formField{
+			{key: "name", essential: true, needed: true, build: func(bool) huh.Field {
+				return huh.NewInput().
+					Title(requiredTitle("Name")).
+					Placeholder("Kitchen Refrigerator").
+					Value(&values.Name).
+					Validate(requiredText("name"))
+			}},
+			inputField("brand", "Brand", &values.Brand, nil),
+			inputField("model_number", "Model number", &values.ModelNumber, nil),
+			inputField("serial_number", "Serial number", &values.SerialNumber, nil),
+			{key: "location", build: func(required bool) huh.Field {
+				return huh.NewInput().
+					Title(fieldTitle("Location", required)).
+					Placeholder("Kitchen").
+					Value(&values.Location).
+					Validate(fieldCheck("location", required, nil))
+			}},
+		}},
+		{title: "Details", fields: []formField{
+			dateField("purchase_date", "Purchase date", &values.PurchaseDate),
+			dateField("warranty_expiry", "Warranty expiry", &values.WarrantyExpiry),
+			{key: "cost", build: func(required bool) huh.Field {
+				return huh.NewInput().
+					Title(fieldTitle("Cost", required)).
+					Placeholder("899.00").
+					Value(&values.Cost).
+					Validate(fieldCheck("cost", required,
+						m.recordMoney("cost", &values.Currency)))
+			}},
+			inputField("currency", "Currency", &values.Currency, m.recordCurrencyValidator()),
+			textField("notes", "Notes", &values.Notes),
+		}},
+	}
 }
 
 func (m *Model) submitApplianceForm() error {
@@ -969,7 +966,11 @@ func (m *Model) parseApplianceFormData() (data.Appliance, error) {
 	if err != nil {
 		return data.Appliance{}, data.FieldError("Warranty Expiry", err)
 	}
-	cost, err := m.cur.ParseOptionalCents(values.Cost)
+	code, cur, err := m.parseRecordCurrency(values.Currency)
+	if err != nil {
+		return data.Appliance{}, data.FieldError("Currency", err)
+	}
+	cost, err := cur.ParseOptionalCents(values.Cost)
 	if err != nil {
 		return data.Appliance{}, data.FieldError("Cost", err)
 	}
@@ -982,22 +983,14 @@ func (m *Model) parseApplianceFormData() (data.Appliance, error) {
 		WarrantyExpiry: warrantyExpiry,
 		Location:       strings.TrimSpace(values.Location),
 		CostCents:      cost,
+		CostCurrency:   code,
 		Notes:          strings.TrimSpace(values.Notes),
 	}, nil
 }
 
 func (m *Model) startVendorForm() {
 	values := &vendorFormData{}
-	form := huh.NewForm(
-		huh.NewGroup(
-			huh.NewInput().
-				Title(requiredTitle("Name")).
-				Placeholder("Acme Plumbing").
-				Value(&values.Name).
-				Validate(requiredText("name")),
-		),
-	)
-	m.activateForm(form, values)
+	m.activateForm(m.recordForm(formVendor, m.vendorFormGroups(values), true), values)
 }
 
 func (m *Model) startEditVendorForm(id string) error {
@@ -1012,21 +1005,27 @@ func (m *Model) startEditVendorForm(id string) error {
 }
 
 func (m *Model) openVendorForm(values *vendorFormData) {
-	form := huh.NewForm(
-		huh.NewGroup(
-			huh.NewInput().
-				Title(requiredTitle("Name")).
-				Placeholder("Acme Plumbing").
-				Value(&values.Name).
-				Validate(requiredText("name")),
-			huh.NewInput().Title("Contact name").Value(&values.ContactName),
-			huh.NewInput().Title("Email").Value(&values.Email),
-			huh.NewInput().Title("Phone").Value(&values.Phone),
-			huh.NewInput().Title("Website").Value(&values.Website),
-			huh.NewText().Title("Notes").Value(&values.Notes),
-		),
-	)
-	m.activateForm(form, values)
+	m.activateForm(m.recordForm(formVendor, m.vendorFormGroups(values), false), values)
+}
+
+// vendorFormGroups lays out the vendor form's fields.
+func (m *Model) vendorFormGroups(values *vendorFormData) []formGroup {
+	return []formGroup{
+		{fields: []formField{
+			{key: "name", essential: true, needed: true, build: func(bool) huh.Field {
+				return huh.NewInput().
+					Title(requiredTitle("Name")).
+					Placeholder("Acme Plumbing").
+					Value(&values.Name).
+					Validate(requiredText("name"))
+			}},
+			inputField("contact_name", "Contact name", &values.ContactName, nil),
+			inputField("email", "Email", &values.Email, nil),
+			inputField("phone", "Phone", &values.Phone, nil),
+			inputField("website", "Website", &values.Website, nil),
+			textField("notes", "Notes", &values.Notes),
+		}},
+	}
 }
 
 func (m *Model) submitVendorForm() error {
@@ -1060,7 +1059,7 @@ var vendorInlineSpecs = map[int]inlineColSpec{
 	int(vendorColName): {
 		kind: ieText, title: "Name",
 		fieldPtr: func(d formData) *string { return &mustAssert[*vendorFormData](d).Name },
-		validate: func(*Model) func(string) error { return requiredText("name") },
+		validate: func(*Model, formData) func(string) error { return requiredText("name") },
 	},
 	int(vendorColContact): {
 		kind: ieText, title: "Contact name",
@@ -1119,7 +1118,7 @@ var projectInlineSpecs = map[int]inlineColSpec{
 	int(projectColTitle): {
 		kind: ieText, title: "Title",
 		fieldPtr: func(d formData) *string { return &mustAssert[*projectFormData](d).Title },
-		validate: func(*Model) func(string) error { return requiredText("title") },
+		validate: func(*Model, formData) func(string) error { return requiredText("title") },
 	},
 	int(projectColStatus): {
 		kind: ieSelect, title: "Status",
@@ -1131,12 +1130,16 @@ var projectInlineSpecs = map[int]inlineColSpec{
 	int(projectColBudget): {
 		kind: ieMoney, title: "Budget", placeholder: "1250.00",
 		fieldPtr: func(d formData) *string { return &mustAssert[*projectFormData](d).Budget },
-		validate: func(m *Model) func(string) error { return optionalMoney("budget", m.cur) },
+		validate: func(m *Model, d formData) func(string) error {
+			return m.recordMoney("budget", &mustAssert[*projectFormData](d).Currency)
+		},
 	},
 	int(projectColActual): {
 		kind: ieMoney, title: "Actual cost", placeholder: "1400.00",
 		fieldPtr: func(d formData) *string { return &mustAssert[*projectFormData](d).Actual },
-		validate: func(m *Model) func(string) error { return optionalMoney("actual cost", m.cur) },
+		validate: func(m *Model, d formData) func(string) error {
+			return m.recordMoney("actual cost", &mustAssert[*projectFormData](d).Currency)
+		},
 	},
 	int(projectColStart): {
 		kind:     ieDate,
@@ -1179,32 +1182,41 @@ var quoteInlineSpecs = map[int]inlineColSpec{
 	int(quoteColVendor): {
 		kind: ieText, title: "Vendor name",
 		fieldPtr: func(d formData) *string { return &mustAssert[*quoteFormData](d).VendorName },
-		validate: func(*Model) func(string) error { return requiredText("vendor name") },
+		validate: func(*Model, formData) func(string) error { return requiredText("vendor name") },
 	},
 	int(quoteColTotal): {
 		kind: ieMoney, title: "Total", placeholder: "3250.00",
 		fieldPtr: func(d formData) *string { return &mustAssert[*quoteFormData](d).Total },
-		validate: func(m *Model) func(string) error { return requiredMoney(m.cur) },
+		validate: func(m *Model, _ formData) func(string) error { return requiredMoney(m.cur) },
 	},
 	int(quoteColLabor): {
 		kind: ieMoney, title: "Labor", placeholder: "2000.00",
 		fieldPtr: func(d formData) *string { return &mustAssert[*quoteFormData](d).Labor },
-		validate: func(m *Model) func(string) error { return optionalMoney("labor", m.cur) },
+		validate: func(m *Model, _ formData) func(string) error { return optionalMoney("labor", m.cur) },
 	},
 	int(quoteColMat): {
 		kind: ieMoney, title: "Materials", placeholder: "1000.00",
 		fieldPtr: func(d formData) *string { return &mustAssert[*quoteFormData](d).Materials },
-		validate: func(m *Model) func(string) error { return optionalMoney("materials", m.cur) },
+		validate: func(m *Model, _ formData) func(string) error { return optionalMoney("materials", m.cur) },
 	},
 	int(quoteColOther): {
 		kind: ieMoney, title: "Other", placeholder: "250.00",
 		fieldPtr: func(d formData) *string { return &mustAssert[*quoteFormData](d).Other },
-		validate: func(m *Model) func(string) error { return optionalMoney("other costs", m.cur) },
+		validate: func(m *Model, _ formData) func(string) error { return optionalMoney("other costs", m.cur) },
+	},
+	int(quoteColTax): {
+		kind: ieMoney, title: "Tax", placeholder: "260.00",
+		fieldPtr: func(d formData) *string { return &mustAssert[*quoteFormData](d).Tax },
+		validate: func(m *Model, _ formData) func(string) error { return optionalMoney("tax", m.cur) },
 	},
 	int(quoteColRecv): {
 		kind:     ieDate,
 		fieldPtr: func(d formData) *string { return &mustAssert[*quoteFormData](d).ReceivedDate },
 	},
+	int(quoteColAccepted): {
+		kind:     ieDate,
+		fieldPtr: func(d formData) *string { return &mustAssert[*quoteFormData](d).AcceptedDate },
+	},
 }
 
 func (m *Model) inlineEditQuote(id string, col quoteCol) error {
@@ -1227,7 +1239,7 @@ var maintenanceInlineSpecs = map[int]inlineColSpec{
 	int(maintenanceColItem): {
 		kind: ieText, title: "Item",
 		fieldPtr: func(d formData) *string { return &mustAssert[*maintenanceFormData](d).Name },
-		validate: func(*Model) func(string) error { return requiredText("item") },
+		validate: func(*Model, formData) func(string) error { return requiredText("item") },
 	},
 	int(maintenanceColCategory): {
 		kind: ieSelect, title: "Category",
@@ -1243,6 +1255,13 @@ var maintenanceInlineSpecs = map[int]inlineColSpec{
 			return seasonOptions(), nil
 		},
 	},
+	int(maintenanceColMonth): {
+		kind: ieSelect, title: "Month",
+		fieldPtr: func(d formData) *string { return &mustAssert[*maintenanceFormData](d).Month },
+		selectOptions: func(*Model) ([]huh.Option[string], error) {
+			return monthOptions(), nil
+		},
+	},
 	int(maintenanceColAppliance): {
 		kind: ieSelect, title: "Appliance",
 		fieldPtr: func(d formData) *string { return &mustAssert[*maintenanceFormData](d).ApplianceID },
@@ -1261,7 +1280,7 @@ var maintenanceInlineSpecs = map[int]inlineColSpec{
 	int(maintenanceColEvery): {
 		kind: ieText, title: "Interval", placeholder: "6m",
 		fieldPtr: func(d formData) *string { return &mustAssert[*maintenanceFormData](d).IntervalMonths },
-		validate: func(*Model) func(string) error { return optionalInterval() },
+		validate: func(m *Model, _ formData) func(string) error { return m.optionalScheduleInterval() },
 		beforeEdit: func(d formData) {
 			v := mustAssert[*maintenanceFormData](d)
 			v.ScheduleType = schedInterval
@@ -1299,7 +1318,7 @@ var applianceInlineSpecs = map[int]inlineColSpec{
 	int(applianceColName): {
 		kind: ieText, title: "Name",
 		fieldPtr: func(d formData) *string { return &mustAssert[*applianceFormData](d).Name },
-		validate: func(*Model) func(string) error { return requiredText("name") },
+		validate: func(*Model, formData) func(string) error { return requiredText("name") },
 	},
 	int(applianceColBrand): {
 		kind: ieText, title: "Brand",
@@ -1328,7 +1347,9 @@ var applianceInlineSpecs = map[int]inlineColSpec{
 	int(applianceColCost): {
 		kind: ieMoney, title: "Cost", placeholder: "899.00",
 		fieldPtr: func(d formData) *string { return &mustAssert[*applianceFormData](d).Cost },
-		validate: func(m *Model) func(string) error { return optionalMoney("cost", m.cur) },
+		validate: func(m *Model, d formData) func(string) error {
+			return m.recordMoney("cost", &mustAssert[*applianceFormData](d).Currency)
+		},
 	},
 }
 
@@ -1351,6 +1372,7 @@ func (m *Model) inlineEditAppliance(id string, col applianceCol) error {
 func (m *Model) startServiceLogForm(maintenanceItemID string) error {
 	values := &serviceLogFormData{
 		MaintenanceItemID: maintenanceItemID,
+		VendorID:          m.defaultPerformerID(),
 	}
 	data.ApplyDefaults(values)
 	vendorOpts := vendorOpts("Self (homeowner)", m.vendors)
@@ -1360,16 +1382,31 @@ func (m *Model) startServiceLogForm(maintenanceItemID string) error {
 				Title(requiredTitle("Date serviced")+" (YYYY-MM-DD)").
 				Value(&values.ServicedAt).
 				Validate(requiredDate("date serviced")),
-			huh.NewSelect[string]().
-				Title("Performed by").
-				Options(vendorOpts...).
-				Value(&values.VendorID),
+			m.linkSelect(
+				huh.NewSelect[string]().Title("Performed by"),
+				m.vendorLink("Self (homeowner)"), vendorOpts, &values.VendorID,
+			),
 		),
 	)
 	m.activateForm(form, values)
 	return nil
 }
 
+// defaultPerformerID returns the ID of the vendor named by the configured
+// default performer, or "" (self) when none is set or no vendor matches.
+func (m *Model) defaultPerformerID() string {
+	name := strings.TrimSpace(m.defaultPerformer)
+	if name == "" {
+		return ""
+	}
+	for _, v := range m.vendors {
+		if strings.EqualFold(v.Name, name) {
+			return v.ID
+		}
+	}
+	return ""
+}
+
 func (m *Model) startEditServiceLogForm(id string) error {
 	entry, err := m.store.GetServiceLog(id)
 	if err != nil {
@@ -1382,6 +1419,28 @@ func (m *Model) startEditServiceLogForm(id string) error {
 	return nil
 }
 
+// startCloneServiceLogForm opens an add form prefilled from the most
+// recent entry in the open service log, dated today, so a repeat service
+// like a monthly filter change only needs a save.
+func (m *Model) startCloneServiceLogForm() error {
+	h, ok := m.effectiveTab().Handler.(serviceLogHandler)
+	if !ok {
+		return errors.New("not in a service log")
+	}
+	entries, err := m.store.ListServiceLog(h.maintenanceItemID, false)
+	if err != nil {
+		return fmt.Errorf("load service log: %w", err)
+	}
+	if len(entries) == 0 {
+		return errors.New("no service logged yet -- press a to add one")
+	}
+	values := serviceLogFormValues(entries[0], m.cur)
+	values.ServicedAt = ""
+	data.ApplyDefaults(values)
+	m.openServiceLogForm(values, vendorOpts("Self (homeowner)", m.vendors))
+	return nil
+}
+
 func (m *Model) openServiceLogForm(
 	values *serviceLogFormData,
 	vendorOpts []huh.Option[string],
@@ -1392,10 +1451,10 @@ func (m *Model) openServiceLogForm(
 				Title(requiredTitle("Date serviced")+" (YYYY-MM-DD)").
 				Value(&values.ServicedAt).
 				Validate(requiredDate("date serviced")),
-			huh.NewSelect[string]().
-				Title("Performed by").
-				Options(vendorOpts...).
-				Value(&values.VendorID),
+			m.linkSelect(
+				huh.NewSelect[string]().Title("Performed by"),
+				m.vendorLink("Self (homeowner)"), vendorOpts, &values.VendorID,
+			),
 			huh.NewInput().
 				Title("Cost").
 				Placeholder("125.00").
@@ -1465,7 +1524,7 @@ var serviceLogInlineSpecs = map[int]inlineColSpec{
 	int(serviceLogColCost): {
 		kind: ieMoney, title: "Cost", placeholder: "125.00",
 		fieldPtr: func(d formData) *string { return &mustAssert[*serviceLogFormData](d).Cost },
-		validate: func(m *Model) func(string) error { return optionalMoney("cost", m.cur) },
+		validate: func(m *Model, _ formData) func(string) error { return optionalMoney("cost", m.cur) },
 	},
 	int(serviceLogColNotes): {
 		kind:     ieNotes,
@@ -1634,7 +1693,7 @@ func (m *Model) openDatePicker(
 	savedKind := values.formKind()
 	m.openCalendar(dateField, func() {
 		if err := m.handleFormSubmit(); err != nil {
-			m.setStatusError(err.Error())
+			m.setStatusError(humanizeError(err))
 		} else {
 			m.setStatusSaved()
 			m.reloadAfterFormSave(savedKind)
@@ -1817,6 +1876,12 @@ func (m *Model) handleFormSubmit() error {
 	if handler == nil {
 		return fmt.Errorf("no handler for form kind %v", kind)
 	}
+	if err := m.checkRequiredFields(); err != nil {
+		return err
+	}
+	if err := m.fs.pendingNewRecord(); err != nil {
+		return err
+	}
 	return handler.SubmitForm(m)
 }
 
@@ -1849,7 +1914,7 @@ func (m *Model) saveHouseFormData(values *houseFormData) error {
 	if err != nil {
 		return data.FieldError("Bedrooms", err)
 	}
-	bathrooms, err := data.ParseOptionalFloat(values.Bathrooms)
+	bathrooms, err := data.ParseOptionalFloatStep(values.Bathrooms, data.BathroomStep)
 	if err != nil {
 		return data.FieldError("Bathrooms", err)
 	}
@@ -1913,10 +1978,22 @@ func (m *Model) submitProjectForm() error {
 	if err != nil {
 		return err
 	}
-	return m.createOrUpdate(&project.ID,
+	var prevStatus string
+	if m.fs.editID != nil {
+		prev, err := m.store.GetProject(*m.fs.editID)
+		if err != nil {
+			return fmt.Errorf("load project: %w", err)
+		}
+		prevStatus = prev.Status
+	}
+	if err := m.createOrUpdate(&project.ID,
 		func() error { return m.store.CreateProject(&project) },
 		func() error { return m.store.UpdateProject(project) },
-	)
+	); err != nil {
+		return err
+	}
+	m.noteProjectCompletion(project, prevStatus)
+	return nil
 }
 
 func (m *Model) parseProjectFormData() (data.Project, error) {
@@ -1924,11 +2001,15 @@ func (m *Model) parseProjectFormData() (data.Project, error) {
 	if err != nil {
 		return data.Project{}, err
 	}
-	budget, err := m.cur.ParseOptionalCents(values.Budget)
+	code, cur, err := m.parseRecordCurrency(values.Currency)
+	if err != nil {
+		return data.Project{}, data.FieldError("Currency", err)
+	}
+	budget, err := cur.ParseOptionalCents(values.Budget)
 	if err != nil {
 		return data.Project{}, data.FieldError("Budget", err)
 	}
-	actual, err := m.cur.ParseOptionalCents(values.Actual)
+	actual, err := cur.ParseOptionalCents(values.Actual)
 	if err != nil {
 		return data.Project{}, data.FieldError("Actual", err)
 	}
@@ -1940,15 +2021,21 @@ func (m *Model) parseProjectFormData() (data.Project, error) {
 	if err != nil {
 		return data.Project{}, data.FieldError("End Date", err)
 	}
+	recurrence, err := data.ParseIntervalMonths(values.Recurrence)
+	if err != nil {
+		return data.Project{}, data.FieldError("Repeats every", err)
+	}
 	return data.Project{
-		Title:         strings.TrimSpace(values.Title),
-		ProjectTypeID: values.ProjectTypeID,
-		Status:        values.Status,
-		Description:   strings.TrimSpace(values.Description),
-		StartDate:     startDate,
-		EndDate:       endDate,
-		BudgetCents:   budget,
-		ActualCents:   actual,
+		Title:            strings.TrimSpace(values.Title),
+		ProjectTypeID:    values.ProjectTypeID,
+		Status:           values.Status,
+		Description:      strings.TrimSpace(values.Description),
+		StartDate:        startDate,
+		EndDate:          endDate,
+		BudgetCents:      budget,
+		ActualCents:      actual,
+		BudgetCurrency:   code,
+		RecurrenceMonths: recurrence,
 	}, nil
 }
 
@@ -1957,10 +2044,20 @@ func (m *Model) submitQuoteForm() error {
 	if err != nil {
 		return err
 	}
-	return m.createOrUpdate(&quote.ID,
+	if err := m.createOrUpdate(&quote.ID,
 		func() error { return m.store.CreateQuote(&quote, vendor) },
 		func() error { return m.store.UpdateQuote(quote, vendor) },
-	)
+	); err != nil {
+		return err
+	}
+	if quote.BreakdownMismatch() {
+		sum, _ := quote.BreakdownCents()
+		m.saveWarning = fmt.Sprintf(
+			"Line items add up to %s but the total is %s.",
+			m.cur.FormatCents(sum), m.cur.FormatCents(quote.TotalCents),
+		)
+	}
+	return nil
 }
 
 func (m *Model) parseQuoteFormData() (data.Quote, data.Vendor, error) {
@@ -1984,17 +2081,27 @@ func (m *Model) parseQuoteFormData() (data.Quote, data.Vendor, error) {
 	if err != nil {
 		return data.Quote{}, data.Vendor{}, data.FieldError("Other", err)
 	}
+	tax, err := m.cur.ParseOptionalCents(values.Tax)
+	if err != nil {
+		return data.Quote{}, data.Vendor{}, data.FieldError("Tax", err)
+	}
 	received, err := data.ParseOptionalDate(values.ReceivedDate)
 	if err != nil {
 		return data.Quote{}, data.Vendor{}, data.FieldError("Received Date", err)
 	}
+	accepted, err := data.ParseOptionalDate(values.AcceptedDate)
+	if err != nil {
+		return data.Quote{}, data.Vendor{}, data.FieldError("Accepted Date", err)
+	}
 	quote := data.Quote{
 		ProjectID:      values.ProjectID,
 		TotalCents:     total,
 		LaborCents:     labor,
 		MaterialsCents: materials,
 		OtherCents:     other,
+		TaxCents:       tax,
 		ReceivedDate:   received,
+		AcceptedDate:   accepted,
 		Notes:          strings.TrimSpace(values.Notes),
 	}
 	vendor := data.Vendor{
@@ -2031,13 +2138,13 @@ func (m *Model) parseMaintenanceFormData() (data.MaintenanceItem, error) {
 
 	// The schedule type selector enforces mutual exclusion at the UI level:
 	// only the field matching the selected type is parsed.
-	var interval int
+	var interval data.Interval
 	var dueDate *time.Time
 
 	switch values.ScheduleType {
 	case schedNone:
 	case schedInterval:
-		interval, err = data.ParseIntervalMonths(values.IntervalMonths)
+		interval, err = data.ParseInterval(values.IntervalMonths, m.intervalUnit)
 		if err != nil {
 			return data.MaintenanceItem{}, data.FieldError("Interval", err)
 		}
@@ -2052,6 +2159,15 @@ func (m *Model) parseMaintenanceFormData() (data.MaintenanceItem, error) {
 	if err != nil {
 		return data.MaintenanceItem{}, data.FieldError("Cost", err)
 	}
+	var month int
+	if values.Month != "" {
+		month, err = strconv.Atoi(values.Month)
+		if err != nil || month < 1 || month > 12 {
+			return data.MaintenanceItem{}, data.FieldError(
+				"Month", fmt.Errorf("should be 1-12, got %q", values.Month),
+			)
+		}
+	}
 	var appID *string
 	if values.ApplianceID != "" {
 		appID = &values.ApplianceID
@@ -2061,8 +2177,10 @@ func (m *Model) parseMaintenanceFormData() (data.MaintenanceItem, error) {
 		CategoryID:     values.CategoryID,
 		ApplianceID:    appID,
 		Season:         values.Season,
+		Month:          month,
 		LastServicedAt: lastServiced,
-		IntervalMonths: interval,
+		IntervalMonths: interval.Months,
+		IntervalDays:   interval.Days,
 		DueDate:        dueDate,
 		ManualURL:      strings.TrimSpace(values.ManualURL),
 		ManualText:     strings.TrimSpace(values.ManualText),
@@ -2150,10 +2268,20 @@ func optionalInterval() func(string) error {
 	return validateWith("interval", data.ParseIntervalMonths)
 }
 
-func optionalFloat(
-	label string, //nolint:unparam // signature matches optionalInt for consistency
-) func(string) error {
-	return validateWith(label, data.ParseOptionalFloat)
+// optionalScheduleInterval validates a maintenance interval, reading a
+// bare number in the configured unit.
+func (m *Model) optionalScheduleInterval() func(string) error {
+	return validateWith("interval", func(s string) (data.Interval, error) {
+		return data.ParseInterval(s, m.intervalUnit)
+	})
+}
+
+// optionalFloat validates a non-negative decimal that must land on a
+// multiple of step; a zero step allows any precision.
+func optionalFloat(label string, step float64) func(string) error {
+	return validateWith(label, func(s string) (float64, error) {
+		return data.ParseOptionalFloatStep(s, step)
+	})
 }
 
 // endDateAfterStart validates that end date is a valid optional date and,
@@ -2191,19 +2319,50 @@ func optionalMoney(label string, cur locale.Currency) func(string) error {
 	return validateWith(label, cur.ParseOptionalCents)
 }
 
+// recordCurrencyValidator checks a currency code typed into a form. Blank
+// means the house currency.
+func (m *Model) recordCurrencyValidator() func(string) error {
+	return validateWith("currency", m.cur.WithCode)
+}
+
+// recordMoney validates an amount in the currency named by *code, which the
+// same form edits; an unknown code falls back to the house currency.
+func (m *Model) recordMoney(label string, code *string) func(string) error {
+	return func(input string) error {
+		return optionalMoney(label, recordCurrency(m.cur, *code))(input)
+	}
+}
+
+// parseRecordCurrency resolves a currency code typed into a form, returning
+// the code to store -- empty for the house currency, so the record follows
+// it -- and the currency the record's amounts are parsed in.
+func (m *Model) parseRecordCurrency(input string) (string, locale.Currency, error) {
+	cur, err := m.cur.WithCode(input)
+	if err != nil {
+		return "", locale.Currency{}, err
+	}
+	if cur.Code() == m.cur.Code() {
+		return "", cur, nil
+	}
+	return cur.Code(), cur, nil
+}
+
 func requiredMoney(cur locale.Currency) func(string) error {
 	return validateWith("total", cur.ParseRequiredCents)
 }
 
 func projectFormValues(project data.Project, cur locale.Currency) *projectFormData {
+	cur = recordCurrency(cur, project.BudgetCurrency)
 	return &projectFormData{
 		Title:         project.Title,
 		ProjectTypeID: project.ProjectTypeID,
 		Status:        project.Status,
 		Budget:        cur.FormatOptionalCents(project.BudgetCents),
 		Actual:        cur.FormatOptionalCents(project.ActualCents),
+		Currency:      cur.Code(),
 		StartDate:     data.FormatDate(project.StartDate),
 		EndDate:       data.FormatDate(project.EndDate),
+		Recurrence:    formatInterval(project.RecurrenceMonths),
 		Description:   project.Description,
 	}
 }
@@ -2221,7 +2380,9 @@ func quoteFormValues(quote data.Quote, cur locale.Currency) *quoteFormData {
 		Labor:        cur.FormatOptionalCents(quote.LaborCents),
 		Materials:    cur.FormatOptionalCents(quote.MaterialsCents),
 		Other:        cur.FormatOptionalCents(quote.OtherCents),
+		Tax:          cur.FormatOptionalCents(quote.TaxCents),
 		ReceivedDate: data.FormatDate(quote.ReceivedDate),
+		AcceptedDate: data.FormatDate(quote.AcceptedDate),
 		Notes:        quote.Notes,
 	}
 }
@@ -2233,7 +2394,7 @@ func maintenanceFormValues(item data.MaintenanceItem, cur locale.Currency) *main
 	}
 	sched := schedNone
 	switch {
-	case item.IntervalMonths > 0:
+	case !item.Interval().IsZero():
 		sched = schedInterval
 	case item.DueDate != nil:
 		sched = schedDueDate
@@ -2243,9 +2404,10 @@ func maintenanceFormValues(item data.MaintenanceItem, cur locale.Currency) *main
 		CategoryID:     item.CategoryID,
 		ApplianceID:    appID,
 		Season:         item.Season,
+		Month:          monthFormValue(item.Month),
 		ScheduleType:   sched,
 		LastServiced:   data.FormatDate(item.LastServicedAt),
-		IntervalMonths: formatInterval(item.IntervalMonths),
+		IntervalMonths: item.Interval().String(),
 		DueDate:        data.FormatDate(item.DueDate),
 		ManualURL:      item.ManualURL,
 		ManualText:     item.ManualText,
@@ -2255,6 +2417,7 @@ func maintenanceFormValues(item data.MaintenanceItem, cur locale.Currency) *main
 }
 
 func applianceFormValues(item data.Appliance, cur locale.Currency) *applianceFormData {
+	cur = recordCurrency(cur, item.CostCurrency)
 	return &applianceFormData{
 		Name:           item.Name,
 		Brand:          item.Brand,
@@ -2264,6 +2427,7 @@ func applianceFormValues(item data.Appliance, cur locale.Currency) *applianceFor
 		WarrantyExpiry: data.FormatDate(item.WarrantyExpiry),
 		Location:       item.Location,
 		Cost:           cur.FormatOptionalCents(item.CostCents),
+		Currency:       cur.Code(),
 		Notes:          item.Notes,
 	}
 }
@@ -2392,11 +2556,61 @@ func (m *Model) startDocumentForm(entityKind string) error {
 	return nil
 }
 
+// documentEntityKinds maps the forms of linkable entities to the document
+// entity kind used when a document is attached to one of their rows.
+var documentEntityKinds = map[FormKind]string{
+	formProject:     data.DocumentEntityProject,
+	formQuote:       data.DocumentEntityQuote,
+	formMaintenance: data.DocumentEntityMaintenance,
+	formAppliance:   data.DocumentEntityAppliance,
+	formIncident:    data.DocumentEntityIncident,
+	formServiceLog:  data.DocumentEntityServiceLog,
+	formVendor:      data.DocumentEntityVendor,
+}
+
+// importTarget returns the entity a document imported right now should be
+// linked to: the parent of an entity-scoped document list, or, when
+// auto-link is enabled, the selected row of an entity tab.
+func (m *Model) importTarget() (entityRef, bool) {
+	tab := m.effectiveTab()
+	if tab == nil || tab.Handler == nil {
+		return entityRef{}, false
+	}
+	if sh, ok := tab.Handler.(scopedHandler); ok && sh.docEntity.Kind != "" {
+		return sh.docEntity, true
+	}
+	if !m.autoLinkDocuments {
+		return entityRef{}, false
+	}
+	kind, ok := documentEntityKinds[tab.Handler.FormKind()]
+	if !ok {
+		return entityRef{}, false
+	}
+	meta, ok := m.selectedRowMeta()
+	if !ok || meta.Deleted {
+		return entityRef{}, false
+	}
+	return entityRef{Kind: kind, ID: meta.ID}, true
+}
+
+// canImportDocument reports whether the quick-add and paste-image imports
+// are available: on document lists, and on entity rows they would link to.
+func (m *Model) canImportDocument() bool {
+	if m.effectiveTab().isDocumentTab() {
+		return true
+	}
+	_, ok := m.importTarget()
+	return ok
+}
+
 // startQuickDocumentForm opens a minimal document form that only asks for a
 // file path. Title and notes are auto-filled by the extraction pipeline on
 // submit, making this the fast path for ingesting files.
 func (m *Model) startQuickDocumentForm() {
 	values := &documentFormData{DeferCreate: true}
+	if target, ok := m.importTarget(); ok {
+		values.EntityRef = target
+	}
 	form := huh.NewForm(
 		huh.NewGroup(
 			m.newDocumentFilePicker("File to attach").
@@ -2584,7 +2798,7 @@ func (m *Model) parseDocumentFormData() (documentParseResult, error) {
 
 		// Title defaults to filename; LLM may improve it asynchronously.
 		if doc.Title == "" {
-			doc.Title = data.TitleFromFilename(doc.FileName)
+			doc.Title = m.docTitles.Title(doc.FileName)
 		}
 
 		return documentParseResult{
@@ -2611,7 +2825,7 @@ var documentInlineSpecs = map[int]inlineColSpec{
 	int(documentColTitle): {
 		kind: ieText, title: "Title",
 		fieldPtr: func(d formData) *string { return &mustAssert[*documentFormData](d).Title },
-		validate: func(*Model) func(string) error { return requiredText("title") },
+		validate: func(*Model, formData) func(string) error { return requiredText("title") },
 	},
 	int(documentColNotes): {
 		kind:     ieNotes,
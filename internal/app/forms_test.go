@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -122,3 +123,28 @@ func TestOptionalFilePathExpandsTilde(t *testing.T) {
 	assert.NoError(t, validate(""))
 	assert.Error(t, validate("~/nonexistent-file-abc123"))
 }
+
+func TestDocumentImportAppliesTitleRules(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	m.docTitles = data.TitleRules{
+		Rewrites: []data.TitleRewrite{{
+			Pattern: regexp.MustCompile(`^INV_(.+?)_(.+)$`),
+			Replace: "${2} inv ${1}",
+		}},
+		Dates: data.TitleDatesShort,
+		Words: map[string]string{"inv": "Invoice"},
+	}
+	path := filepath.Join(t.TempDir(), "INV_2025-01_garcia.txt")
+	require.NoError(t, os.WriteFile(path, []byte("invoice"), 0o600))
+
+	m.fs.formData = &documentFormData{FilePath: path}
+	result, err := m.parseDocumentFormData()
+	require.NoError(t, err)
+	assert.Equal(t, "Garcia Invoice Jan 2025", result.Doc.Title)
+
+	m.fs.formData = &documentFormData{Title: "Typed", FilePath: path}
+	result, err = m.parseDocumentFormData()
+	require.NoError(t, err)
+	assert.Equal(t, "Typed", result.Doc.Title, "a typed title wins over the rules")
+}
@@ -4,6 +4,7 @@
 package app
 
 import (
+	"slices"
 	"strings"
 	"unicode"
 
@@ -65,6 +66,118 @@ func fuzzyMatch(query, target string) (int, []int) {
 	return score, positions
 }
 
+// fuzzyTypoMatch is fuzzyMatch made forgiving for free-text search. A
+// subsequence match counts only when it is compact -- spanning at most
+// twice the query length -- so "ktchen" finds "Kitchen Remodel" but
+// scattered letters don't match every long title. Failing that, each query
+// word may match the start of a target word within a small edit distance,
+// which catches transpositions like "kithcen" that no subsequence covers.
+// Returns 0 when neither applies.
+func fuzzyTypoMatch(query, target string) (int, []int) {
+	if score, pos := fuzzyMatch(query, target); score > 0 && len(pos) > 0 &&
+		pos[len(pos)-1]-pos[0] < 2*len(pos) {
+		return score, pos
+	}
+	return fuzzyWordMatch(query, target)
+}
+
+// fuzzyWordMatch matches every query word against the start of some target
+// word, allowing typos in proportion to the word's length. Scores stay
+// below a clean subsequence match of the same query.
+func fuzzyWordMatch(query, target string) (int, []int) {
+	notWord := func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) }
+	qWords := strings.FieldsFunc(strings.ToLower(query), notWord)
+	if len(qWords) == 0 {
+		return 0, nil
+	}
+
+	// Target words with their rune offsets, for highlighting.
+	type word struct {
+		runes []rune
+		start int
+	}
+	var tWords []word
+	tRunes := []rune(strings.ToLower(target))
+	for i := 0; i < len(tRunes); {
+		if notWord(tRunes[i]) {
+			i++
+			continue
+		}
+		j := i
+		for j < len(tRunes) && !notWord(tRunes[j]) {
+			j++
+		}
+		tWords = append(tWords, word{runes: tRunes[i:j], start: i})
+		i = j
+	}
+
+	score := 0
+	var positions []int
+	for _, qw := range qWords {
+		q := []rune(qw)
+		allowed := typoBudget(len(q))
+		bestDist, bestLen, bestWord := allowed+1, 0, -1
+		for wi, tw := range tWords {
+			// Compare against target prefixes one rune shorter to one
+			// longer than the query word, so a dropped or doubled letter
+			// still lines up.
+			for n := max(len(q)-1, 1); n <= min(len(q)+1, len(tw.runes)); n++ {
+				if d := osaDistance(q, tw.runes[:n]); d < bestDist {
+					bestDist, bestLen, bestWord = d, n, wi
+				}
+			}
+		}
+		if bestWord < 0 {
+			return 0, nil
+		}
+		score += 8*len(q) - 15*bestDist
+		for k := range bestLen {
+			positions = append(positions, tWords[bestWord].start+k)
+		}
+	}
+	slices.Sort(positions)
+	return max(score, 1), slices.Compact(positions)
+}
+
+// typoBudget returns the edit distance allowed for a query word of n runes.
+// Short words must match exactly; otherwise one typo in four letters.
+func typoBudget(n int) int {
+	switch {
+	case n < 4:
+		return 0
+	case n < 8:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// osaDistance returns the optimal string alignment distance between a and
+// b: Levenshtein distance plus transposition of adjacent runes.
+func osaDistance(a, b []rune) int {
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(b)]
+}
+
 // fuzzyScored is the interface for any match type that carries a score
 // and a tiebreaker index.
 type fuzzyScored interface {
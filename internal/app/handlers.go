@@ -144,7 +144,11 @@ func (projectHandler) Load(
 	ids := entityIDs(projects, func(p data.Project) string { return p.ID })
 	quoteCounts := fetchCounts(store.CountQuotesByProject, ids)
 	docCounts := fetchDocCounts(store, data.DocumentEntityProject, ids)
-	rows, meta, cellRows := projectRows(projects, quoteCounts, docCounts, store.Currency())
+	spent, err := store.SpentCentsByProject(ids)
+	if err != nil {
+		spent = map[string]int64{}
+	}
+	rows, meta, cellRows := projectRows(projects, quoteCounts, docCounts, spent, store.Currency())
 	return rows, meta, cellRows, nil
 }
 
@@ -227,7 +231,14 @@ func (maintenanceHandler) Load(
 	ids := entityIDs(items, func(item data.MaintenanceItem) string { return item.ID })
 	logCounts := fetchCounts(store.CountServiceLogs, ids)
 	docCounts := fetchDocCounts(store, data.DocumentEntityMaintenance, ids)
-	rows, meta, cellRows := maintenanceRows(items, logCounts, docCounts)
+	estimates, err := store.AverageServiceCostByItem(ids)
+	if err != nil {
+		estimates = map[string]int64{}
+	}
+	rows, meta, cellRows := maintenanceRows(items, logCounts, docCounts, estimates, store.Currency())
+	for i := range items {
+		meta[i].Archived = items[i].Appliance.ArchivedAt != nil
+	}
 	return rows, meta, cellRows, nil
 }
 
@@ -243,6 +254,11 @@ func (maintenanceHandler) SyncFixedValues(m *Model, specs []columnSpec) {
 		data.SeasonFall,
 		data.SeasonWinter,
 	})
+	monthNames := make([]string, 12)
+	for i := range monthNames {
+		monthNames[i] = shortMonthName(i + 1)
+	}
+	setFixedValues(specs, "Month", monthNames)
 }
 
 // ---------------------------------------------------------------------------
@@ -353,6 +369,7 @@ type scopedHandler struct {
 	inlineEditFn func(*Model, string, int) error // nil = TabHandler.InlineEdit
 	startAddFn   func(*Model) error              // nil = TabHandler.StartAddForm
 	submitFn     func(*Model) error              // nil = TabHandler.SubmitForm
+	docEntity    entityRef                       // parent entity of a scoped document list
 }
 
 func (s scopedHandler) Load(
@@ -411,10 +428,19 @@ func newApplianceMaintenanceHandler(applianceID string) scopedHandler {
 			ids := entityIDs(items, func(item data.MaintenanceItem) string { return item.ID })
 			logCounts := fetchCounts(store.CountServiceLogs, ids)
 			docCounts := fetchDocCounts(store, data.DocumentEntityMaintenance, ids)
-			rows, meta, cellRows := applianceMaintenanceRows(items, logCounts, docCounts)
+			estimates, err := store.AverageServiceCostByItem(ids)
+			if err != nil {
+				estimates = map[string]int64{}
+			}
+			rows, meta, cellRows := applianceMaintenanceRows(
+				items, logCounts, docCounts, estimates, store.Currency(),
+			)
 			return rows, meta, cellRows, nil
 		},
 		inlineEditFn: skipColEdit(parent, int(maintenanceColAppliance)), // skip Appliance column
+		startAddFn: func(m *Model) error {
+			return m.startApplianceMaintenanceForm(applianceID)
+		},
 	}
 }
 
@@ -630,6 +656,7 @@ func newEntityDocumentHandler(entityKind string, entityID string) scopedHandler
 		startAddFn: func(m *Model) error {
 			return m.startDocumentForm(entityKind)
 		},
+		docEntity: entityRef{Kind: entityKind, ID: entityID},
 		submitFn: func(m *Model) error {
 			return m.submitScopedDocumentForm(entityKind, entityID)
 		},
@@ -0,0 +1,85 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"testing"
+
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHomeKeyLeavesDetailForDashboard(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	cats, err := m.store.MaintenanceCategories()
+	require.NoError(t, err)
+	item := data.MaintenanceItem{Name: "Test Smoke Alarms", CategoryID: cats[0].ID}
+	require.NoError(t, m.store.CreateMaintenance(&item))
+	m.active = tabIndex(tabMaintenance)
+	require.NoError(t, m.reloadActiveTab())
+	require.NoError(t, m.openServiceLogDetail(item.ID, item.Name))
+	m.enterEditMode()
+	m.helpViewport = nil
+	m.showDashboard = false
+
+	sendKey(m, keyShiftH)
+	assert.False(t, m.inDetail())
+	assert.Equal(t, modeNormal, m.mode)
+	assert.True(t, m.showDashboard)
+}
+
+func TestHomeKeyClosesOverlays(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.showDashboard = false
+	sendKey(m, keyQuestion)
+	require.NotNil(t, m.helpViewport)
+
+	sendKey(m, keyShiftH)
+	assert.Nil(t, m.helpViewport)
+	assert.True(t, m.showDashboard)
+}
+
+func TestHomeKeyIgnoredInForms(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	m.showDashboard = false
+	openHouseForm(m)
+	require.Equal(t, modeForm, m.mode)
+
+	sendKey(m, keyShiftH)
+	assert.Equal(t, modeForm, m.mode, "the form stays open")
+	assert.False(t, m.showDashboard)
+}
+
+func TestHomeKeyTypesIntoChat(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.showDashboard = false
+	m.openChat()
+
+	sendKey(m, keyShiftH)
+	assert.True(t, m.chat.Visible)
+	assert.Equal(t, "H", m.chat.Input.Value())
+	assert.False(t, m.showDashboard)
+}
+
+func TestConfiguredHomeKeyClosesChat(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.keys.Home = homeKeyBinding("ctrl+g")
+	m.showDashboard = false
+	m.openChat()
+
+	sendKey(m, "ctrl+g")
+	assert.False(t, m.chat.Visible)
+	assert.NotNil(t, m.chat, "the chat session is kept")
+	assert.True(t, m.showDashboard)
+
+	m.showDashboard = false
+	sendKey(m, keyShiftH)
+	assert.False(t, m.showDashboard, "the default key no longer applies")
+}
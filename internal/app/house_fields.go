@@ -218,13 +218,13 @@ func houseFieldDefs() []houseFieldDef {
 					Title("Bathrooms").
 					Placeholder("2.5").
 					Value(v).
-					Validate(optionalFloat("bathrooms"))
+					Validate(optionalFloat("bathrooms", data.BathroomStep))
 			},
 			get: func(p data.HouseProfile, _ locale.Currency, _ data.UnitSystem) string {
 				return formatFloat(p.Bathrooms)
 			},
 			ptr:      func(fd *houseFormData) *string { return &fd.Bathrooms },
-			validate: optionalFloat("bathrooms"),
+			validate: optionalFloat("bathrooms", data.BathroomStep),
 		},
 		{
 			key: "foundation_type", label: "Fndtn", section: houseSectionStructure,
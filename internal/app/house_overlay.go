@@ -192,7 +192,7 @@ func (m *Model) houseOverlayStartEdit() tea.Cmd {
 		ptr := def.ptr(fd)
 		*ptr = def.toggle(*ptr)
 		if err := m.saveHouseFormData(fd); err != nil {
-			m.setStatusError(err.Error())
+			m.setStatusError(humanizeError(err))
 		}
 		return nil
 	}
@@ -236,13 +236,13 @@ func (m *Model) houseOverlaySubmitEdit() {
 
 	if def.validate != nil {
 		if err := def.validate(val); err != nil {
-			m.setStatusError(err.Error())
+			m.setStatusError(humanizeError(err))
 			return
 		}
 	}
 
 	if err := m.saveHouseFormData(s.formData); err != nil {
-		m.setStatusError(err.Error())
+		m.setStatusError(humanizeError(err))
 		return
 	}
 	s.editing = false
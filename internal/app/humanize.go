@@ -0,0 +1,89 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"errors"
+	"os/exec"
+	"regexp"
+
+	"github.com/micasa-dev/micasa/internal/data"
+)
+
+// uniqueTableRe pulls the table name out of SQLite's "UNIQUE constraint
+// failed: vendors.name" message.
+var uniqueTableRe = regexp.MustCompile(`constraint failed: ([a-z_]+)\.`)
+
+// humanizeError turns err into a status-bar message that says what went
+// wrong and what to do about it. Errors that already carry a hint keep it;
+// errors with no known recovery keep their own text.
+func humanizeError(err error) string {
+	if err == nil {
+		return ""
+	}
+	if hint := data.Hint(err); hint != "" {
+		return hint
+	}
+	var execErr *exec.Error
+	switch {
+	case errors.Is(err, data.ErrDBLocked):
+		return "Database busy (another micasa or a sync may be writing) -- try again."
+	case errors.Is(err, data.ErrDuplicate):
+		if entity := duplicateEntity(err); entity != "" {
+			return "That " + entity + " already exists."
+		}
+		return "That record already exists."
+	case errors.Is(err, data.ErrFKConstraint):
+		return "A linked record is missing or still in use -- reload and try again."
+	case errors.Is(err, data.ErrNotFound):
+		return "Record not found -- it may have been deleted."
+	case errors.As(err, &execErr) && errors.Is(err, exec.ErrNotFound):
+		return toolNotInstalled(execErr.Name)
+	}
+	return err.Error()
+}
+
+// duplicateNouns names a table's rows in duplicate-record messages.
+var duplicateNouns = map[string]string{
+	data.TableAppliances:            "appliance",
+	data.TableDocuments:             "document",
+	data.TableHouseProfiles:         "house profile",
+	data.TableIncidents:             "incident",
+	data.TableMaintenanceCategories: "maintenance category",
+	data.TableMaintenanceItems:      "maintenance item",
+	data.TableProjectTypes:          "project type",
+	data.TableProjects:              "project",
+	data.TableQuotes:                "quote",
+	data.TableServiceLogEntries:     "service log entry",
+	data.TableVendors:               "vendor",
+}
+
+// duplicateEntity returns the singular entity name for a unique constraint
+// failure, e.g. "vendor" for vendors.name, or "" if the table is unknown.
+func duplicateEntity(err error) string {
+	match := uniqueTableRe.FindStringSubmatch(err.Error())
+	if match == nil {
+		return ""
+	}
+	return duplicateNouns[match[1]]
+}
+
+// toolNotInstalled names the package to install for a missing external
+// command. Extraction and clipboard tools get the package names from the
+// documents guide.
+func toolNotInstalled(name string) string {
+	switch name {
+	case "tesseract":
+		return "OCR tool not installed -- install tesseract (tesseract-ocr)."
+	case "pdfinfo", "pdftocairo", "pdftotext", "pdfimages", "pdftohtml", "pdftoppm":
+		return name + " not installed -- install poppler (poppler-utils)."
+	case "wl-paste":
+		return "wl-paste not installed -- install wl-clipboard to paste images."
+	case "xclip":
+		return "xclip not installed -- install xclip to paste images."
+	case "pngpaste":
+		return "pngpaste not installed -- install it (brew install pngpaste) to paste images."
+	}
+	return name + " not installed -- install it and make sure it is on your PATH."
+}
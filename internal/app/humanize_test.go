@@ -0,0 +1,118 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"testing"
+
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sentinelErr stands in for a classified store error: it matches kind via
+// errors.Is and carries the driver's message.
+type sentinelErr struct {
+	kind error
+	msg  string
+}
+
+func (e sentinelErr) Error() string        { return e.msg }
+func (e sentinelErr) Is(target error) bool { return target == e.kind }
+
+func TestHumanizeError(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"nil", nil, ""},
+		{"plain", errors.New("something odd"), "something odd"},
+		{
+			"hint wins",
+			data.WithHint(sentinelErr{data.ErrDuplicate, "x"}, "Vendor name taken."),
+			"Vendor name taken.",
+		},
+		{
+			"locked",
+			fmt.Errorf("save: %w", sentinelErr{data.ErrDBLocked, "database is locked (5)"}),
+			"Database busy (another micasa or a sync may be writing) -- try again.",
+		},
+		{
+			"duplicate vendor",
+			sentinelErr{data.ErrDuplicate, "constraint failed: UNIQUE constraint failed: vendors.name (2067)"},
+			"That vendor already exists.",
+		},
+		{
+			"duplicate snake table",
+			sentinelErr{data.ErrDuplicate, "UNIQUE constraint failed: project_types.name"},
+			"That project type already exists.",
+		},
+		{
+			"duplicate irregular plural",
+			sentinelErr{data.ErrDuplicate, "UNIQUE constraint failed: maintenance_categories.name"},
+			"That maintenance category already exists.",
+		},
+		{
+			"duplicate service log entry",
+			sentinelErr{data.ErrDuplicate, "UNIQUE constraint failed: service_log_entries.id"},
+			"That service log entry already exists.",
+		},
+		{
+			"duplicate internal table",
+			sentinelErr{data.ErrDuplicate, "UNIQUE constraint failed: sync_devices.id"},
+			"That record already exists.",
+		},
+		{
+			"duplicate unknown",
+			sentinelErr{data.ErrDuplicate, "duplicated key not allowed"},
+			"That record already exists.",
+		},
+		{
+			"fk",
+			sentinelErr{data.ErrFKConstraint, "FOREIGN KEY constraint failed"},
+			"A linked record is missing or still in use -- reload and try again.",
+		},
+		{
+			"not found",
+			sentinelErr{data.ErrNotFound, "record not found"},
+			"Record not found -- it may have been deleted.",
+		},
+		{
+			"tesseract missing",
+			fmt.Errorf("ocr: %w", &exec.Error{Name: "tesseract", Err: exec.ErrNotFound}),
+			"OCR tool not installed -- install tesseract (tesseract-ocr).",
+		},
+		{
+			"poppler missing",
+			&exec.Error{Name: "pdftotext", Err: exec.ErrNotFound},
+			"pdftotext not installed -- install poppler (poppler-utils).",
+		},
+		{
+			"other tool missing",
+			&exec.Error{Name: "frob", Err: exec.ErrNotFound},
+			"frob not installed -- install it and make sure it is on your PATH.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, humanizeError(tt.err))
+		})
+	}
+}
+
+func TestHumanizeErrorFromStore(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	_, err := m.store.GetVendor("missing")
+	require.Error(t, err)
+	m.surfaceError(err)
+	assert.Equal(t, "Record not found -- it may have been deleted.", m.status.Text)
+	assert.Equal(t, statusError, m.status.Kind)
+}
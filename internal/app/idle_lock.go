@@ -0,0 +1,68 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+// idleLockMsg fires when the idle timer may have run out. The handler
+// re-checks against the last input, so only one tick is ever pending.
+type idleLockMsg struct{}
+
+func idleLockTick(d time.Duration) tea.Cmd {
+	return tea.Tick(d, func(_ time.Time) tea.Msg {
+		return idleLockMsg{}
+	})
+}
+
+// noteActivity records user input for the idle lock timer.
+func (m *Model) noteActivity() {
+	m.lastActivity = time.Now()
+}
+
+// checkIdleLock locks the screen once no input has arrived for the idle
+// timeout, or schedules the next check for when it would run out.
+func (m *Model) checkIdleLock() tea.Cmd {
+	if m.idleLock <= 0 || m.locked {
+		return nil
+	}
+	if remaining := m.idleLock - time.Since(m.lastActivity); remaining > 0 {
+		return idleLockTick(remaining)
+	}
+	m.locked = true
+	return nil
+}
+
+// unlockIdle hides the lock screen and restarts the idle timer.
+func (m *Model) unlockIdle() tea.Cmd {
+	m.locked = false
+	m.noteActivity()
+	return idleLockTick(m.idleLock)
+}
+
+// buildLockedView replaces the whole screen while idle-locked so no
+// records stay visible on an unattended terminal.
+func (m *Model) buildLockedView() string {
+	width := m.effectiveWidth()
+	height := m.effectiveHeight()
+
+	panel := lipgloss.JoinVertical(
+		lipgloss.Center,
+		m.styles.HeaderSection().Render(" micasa is locked "),
+		"",
+		m.styles.HeaderHint().Render("press any key to resume"),
+	)
+
+	return lipgloss.Place(
+		width,
+		height,
+		lipgloss.Center,
+		lipgloss.Center,
+		clampLines(panel, width),
+	)
+}
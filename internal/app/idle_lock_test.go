@@ -0,0 +1,62 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdleLockLocksAfterTimeout(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.idleLock = time.Minute
+	m.lastActivity = time.Now().Add(-2 * time.Minute)
+
+	_, cmd := m.Update(idleLockMsg{})
+	assert.Nil(t, cmd, "no further ticks while locked")
+	require.True(t, m.locked)
+
+	view := ansi.Strip(m.buildView())
+	assert.Contains(t, view, "micasa is locked")
+	assert.NotContains(t, view, "Projects", "tabs and records are hidden")
+}
+
+func TestIdleLockReschedulesWhileActive(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.idleLock = time.Minute
+	m.lastActivity = time.Now().Add(-30 * time.Second)
+
+	_, cmd := m.Update(idleLockMsg{})
+	assert.NotNil(t, cmd, "checks again when the remaining time runs out")
+	assert.False(t, m.locked)
+}
+
+func TestIdleLockAnyKeyUnlocksWithoutActing(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.idleLock = time.Minute
+	m.locked = true
+
+	sendKey(m, keyI)
+
+	assert.False(t, m.locked)
+	assert.Equal(t, modeNormal, m.mode, "the unlocking key is swallowed")
+	assert.WithinDuration(t, time.Now(), m.lastActivity, time.Second)
+}
+
+func TestIdleLockDisabled(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.lastActivity = time.Now().Add(-24 * time.Hour)
+
+	_, cmd := m.Update(idleLockMsg{})
+	assert.Nil(t, cmd)
+	assert.False(t, m.locked)
+}
@@ -30,8 +30,9 @@ type inlineColSpec struct {
 	fieldPtr func(formData) *string
 
 	// validate returns a validator for text/money inputs. Nil means no
-	// validation. Receives the Model so money validators can access currency.
-	validate func(*Model) func(string) error
+	// validation. Receives the Model so money validators can access currency,
+	// and the form values for records that carry their own currency.
+	validate func(*Model, formData) func(string) error
 
 	// selectOptions builds the huh options for select columns. Receives the
 	// Model so it can load dynamic data (appliances, vendors, etc.).
@@ -75,7 +76,7 @@ func (m *Model) dispatchInlineEdit(
 	case ieText, ieMoney:
 		var vfn func(string) error
 		if spec.validate != nil {
-			vfn = spec.validate(m)
+			vfn = spec.validate(m, values)
 		}
 		m.openInlineInput(id, spec.title, spec.placeholder, spec.fieldPtr(values), vfn, values)
 
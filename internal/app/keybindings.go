@@ -18,6 +18,7 @@ type AppKeyMap struct {
 	// --- Global (pre-overlay, model_update.go) ---
 	Quit   key.Binding
 	Cancel key.Binding
+	Home   key.Binding
 
 	// --- Common (handleCommonKeys — both normal + edit) ---
 	ColLeft     key.Binding
@@ -47,20 +48,35 @@ type AppKeyMap struct {
 	ColHide       key.Binding
 	ColShowAll    key.Binding
 	ColFinder     key.Binding
+	ColNarrow     key.Binding
+	ColWiden      key.Binding
+	ColWidthReset key.Binding
+	Recent        key.Binding
+	QuoteCompare  key.Binding
+	Views         key.Binding
 	DocSearch     key.Binding
 	DocOpen       key.Binding // also used in handleEditKeys
 	ToggleUnits   key.Binding
+	ToggleMoney   key.Binding
 	Chat          key.Binding
 	Escape        key.Binding
 	YankCell      key.Binding
+	YankView      key.Binding
 
 	// --- Edit mode (handleEditKeys) ---
 	Add         key.Binding
 	QuickAdd    key.Binding
+	PasteImage  key.Binding
 	EditCell    key.Binding
 	EditFull    key.Binding
 	Delete      key.Binding
 	HardDelete  key.Binding
+	Snooze      key.Binding
+	Archive     key.Binding
+	CloneLast   key.Binding
+	Mark        key.Binding
+	Complete    key.Binding
+	Merge       key.Binding
 	ReExtract   key.Binding
 	ShowDeleted key.Binding
 	HouseEdit   key.Binding
@@ -73,6 +89,7 @@ type AppKeyMap struct {
 	FormPrevField   key.Binding // help-only; huh dispatches internally
 	FormEditor      key.Binding
 	FormHiddenFiles key.Binding
+	FormNewRecord   key.Binding
 
 	// --- Chat (handleChatKey main) ---
 	ChatSend      key.Binding
@@ -80,6 +97,13 @@ type AppKeyMap struct {
 	ChatHistoryUp key.Binding
 	ChatHistoryDn key.Binding
 	ChatHide      key.Binding
+	ChatLinks     key.Binding
+
+	// --- Chat record picker (handleChatKey picker) ---
+	ChatLinkUp     key.Binding
+	ChatLinkDown   key.Binding
+	ChatLinkJump   key.Binding
+	ChatLinkCancel key.Binding
 
 	// --- Chat completer (handleChatKey completer) ---
 	CompleterUp      key.Binding
@@ -116,6 +140,9 @@ type AppKeyMap struct {
 	DocSearchDown    key.Binding
 	DocSearchConfirm key.Binding
 	DocSearchCancel  key.Binding
+	DocSearchScope   key.Binding
+	DocSearchEdit    key.Binding
+	DocSearchDelete  key.Binding
 
 	// --- Column finder (handleColumnFinderKey) ---
 	ColFinderUp        key.Binding
@@ -125,6 +152,40 @@ type AppKeyMap struct {
 	ColFinderClear     key.Binding
 	ColFinderBackspace key.Binding
 
+	// --- Recently viewed (handleRecentPickerKey) ---
+	RecentUp      key.Binding
+	RecentDown    key.Binding
+	RecentConfirm key.Binding
+	RecentCancel  key.Binding
+
+	// --- Quote comparison (handleQuoteCompareKey) ---
+	CompareLeft  key.Binding
+	CompareRight key.Binding
+	CompareClose key.Binding
+
+	// --- Merge (handleMergeKey) ---
+	MergeUp      key.Binding
+	MergeDown    key.Binding
+	MergePrev    key.Binding
+	MergeNext    key.Binding
+	MergeSkip    key.Binding
+	MergeConfirm key.Binding
+	MergeUndo    key.Binding
+	MergeClose   key.Binding
+
+	// --- Save review (handleSaveReviewKey) ---
+	ReviewSave key.Binding
+	ReviewBack key.Binding
+
+	// --- Saved views (handleViewsPickerKey) ---
+	ViewsUp        key.Binding
+	ViewsDown      key.Binding
+	ViewsConfirm   key.Binding
+	ViewsCancel    key.Binding
+	ViewsSave      key.Binding
+	ViewsDelete    key.Binding
+	ViewsBackspace key.Binding
+
 	// --- Ops tree (handleOpsTreeKey) ---
 	OpsUp       key.Binding
 	OpsDown     key.Binding
@@ -142,11 +203,12 @@ type AppKeyMap struct {
 	ExtUp         key.Binding
 	ExtDown       key.Binding
 	ExtToggle     key.Binding
-	ExtRemodel    key.Binding
+	ExtRerun      key.Binding
 	ExtToggleTSV  key.Binding
 	ExtAccept     key.Binding
 	ExtExplore    key.Binding
 	ExtBackground key.Binding
+	ExtSkipLLM    key.Binding
 
 	// --- Extraction explore (handleExtractionExploreKey) ---
 	ExploreUp       key.Binding
@@ -182,14 +244,21 @@ type AppKeyMap struct {
 	HelpClose      key.Binding
 
 	// --- Confirmations (handleConfirmDiscard, handleConfirmHardDelete) ---
-	ConfirmYes key.Binding
-	ConfirmNo  key.Binding
+	ConfirmYes      key.Binding
+	ConfirmNo       key.Binding
+	ConfirmEndToday key.Binding // handleConfirmCompleteProjects only
+	ConfirmUndo     key.Binding // handleUndoCompletePrompt only
 
 	// --- Inline input (handleInlineInputKey) ---
 	InlineConfirm key.Binding
 	InlineCancel  key.Binding
 }
 
+// homeKeyBinding binds k (ui.home_key) to the jump-to-dashboard action.
+func homeKeyBinding(k string) key.Binding {
+	return key.NewBinding(key.WithKeys(k), key.WithHelp(k, "dashboard from anywhere"))
+}
+
 func newAppKeyMap() AppKeyMap {
 	return AppKeyMap{
 		// Global
@@ -198,6 +267,7 @@ func newAppKeyMap() AppKeyMap {
 			key.WithKeys(keyCtrlC),
 			key.WithHelp("ctrl+c", "cancel LLM operation"),
 		),
+		Home: homeKeyBinding(keyShiftH),
 
 		// Common
 		ColLeft: key.NewBinding(
@@ -239,7 +309,7 @@ func newAppKeyMap() AppKeyMap {
 		SortClear: key.NewBinding(key.WithKeys(keyShiftS)),
 		ToggleSettled: key.NewBinding(
 			key.WithKeys(keyT),
-			key.WithHelp(keyT, "toggle settled projects"),
+			key.WithHelp(keyT, "toggle settled projects / archived appliances"),
 		),
 		FilterPin: key.NewBinding(key.WithKeys(keyN), key.WithHelp(keyN, "pin/unpin")),
 		FilterToggle: key.NewBinding(
@@ -263,6 +333,27 @@ func newAppKeyMap() AppKeyMap {
 			key.WithKeys(keySlash),
 			key.WithHelp(keySlash, "find column"),
 		),
+		ColNarrow: key.NewBinding(
+			key.WithKeys(keyLess),
+			key.WithHelp(keyLess+"/"+keyGreater, "narrow/widen column"),
+		),
+		ColWiden: key.NewBinding(key.WithKeys(keyGreater)),
+		ColWidthReset: key.NewBinding(
+			key.WithKeys(keyEqual),
+			key.WithHelp(keyEqual, "auto column width"),
+		),
+		Recent: key.NewBinding(
+			key.WithKeys(keyCtrlR),
+			key.WithHelp("ctrl+r", "recently viewed"),
+		),
+		QuoteCompare: key.NewBinding(
+			key.WithKeys(keyShiftQ),
+			key.WithHelp(keyShiftQ, "compare a project's quotes"),
+		),
+		Views: key.NewBinding(
+			key.WithKeys(keyV),
+			key.WithHelp(keyV, "saved views"),
+		),
 		DocSearch: key.NewBinding(
 			key.WithKeys(keyCtrlF),
 			key.WithHelp("ctrl+f", "search documents"),
@@ -272,12 +363,20 @@ func newAppKeyMap() AppKeyMap {
 			key.WithKeys(keyShiftU),
 			key.WithHelp(keyShiftU, "toggle units"),
 		),
+		ToggleMoney: key.NewBinding(
+			key.WithKeys(keyShiftM),
+			key.WithHelp(keyShiftM, "toggle exact/compact money"),
+		),
 		Chat: key.NewBinding(key.WithKeys(keyAt), key.WithHelp(keyAt, "ask LLM")),
 		Escape: key.NewBinding(
 			key.WithKeys(keyEsc),
 			key.WithHelp("esc", "close detail / clear status"),
 		),
 		YankCell: key.NewBinding(key.WithKeys(keyY), key.WithHelp(keyY, "copy cell")),
+		YankView: key.NewBinding(
+			key.WithKeys(keyShiftY),
+			key.WithHelp(keyShiftY, "copy visible table"),
+		),
 
 		// Edit mode
 		Add: key.NewBinding(key.WithKeys(keyA), key.WithHelp(keyA, "add entry")),
@@ -285,6 +384,10 @@ func newAppKeyMap() AppKeyMap {
 			key.WithKeys(keyShiftA),
 			key.WithHelp(keyShiftA, "add document with extraction"),
 		),
+		PasteImage: key.NewBinding(
+			key.WithKeys(keyCtrlV),
+			key.WithHelp(keyCtrlV, "paste image with extraction"),
+		),
 		EditCell: key.NewBinding(key.WithKeys(keyE), key.WithHelp(keyE, "edit cell or row")),
 		EditFull: key.NewBinding(
 			key.WithKeys(keyShiftE),
@@ -295,6 +398,12 @@ func newAppKeyMap() AppKeyMap {
 			key.WithKeys(keyShiftD),
 			key.WithHelp(keyShiftD, "permanently delete"),
 		),
+		Snooze:      key.NewBinding(key.WithKeys(keyZ), key.WithHelp(keyZ, "snooze")),
+		Archive:     key.NewBinding(key.WithKeys(keyZ), key.WithHelp(keyZ, "archive")),
+		CloneLast:   key.NewBinding(key.WithKeys(keyC), key.WithHelp(keyC, "clone last")),
+		Mark:        key.NewBinding(key.WithKeys(keySpace), key.WithHelp(keySpace, "mark")),
+		Complete:    key.NewBinding(key.WithKeys(keyShiftC), key.WithHelp(keyShiftC, "complete")),
+		Merge:       key.NewBinding(key.WithKeys(keyShiftM), key.WithHelp(keyShiftM, "merge")),
 		ReExtract:   key.NewBinding(key.WithKeys(keyR), key.WithHelp(keyR, "re-extract")),
 		ShowDeleted: key.NewBinding(key.WithKeys(keyX), key.WithHelp(keyX, "show deleted")),
 		HouseEdit:   key.NewBinding(key.WithKeys(keyP), key.WithHelp(keyP, "house profile")),
@@ -313,6 +422,10 @@ func newAppKeyMap() AppKeyMap {
 			key.WithKeys(keyShiftH),
 			key.WithHelp(keyShiftH, "toggle hidden files"),
 		),
+		FormNewRecord: key.NewBinding(
+			key.WithKeys(keyEnter),
+			key.WithHelp(symReturn, "on \"+ New …\", add a project, appliance, or vendor"),
+		),
 
 		// Chat
 		ChatSend: key.NewBinding(
@@ -329,6 +442,16 @@ func newAppKeyMap() AppKeyMap {
 		),
 		ChatHistoryDn: key.NewBinding(key.WithKeys(keyDown, keyCtrlN)),
 		ChatHide:      key.NewBinding(key.WithKeys(keyEsc), key.WithHelp("esc", "hide chat")),
+		ChatLinks: key.NewBinding(
+			key.WithKeys(keyTab),
+			key.WithHelp("tab", "pick a record from the answer"),
+		),
+
+		// Chat record picker
+		ChatLinkUp:     key.NewBinding(key.WithKeys(keyUp, keyCtrlP)),
+		ChatLinkDown:   key.NewBinding(key.WithKeys(keyDown, keyCtrlN)),
+		ChatLinkJump:   key.NewBinding(key.WithKeys(keyEnter)),
+		ChatLinkCancel: key.NewBinding(key.WithKeys(keyEsc)),
 
 		// Chat completer
 		CompleterUp:      key.NewBinding(key.WithKeys(keyUp, keyCtrlP)),
@@ -365,6 +488,9 @@ func newAppKeyMap() AppKeyMap {
 		DocSearchDown:    key.NewBinding(key.WithKeys(keyDown, keyCtrlN, keyCtrlJ)),
 		DocSearchConfirm: key.NewBinding(key.WithKeys(keyEnter)),
 		DocSearchCancel:  key.NewBinding(key.WithKeys(keyEsc)),
+		DocSearchScope:   key.NewBinding(key.WithKeys(keyTab)),
+		DocSearchEdit:    key.NewBinding(key.WithKeys(keyCtrlE)),
+		DocSearchDelete:  key.NewBinding(key.WithKeys(keyCtrlD)),
 
 		// Column finder
 		ColFinderUp:        key.NewBinding(key.WithKeys(keyUp, keyCtrlP)),
@@ -374,6 +500,40 @@ func newAppKeyMap() AppKeyMap {
 		ColFinderClear:     key.NewBinding(key.WithKeys(keyCtrlU)),
 		ColFinderBackspace: key.NewBinding(key.WithKeys(keyBackspace)),
 
+		// Recently viewed
+		RecentUp:      key.NewBinding(key.WithKeys(keyK, keyUp, keyCtrlP)),
+		RecentDown:    key.NewBinding(key.WithKeys(keyJ, keyDown, keyCtrlN)),
+		RecentConfirm: key.NewBinding(key.WithKeys(keyEnter)),
+		RecentCancel:  key.NewBinding(key.WithKeys(keyEsc, keyCtrlR)),
+
+		// Quote comparison
+		CompareLeft:  key.NewBinding(key.WithKeys(keyH, keyLeft)),
+		CompareRight: key.NewBinding(key.WithKeys(keyL, keyRight)),
+		CompareClose: key.NewBinding(key.WithKeys(keyEsc, keyShiftQ)),
+
+		// Merge
+		MergeUp:      key.NewBinding(key.WithKeys(keyK, keyUp)),
+		MergeDown:    key.NewBinding(key.WithKeys(keyJ, keyDown)),
+		MergePrev:    key.NewBinding(key.WithKeys(keyH, keyLeft)),
+		MergeNext:    key.NewBinding(key.WithKeys(keyL, keyRight)),
+		MergeSkip:    key.NewBinding(key.WithKeys(keySpace)),
+		MergeConfirm: key.NewBinding(key.WithKeys(keyEnter)),
+		MergeUndo:    key.NewBinding(key.WithKeys(keyU)),
+		MergeClose:   key.NewBinding(key.WithKeys(keyEsc)),
+
+		// Save review
+		ReviewSave: key.NewBinding(key.WithKeys(keyY, keyEnter)),
+		ReviewBack: key.NewBinding(key.WithKeys(keyN, keyEsc)),
+
+		// Saved views
+		ViewsUp:        key.NewBinding(key.WithKeys(keyUp, keyCtrlP)),
+		ViewsDown:      key.NewBinding(key.WithKeys(keyDown, keyCtrlN)),
+		ViewsConfirm:   key.NewBinding(key.WithKeys(keyEnter)),
+		ViewsCancel:    key.NewBinding(key.WithKeys(keyEsc)),
+		ViewsSave:      key.NewBinding(key.WithKeys(keyCtrlS)),
+		ViewsDelete:    key.NewBinding(key.WithKeys(keyCtrlD)),
+		ViewsBackspace: key.NewBinding(key.WithKeys(keyBackspace)),
+
 		// Ops tree
 		OpsUp:       key.NewBinding(key.WithKeys(keyK, keyUp)),
 		OpsDown:     key.NewBinding(key.WithKeys(keyJ, keyDown)),
@@ -391,11 +551,12 @@ func newAppKeyMap() AppKeyMap {
 		ExtUp:         key.NewBinding(key.WithKeys(keyK, keyUp)),
 		ExtDown:       key.NewBinding(key.WithKeys(keyJ, keyDown)),
 		ExtToggle:     key.NewBinding(key.WithKeys(keyEnter)),
-		ExtRemodel:    key.NewBinding(key.WithKeys(keyR)),
+		ExtRerun:      key.NewBinding(key.WithKeys(keyR)),
 		ExtToggleTSV:  key.NewBinding(key.WithKeys(keyT)),
 		ExtAccept:     key.NewBinding(key.WithKeys(keyA)),
 		ExtExplore:    key.NewBinding(key.WithKeys(keyX)),
 		ExtBackground: key.NewBinding(key.WithKeys(keyCtrlB)),
+		ExtSkipLLM:    key.NewBinding(key.WithKeys(keyS)),
 
 		// Extraction explore
 		ExploreUp:       key.NewBinding(key.WithKeys(keyK, keyUp)),
@@ -431,8 +592,10 @@ func newAppKeyMap() AppKeyMap {
 		HelpClose:      key.NewBinding(key.WithKeys(keyEsc, keyQuestion)),
 
 		// Confirmations
-		ConfirmYes: key.NewBinding(key.WithKeys(keyY)),
-		ConfirmNo:  key.NewBinding(key.WithKeys(keyN, keyEsc)),
+		ConfirmYes:      key.NewBinding(key.WithKeys(keyY)),
+		ConfirmNo:       key.NewBinding(key.WithKeys(keyN, keyEsc)),
+		ConfirmEndToday: key.NewBinding(key.WithKeys(keyT)),
+		ConfirmUndo:     key.NewBinding(key.WithKeys(keyU)),
 
 		// Inline input
 		InlineConfirm: key.NewBinding(key.WithKeys(keyEnter)),
@@ -464,6 +627,14 @@ func (m *Model) normalModeShortHelp() []key.Binding {
 
 	bindings = append(bindings, m.keys.EnterEditMode)
 
+	// What s does on the current column.
+	if hint := m.sortHint(); hint != "" {
+		bindings = append(bindings, key.NewBinding(
+			key.WithKeys(keyS),
+			key.WithHelp(keyS, hint),
+		))
+	}
+
 	if m.effectiveTab().isDocumentTab() {
 		bindings = append(bindings, m.keys.DocOpen, m.keys.DocSearch)
 	}
@@ -503,6 +674,21 @@ func (m *Model) editModeShortHelp() []key.Binding {
 	if m.effectiveTab().isDocumentTab() {
 		bindings = append(bindings, m.keys.DocOpen, m.keys.ReExtract)
 	}
+	if m.effectiveTab().isMaintenanceTab() {
+		bindings = append(bindings, m.keys.Snooze)
+	}
+	if m.effectiveTab().isServiceLogTab() {
+		bindings = append(bindings, m.keys.CloneLast)
+	}
+	if m.effectiveTab().isProjectTab() {
+		bindings = append(bindings, m.keys.Mark, m.keys.Complete)
+	}
+	if m.effectiveTab().isVendorTab() {
+		bindings = append(bindings, m.keys.Merge)
+	}
+	if m.effectiveTab().isApplianceTab() {
+		bindings = append(bindings, m.keys.Archive, m.keys.Mark, m.keys.Merge)
+	}
 
 	bindings = append(bindings, m.keys.ExitEdit)
 
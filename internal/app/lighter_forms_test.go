@@ -6,6 +6,8 @@ package app
 import (
 	"testing"
 
+	"github.com/micasa-dev/micasa/internal/data"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -362,3 +364,23 @@ func TestAddServiceLogFormHasOnlyEssentialFields(t *testing.T) {
 		assert.NotContainsf(t, view, absent, "add service log form should NOT contain %q", absent)
 	}
 }
+
+func TestAddServiceLogFormPrefillsDefaultPerformer(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	v := data.Vendor{Name: "Handy Hal"}
+	require.NoError(t, m.store.CreateVendor(&v))
+	require.NoError(t, m.loadLookups())
+
+	m.defaultPerformer = "handy hal"
+	require.NoError(t, m.startServiceLogForm(""))
+	values, ok := m.fs.formData.(*serviceLogFormData)
+	require.True(t, ok)
+	assert.Equal(t, v.ID, values.VendorID)
+
+	m.defaultPerformer = "Nobody"
+	require.NoError(t, m.startServiceLogForm(""))
+	values, ok = m.fs.formData.(*serviceLogFormData)
+	require.True(t, ok)
+	assert.Empty(t, values.VendorID, "unknown vendor falls back to self")
+}
@@ -32,7 +32,7 @@ func magFormat(c cell, includeUnit bool, currencySymbol string) string {
 	case cellMoney, cellDrilldown, cellOps:
 		// Definitely numeric; continue to parsing below.
 	case cellText, cellReadonly, cellDate, cellStatus, cellWarranty,
-		cellUrgency, cellNotes, cellEntity, cellTelephoneNumber:
+		cellUrgency, cellNotes, cellEntity, cellTelephoneNumber, cellDuration, cellNumber:
 		return value
 	default:
 		panic(fmt.Sprintf("unhandled cellKind: %d", c.Kind))
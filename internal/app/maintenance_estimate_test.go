@@ -0,0 +1,51 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserSeesNextServiceEstimateFromServiceLog(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	cats, err := m.store.MaintenanceCategories()
+	require.NoError(t, err)
+	item := data.MaintenanceItem{Name: "Gutter cleaning", CategoryID: cats[0].ID}
+	require.NoError(t, m.store.CreateMaintenance(&item))
+	for _, cents := range []int64{20000, 30000} {
+		require.NoError(t, m.store.CreateServiceLog(&data.ServiceLogEntry{
+			MaintenanceItemID: item.ID,
+			ServicedAt:        time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC),
+			CostCents:         &cents,
+		}, data.Vendor{}))
+	}
+
+	m.active = tabIndex(tabMaintenance)
+	require.NoError(t, m.reloadActiveTab())
+	tab := m.activeTab()
+	require.Len(t, tab.CellRows, 1)
+	assert.Equal(t, "$250.00", tab.CellRows[0][maintenanceColEst].Value)
+}
+
+func TestNextServiceEstimateIsEmptyWithoutHistory(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	cats, err := m.store.MaintenanceCategories()
+	require.NoError(t, err)
+	require.NoError(t, m.store.CreateMaintenance(&data.MaintenanceItem{
+		Name: "Gutter cleaning", CategoryID: cats[0].ID,
+	}))
+
+	m.active = tabIndex(tabMaintenance)
+	require.NoError(t, m.reloadActiveTab())
+	tab := m.activeTab()
+	require.Len(t, tab.CellRows, 1)
+	assert.True(t, tab.CellRows[0][maintenanceColEst].Null)
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/micasa-dev/micasa/internal/locale"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserTagsMaintenanceWithMonth(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	m.active = tabIndex(tabMaintenance)
+	openAddForm(m)
+
+	values, ok := m.fs.formData.(*maintenanceFormData)
+	require.True(t, ok)
+	values.Name = "Open the pool"
+	values.Month = "5"
+	sendKey(m, "ctrl+s")
+
+	items, err := m.store.ListMaintenance(false)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, int(time.May), items[0].Month)
+	assert.Equal(t, "5", maintenanceFormValues(items[0], locale.DefaultCurrency()).Month)
+
+	sendKey(m, "esc")
+	tab := m.activeTab()
+	require.Len(t, tab.CellRows, 1)
+	assert.Equal(t, "May", tab.CellRows[0][maintenanceColMonth].Value)
+}
+
+func TestMaintenanceMonthOutOfRangeIsFieldError(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	m.active = tabIndex(tabMaintenance)
+	openAddForm(m)
+
+	values, ok := m.fs.formData.(*maintenanceFormData)
+	require.True(t, ok)
+	values.Name = "Open the pool"
+	values.Month = "13"
+	_, err := m.parseMaintenanceFormData()
+	require.Error(t, err)
+	assert.Equal(t, `Month: should be 1-12, got "13"`, data.Hint(err))
+}
+
+func TestMaintenanceMonthCellEmptyWhenUntagged(t *testing.T) {
+	t.Parallel()
+	assert.True(t, maintenanceMonthCell(0).Null)
+	assert.Equal(t, "Dec", maintenanceMonthCell(12).Value)
+}
+
+func TestDashboardSeasonalIncludesMonthTaggedItems(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	m.styles = appStyles
+	cats, err := m.store.MaintenanceCategories()
+	require.NoError(t, err)
+	require.NoError(t, m.store.CreateMaintenance(&data.MaintenanceItem{
+		Name:       "Open the pool",
+		CategoryID: cats[0].ID,
+		Month:      int(time.May),
+	}))
+
+	require.NoError(t, m.loadDashboardAt(time.Date(2026, 4, 10, 0, 0, 0, 0, time.UTC)))
+	rows := m.dashSeasonalRows()
+	require.Len(t, rows, 1)
+	assert.Equal(t, "Open the pool", rows[0].Cells[0].Text)
+	assert.Equal(t, "May", rows[0].Cells[2].Text)
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSnoozeModel(t *testing.T) (*Model, data.MaintenanceItem) {
+	t.Helper()
+	m := newTestModelWithStore(t)
+	cats, err := m.store.MaintenanceCategories()
+	require.NoError(t, err)
+	item := data.MaintenanceItem{Name: "Flush Water Heater", CategoryID: cats[0].ID}
+	require.NoError(t, m.store.CreateMaintenance(&item))
+	m.active = tabIndex(tabMaintenance)
+	require.NoError(t, m.reloadActiveTab())
+	m.enterEditMode()
+	return m, item
+}
+
+func TestSnoozeKeyTogglesSnooze(t *testing.T) {
+	t.Parallel()
+	m, item := newSnoozeModel(t)
+	m.snoozeDays = 14
+	require.Contains(t, m.statusView(), "snooze")
+
+	sendKey(m, keyZ)
+	assert.Equal(t, statusInfo, m.status.Kind, m.status.Text)
+	assert.Contains(t, m.status.Text, "Snoozed Flush Water Heater until")
+	fetched, err := m.store.GetMaintenance(item.ID)
+	require.NoError(t, err)
+	require.NotNil(t, fetched.SnoozedUntil)
+	assert.Equal(t, 14, daysUntil(time.Now(), *fetched.SnoozedUntil))
+
+	sendKey(m, keyZ)
+	assert.Equal(t, "Unsnoozed Flush Water Heater.", m.status.Text)
+	fetched, err = m.store.GetMaintenance(item.ID)
+	require.NoError(t, err)
+	assert.Nil(t, fetched.SnoozedUntil)
+}
+
+func TestSnoozeExpiredSnoozesAgain(t *testing.T) {
+	t.Parallel()
+	m, item := newSnoozeModel(t)
+	past := time.Now().AddDate(0, 0, -3)
+	require.NoError(t, m.store.SnoozeMaintenance(item.ID, &past))
+
+	m.toggleSnoozeSelected(time.Now())
+	fetched, err := m.store.GetMaintenance(item.ID)
+	require.NoError(t, err)
+	require.NotNil(t, fetched.SnoozedUntil)
+	assert.Equal(t, defaultSnoozeDays, daysUntil(time.Now(), *fetched.SnoozedUntil))
+}
+
+func TestSnoozeKeyOnlyOnMaintenance(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	m.active = tabIndex(tabProjects)
+	m.enterEditMode()
+
+	sendKey(m, keyZ)
+	assert.Empty(t, m.status.Text)
+	assert.NotContains(t, m.statusView(), "snooze")
+}
@@ -13,6 +13,7 @@ import (
 	"os/exec"
 	"reflect"
 	"strings"
+	"time"
 
 	"charm.land/bubbles/v2/key"
 	"charm.land/bubbles/v2/progress"
@@ -37,6 +38,7 @@ const (
 	keyLeft      = "left"
 	keyRight     = "right"
 	keyTab       = "tab"
+	keySpace     = "space"
 	keyBackspace = "backspace"
 	keyPgUp      = "pgup"
 	keyPgDown    = "pgdown"
@@ -59,8 +61,10 @@ const (
 	keyCtrlP = "ctrl+p"
 	keyCtrlB = "ctrl+b"
 	keyCtrlQ = "ctrl+q"
+	keyCtrlR = "ctrl+r"
 	keyCtrlS = "ctrl+s"
 	keyCtrlU = "ctrl+u"
+	keyCtrlV = "ctrl+v"
 
 	// Letters (lower).
 	keyA = "a"
@@ -82,8 +86,10 @@ const (
 	keyS = "s"
 	keyT = "t"
 	keyU = "u"
+	keyV = "v"
 	keyX = "x"
 	keyY = "y"
+	keyZ = "z"
 
 	// Letters (upper / shift).
 	keyShiftA = "A"
@@ -97,9 +103,12 @@ const (
 	keyShiftJ = "J"
 	keyShiftK = "K"
 	keyShiftL = "L"
+	keyShiftM = "M"
 	keyShiftN = "N"
+	keyShiftQ = "Q"
 	keyShiftS = "S"
 	keyShiftU = "U"
+	keyShiftY = "Y"
 
 	// Symbols.
 	keyBang     = "!"
@@ -110,6 +119,9 @@ const (
 	keyDollar   = "$"
 	keyLBracket = "["
 	keyRBracket = "]"
+	keyLess     = "<"
+	keyGreater  = ">"
+	keyEqual    = "="
 
 	// Display symbols for key hints.
 	symReturn = "\u21b5" // ↵
@@ -138,8 +150,11 @@ const (
 	symEllipsis  = "\u2026" // …
 	symEmptySet  = "\u2205" // ∅
 	symEmDash    = "\u2014" // —
+	symCheck     = "\u2713" // ✓
 	symInfinity  = "\u221E" // ∞
 	symMiddleDot = "\u00b7" // ·
+	symSigma     = "\u03a3" // Σ
+	symApprox    = "\u2248" // ≈
 )
 
 // helpSection is a titled group of key bindings for the help overlay.
@@ -190,6 +205,10 @@ type Model struct {
 	llmClient             llm.ChatProvider
 	chatCfg               chatConfig
 	filePickerDir         string // starting directory for document file picker
+	autoLinkDocuments     bool   // link imports to the selected entity row
+	docTitles             data.TitleRules
+	docStorage            data.DocumentStorage
+	docStorageWarn        uint64 // bytes; 0 = never warn
 	ex                    extractState
 	pull                  pullState
 	chat                  *chatState // non-nil when chat overlay is open
@@ -206,6 +225,12 @@ type Model struct {
 	opsTree               *opsTreeState
 	calendar              *calendarState
 	columnFinder          *columnFinderState
+	recentPicker          *recentPickerState
+	quoteCompare          *quoteCompareState
+	merge                 *mergeState
+	saveReview            *saveReviewState
+	viewsPicker           *viewsPickerState
+	recent                []recentEntry // recently viewed records, most recent first
 	docSearch             *docSearchState
 	dash                  dashState
 	unitSystem            data.UnitSystem
@@ -214,16 +239,22 @@ type Model struct {
 	mode                  Mode
 	prevMode              Mode // mode to restore after form closes
 	fs                    formState
+	formStack             []formFrame // forms set aside while a linked record is added
 	inlineInput           *inlineInputState
-	magMode               bool        // easter egg: display numbers as order-of-magnitude
-	confirm               confirmKind // active confirmation dialog (zero = none)
-	hardDeleteID          string      // entity ID pending permanent deletion
+	magMode               bool                    // easter egg: display numbers as order-of-magnitude
+	exactMoney            bool                    // show money at full precision instead of compact
+	confirm               confirmKind             // active confirmation dialog (zero = none)
+	hardDeleteID          string                  // entity ID pending permanent deletion
+	recurProjectID        string                  // completed recurring project to offer rescheduling
+	completeIDs           []string                // projects pending bulk completion
+	lastCompletion        *data.ProjectCompletion // bulk completion the status bar offers to undo
 	lastRowClick          rowClickState
 	lastDashClick         rowClickState
 	isDark                bool // terminal background is dark
 	keys                  AppKeyMap
 	cur                   locale.Currency
 	status                statusMsg
+	saveWarning           string // non-fatal note from the last save, shown with "Saved."
 	projectTypes          []data.ProjectType
 	maintenanceCategories []data.MaintenanceCategory
 	vendors               []data.Vendor
@@ -234,6 +265,46 @@ type Model struct {
 	addressCountry  string
 	addressAutofill bool
 
+	// Days past due before the dashboard flags maintenance as overdue.
+	maintenanceGraceDays int
+	// Days the snooze action hides a maintenance item from the dashboard.
+	snoozeDays int
+	// Warranty expirations the dashboard lists; zero means the default.
+	warrantyWindow data.WarrantyWindow
+	// Unit for a bare maintenance interval number; empty means months.
+	intervalUnit data.IntervalUnit
+	// Fixed rates for the dashboard's approximate cross-currency total.
+	currencyRates locale.Rates
+	// Vendor name preselected when logging service; empty means self.
+	defaultPerformer string
+	// Ask before saving an edit, listing the fields it changed.
+	reviewChanges bool
+	// Per-record-type add form fields and required fields from [forms].
+	formPrefs map[FormKind]formFieldPrefs
+
+	// UI locale for dates and counts; independent of the currency locale.
+	display locale.Display
+
+	// Lines a notes cell may wrap onto in table rows (1 = first line only).
+	noteLines int
+
+	// Status bar segments in display order (nil = defaults), and whether
+	// the bar is kept to a single row.
+	statusSegments []string
+	compactStatus  bool
+
+	// Smallest terminal size to lay out for (0 = minUsableWidth/Height).
+	minWidth  int
+	minHeight int
+
+	// Keyboard-only: don't ask the terminal for mouse events.
+	mouseDisabled bool
+
+	// Idle lock: blank the screen after idleLock without input (0 = off).
+	idleLock     time.Duration
+	lastActivity time.Time
+	locked       bool
+
 	// App lifecycle context: cancelled on quit, parent of all feature contexts.
 	// Access via lifecycleCtx() which provides a nil-safe fallback for tests.
 	appCtx    context.Context
@@ -288,6 +359,7 @@ func NewModel(store *data.Store, options Options) (*Model, error) {
 		if chatCfg.Effort != "" {
 			client.SetEffort(chatCfg.Effort)
 		}
+		client.SetContextTokens(chatCfg.ContextTokens)
 	}
 
 	pprog := progress.New(
@@ -297,15 +369,18 @@ func NewModel(store *data.Store, options Options) (*Model, error) {
 	pprog.PercentageStyle = appStyles.TextDim()
 
 	model := &Model{
-		appCtx:        appCtx,
-		appCancel:     appCancel,
-		zones:         zone.New(),
-		store:         store,
-		dbPath:        options.DBPath,
-		configPath:    options.ConfigPath,
-		llmClient:     client,
-		chatCfg:       chatCfg,
-		filePickerDir: options.FilePickerDir,
+		appCtx:            appCtx,
+		appCancel:         appCancel,
+		zones:             zone.New(),
+		store:             store,
+		dbPath:            options.DBPath,
+		configPath:        options.ConfigPath,
+		llmClient:         client,
+		chatCfg:           chatCfg,
+		filePickerDir:     options.FilePickerDir,
+		autoLinkDocuments: options.AutoLinkDocuments,
+		docTitles:         options.DocumentTitles,
+		docStorageWarn:    options.DocStorageWarning,
 		ex: extractState{
 			extractionProvider: options.ExtractionConfig.Provider,
 			extractionBaseURL:  options.ExtractionConfig.BaseURL,
@@ -316,20 +391,41 @@ func NewModel(store *data.Store, options Options) (*Model, error) {
 			extractionEnabled:  options.ExtractionConfig.Enabled,
 			ocrTSV:             options.ExtractionConfig.OCRTSV,
 			ocrConfThreshold:   options.ExtractionConfig.OCRConfThreshold,
+			maxInputChars:      options.ExtractionConfig.MaxInputChars,
+			contextTokens:      options.ExtractionConfig.ContextTokens,
+			promptVariants:     options.ExtractionConfig.PromptVariants,
+			skipLLMOnImport:    options.ExtractionConfig.SkipLLMOnImport,
+			strict:             options.ExtractionConfig.Strict,
 			extractors:         options.ExtractionConfig.Extractors,
 		},
-		pull:            pullState{progress: pprog},
-		addressClient:   &http.Client{},
-		addressBaseURL:  postalCodeAPIBaseURL,
-		addressCountry:  options.AddressCountry,
-		addressAutofill: options.AddressAutofill,
-		styles:          appStyles,
-		tabs:            NewTabs(),
-		active:          0,
-		mode:            modeNormal,
-		keys:            newAppKeyMap(),
-		cur:             store.Currency(),
-		syncCfg:         options.syncCfg,
+		pull:                 pullState{progress: pprog},
+		addressClient:        &http.Client{},
+		addressBaseURL:       postalCodeAPIBaseURL,
+		addressCountry:       options.AddressCountry,
+		addressAutofill:      options.AddressAutofill,
+		maintenanceGraceDays: options.MaintenanceGraceDays,
+		snoozeDays:           options.SnoozeDays,
+		warrantyWindow:       options.WarrantyWindow,
+		intervalUnit:         options.IntervalUnit,
+		currencyRates:        options.CurrencyRates,
+		defaultPerformer:     options.DefaultPerformer,
+		reviewChanges:        options.ReviewChanges,
+		idleLock:             options.IdleLock,
+		noteLines:            options.NoteLines,
+		statusSegments:       options.StatusSegments,
+		compactStatus:        options.CompactStatus,
+		minWidth:             options.MinWidth,
+		minHeight:            options.MinHeight,
+		mouseDisabled:        options.DisableMouse,
+		lastActivity:         time.Now(),
+		display:              options.Display,
+		styles:               appStyles,
+		tabs:                 NewTabs(),
+		active:               0,
+		mode:                 modeNormal,
+		keys:                 newAppKeyMap(),
+		cur:                  store.Currency(),
+		syncCfg:              options.syncCfg,
 	}
 
 	if cfg := options.syncCfg; cfg != nil {
@@ -340,6 +436,12 @@ func NewModel(store *data.Store, options Options) (*Model, error) {
 	}
 	// Best-effort: fall back to locale detection if setting unreadable.
 	model.unitSystem, _ = store.GetUnitSystem()
+	if err := model.applyDefaultSorts(options.DefaultSorts); err != nil {
+		return nil, err
+	}
+	if err := model.applyFormFields(options.FormFields); err != nil {
+		return nil, err
+	}
 	if err := model.loadLookups(); err != nil {
 		return nil, err
 	}
@@ -349,6 +451,8 @@ func NewModel(store *data.Store, options Options) (*Model, error) {
 	if err := model.reloadAllTabs(); err != nil {
 		return nil, err
 	}
+	// Best-effort: fall back to computed widths if the setting is unreadable.
+	_ = model.loadColumnWidths()
 	if !model.hasHouse {
 		model.startHouseForm()
 	} else {
@@ -358,8 +462,16 @@ func NewModel(store *data.Store, options Options) (*Model, error) {
 		if show {
 			// Best-effort: start without dashboard on load failure.
 			_ = model.loadDashboard()
+		} else if options.DueSummary {
+			model.showDueSummary(time.Now())
 		}
 	}
+	if fsType, ok := store.NetworkFilesystem(); ok {
+		model.showNetworkFSWarning(fsType)
+	}
+	if options.HomeKey != "" {
+		model.keys.Home = homeKeyBinding(options.HomeKey)
+	}
 	appCancel = nil // prevent deferred cleanup; Model now owns the context
 	return model, nil
 }
@@ -380,12 +492,18 @@ func (m *Model) Init() tea.Cmd {
 		m.syncStatus = syncSyncing
 		cmds = append(cmds, doSync(m.syncCtx, m.syncEngine), syncTick())
 	}
+	if m.idleLock > 0 {
+		cmds = append(cmds, idleLockTick(m.idleLock))
+	}
 	return tea.Batch(cmds...)
 }
 
 func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	prevGen := m.syncDebounceGen
 	model, cmd := m.update(msg)
+	if next := m.advanceExtractionQueue(); next != nil {
+		cmd = tea.Batch(cmd, next)
+	}
 	if m.syncEngine != nil && m.syncDebounceGen != prevGen {
 		cmd = tea.Batch(cmd, syncDebounce(m.syncDebounceGen))
 	}
@@ -395,12 +513,20 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 func (m *Model) View() tea.View {
 	v := tea.NewView(m.zones.Scan(m.buildView()))
 	v.AltScreen = true
-	v.MouseMode = tea.MouseModeCellMotion
+	if !m.mouseDisabled {
+		v.MouseMode = tea.MouseModeCellMotion
+	}
 	return v
 }
 
 func (m *Model) enterNormalMode() {
 	m.mode = modeNormal
+	for i := range m.tabs {
+		m.tabs[i].Marked = nil
+	}
+	if dc := m.detail(); dc != nil {
+		dc.Tab.Marked = nil
+	}
 	m.setAllTableKeyMaps(normalTableKeyMap())
 }
 
@@ -486,6 +612,18 @@ func (m *Model) toggleUnitSystem() {
 	}
 }
 
+// toggleExactMoney switches table money cells between compact ("1.2k") and
+// full-precision amounts for the rest of the session.
+func (m *Model) toggleExactMoney() {
+	m.exactMoney = !m.exactMoney
+	if m.exactMoney {
+		m.setStatusInfo("money: exact")
+	} else {
+		m.setStatusInfo("money: compact")
+	}
+	m.resizeTables()
+}
+
 func (m *Model) toggleDashboard() {
 	m.showDashboard = !m.showDashboard
 	if m.showDashboard {
@@ -496,6 +634,51 @@ func (m *Model) toggleDashboard() {
 	}
 }
 
+// goHome handles the home key: it closes drilldowns and overlays, returns
+// to Nav mode, and shows the dashboard. It declines, leaving the key to
+// the focused widget, while something is being edited (a form, inline
+// input, house field, date picker, confirmation, or extraction review) and
+// while a text overlay has focus if the key would type a character there.
+func (m *Model) goHome(msg tea.KeyPressMsg) bool {
+	if m.mode == modeForm || m.inlineInput != nil || m.calendar != nil ||
+		m.confirm != confirmNone {
+		return false
+	}
+	if m.houseOverlay != nil && m.houseOverlay.editing {
+		return false
+	}
+	if ex := m.ex.extraction; ex != nil && ex.Visible {
+		return false
+	}
+	typing := (m.chat != nil && m.chat.Visible) || m.columnFinder != nil ||
+		m.viewsPicker != nil || m.docSearch != nil
+	if typing && msg.Text != "" {
+		return false
+	}
+
+	m.houseOverlay = nil
+	m.helpViewport = nil
+	m.notePreview = nil
+	m.opsTree = nil
+	m.closeColumnFinder()
+	m.recentPicker = nil
+	m.quoteCompare = nil
+	m.merge = nil
+	m.viewsPicker = nil
+	m.closeDocSearch()
+	m.hideChat()
+	m.closeAllDetails()
+	m.enterNormalMode()
+	m.resizeTables()
+
+	if m.showDashboard {
+		m.surfaceError(m.loadDashboard())
+	} else {
+		m.toggleDashboard()
+	}
+	return true
+}
+
 // navigateToLink closes any open drilldown stack, switches to the target tab,
 // and selects the row matching the FK.
 func (m *Model) navigateToLink(link *columnLink, targetID string) error {
@@ -507,7 +690,9 @@ func (m *Model) navigateToLink(link *columnLink, targetID string) error {
 	}
 	if !selectRowByID(tab, targetID) {
 		m.setStatusError(fmt.Sprintf("Linked item %s not found (deleted?).", targetID))
+		return nil
 	}
+	m.noteRecent()
 	return nil
 }
 
@@ -589,6 +774,26 @@ func (m *Model) toggleSettledFilter() bool {
 	return true
 }
 
+// toggleShowArchived shows or hides archived appliances on the Appliances
+// tab, and their maintenance on the Maintenance tab.
+func (m *Model) toggleShowArchived() bool {
+	if m.inDetail() {
+		return false
+	}
+	tab := m.activeTab()
+	if tab == nil || (tab.Kind != tabAppliances && tab.Kind != tabMaintenance) {
+		return false
+	}
+	tab.ShowArchived = !tab.ShowArchived
+	if tab.ShowArchived {
+		m.setStatusInfo("Archived shown.")
+	} else {
+		m.setStatusInfo("Archived hidden.")
+	}
+	m.surfaceError(m.reloadTab(tab))
+	return true
+}
+
 func (m *Model) selectedRowMeta() (rowMeta, bool) {
 	tab := m.effectiveTab()
 	if tab == nil || len(tab.Rows) == 0 {
@@ -629,6 +834,9 @@ func (m *Model) reloadTab(tab *Tab) error {
 	if err != nil {
 		return err
 	}
+	if !tab.ShowArchived {
+		rows, meta, cellRows = withoutArchived(rows, meta, cellRows)
+	}
 	// Store the full data set for pin-and-filter to operate on without
 	// re-querying the database.
 	tab.FullRows = rows
@@ -636,6 +844,13 @@ func (m *Model) reloadTab(tab *Tab) error {
 	tab.FullCellRows = cellRows
 	tab.Stale = false
 	m.refreshTable(tab)
+	if tab.Kind == tabDocuments {
+		storage, err := m.store.DocumentStorage()
+		if err != nil {
+			return err
+		}
+		m.docStorage = storage
+	}
 	return nil
 }
 
@@ -785,7 +1000,7 @@ func (m *Model) handlePullProgress(msg pullProgressMsg) tea.Cmd {
 			})
 			m.refreshChatViewport()
 		} else {
-			m.setStatusError(fmt.Sprintf("model pull: %s", msg.Err))
+			m.setStatusError(fmt.Sprintf("model pull: %s", humanizeError(msg.Err)))
 		}
 		m.resizeTables()
 		return nil
@@ -824,11 +1039,17 @@ func (m *Model) handlePullProgress(msg pullProgressMsg) tea.Cmd {
 			m.ex.pendingExtractionDocID = nil
 			doc, err := m.store.GetDocument(docID)
 			if err != nil {
-				m.setStatusError("load document for extraction: " + err.Error())
+				m.setStatusError("load document for extraction: " + humanizeError(err))
 			} else {
-				return m.startExtractionOverlay(
-					docID, doc.FileName, doc.Data, doc.MIMEType, doc.ExtractedText, doc.ExtractData,
-				)
+				cmd, _ := m.queueExtraction(queuedExtraction{
+					docID:         docID,
+					filename:      doc.FileName,
+					fileData:      doc.Data,
+					mime:          doc.MIMEType,
+					extractedText: doc.ExtractedText,
+					extractData:   doc.ExtractData,
+				})
+				return cmd
 			}
 		}
 		// Auto-rerun extraction if the overlay is open and waiting for a
@@ -901,11 +1122,15 @@ func (m *Model) extractionLLMClient() llm.ExtractionProvider {
 		if err != nil {
 			return nil
 		}
+		if m.ex.strict {
+			cc.SetSeed(strictExtractionSeed)
+		}
 		client = cc
 	}
 	if m.ex.extractionEffort != "" {
 		client.SetEffort(m.ex.extractionEffort)
 	}
+	client.SetContextTokens(m.ex.contextTokens)
 	m.ex.extractionClient = client
 	return client
 }
@@ -923,12 +1148,14 @@ func (m *Model) afterDocumentSave() tea.Cmd {
 	// Load metadata (no BLOB) to decide whether extraction is needed.
 	meta, err := m.store.GetDocumentMetadata(docID)
 	if err != nil {
-		m.setStatusError("load document for extraction: " + err.Error())
+		m.setStatusError("load document for extraction: " + humanizeError(err))
 		return nil
 	}
 
-	// Check if LLM extraction is configured and ready.
-	llmReady := m.ex.extractionEnabled && m.extractionLLMClient() != nil && m.ex.extractionReady
+	// Check if LLM extraction is configured and ready. Imports skip the
+	// LLM entirely when extraction.llm.on_import is off.
+	runLLM := !m.ex.skipLLMOnImport && m.ex.extractionEnabled && m.extractionLLMClient() != nil
+	llmReady := runLLM && m.ex.extractionReady
 
 	// Determine if async extraction is needed. Skip OCR when the
 	// document already has extracted text from a previous run.
@@ -940,7 +1167,7 @@ func (m *Model) afterDocumentSave() tea.Cmd {
 	// If nothing async is needed, bail early.
 	if !needsExtract && !llmReady {
 		// If LLM is configured but model not ready, queue for after pull.
-		if m.ex.extractionEnabled && m.extractionLLMClient() != nil && !m.ex.extractionReady {
+		if runLLM && !m.ex.extractionReady {
 			m.ex.pendingExtractionDocID = &docID
 			if !m.pull.active {
 				m.setStatusInfo("checking extraction model" + symEllipsis)
@@ -953,18 +1180,20 @@ func (m *Model) afterDocumentSave() tea.Cmd {
 	// Extraction needed -- load the full document with BLOB data.
 	doc, err := m.store.GetDocument(docID)
 	if err != nil {
-		m.setStatusError("load document for extraction: " + err.Error())
+		m.setStatusError("load document for extraction: " + humanizeError(err))
 		return nil
 	}
 
-	return m.startExtractionOverlay(
-		docID,
-		doc.FileName,
-		doc.Data,
-		doc.MIMEType,
-		doc.ExtractedText,
-		doc.ExtractData,
-	)
+	cmd, _ := m.queueExtraction(queuedExtraction{
+		docID:         docID,
+		filename:      doc.FileName,
+		fileData:      doc.Data,
+		mime:          doc.MIMEType,
+		extractedText: doc.ExtractedText,
+		extractData:   doc.ExtractData,
+		skipLLM:       m.ex.skipLLMOnImport,
+	})
+	return cmd
 }
 
 // cancelPull cancels any in-flight model pull and closes the HTTP body.
@@ -988,12 +1217,19 @@ func (m *Model) saveForm() tea.Cmd {
 	if fd, ok := m.fs.formData.(*documentFormData); ok && fd.DeferCreate {
 		return m.saveDeferredDocumentForm()
 	}
+	if m.reviewSave(true) {
+		return nil
+	}
+	return m.commitForm()
+}
 
+// commitForm saves the completed form and closes it.
+func (m *Model) commitForm() tea.Cmd {
 	isFirstHouse := m.fs.formKind() == formHouse && !m.hasHouse
 	kind := m.fs.formKind()
 	err := m.handleFormSubmit()
 	if err != nil {
-		m.setStatusError(err.Error())
+		m.setStatusError(humanizeError(err))
 		return nil
 	}
 	// Reload before exitForm so the new/updated row is in the table
@@ -1001,6 +1237,9 @@ func (m *Model) saveForm() tea.Cmd {
 	m.reloadAfterFormSave(kind)
 	cmd := m.afterDocumentSaveIfNeeded(kind)
 	m.exitForm()
+	if m.saveWarning != "" {
+		m.setStatusSaved()
+	}
 	if isFirstHouse {
 		m.setStatusInfo("House set up. Press b/f to switch tabs, i to edit, ? for help.")
 	}
@@ -1018,11 +1257,19 @@ func (m *Model) saveFormInPlace() tea.Cmd {
 	if fd, ok := m.fs.formData.(*documentFormData); ok && fd.DeferCreate {
 		return m.saveQuickDocumentDirect()
 	}
+	if m.reviewSave(false) {
+		return nil
+	}
+	return m.commitFormInPlace()
+}
+
+// commitFormInPlace saves the form and leaves it open.
+func (m *Model) commitFormInPlace() tea.Cmd {
 	kind := m.fs.formKind()
 	isCreate := m.fs.editID == nil
 	err := m.handleFormSubmit()
 	if err != nil {
-		m.setStatusError(err.Error())
+		m.setStatusError(humanizeError(err))
 		return nil
 	}
 	m.setStatusSaved()
@@ -1044,12 +1291,12 @@ func (m *Model) saveFormInPlace() tea.Cmd {
 func (m *Model) saveQuickDocumentDirect() tea.Cmd {
 	result, err := m.parseDocumentFormData()
 	if err != nil {
-		m.setStatusError(err.Error())
+		m.setStatusError(humanizeError(err))
 		return nil
 	}
 	doc := result.Doc
 	if err := m.store.CreateDocument(&doc); err != nil {
-		m.setStatusError(err.Error())
+		m.setStatusError(humanizeError(err))
 		return nil
 	}
 	m.reloadAfterMutation()
@@ -1074,36 +1321,53 @@ func (m *Model) afterDocumentSaveIfNeeded(kind FormKind) tea.Cmd {
 func (m *Model) saveDeferredDocumentForm() tea.Cmd {
 	result, err := m.parseDocumentFormData()
 	if err != nil {
-		m.setStatusError(err.Error())
+		m.setStatusError(humanizeError(err))
 		return nil
 	}
-	doc := result.Doc
 	m.exitForm()
-
-	cmd := m.startExtractionOverlay(
-		"", // no DB ID yet
-		doc.FileName,
-		doc.Data,
-		doc.MIMEType,
-		doc.ExtractedText,
-		doc.ExtractData,
-	)
-	if cmd == nil {
-		// No extraction steps needed. Create the document immediately.
-		if err := m.store.CreateDocument(&doc); err != nil {
-			m.setStatusError(err.Error())
-			return nil
-		}
-		m.reloadAfterMutation()
+	cmd, _, err := m.extractDeferredDocument(result.Doc)
+	if err != nil {
+		m.setStatusError(humanizeError(err))
 		return nil
 	}
-	m.ex.extraction.pendingDoc = &doc
-	if result.ExtractErr != nil {
+	if cmd != nil && result.ExtractErr != nil {
 		m.setStatusInfo(fmt.Sprintf("extraction incomplete: %s", result.ExtractErr))
 	}
 	return cmd
 }
 
+// extractDeferredDocument opens the extraction overlay for an unsaved
+// document, which is created when the user accepts the results. While
+// another extraction is open the document waits in the queue and the
+// returned command is nil. With no extraction steps to run the document is
+// created right away and extracting is false.
+func (m *Model) extractDeferredDocument(doc data.Document) (cmd tea.Cmd, extracting bool, err error) {
+	cmd, extracting = m.queueExtraction(queuedExtraction{
+		filename:      doc.FileName,
+		fileData:      doc.Data,
+		mime:          doc.MIMEType,
+		extractedText: doc.ExtractedText,
+		extractData:   doc.ExtractData,
+		pendingDoc:    &doc,
+		skipLLM:       m.ex.skipLLMOnImport,
+	})
+	if !extracting {
+		if err := m.createDeferredDocument(&doc); err != nil {
+			return nil, false, err
+		}
+	}
+	return cmd, extracting, nil
+}
+
+// createDeferredDocument saves a document that skipped extraction.
+func (m *Model) createDeferredDocument(doc *data.Document) error {
+	if err := m.store.CreateDocument(doc); err != nil {
+		return err
+	}
+	m.reloadAfterMutation()
+	return nil
+}
+
 // reloadAfterFormSave picks the minimal reload strategy based on which
 // form was just saved. House and vendor mutations need broader refreshes;
 // everything else uses the targeted reload.
@@ -1204,14 +1468,14 @@ func (m *Model) submitInlineInput() {
 	value := ii.Input.Value()
 	if ii.Validate != nil {
 		if err := ii.Validate(value); err != nil {
-			m.setStatusError(err.Error())
+			m.setStatusError(humanizeError(err))
 			return
 		}
 	}
 	*ii.FieldPtr = value
 	kind := ii.FormData.formKind()
 	if err := m.handleFormSubmit(); err != nil {
-		m.setStatusError(err.Error())
+		m.setStatusError(humanizeError(err))
 		return
 	}
 	m.closeInlineInput()
@@ -1236,6 +1500,7 @@ func (m *Model) resetFormState() {
 	m.fs.lastPostalCode = ""
 	m.fs.autoFilledCity = ""
 	m.fs.autoFilledState = ""
+	m.fs.links = nil
 	if m.confirm.isFormConfirm() {
 		m.confirm = confirmNone
 	}
@@ -1250,6 +1515,10 @@ func (m *Model) closeInlineInput() {
 // set (item was saved at least once), the cursor moves to that row so the
 // user lands on the item they were just editing/creating.
 func (m *Model) exitForm() {
+	if len(m.formStack) > 0 {
+		m.closeLinkedForm()
+		return
+	}
 	savedID := m.fs.editID
 	m.mode = m.prevMode
 	// Restore correct table key bindings for the returning mode.
@@ -1259,6 +1528,9 @@ func (m *Model) exitForm() {
 		m.setAllTableKeyMaps(normalTableKeyMap())
 	}
 	m.resetFormState()
+	if m.recurProjectID != "" {
+		m.confirm = confirmScheduleNext
+	}
 	if savedID != nil {
 		if tab := m.effectiveTab(); tab != nil {
 			selectRowByID(tab, *savedID)
@@ -1331,8 +1603,22 @@ func (m *Model) scrollRule(
 	return m.styles.Rule().Render(strings.Repeat(ch, rightW)) + indicator
 }
 
+// minTerminalSize returns the configured minimum terminal size, falling
+// back to minUsableWidth x minUsableHeight.
+func (m *Model) minTerminalSize() (width, height int) {
+	width, height = minUsableWidth, minUsableHeight
+	if m.minWidth > 0 {
+		width = m.minWidth
+	}
+	if m.minHeight > 0 {
+		height = m.minHeight
+	}
+	return width, height
+}
+
 func (m *Model) terminalTooSmall() bool {
-	return m.effectiveWidth() < minUsableWidth || m.effectiveHeight() < minUsableHeight
+	minW, minH := m.minTerminalSize()
+	return m.effectiveWidth() < minW || m.effectiveHeight() < minH
 }
 
 // overlay unifies dispatch for UI surfaces that capture keyboard input when
@@ -1406,6 +1692,46 @@ func (o columnFinderOverlay) handleKey(key tea.KeyPressMsg) tea.Cmd {
 }
 func (o columnFinderOverlay) hidesMainKeys() bool { return true }
 
+type recentPickerOverlay struct{ m *Model }
+
+func (o recentPickerOverlay) isVisible() bool { return o.m.recentPicker != nil }
+func (o recentPickerOverlay) handleKey(key tea.KeyPressMsg) tea.Cmd {
+	return o.m.handleRecentPickerKey(key)
+}
+func (o recentPickerOverlay) hidesMainKeys() bool { return true }
+
+type quoteCompareOverlay struct{ m *Model }
+
+func (o quoteCompareOverlay) isVisible() bool { return o.m.quoteCompare != nil }
+func (o quoteCompareOverlay) handleKey(key tea.KeyPressMsg) tea.Cmd {
+	return o.m.handleQuoteCompareKey(key)
+}
+func (o quoteCompareOverlay) hidesMainKeys() bool { return true }
+
+type mergeOverlay struct{ m *Model }
+
+func (o mergeOverlay) isVisible() bool { return o.m.merge != nil }
+func (o mergeOverlay) handleKey(key tea.KeyPressMsg) tea.Cmd {
+	return o.m.handleMergeKey(key)
+}
+func (o mergeOverlay) hidesMainKeys() bool { return true }
+
+type saveReviewOverlay struct{ m *Model }
+
+func (o saveReviewOverlay) isVisible() bool { return o.m.saveReview != nil }
+func (o saveReviewOverlay) handleKey(key tea.KeyPressMsg) tea.Cmd {
+	return o.m.handleSaveReviewKey(key)
+}
+func (o saveReviewOverlay) hidesMainKeys() bool { return true }
+
+type viewsPickerOverlay struct{ m *Model }
+
+func (o viewsPickerOverlay) isVisible() bool { return o.m.viewsPicker != nil }
+func (o viewsPickerOverlay) handleKey(key tea.KeyPressMsg) tea.Cmd {
+	return o.m.handleViewsPickerKey(key)
+}
+func (o viewsPickerOverlay) hidesMainKeys() bool { return true }
+
 type docSearchOverlay struct{ m *Model }
 
 func (o docSearchOverlay) isVisible() bool { return o.m.docSearch != nil }
@@ -1438,6 +1764,11 @@ func (m *Model) overlays() []overlay {
 		opsTreeOverlay{m},
 		calendarOverlay{m},
 		columnFinderOverlay{m},
+		recentPickerOverlay{m},
+		quoteCompareOverlay{m},
+		mergeOverlay{m},
+		saveReviewOverlay{m},
+		viewsPickerOverlay{m},
 		docSearchOverlay{m},
 		inlineInputOverlay{m},
 	}
@@ -1730,7 +2061,7 @@ func (m *Model) tabViewport(tab *Tab) tableViewport {
 		return *tab.cachedVP
 	}
 	normalSep := m.styles.TableSeparator().Render(" │ ")
-	vp := computeTableViewport(tab, m.effectiveWidth(), normalSep, m.cur.Symbol())
+	vp := computeTableViewport(tab, m.effectiveWidth(), normalSep, m.cur.Symbol(), m.noteLines)
 	tab.cachedVP = &vp
 	return vp
 }
@@ -1801,7 +2132,7 @@ func editorBinary() (string, []string, error) {
 func (m *Model) launchExternalEditor() tea.Cmd {
 	editor, editorArgs, err := editorBinary()
 	if err != nil {
-		m.setStatusError(err.Error())
+		m.setStatusError(humanizeError(err))
 		return nil
 	}
 	if m.fs.notesFieldPtr == nil {
@@ -1810,13 +2141,13 @@ func (m *Model) launchExternalEditor() tea.Cmd {
 
 	f, err := os.CreateTemp("", "micasa-notes-*.txt")
 	if err != nil {
-		m.setStatusError(fmt.Sprintf("create temp file: %s", err))
+		m.setStatusError(fmt.Sprintf("create temp file: %s", humanizeError(err)))
 		return nil
 	}
 	if _, err := f.WriteString(*m.fs.notesFieldPtr); err != nil {
 		_ = f.Close()
 		_ = os.Remove(f.Name())
-		m.setStatusError(fmt.Sprintf("write temp file: %s", err))
+		m.setStatusError(fmt.Sprintf("write temp file: %s", humanizeError(err)))
 		return nil
 	}
 	_ = f.Close()
@@ -1856,7 +2187,7 @@ func (m *Model) handleEditorFinished(msg editorFinishedMsg) tea.Cmd {
 	defer func() { _ = os.Remove(pe.TempFile) }()
 
 	if msg.Err != nil {
-		m.setStatusError(fmt.Sprintf("editor: %s", msg.Err))
+		m.setStatusError(fmt.Sprintf("editor: %s", humanizeError(msg.Err)))
 		// Reopen textarea with the original text so the user can retry.
 		m.reopenNotesEdit(pe)
 		return m.formInitCmd()
@@ -1864,7 +2195,7 @@ func (m *Model) handleEditorFinished(msg editorFinishedMsg) tea.Cmd {
 
 	content, err := os.ReadFile(pe.TempFile)
 	if err != nil {
-		m.setStatusError(fmt.Sprintf("read temp file: %s", err))
+		m.setStatusError(fmt.Sprintf("read temp file: %s", humanizeError(err)))
 		m.reopenNotesEdit(pe)
 		return m.formInitCmd()
 	}
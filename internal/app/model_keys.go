@@ -11,6 +11,7 @@ import (
 	"time"
 	"unicode"
 
+	"github.com/micasa-dev/micasa/internal/llm"
 	"github.com/rivo/uniseg"
 
 	"charm.land/bubbles/v2/key"
@@ -59,7 +60,7 @@ func (m *Model) handleDashboardKeys(msg tea.KeyPressMsg) bool {
 	case key.Matches(msg, m.keys.ColLeft, m.keys.ColRight):
 		// Block column movement on dashboard.
 		return true
-	case key.Matches(msg, m.keys.Sort, m.keys.SortClear, m.keys.ColHide, m.keys.ColShowAll, m.keys.EnterEditMode, m.keys.ColFinder, m.keys.FilterPin, m.keys.FilterToggle, m.keys.FilterNegate, m.keys.YankCell):
+	case key.Matches(msg, m.keys.Sort, m.keys.SortClear, m.keys.ColHide, m.keys.ColShowAll, m.keys.EnterEditMode, m.keys.ColFinder, m.keys.Views, m.keys.FilterPin, m.keys.FilterToggle, m.keys.FilterNegate, m.keys.YankCell, m.keys.YankView):
 		// Block table-specific keys on dashboard.
 		return true
 	}
@@ -207,8 +208,11 @@ func (m *Model) handleNormalKeys(msg tea.KeyPressMsg) (tea.Cmd, bool) {
 	case key.Matches(msg, m.keys.ToggleUnits):
 		m.toggleUnitSystem()
 		return nil, true
+	case key.Matches(msg, m.keys.ToggleMoney):
+		m.toggleExactMoney()
+		return nil, true
 	case key.Matches(msg, m.keys.ToggleSettled):
-		if m.toggleSettledFilter() {
+		if m.toggleSettledFilter() || m.toggleShowArchived() {
 			return nil, true
 		}
 	case key.Matches(msg, m.keys.ColHide):
@@ -220,6 +224,26 @@ func (m *Model) handleNormalKeys(msg tea.KeyPressMsg) (tea.Cmd, bool) {
 	case key.Matches(msg, m.keys.ColFinder):
 		m.openColumnFinder()
 		return nil, true
+	case key.Matches(msg, m.keys.ColNarrow):
+		m.resizeColumn(-colWidthStep)
+		return nil, true
+	case key.Matches(msg, m.keys.ColWiden):
+		m.resizeColumn(colWidthStep)
+		return nil, true
+	case key.Matches(msg, m.keys.ColWidthReset):
+		m.resetColumnWidth()
+		return nil, true
+	case key.Matches(msg, m.keys.Recent):
+		m.openRecentPicker()
+		return nil, true
+	case key.Matches(msg, m.keys.QuoteCompare):
+		if err := m.openQuoteCompare(); err != nil {
+			m.setStatusError(humanizeError(err))
+		}
+		return nil, true
+	case key.Matches(msg, m.keys.Views):
+		m.openViewsPicker()
+		return nil, true
 	case key.Matches(msg, m.keys.DocSearch):
 		if m.effectiveTab().isDocumentTab() {
 			return m.openDocSearch(), true
@@ -233,7 +257,7 @@ func (m *Model) handleNormalKeys(msg tea.KeyPressMsg) (tea.Cmd, bool) {
 		return nil, true
 	case key.Matches(msg, m.keys.Enter):
 		if err := m.handleNormalEnter(); err != nil {
-			m.setStatusError(err.Error())
+			m.setStatusError(humanizeError(err))
 			return nil, true
 		}
 		if m.mode == modeForm {
@@ -282,6 +306,8 @@ func (m *Model) handleNormalKeys(msg tea.KeyPressMsg) (tea.Cmd, bool) {
 			Kind: statusStyled,
 		}
 		return tea.SetClipboard(clipValue), true
+	case key.Matches(msg, m.keys.YankView):
+		return m.yankView(), true
 	case key.Matches(msg, m.keys.Escape):
 		if m.inDetail() {
 			m.closeDetail()
@@ -293,6 +319,49 @@ func (m *Model) handleNormalKeys(msg tea.KeyPressMsg) (tea.Cmd, bool) {
 	return nil, false
 }
 
+// yankView copies the table as it is on screen -- visible columns, rows
+// after pins and sorts -- as a pipe-delimited text table. Rows the pin
+// preview dims and deleted rows are left out, as in the money footer.
+func (m *Model) yankView() tea.Cmd {
+	tab := m.effectiveTab()
+	if tab == nil {
+		m.setStatusInfo("Nothing to copy.")
+		return nil
+	}
+	var cols []int
+	var headers []string
+	for i, spec := range tab.Specs {
+		if spec.HideOrder > 0 {
+			continue
+		}
+		cols = append(cols, i)
+		headers = append(headers, spec.Title)
+	}
+	rows := make([][]string, 0, len(tab.CellRows))
+	for i, cellRow := range tab.CellRows {
+		if i < len(tab.Rows) && (tab.Rows[i].Deleted || tab.Rows[i].Dimmed) {
+			continue
+		}
+		row := make([]string, len(cols))
+		for j, col := range cols {
+			if col < len(cellRow) && !cellRow[col].Null {
+				row[j] = cellRow[col].Value
+			}
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) == 0 {
+		m.setStatusInfo("Nothing to copy.")
+		return nil
+	}
+	label := fmt.Sprintf("%d rows", len(rows))
+	if len(rows) == 1 {
+		label = "1 row"
+	}
+	m.setStatusInfo(fmt.Sprintf("Copied %s of %s.", label, tab.Name))
+	return tea.SetClipboard(llm.FormatResultsTable(headers, rows))
+}
+
 // opsJSON holds the result of fetching extraction-ops JSON for clipboard copy.
 type opsJSON struct {
 	data   string // pretty-printed or raw JSON
@@ -322,7 +391,7 @@ func yankStyle(kind cellKind) lipgloss.Style {
 	switch kind {
 	case cellMoney:
 		return appStyles.Money()
-	case cellReadonly:
+	case cellReadonly, cellNumber:
 		return appStyles.Readonly()
 	case cellDate, cellWarranty, cellUrgency, cellDrilldown, cellOps:
 		return appStyles.AccentText()
@@ -330,7 +399,7 @@ func yankStyle(kind cellKind) lipgloss.Style {
 		return appStyles.SecondaryText()
 	case cellEntity:
 		return appStyles.SecondaryText()
-	case cellText, cellNotes, cellTelephoneNumber:
+	case cellText, cellNotes, cellTelephoneNumber, cellDuration:
 		return appStyles.DashValue()
 	}
 	return appStyles.DashValue()
@@ -462,20 +531,26 @@ func (m *Model) handleEditKeys(msg tea.KeyPressMsg) (tea.Cmd, bool) {
 		m.startAddForm()
 		return m.formInitCmd(), true
 	case key.Matches(msg, m.keys.QuickAdd):
-		if tab := m.effectiveTab(); tab != nil && tab.Kind == tabDocuments {
+		if m.canImportDocument() {
 			m.startQuickDocumentForm()
 			return m.formInitCmd(), true
 		}
 		return nil, false
+	case key.Matches(msg, m.keys.PasteImage):
+		if m.canImportDocument() {
+			m.setStatusInfo("Reading clipboard...")
+			return readClipboardImageCmd(), true
+		}
+		return nil, false
 	case key.Matches(msg, m.keys.EditCell):
 		if err := m.startCellOrFormEdit(); err != nil {
-			m.setStatusError(err.Error())
+			m.setStatusError(humanizeError(err))
 			return nil, true
 		}
 		return m.formInitCmd(), true
 	case key.Matches(msg, m.keys.EditFull):
 		if err := m.startEditForm(); err != nil {
-			m.setStatusError(err.Error())
+			m.setStatusError(humanizeError(err))
 			return nil, true
 		}
 		return m.formInitCmd(), true
@@ -485,6 +560,44 @@ func (m *Model) handleEditKeys(msg tea.KeyPressMsg) (tea.Cmd, bool) {
 	case key.Matches(msg, m.keys.HardDelete):
 		m.promptHardDelete()
 		return nil, true
+	case key.Matches(msg, m.keys.Archive) && m.effectiveTab().isApplianceTab():
+		m.toggleArchiveSelected(time.Now())
+		return nil, true
+	case key.Matches(msg, m.keys.Snooze):
+		if m.effectiveTab().isMaintenanceTab() {
+			m.toggleSnoozeSelected(time.Now())
+			return nil, true
+		}
+		return nil, false
+	case key.Matches(msg, m.keys.Mark):
+		if tab := m.effectiveTab(); tab.isProjectTab() || tab.isApplianceTab() {
+			m.toggleMarkSelected()
+			return nil, true
+		}
+		return nil, false
+	case key.Matches(msg, m.keys.Complete):
+		if m.effectiveTab().isProjectTab() {
+			m.promptCompleteProjects()
+			return nil, true
+		}
+		return nil, false
+	case key.Matches(msg, m.keys.Merge):
+		if tab := m.effectiveTab(); !tab.isVendorTab() && !tab.isApplianceTab() {
+			return nil, false
+		}
+		if err := m.openMerge(); err != nil {
+			m.setStatusError(humanizeError(err))
+		}
+		return nil, true
+	case key.Matches(msg, m.keys.CloneLast):
+		if !m.effectiveTab().isServiceLogTab() {
+			return nil, false
+		}
+		if err := m.startCloneServiceLogForm(); err != nil {
+			m.setStatusError(humanizeError(err))
+			return nil, true
+		}
+		return m.formInitCmd(), true
 	case key.Matches(msg, m.keys.DocOpen):
 		if cmd := m.openSelectedDocument(); cmd != nil {
 			return cmd, true
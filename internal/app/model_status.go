@@ -6,11 +6,29 @@ package app
 import (
 	"errors"
 	"fmt"
+	"log/slog"
+	"time"
 
 	"charm.land/bubbles/v2/key"
 	tea "charm.land/bubbletea/v2"
 )
 
+// quit cancels all in-flight background work and returns the quit command.
+// Every exit path goes through here so a confirmed discard-and-quit tears
+// down the same state as a plain ctrl+q.
+func (m *Model) quit() tea.Cmd {
+	if m.appCancel != nil {
+		m.appCancel()
+	}
+	m.cancelChatOperations()
+	m.cancelAllExtractions()
+	m.cancelPull()
+	if m.syncCancel != nil {
+		m.syncCancel()
+	}
+	return tea.Quit
+}
+
 // handleConfirmDiscard processes keys while the "discard unsaved changes?"
 // prompt is active. Only y (discard) and n/esc (keep editing) are recognized.
 func (m *Model) handleConfirmDiscard(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
@@ -18,12 +36,7 @@ func (m *Model) handleConfirmDiscard(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 	case key.Matches(msg, m.keys.ConfirmYes):
 		if m.confirm == confirmFormQuitDiscard {
 			m.confirm = confirmNone
-			if m.appCancel != nil {
-				m.appCancel()
-			}
-			m.cancelChatOperations()
-			m.cancelPull()
-			return m, tea.Quit
+			return m, m.quit()
 		}
 		m.confirm = confirmNone
 		m.exitForm()
@@ -39,7 +52,7 @@ func (m *Model) startAddForm() {
 		return
 	}
 	if err := tab.Handler.StartAddForm(m); err != nil {
-		m.setStatusError(err.Error())
+		m.setStatusError(humanizeError(err))
 	}
 }
 
@@ -83,7 +96,8 @@ func (m *Model) startCellOrFormEdit() error {
 		}
 	}
 
-	if spec.Kind == cellReadonly || spec.Kind == cellDrilldown || spec.Kind == cellOps {
+	if spec.Kind == cellReadonly || spec.Kind == cellNumber ||
+		spec.Kind == cellDrilldown || spec.Kind == cellOps {
 		return m.startEditForm()
 	}
 	return tab.Handler.InlineEdit(m, meta.ID, col)
@@ -101,7 +115,7 @@ func (m *Model) toggleDeleteSelected() {
 	}
 	if meta.Deleted {
 		if err := tab.Handler.Restore(m.store, meta.ID); err != nil {
-			m.setStatusError(err.Error())
+			m.setStatusError(humanizeError(err))
 			return
 		}
 		if tab.LastDeleted != nil && *tab.LastDeleted == meta.ID {
@@ -116,7 +130,7 @@ func (m *Model) toggleDeleteSelected() {
 		return
 	}
 	if err := tab.Handler.Delete(m.store, meta.ID); err != nil {
-		m.setStatusError(err.Error())
+		m.setStatusError(humanizeError(err))
 		return
 	}
 	tab.LastDeleted = &meta.ID
@@ -131,6 +145,119 @@ func (m *Model) toggleDeleteSelected() {
 	m.surfaceError(m.reloadEffectiveTab())
 }
 
+// isMaintenanceTab reports whether this tab lists maintenance items,
+// covering the top-level tab and the appliance maintenance sub-tab.
+func (t *Tab) isMaintenanceTab() bool {
+	return t != nil && t.Handler != nil && t.Handler.FormKind() == formMaintenance
+}
+
+func (t *Tab) isServiceLogTab() bool {
+	return t != nil && t.Handler != nil && t.Handler.FormKind() == formServiceLog
+}
+
+// showDueSummary greets the user with what needs attention, so opening the
+// app is a reminder even with the dashboard hidden. Best-effort: a failed
+// query just skips the message.
+func (m *Model) showDueSummary(now time.Time) {
+	sum, err := m.store.DueSummary(
+		now, m.maintenanceGraceDays, m.effectiveWarrantyWindow().LookAheadDays,
+	)
+	if err != nil || sum.IsZero() {
+		return
+	}
+	m.setStatusInfo("Needs attention: " + sum.String() + ".")
+}
+
+// showNetworkFSWarning warns that the database is on a network filesystem
+// (fsType, e.g. "nfs"), where SQLite's locking can't be trusted and two
+// machines writing at once can corrupt it.
+func (m *Model) showNetworkFSWarning(fsType string) {
+	m.setStatusError(
+		"Database on a network share (" + fsType +
+			") -- locking may be unreliable; consider a local path.",
+	)
+}
+
+// defaultSnoozeDays is the snooze length when none was configured; it
+// matches the dashboard.snooze_days default.
+const defaultSnoozeDays = 7
+
+// toggleSnoozeSelected snoozes the selected maintenance item for the
+// configured number of days, hiding it from the dashboard, or clears the
+// snooze when one is still running.
+func (m *Model) toggleSnoozeSelected(now time.Time) {
+	meta, ok := m.selectedRowMeta()
+	if !ok {
+		m.setStatusError("Nothing selected.")
+		return
+	}
+	if meta.Deleted {
+		m.setStatusError("Restore the item before snoozing it.")
+		return
+	}
+	item, err := m.store.GetMaintenance(meta.ID)
+	if err != nil {
+		m.setStatusError(humanizeError(err))
+		return
+	}
+
+	var until *time.Time
+	if !isSnoozed(now, item) {
+		days := m.snoozeDays
+		if days <= 0 {
+			days = defaultSnoozeDays
+		}
+		t := time.Date(now.Year(), now.Month(), now.Day()+days, 0, 0, 0, 0, time.UTC)
+		until = &t
+	}
+	if err := m.store.SnoozeMaintenance(item.ID, until); err != nil {
+		m.setStatusError(humanizeError(err))
+		return
+	}
+	if until != nil {
+		m.setStatusInfo(fmt.Sprintf("Snoozed %s until %s.", item.Name, m.display.Date(*until)))
+	} else {
+		m.setStatusInfo(fmt.Sprintf("Unsnoozed %s.", item.Name))
+	}
+	m.surfaceError(m.reloadEffectiveTab())
+}
+
+// toggleArchiveSelected archives the selected appliance as of today, or
+// unarchives it if it's already archived. Archived appliances and their
+// maintenance drop off the dashboard and out of the default table views.
+func (m *Model) toggleArchiveSelected(now time.Time) {
+	meta, ok := m.selectedRowMeta()
+	if !ok {
+		m.setStatusError("Nothing selected.")
+		return
+	}
+	if meta.Deleted {
+		m.setStatusError("Restore the appliance before archiving it.")
+		return
+	}
+	item, err := m.store.GetAppliance(meta.ID)
+	if err != nil {
+		m.setStatusError(humanizeError(err))
+		return
+	}
+
+	var at *time.Time
+	if item.ArchivedAt == nil {
+		t := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		at = &t
+	}
+	if err := m.store.ArchiveAppliance(item.ID, at); err != nil {
+		m.setStatusError(humanizeError(err))
+		return
+	}
+	if at != nil {
+		m.setStatusInfo(fmt.Sprintf("Archived %s.", item.Name))
+	} else {
+		m.setStatusInfo(fmt.Sprintf("Unarchived %s.", item.Name))
+	}
+	m.reloadAfterMutation()
+}
+
 func (m *Model) promptHardDelete() {
 	tab := m.effectiveTab()
 	if tab == nil {
@@ -172,7 +299,7 @@ func (m *Model) handleConfirmHardDelete(msg tea.KeyPressMsg) {
 			err = m.store.HardDeleteIncident(m.hardDeleteID)
 		}
 		if err != nil {
-			m.setStatusError(err.Error())
+			m.setStatusError(humanizeError(err))
 			return
 		}
 		m.setStatusInfo("Permanently deleted.")
@@ -186,18 +313,28 @@ func (m *Model) setStatusInfo(text string) {
 	m.status = statusMsg{Text: text, Kind: statusInfo}
 }
 
+// setStatusSaved confirms a save, appending any pending saveWarning.
 func (m *Model) setStatusSaved() {
+	if m.saveWarning != "" {
+		m.setStatusInfo("Saved. " + m.saveWarning)
+		m.saveWarning = ""
+		return
+	}
 	m.setStatusInfo("Saved.")
 }
 
+// setStatusError shows an error in the status bar and logs it at debug
+// level, so errors seen in the TUI reach --json-logs without the default
+// logger printing over the screen.
 func (m *Model) setStatusError(text string) {
 	m.status = statusMsg{Text: text, Kind: statusError}
+	slog.Debug("status error", "message", text)
 }
 
 // surfaceError shows a reload failure in the status bar. Used in
 // fire-and-forget reload paths where the caller cannot return an error.
 func (m *Model) surfaceError(err error) {
 	if err != nil {
-		m.setStatusError(err.Error())
+		m.setStatusError(humanizeError(err))
 	}
 }
@@ -374,7 +374,7 @@ func (m *Model) closeDetail() {
 
 	// When closing a mutated service log detail, move the column cursor
 	// to the "Last" column so the user sees the synced date.
-	if top.Mutated {
+	if top.Mutated && m.store != nil && m.store.LastServicedSync() {
 		if _, ok := top.Tab.Handler.(serviceLogHandler); ok {
 			if tab := m.effectiveTab(); tab != nil && tab.Kind == tabMaintenance {
 				tab.ColCursor = int(maintenanceColLast)
@@ -427,6 +427,7 @@ func (m *Model) reloadDetailTab() error {
 // switchToTab sets the active tab index, reloads it (lazy if stale), and
 // clears the status message. Centralizes the reload-after-switch pattern.
 func (m *Model) switchToTab(idx int) {
+	m.noteRecent()
 	m.active = idx
 	m.status = statusMsg{}
 	tab := m.activeTab()
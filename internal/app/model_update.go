@@ -5,6 +5,7 @@ package app
 
 import (
 	"fmt"
+	"time"
 
 	"charm.land/bubbles/v2/key"
 	"charm.land/bubbles/v2/spinner"
@@ -25,22 +26,19 @@ func (m *Model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.height = typed.Height
 		m.resizeTables()
 		m.updateAllViewports()
+	case idleLockMsg:
+		return m, m.checkIdleLock()
 	case tea.KeyPressMsg:
+		if m.locked {
+			return m, m.unlockIdle()
+		}
+		m.noteActivity()
 		if key.Matches(typed, m.keys.Quit) {
 			if m.mode == modeForm && m.fs.formDirty {
 				m.confirm = confirmFormQuitDiscard
 				return m, nil
 			}
-			if m.appCancel != nil {
-				m.appCancel()
-			}
-			m.cancelChatOperations()
-			m.cancelAllExtractions()
-			m.cancelPull()
-			if m.syncCancel != nil {
-				m.syncCancel()
-			}
-			return m, tea.Quit
+			return m, m.quit()
 		}
 		if key.Matches(typed, m.keys.Cancel) {
 			// When the extraction overlay is open and running,
@@ -56,6 +54,9 @@ func (m *Model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.cancelAllExtractions()
 			return m, nil
 		}
+		if key.Matches(typed, m.keys.Home) && m.goHome(typed) {
+			return m, nil
+		}
 	case chatChunkMsg:
 		// Chunks arriving after chat is closed are harmlessly dropped.
 		if m.chat != nil {
@@ -126,12 +127,22 @@ func (m *Model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, tea.Batch(cmds...)
 	case tea.MouseClickMsg:
+		if m.locked {
+			return m, nil
+		}
+		m.noteActivity()
 		return m.handleMouseClick(typed)
 	case tea.MouseWheelMsg:
+		if m.locked {
+			return m, nil
+		}
+		m.noteActivity()
 		return m.handleMouseWheel(typed)
+	case clipboardImageMsg:
+		return m, m.handleClipboardImage(typed)
 	case openFileResultMsg:
 		if typed.Err != nil {
-			m.setStatusError(fmt.Sprintf("open: %s", typed.Err))
+			m.setStatusError(fmt.Sprintf("open: %s", humanizeError(typed.Err)))
 		}
 		return m, nil
 	case syncDoneMsg:
@@ -153,7 +164,7 @@ func (m *Model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 	case syncErrorMsg:
 		m.syncStatus = syncOffline
-		m.setStatusError(fmt.Sprintf("sync: %s", typed.Err))
+		m.setStatusError(fmt.Sprintf("sync: %s", humanizeError(typed.Err)))
 		return m, nil
 	case syncTickMsg:
 		if m.syncEngine == nil || m.syncStatus == syncSyncing {
@@ -169,7 +180,7 @@ func (m *Model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, doSync(m.syncCtx, m.syncEngine)
 	case postalCodeLookupMsg:
 		if typed.Err != nil {
-			m.setStatusError(fmt.Sprintf("postal code lookup: %v", typed.Err))
+			m.setStatusError(fmt.Sprintf("postal code lookup: %s", humanizeError(typed.Err)))
 			return m, nil
 		}
 		values, ok := m.fs.formData.(*houseFormData)
@@ -242,6 +253,17 @@ func (m *Model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.handleConfirmHardDelete(typed)
 			return m, nil
 		}
+		if m.confirm == confirmScheduleNext {
+			m.handleConfirmScheduleNext(typed, time.Now())
+			return m, nil
+		}
+		if m.confirm == confirmCompleteProjects {
+			m.handleConfirmCompleteProjects(typed, time.Now())
+			return m, nil
+		}
+		if m.confirm == confirmUndoComplete && m.handleUndoCompletePrompt(typed) {
+			return m, nil
+		}
 		// Dashboard intercepts nav keys before other handlers.
 		if m.dashboardVisible() {
 			if m.handleDashboardKeys(typed) {
@@ -273,11 +295,43 @@ func (m *Model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	if tab == nil {
 		return m, nil
 	}
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok && m.pageTable(tab, keyMsg) {
+		return m, nil
+	}
 	var cmd tea.Cmd
 	tab.Table, cmd = tab.Table.Update(msg)
 	return m, cmd
 }
 
+// pageTable handles page and half-page keys itself rather than letting the
+// table widget move by its full allotted height, which counts chrome lines
+// and so jumps past rows that were never shown. The cursor moves by the
+// rendered body height, and the column viewport is re-synced so the active
+// column stays scrolled into view alongside the selected row. Returns
+// false when msg is not a paging key.
+func (m *Model) pageTable(tab *Tab, msg tea.KeyPressMsg) bool {
+	km := tab.Table.KeyMap
+	page := tableBodyHeight(tab)
+	var delta int
+	switch {
+	case key.Matches(msg, km.PageDown):
+		delta = page
+	case key.Matches(msg, km.PageUp):
+		delta = -page
+	case key.Matches(msg, km.HalfPageDown):
+		delta = max(page/2, 1)
+	case key.Matches(msg, km.HalfPageUp):
+		delta = -max(page/2, 1)
+	default:
+		return false
+	}
+	if n := len(tab.Rows); n > 0 {
+		tab.Table.SetCursor(min(max(tab.Table.Cursor()+delta, 0), n-1))
+	}
+	m.updateTabViewport(tab)
+	return true
+}
+
 // updateForm handles input while a form is active.
 func (m *Model) updateForm(msg tea.Msg) (tea.Model, tea.Cmd) {
 	// Handle confirm-discard dialog: only y/n/esc are active.
@@ -287,6 +341,11 @@ func (m *Model) updateForm(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 	}
+	// The save review takes its keys as an overlay; nothing else reaches
+	// the form until it is answered.
+	if m.saveReview != nil {
+		return m, nil
+	}
 	if keyMsg, ok := msg.(tea.KeyPressMsg); ok && key.Matches(keyMsg, m.keys.FormSave) {
 		return m, m.saveFormInPlace()
 	}
@@ -326,6 +385,14 @@ func (m *Model) updateForm(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 	}
+	// Enter on a linked select's "+ New …" option opens that record's
+	// form on top of this one.
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok && key.Matches(keyMsg, m.keys.FormNewRecord) {
+		if link, ok := m.focusedNewRecord(); ok {
+			m.openLinkedForm(link)
+			return m, m.formInitCmd()
+		}
+	}
 	// Intercept 1-9 on Select fields to jump to the Nth option.
 	if keyMsg, ok := msg.(tea.KeyPressMsg); ok {
 		if n, isOrdinal := selectOrdinal(keyMsg); isOrdinal && isSelectField(m.fs.form) {
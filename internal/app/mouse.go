@@ -164,7 +164,7 @@ func (m *Model) handleLeftClick(msg tea.MouseClickMsg) (tea.Model, tea.Cmd) {
 					if isDouble && m.mode == modeNormal {
 						m.lastRowClick = rowClickState{}
 						if err := m.handleNormalEnter(); err != nil {
-							m.setStatusError(err.Error())
+							m.setStatusError(humanizeError(err))
 						}
 					} else {
 						tab.Table.SetCursor(i)
@@ -217,7 +217,7 @@ func (m *Model) handleHintClick(msg tea.MouseClickMsg) (tea.Model, tea.Cmd) {
 		{"enter", func() (tea.Model, tea.Cmd) {
 			if m.mode == modeNormal {
 				if err := m.handleNormalEnter(); err != nil {
-					m.setStatusError(err.Error())
+					m.setStatusError(humanizeError(err))
 				}
 				if m.mode == modeForm {
 					return m, m.formInitCmd()
@@ -470,6 +470,14 @@ func (m *Model) dismissActiveOverlay() {
 		m.opsTree = nil
 	case m.columnFinder != nil:
 		m.columnFinder = nil
+	case m.recentPicker != nil:
+		m.recentPicker = nil
+	case m.quoteCompare != nil:
+		m.quoteCompare = nil
+	case m.merge != nil:
+		m.merge = nil
+	case m.viewsPicker != nil:
+		m.viewsPicker = nil
 	case m.docSearch != nil:
 		m.docSearch = nil
 	case m.ex.extraction != nil && m.ex.extraction.Visible:
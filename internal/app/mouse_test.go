@@ -51,7 +51,7 @@ func drilldownColX(t *testing.T, m *Model, tab *Tab) int {
 	m.View()
 	width := m.effectiveWidth()
 	normalSep := m.styles.TableSeparator().Render(" \u2502 ")
-	vp := computeTableViewport(tab, width, normalSep, m.cur.Symbol())
+	vp := computeTableViewport(tab, width, normalSep, m.cur.Symbol(), 1)
 	for vi, fi := range vp.VisToFull {
 		if fi < len(tab.Specs) && tab.Specs[fi].Kind == cellDrilldown {
 			z := m.zones.Get(fmt.Sprintf("%s%d", zoneCol, vi))
@@ -64,6 +64,17 @@ func drilldownColX(t *testing.T, m *Model, tab *Tab) int {
 	return 0
 }
 
+func TestViewMouseModeFollowsToggle(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.width, m.height = 120, 40
+	assert.Equal(t, tea.MouseModeCellMotion, m.View().MouseMode)
+
+	m.mouseDisabled = true
+	assert.Equal(t, tea.MouseModeNone, m.View().MouseMode,
+		"keyboard-only mode must not request mouse events")
+}
+
 // TestTabClickSwitchesTab verifies that clicking on a tab changes the
 // active tab, simulating a real user left-click on tab zone markers.
 func TestTabClickSwitchesTab(t *testing.T) {
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"charm.land/bubbles/v2/table"
+	"github.com/charmbracelet/x/ansi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -279,7 +280,7 @@ func TestNaturalWidthsMultilineNotesFirstLine(t *testing.T) {
 	rows := [][]cell{
 		{{Value: "short\nvery long second line here", Kind: cellNotes}},
 	}
-	widths := naturalWidths(specs, rows, "$")
+	widths := naturalWidths(specs, rows, "$", 1)
 	// Width: first line ("short" = 5) + "…" (1) + gap (1) + "+1" (2) = 9.
 	// Not the longer second line (26).
 	require.Len(t, widths, 1)
@@ -340,7 +341,7 @@ func TestNaturalWidthsNotesCappedAtMax(t *testing.T) {
 			},
 		},
 	}
-	widths := naturalWidths(specs, rows, "$")
+	widths := naturalWidths(specs, rows, "$", 1)
 	require.Len(t, widths, 1)
 	assert.Equal(t, 40, widths[0], "notes column natural width should be capped at Max")
 }
@@ -510,3 +511,72 @@ func TestDocumentNotesSaveDoesNotTriggerExtraction(t *testing.T) {
 	assert.Nil(t, cmd, "saving document notes should not return extraction command")
 	assert.Nil(t, m.ex.extraction, "saving document notes should not start extraction")
 }
+
+func TestMultilineNotesWrapUpToNoteLines(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.noteLines = 2
+	m.active = tabIndex(tabMaintenance)
+	_ = m.openServiceLogDetail("01JNOTEXIST000000000000001", "Test")
+	tab := m.effectiveTab()
+	require.NotNil(t, tab)
+
+	note := "Changed the filter\nand checked pressure\nbled the radiators"
+	tab.Table.SetRows([]table.Row{
+		{"1", "2026-01-15", "Self", "$50.00", note},
+	})
+	tab.Rows = []rowMeta{{ID: "01JTEST00000000000000001"}}
+	tab.CellRows = [][]cell{
+		{
+			{Value: "1", Kind: cellReadonly},
+			{Value: "2026-01-15", Kind: cellDate},
+			{Value: "Self", Kind: cellText},
+			{Value: "$50.00", Kind: cellMoney},
+			{Value: note, Kind: cellNotes},
+		},
+	}
+
+	view := ansi.Strip(m.buildView())
+
+	assert.Contains(t, view, "Changed the filter")
+	assert.Contains(t, view, "and checked pressure…",
+		"the last shown line ends with an ellipsis")
+	assert.Contains(t, view, "+1", "the third line is counted, not shown")
+	assert.NotContains(t, view, "bled the radiators")
+}
+
+func TestWrapNoteLines(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, []string{"one two", "three"}, wrapNoteLines("one two three", 8, 3))
+	assert.Equal(t, []string{"a", "b\nc\nd"}, wrapNoteLines("a\nb\nc\nd", 10, 2),
+		"the last line keeps the rest for the +N count")
+	assert.Nil(t, wrapNoteLines("  ", 10, 3))
+}
+
+func TestNaturalWidthsWrappedNotesUseWidestLine(t *testing.T) {
+	t.Parallel()
+	specs := []columnSpec{
+		{Title: "N", Min: 1, Max: 40, Flex: true, Kind: cellNotes},
+	}
+	rows := [][]cell{
+		{{Value: "short\nvery long second line here", Kind: cellNotes}},
+	}
+	widths := naturalWidths(specs, rows, "$", 3)
+	assert.Equal(t, []int{len("very long second line here")}, widths)
+}
+
+func TestVisibleRangeLines(t *testing.T) {
+	t.Parallel()
+	heights := []int{1, 3, 1, 2, 1, 1}
+	start, end := visibleRangeLines(heights, 4, 3)
+	assert.Equal(t, 2, start)
+	assert.Equal(t, 5, end, "rows 2-4 fill the 4-line budget around the cursor")
+
+	start, end = visibleRangeLines(heights, 100, 0)
+	assert.Equal(t, 0, start)
+	assert.Equal(t, len(heights), end)
+
+	start, end = visibleRangeLines(heights, 2, 1)
+	assert.Equal(t, 1, start)
+	assert.Equal(t, 2, end, "a row taller than the budget is still shown")
+}
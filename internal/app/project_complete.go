@@ -0,0 +1,182 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+)
+
+func (t *Tab) isProjectTab() bool {
+	return t != nil && t.Handler != nil && t.Handler.FormKind() == formProject
+}
+
+// toggleMarkSelected marks or unmarks the selected row for a bulk action
+// and moves the cursor down, so a run of rows can be marked by holding the
+// key.
+func (m *Model) toggleMarkSelected() {
+	tab := m.effectiveTab()
+	meta, ok := m.selectedRowMeta()
+	if !ok {
+		m.setStatusError("Nothing selected.")
+		return
+	}
+	if tab.Marked[meta.ID] {
+		delete(tab.Marked, meta.ID)
+	} else {
+		if tab.Marked == nil {
+			tab.Marked = make(map[string]bool)
+		}
+		tab.Marked[meta.ID] = true
+	}
+	tab.Table.MoveDown(1)
+	if n := len(tab.markedIDs()); n > 0 {
+		m.setStatusInfo(fmt.Sprintf("%d marked.", n))
+	} else {
+		m.setStatusInfo("None marked.")
+	}
+}
+
+// markedIDs returns the IDs of the marked rows the tab shows, in row
+// order. Marks on rows that were filtered out or deleted are ignored.
+func (t *Tab) markedIDs() []string {
+	var ids []string
+	for _, r := range t.Rows {
+		if t.Marked[r.ID] && !r.Deleted {
+			ids = append(ids, r.ID)
+		}
+	}
+	return ids
+}
+
+// markedRows returns the tab's row metadata with marks applied, for
+// rendering.
+func (t *Tab) markedRows() []rowMeta {
+	if len(t.Marked) == 0 {
+		return t.Rows
+	}
+	rows := make([]rowMeta, len(t.Rows))
+	for i, r := range t.Rows {
+		r.Marked = t.Marked[r.ID]
+		rows[i] = r
+	}
+	return rows
+}
+
+// promptCompleteProjects asks to mark the marked projects completed, or
+// the selected one when none are marked.
+func (m *Model) promptCompleteProjects() {
+	tab := m.effectiveTab()
+	ids := tab.markedIDs()
+	if len(ids) == 0 {
+		meta, ok := m.selectedRowMeta()
+		if !ok {
+			m.setStatusError("Nothing selected.")
+			return
+		}
+		if meta.Deleted {
+			m.setStatusError("Restore the project before completing it.")
+			return
+		}
+		ids = []string{meta.ID}
+	}
+	m.completeIDs = ids
+	m.confirm = confirmCompleteProjects
+}
+
+// completeProjectsPrompt renders the status bar while
+// confirmCompleteProjects is active.
+func (m *Model) completeProjectsPrompt() string {
+	what := "this project"
+	if n := len(m.completeIDs); n > 1 {
+		what = fmt.Sprintf("%d projects", n)
+	}
+	prompt := m.styles.FormDirty().Render("Mark " + what + " completed?")
+	hints := joinWithSeparator(
+		m.helpSeparator(),
+		m.helpItem(keyY, "complete"),
+		m.helpItem(keyT, "complete, end today"),
+		m.helpItem(keyN, "cancel"),
+	)
+	return prompt + "  " + hints
+}
+
+// handleConfirmCompleteProjects processes keys while the "mark completed?"
+// prompt is active. The end-today answer also fills in today as the end
+// date of projects without one.
+func (m *Model) handleConfirmCompleteProjects(msg tea.KeyPressMsg, now time.Time) {
+	var endToday bool
+	switch {
+	case key.Matches(msg, m.keys.ConfirmYes):
+	case key.Matches(msg, m.keys.ConfirmEndToday):
+		endToday = true
+	case key.Matches(msg, m.keys.ConfirmNo):
+		m.confirm = confirmNone
+		m.completeIDs = nil
+		return
+	default:
+		return
+	}
+	ids := m.completeIDs
+	m.confirm = confirmNone
+	m.completeIDs = nil
+	var endDate *time.Time
+	if endToday {
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		endDate = &today
+	}
+	c, err := m.store.CompleteProjects(ids, endDate)
+	if err != nil {
+		m.setStatusError(humanizeError(err))
+		return
+	}
+	if tab := m.effectiveTab(); tab != nil {
+		tab.Marked = nil
+	}
+	done := c.After
+	if len(done) == 0 {
+		m.setStatusInfo("Already completed.")
+		return
+	}
+	m.reloadAfterMutation()
+	m.setStatusInfo("Completed " + countNoun(len(done), "project") + ".")
+	// Completing one recurring project offers its next run, as the form
+	// does.
+	if len(done) == 1 && done[0].RecurrenceMonths > 0 {
+		m.recurProjectID = done[0].ID
+		m.confirm = confirmScheduleNext
+		return
+	}
+	m.lastCompletion = &c
+	m.confirm = confirmUndoComplete
+}
+
+// undoCompletePrompt renders the status bar while confirmUndoComplete is
+// active.
+func (m *Model) undoCompletePrompt() string {
+	return m.renderStatusMessage() + "  " + m.helpItem(keyU, "undo")
+}
+
+// handleUndoCompletePrompt processes a key while the status bar offers to
+// undo a completion, and reports whether it used the key. Undo puts every
+// project back as it was; any other key dismisses the offer and is handled
+// as usual.
+func (m *Model) handleUndoCompletePrompt(msg tea.KeyPressMsg) bool {
+	c := m.lastCompletion
+	m.confirm = confirmNone
+	m.lastCompletion = nil
+	if c == nil || !key.Matches(msg, m.keys.ConfirmUndo) {
+		return false
+	}
+	if err := m.store.UndoProjectCompletion(*c); err != nil {
+		m.setStatusError(humanizeError(err))
+		return true
+	}
+	m.reloadAfterMutation()
+	m.setStatusInfo("Undid completing " + countNoun(len(c.After), "project") + ".")
+	return true
+}
@@ -0,0 +1,208 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newProjects creates one underway project per title and opens the
+// Projects tab in edit mode.
+func newProjects(t *testing.T, m *Model, titles ...string) []data.Project {
+	t.Helper()
+	projects := make([]data.Project, 0, len(titles))
+	for _, title := range titles {
+		p := data.Project{
+			Title:         title,
+			ProjectTypeID: m.projectTypes[0].ID,
+			Status:        data.ProjectStatusInProgress,
+		}
+		require.NoError(t, m.store.CreateProject(&p))
+		projects = append(projects, p)
+	}
+	m.active = tabIndex(tabProjects)
+	require.NoError(t, m.reloadActiveTab())
+	m.enterEditMode()
+	return projects
+}
+
+// selectRow moves the cursor to the row for id.
+func selectRow(t *testing.T, m *Model, id string) {
+	t.Helper()
+	tab := m.effectiveTab()
+	for i, r := range tab.Rows {
+		if r.ID == id {
+			tab.Table.SetCursor(i)
+			return
+		}
+	}
+	require.Failf(t, "row not found", "id %s", id)
+}
+
+func TestCompleteMarkedProjects(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	projects := newProjects(t, m, "Deck", "Fence", "Shed")
+
+	selectRow(t, m, projects[0].ID)
+	sendKey(m, keySpace)
+	selectRow(t, m, projects[2].ID)
+	sendKey(m, keySpace)
+	assert.Equal(t, "2 marked.", m.status.Text)
+	assert.Len(t, m.effectiveTab().markedIDs(), 2)
+
+	sendKey(m, keyShiftC)
+	require.Equal(t, confirmCompleteProjects, m.confirm)
+	assert.Contains(t, m.statusView(), "Mark 2 projects completed?")
+
+	sendKey(m, keyT)
+	assert.Equal(t, confirmUndoComplete, m.confirm)
+	assert.Equal(t, "Completed 2 projects.", m.status.Text)
+	assert.Empty(t, m.effectiveTab().Marked)
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	for i, want := range []string{
+		data.ProjectStatusCompleted, data.ProjectStatusInProgress, data.ProjectStatusCompleted,
+	} {
+		got, err := m.store.GetProject(projects[i].ID)
+		require.NoError(t, err)
+		assert.Equal(t, want, got.Status, got.Title)
+		if want == data.ProjectStatusCompleted {
+			require.NotNil(t, got.EndDate, got.Title)
+			assert.True(t, got.EndDate.Equal(today), got.Title)
+		} else {
+			assert.Nil(t, got.EndDate, got.Title)
+		}
+	}
+}
+
+func TestCompleteSelectedProjectKeepsEndDate(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	projects := newProjects(t, m, "Deck")
+
+	selectRow(t, m, projects[0].ID)
+	sendKey(m, keyShiftC)
+	assert.Contains(t, m.statusView(), "Mark this project completed?")
+	sendKey(m, keyY)
+	assert.Equal(t, "Completed 1 project.", m.status.Text)
+
+	got, err := m.store.GetProject(projects[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, data.ProjectStatusCompleted, got.Status)
+	assert.Nil(t, got.EndDate, "y keeps the end date as it was")
+}
+
+func TestCompleteProjectsUndo(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	projects := newProjects(t, m, "Deck", "Fence", "Shed")
+	end := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	projects[1].EndDate = &end
+	require.NoError(t, m.store.UpdateProject(projects[1]))
+	require.NoError(t, m.reloadActiveTab())
+
+	for _, p := range projects {
+		selectRow(t, m, p.ID)
+		sendKey(m, keySpace)
+	}
+	sendKey(m, keyShiftC)
+	sendKey(m, keyT)
+	require.Equal(t, confirmUndoComplete, m.confirm)
+	assert.Contains(t, m.statusView(), "Completed 3 projects.")
+	assert.Contains(t, m.statusView(), "undo")
+
+	sendKey(m, keyU)
+	assert.Equal(t, confirmNone, m.confirm)
+	assert.Equal(t, "Undid completing 3 projects.", m.status.Text)
+	for _, p := range projects {
+		got, err := m.store.GetProject(p.ID)
+		require.NoError(t, err)
+		assert.Equal(t, data.ProjectStatusInProgress, got.Status, got.Title)
+		if p.EndDate == nil {
+			assert.Nil(t, got.EndDate, got.Title)
+		} else {
+			require.NotNil(t, got.EndDate, got.Title)
+			assert.True(t, got.EndDate.Equal(end), got.Title)
+		}
+	}
+}
+
+func TestCompleteProjectsUndoDismissedByOtherKey(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	projects := newProjects(t, m, "Deck", "Fence")
+
+	for _, p := range projects {
+		selectRow(t, m, p.ID)
+		sendKey(m, keySpace)
+	}
+	sendKey(m, keyShiftC)
+	sendKey(m, keyY)
+	require.Equal(t, confirmUndoComplete, m.confirm)
+
+	sendKey(m, keyJ)
+	assert.Equal(t, confirmNone, m.confirm)
+	sendKey(m, keyU)
+	for _, p := range projects {
+		got, err := m.store.GetProject(p.ID)
+		require.NoError(t, err)
+		assert.Equal(t, data.ProjectStatusCompleted, got.Status, "u no longer undoes")
+	}
+}
+
+func TestCompleteProjectsCancel(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	projects := newProjects(t, m, "Deck")
+
+	selectRow(t, m, projects[0].ID)
+	sendKey(m, keySpace)
+	sendKey(m, keyShiftC)
+	sendKey(m, keyEsc)
+	assert.Equal(t, confirmNone, m.confirm)
+	assert.Equal(t, modeEdit, m.mode, "esc only cancels the prompt")
+	assert.Len(t, m.effectiveTab().markedIDs(), 1, "cancelling keeps the marks")
+
+	got, err := m.store.GetProject(projects[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, data.ProjectStatusInProgress, got.Status)
+
+	sendKey(m, keyEsc)
+	assert.Empty(t, m.effectiveTab().Marked, "leaving edit mode clears marks")
+}
+
+func TestMarkOnlyOnProjectsTab(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	require.NoError(t, m.store.CreateVendor(&data.Vendor{Name: "Acme"}))
+	m.active = tabIndex(tabVendors)
+	require.NoError(t, m.reloadActiveTab())
+	m.enterEditMode()
+
+	sendKey(m, keySpace)
+	sendKey(m, keyShiftC)
+	assert.Empty(t, m.effectiveTab().Marked)
+	assert.Equal(t, confirmNone, m.confirm)
+}
+
+func TestCompleteRecurringProjectOffersNext(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	project := newRecurringProject(t, m, 12)
+	m.enterEditMode()
+
+	selectRow(t, m, project.ID)
+	sendKey(m, keyShiftC)
+	sendKey(m, keyY)
+	require.Equal(t, confirmScheduleNext, m.confirm)
+	sendKey(m, keyY)
+	assert.Contains(t, m.status.Text, "Scheduled the next Chimney inspection")
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"github.com/micasa-dev/micasa/internal/data"
+)
+
+// noteProjectCompletion remembers a recurring project that a save just
+// marked completed, so exitForm can offer to schedule its next run. Any
+// other save clears a pending offer for the same project.
+func (m *Model) noteProjectCompletion(project data.Project, prevStatus string) {
+	if project.Status == data.ProjectStatusCompleted &&
+		prevStatus != data.ProjectStatusCompleted &&
+		project.RecurrenceMonths > 0 {
+		m.recurProjectID = project.ID
+		return
+	}
+	if m.recurProjectID == project.ID {
+		m.recurProjectID = ""
+	}
+}
+
+// scheduleNextPrompt renders the status bar while confirmScheduleNext is
+// active.
+func (m *Model) scheduleNextPrompt() string {
+	title := "this project"
+	if project, err := m.store.GetProject(m.recurProjectID); err == nil {
+		title = fmt.Sprintf("%q", project.Title)
+	}
+	prompt := m.styles.FormDirty().Render("Schedule the next " + title + "?")
+	hints := joinWithSeparator(
+		m.helpSeparator(),
+		m.helpItem(keyY, "schedule"),
+		m.helpItem(keyN, "skip"),
+	)
+	return prompt + "  " + hints
+}
+
+// handleConfirmScheduleNext processes keys while the "schedule the next
+// one?" prompt is active. Yes creates the next instance of the completed
+// recurring project.
+func (m *Model) handleConfirmScheduleNext(msg tea.KeyPressMsg, now time.Time) {
+	switch {
+	case key.Matches(msg, m.keys.ConfirmYes):
+		id := m.recurProjectID
+		m.confirm = confirmNone
+		m.recurProjectID = ""
+		next, err := m.store.ScheduleNextProject(id, now)
+		if err != nil {
+			m.setStatusError(humanizeError(err))
+			return
+		}
+		when := next.StartDate
+		if when == nil {
+			when = next.EndDate
+		}
+		m.setStatusInfo(fmt.Sprintf("Scheduled the next %s for %s.", next.Title, m.display.Date(*when)))
+		m.reloadAfterMutation()
+	case key.Matches(msg, m.keys.ConfirmNo):
+		m.confirm = confirmNone
+		m.recurProjectID = ""
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"testing"
+
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// completeProjectViaForm opens the edit form for id, marks the project
+// completed, and saves and closes the form.
+func completeProjectViaForm(t *testing.T, m *Model, id string) {
+	t.Helper()
+	require.NoError(t, m.startEditProjectForm(id))
+	values, ok := m.fs.formData.(*projectFormData)
+	require.True(t, ok)
+	values.Status = data.ProjectStatusCompleted
+	m.saveForm()
+	require.NotEqual(t, statusError, m.status.Kind, m.status.Text)
+}
+
+func newRecurringProject(t *testing.T, m *Model, recurrence int) data.Project {
+	t.Helper()
+	project := data.Project{
+		Title:            "Chimney inspection",
+		ProjectTypeID:    m.projectTypes[0].ID,
+		Status:           data.ProjectStatusInProgress,
+		RecurrenceMonths: recurrence,
+	}
+	require.NoError(t, m.store.CreateProject(&project))
+	m.active = tabIndex(tabProjects)
+	require.NoError(t, m.reloadActiveTab())
+	return project
+}
+
+func TestCompletingRecurringProjectOffersNext(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	project := newRecurringProject(t, m, 12)
+
+	completeProjectViaForm(t, m, project.ID)
+	require.Equal(t, confirmScheduleNext, m.confirm)
+	assert.Contains(t, m.statusView(), `Schedule the next "Chimney inspection"?`)
+
+	sendKey(m, keyY)
+	assert.Equal(t, confirmNone, m.confirm)
+	assert.Contains(t, m.status.Text, "Scheduled the next Chimney inspection")
+
+	projects, err := m.store.ListProjects(false)
+	require.NoError(t, err)
+	require.Len(t, projects, 2)
+	var planned int
+	for _, p := range projects {
+		if p.Status == data.ProjectStatusPlanned {
+			planned++
+			assert.Equal(t, 12, p.RecurrenceMonths)
+		}
+	}
+	assert.Equal(t, 1, planned)
+}
+
+func TestCompletingRecurringProjectSkip(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	project := newRecurringProject(t, m, 12)
+
+	completeProjectViaForm(t, m, project.ID)
+	require.Equal(t, confirmScheduleNext, m.confirm)
+	sendKey(m, keyN)
+	assert.Equal(t, confirmNone, m.confirm)
+
+	projects, err := m.store.ListProjects(false)
+	require.NoError(t, err)
+	assert.Len(t, projects, 1)
+}
+
+func TestCompletingOneOffProjectDoesNotPrompt(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	project := newRecurringProject(t, m, 0)
+
+	completeProjectViaForm(t, m, project.ID)
+	assert.Equal(t, confirmNone, m.confirm)
+}
+
+func TestResavingCompletedProjectDoesNotPrompt(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	project := newRecurringProject(t, m, 12)
+	project.Status = data.ProjectStatusCompleted
+	require.NoError(t, m.store.UpdateProject(project))
+
+	completeProjectViaForm(t, m, project.ID)
+	assert.Equal(t, confirmNone, m.confirm)
+}
+
+func TestProjectFormRecurrenceRoundTrip(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	project := newRecurringProject(t, m, 18)
+
+	require.NoError(t, m.startEditProjectForm(project.ID))
+	values, ok := m.fs.formData.(*projectFormData)
+	require.True(t, ok)
+	assert.Equal(t, "1y 6m", values.Recurrence)
+
+	values.Recurrence = "6m"
+	m.saveForm()
+	fetched, err := m.store.GetProject(project.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 6, fetched.RecurrenceMonths)
+}
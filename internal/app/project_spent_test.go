@@ -0,0 +1,52 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"testing"
+
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserSeesProjectSpentFromAcceptedQuotes(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	values := openQuoteAddForm(t, m)
+	values.VendorName = "Acme Roofing"
+	values.Total = "4,500.00"
+	values.AcceptedDate = "2025-04-01"
+	sendKey(m, "ctrl+s")
+
+	quotes, err := m.store.ListQuotes(false)
+	require.NoError(t, err)
+	require.Len(t, quotes, 1)
+	require.NoError(t, m.store.CreateQuote(
+		&data.Quote{ProjectID: quotes[0].ProjectID, TotalCents: 390000},
+		data.Vendor{Name: "Budget Roofing"},
+	))
+
+	m.active = tabIndex(tabProjects)
+	require.NoError(t, m.reloadActiveTab())
+	tab := m.activeTab()
+	require.Len(t, tab.CellRows, 1)
+	assert.Equal(t, "$4,500.00", tab.CellRows[0][projectColSpent].Value,
+		"only the accepted quote counts toward spent")
+}
+
+func TestProjectSpentIsEmptyWithoutAcceptedQuote(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	values := openQuoteAddForm(t, m)
+	values.VendorName = "Acme Roofing"
+	values.Total = "4,500.00"
+	sendKey(m, "ctrl+s")
+
+	m.active = tabIndex(tabProjects)
+	require.NoError(t, m.reloadActiveTab())
+	tab := m.activeTab()
+	require.Len(t, tab.CellRows, 1)
+	assert.True(t, tab.CellRows[0][projectColSpent].Null)
+}
@@ -0,0 +1,263 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/micasa-dev/micasa/internal/data"
+)
+
+// quoteCompareMin is the fewest quotes worth comparing.
+const quoteCompareMin = 2
+
+// quoteCompareState holds the state for the quote comparison overlay.
+type quoteCompareState struct {
+	Project string
+	Quotes  []data.Quote // cheapest first
+	Offset  int          // first quote column shown
+}
+
+// quoteCompareField is one row of the comparison. Money rows set Cents and
+// get their lowest and highest values highlighted; the rest set Text.
+type quoteCompareField struct {
+	Label string
+	Cents func(data.Quote) *int64
+	Text  func(data.Quote) string
+}
+
+var quoteCompareFields = []quoteCompareField{
+	{Label: "Total", Cents: func(q data.Quote) *int64 { return &q.TotalCents }},
+	{Label: "Labor", Cents: func(q data.Quote) *int64 { return q.LaborCents }},
+	{Label: "Materials", Cents: func(q data.Quote) *int64 { return q.MaterialsCents }},
+	{Label: "Other", Cents: func(q data.Quote) *int64 { return q.OtherCents }},
+	{Label: "Tax", Cents: func(q data.Quote) *int64 { return q.TaxCents }},
+	{Label: "Received", Text: func(q data.Quote) string { return data.FormatDate(q.ReceivedDate) }},
+}
+
+// compareProjectID returns the project whose quotes the comparison shows:
+// the selected project on the Projects tab, or the selected quote's project
+// on a quotes table.
+func (m *Model) compareProjectID() (string, bool) {
+	tab := m.effectiveTab()
+	meta, ok := m.selectedRowMeta()
+	if tab == nil || !ok {
+		return "", false
+	}
+	switch tab.Kind {
+	case tabProjects:
+		return meta.ID, true
+	case tabQuotes:
+		quote, err := m.store.GetQuote(meta.ID)
+		if err != nil {
+			return "", false
+		}
+		return quote.ProjectID, true
+	}
+	return "", false
+}
+
+// openQuoteCompare lays the selected project's quotes out side by side.
+func (m *Model) openQuoteCompare() error {
+	projectID, ok := m.compareProjectID()
+	if !ok {
+		m.setStatusInfo("Select a project or quote to compare quotes.")
+		return nil
+	}
+	quotes, err := m.store.ListQuotesByProject(projectID, false)
+	if err != nil {
+		return fmt.Errorf("load quotes: %w", err)
+	}
+	if len(quotes) < quoteCompareMin {
+		m.setStatusInfo(fmt.Sprintf(
+			"Need at least %d quotes to compare, this project has %d.",
+			quoteCompareMin, len(quotes),
+		))
+		return nil
+	}
+	slices.SortStableFunc(quotes, func(a, b data.Quote) int {
+		return cmp.Compare(a.TotalCents, b.TotalCents)
+	})
+	m.quoteCompare = &quoteCompareState{
+		Project: quotes[0].Project.Title,
+		Quotes:  quotes,
+	}
+	return nil
+}
+
+// handleQuoteCompareKey processes keys while the quote comparison is open.
+func (m *Model) handleQuoteCompareKey(msg tea.KeyPressMsg) tea.Cmd {
+	qc := m.quoteCompare
+	if qc == nil {
+		return nil
+	}
+	switch {
+	case key.Matches(msg, m.keys.CompareClose):
+		m.quoteCompare = nil
+	case key.Matches(msg, m.keys.CompareLeft):
+		if qc.Offset > 0 {
+			qc.Offset--
+		}
+	case key.Matches(msg, m.keys.CompareRight):
+		if qc.Offset < len(qc.Quotes)-1 {
+			qc.Offset++
+		}
+	}
+	return nil
+}
+
+// quoteCompareExtremes returns the lowest and highest of the set values.
+// ok is false when fewer than two quotes set the field or they all agree,
+// since there is nothing to tell apart.
+func quoteCompareExtremes(values []*int64) (lo, hi int64, ok bool) {
+	n := 0
+	for _, v := range values {
+		if v == nil {
+			continue
+		}
+		if n == 0 || *v < lo {
+			lo = *v
+		}
+		if n == 0 || *v > hi {
+			hi = *v
+		}
+		n++
+	}
+	return lo, hi, n >= quoteCompareMin && lo != hi
+}
+
+// buildQuoteCompareOverlay renders the quotes as columns with one row per
+// field, marking each money row's lowest value in green and highest in red.
+// Quotes that don't fit scroll horizontally.
+func (m *Model) buildQuoteCompareOverlay() string {
+	qc := m.quoteCompare
+	if qc == nil {
+		return ""
+	}
+	const colGap = 3
+	frameW := appStyles.OverlayBox().GetHorizontalFrameSize()
+	innerW := m.effectiveWidth() - 8 - frameW
+
+	labelW := 0
+	for _, f := range quoteCompareFields {
+		labelW = max(labelW, lipgloss.Width(f.Label))
+	}
+	cells := make([][]string, len(qc.Quotes))
+	widths := make([]int, len(qc.Quotes))
+	for i, q := range qc.Quotes {
+		col := []string{quoteCompareVendor(q)}
+		for _, f := range quoteCompareFields {
+			var text string
+			if f.Cents != nil {
+				text = m.cur.FormatOptionalCents(f.Cents(q))
+			} else {
+				text = f.Text(q)
+			}
+			if text == "" {
+				text = symEmDash
+			}
+			col = append(col, text)
+		}
+		for _, text := range col {
+			widths[i] = max(widths[i], lipgloss.Width(text))
+		}
+		cells[i] = col
+	}
+
+	// Show as many quotes as fit from the scroll offset, at least one.
+	first := min(qc.Offset, len(qc.Quotes)-1)
+	last := first
+	used := labelW
+	for i := first; i < len(qc.Quotes); i++ {
+		if i > first && used+colGap+widths[i] > innerW {
+			break
+		}
+		used += colGap + widths[i]
+		last = i
+	}
+
+	gap := strings.Repeat(" ", colGap)
+	pad := func(s string, w int, right bool) string {
+		fill := strings.Repeat(" ", max(0, w-lipgloss.Width(s)))
+		if right {
+			return fill + s
+		}
+		return s + fill
+	}
+
+	var b strings.Builder
+	title := "Compare Quotes"
+	if qc.Project != "" {
+		title += " " + symMiddleDot + " " + qc.Project
+	}
+	b.WriteString(m.styles.HeaderSection().Render(" " + title + " "))
+	b.WriteString("\n\n")
+
+	header := pad("", labelW, false)
+	for i := first; i <= last; i++ {
+		header += gap + m.styles.TableHeader().Render(pad(cells[i][0], widths[i], true))
+	}
+	b.WriteString(header)
+	b.WriteString("\n")
+
+	for r, f := range quoteCompareFields {
+		var lo, hi int64
+		var marked bool
+		if f.Cents != nil {
+			values := make([]*int64, len(qc.Quotes))
+			for i, q := range qc.Quotes {
+				values[i] = f.Cents(q)
+			}
+			lo, hi, marked = quoteCompareExtremes(values)
+		}
+		line := m.styles.DashLabel().Render(pad(f.Label, labelW, false))
+		for i := first; i <= last; i++ {
+			text := pad(cells[i][r+1], widths[i], true)
+			style := m.styles.DashValue()
+			if f.Cents != nil {
+				if v := f.Cents(qc.Quotes[i]); v == nil {
+					style = m.styles.Null()
+				} else if marked && *v == lo {
+					style = m.styles.Money()
+				} else if marked && *v == hi {
+					style = m.styles.Danger()
+				}
+			}
+			line += gap + style.Render(text)
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	hints := []string{}
+	if first > 0 || last < len(qc.Quotes)-1 {
+		hints = append(hints, m.helpItem(keyH+"/"+keyL, fmt.Sprintf(
+			"scroll (%d%s%d of %d)", first+1, symEmDash, last+1, len(qc.Quotes),
+		)))
+	}
+	hints = append(hints, m.helpItem(keyEsc, "close"))
+	b.WriteString(joinWithSeparator(m.helpSeparator(), hints...))
+
+	return appStyles.OverlayBox().Render(b.String())
+}
+
+// quoteCompareVendor labels a quote's column with its vendor, marking the
+// accepted quote.
+func quoteCompareVendor(q data.Quote) string {
+	name := q.Vendor.Name
+	if name == "" {
+		name = shortID(q.ID)
+	}
+	if q.AcceptedDate != nil {
+		name = symCheck + " " + name
+	}
+	return name
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuoteCompareExtremes(t *testing.T) {
+	t.Parallel()
+	v := func(n int64) *int64 { return &n }
+
+	lo, hi, ok := quoteCompareExtremes([]*int64{v(300), nil, v(100), v(200)})
+	require.True(t, ok)
+	assert.Equal(t, int64(100), lo)
+	assert.Equal(t, int64(300), hi)
+
+	_, _, ok = quoteCompareExtremes([]*int64{v(100), nil, nil})
+	assert.False(t, ok, "a single value has nothing to compare against")
+
+	_, _, ok = quoteCompareExtremes([]*int64{v(100), v(100)})
+	assert.False(t, ok, "equal values should not be highlighted")
+}
+
+func TestQuoteCompareNeedsTwoQuotes(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	projID := seedProject(t, m)
+	require.NoError(t, m.store.CreateQuote(&data.Quote{
+		ProjectID:  projID,
+		TotalCents: 50000,
+	}, data.Vendor{Name: "Solo Co"}))
+
+	m.showDashboard = false
+	m.switchToTab(tabIndex(tabProjects))
+	sendKey(m, keyShiftQ)
+	assert.Nil(t, m.quoteCompare)
+	assert.Contains(t, m.statusView(), "Need at least 2 quotes")
+}
+
+func TestQuoteCompareFromQuotesTab(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	projID := seedProject(t, m)
+	labor := int64(20000)
+	require.NoError(t, m.store.CreateQuote(&data.Quote{
+		ProjectID:  projID,
+		TotalCents: 90000,
+		LaborCents: &labor,
+	}, data.Vendor{Name: "Pricey Co"}))
+	require.NoError(t, m.store.CreateQuote(&data.Quote{
+		ProjectID:  projID,
+		TotalCents: 50000,
+	}, data.Vendor{Name: "Budget Co"}))
+
+	m.showDashboard = false
+	m.switchToTab(tabIndex(tabQuotes))
+	require.NotEmpty(t, m.activeTab().Rows)
+	sendKey(m, keyShiftQ)
+	require.NotNil(t, m.quoteCompare)
+	require.Len(t, m.quoteCompare.Quotes, 2)
+	assert.Equal(t, "Budget Co", m.quoteCompare.Quotes[0].Vendor.Name,
+		"quotes should be ordered cheapest first")
+
+	view := m.buildView()
+	assert.Contains(t, view, "Compare Quotes")
+	assert.Contains(t, view, "Budget Co")
+	assert.Contains(t, view, "Pricey Co")
+	assert.Contains(t, view, "Materials")
+	assert.Contains(t, view, m.cur.FormatCents(labor))
+
+	sendKey(m, keyEsc)
+	assert.Nil(t, m.quoteCompare)
+}
+
+func TestQuoteCompareVendorMarksAccepted(t *testing.T) {
+	t.Parallel()
+	q := data.Quote{Vendor: data.Vendor{Name: "Acme"}}
+	assert.Equal(t, "Acme", quoteCompareVendor(q))
+	now := time.Now()
+	q.AcceptedDate = &now
+	assert.Equal(t, symCheck+" Acme", quoteCompareVendor(q))
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func openQuoteAddForm(t *testing.T, m *Model) *quoteFormData {
+	t.Helper()
+	seedProject(t, m)
+	m.active = tabIndex(tabQuotes)
+	require.NoError(t, m.reloadActiveTab())
+	openAddForm(m)
+	values, ok := m.fs.formData.(*quoteFormData)
+	require.True(t, ok, "quote add form should be open")
+	return values
+}
+
+func TestUserAddsQuoteWithTax(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	values := openQuoteAddForm(t, m)
+	values.VendorName = "Acme Roofing"
+	values.Total = "1,100.00"
+	values.Labor = "600.00"
+	values.Materials = "400.00"
+	values.Tax = "100.00"
+	sendKey(m, "ctrl+s")
+
+	assert.Equal(t, "Saved.", m.status.Text, "balanced breakdown should not warn")
+	quotes, err := m.store.ListQuotes(false)
+	require.NoError(t, err)
+	require.Len(t, quotes, 1)
+	require.NotNil(t, quotes[0].TaxCents)
+	assert.Equal(t, int64(10000), *quotes[0].TaxCents)
+
+	sendKey(m, "esc")
+	tab := m.activeTab()
+	require.Len(t, tab.CellRows, 1)
+	assert.Equal(t, "$100.00", tab.CellRows[0][quoteColTax].Value)
+}
+
+func TestUserSeesWarningWhenQuoteBreakdownMismatches(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	values := openQuoteAddForm(t, m)
+	values.VendorName = "Acme Roofing"
+	values.Total = "1,200.00"
+	values.Labor = "600.00"
+	values.Tax = "100.00"
+	sendKey(m, "ctrl+s")
+
+	assert.Equal(t, statusInfo, m.status.Kind, "mismatch warns but still saves")
+	assert.Contains(t, m.status.Text, "Saved.")
+	assert.Contains(t, m.status.Text, "$700.00")
+	assert.Contains(t, m.status.Text, "$1,200.00")
+	quotes, err := m.store.ListQuotes(false)
+	require.NoError(t, err)
+	assert.Len(t, quotes, 1)
+
+	// The warning is one-shot: a later clean save reports plain success.
+	values.Total = "700.00"
+	sendKey(m, "ctrl+s")
+	assert.Equal(t, "Saved.", m.status.Text)
+}
@@ -0,0 +1,187 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+// recentCap bounds the recently viewed list.
+const recentCap = 20
+
+// recentEntry is one record in the recently viewed list.
+type recentEntry struct {
+	Tab   TabKind
+	ID    string
+	Label string // human-readable name captured when the record was viewed
+}
+
+// recentPickerState holds the state for the recently viewed overlay.
+type recentPickerState struct {
+	Entries []recentEntry
+	Cursor  int
+}
+
+// noteRecent records the selected row of the active top-level tab at the
+// front of the recently viewed list. Called when leaving a record (tab
+// switches, link follows, dashboard jumps) and when arriving at one via a
+// jump, so the list reads like an editor's buffer history. Detail views
+// are skipped: their rows only make sense under their parent.
+// Reports whether anything was recorded.
+func (m *Model) noteRecent() bool {
+	if m.inDetail() {
+		return false
+	}
+	tab := m.activeTab()
+	if tab == nil {
+		return false
+	}
+	cursor := tab.Table.Cursor()
+	if cursor < 0 || cursor >= len(tab.Rows) {
+		return false
+	}
+	entry := recentEntry{
+		Tab:   tab.Kind,
+		ID:    tab.Rows[cursor].ID,
+		Label: recentLabel(tab, cursor),
+	}
+	m.recent = pushRecent(m.recent, entry)
+	return true
+}
+
+// pushRecent moves entry to the front of list, dropping any earlier visit
+// to the same record and trimming to recentCap.
+func pushRecent(list []recentEntry, entry recentEntry) []recentEntry {
+	out := make([]recentEntry, 0, min(len(list)+1, recentCap))
+	out = append(out, entry)
+	for _, e := range list {
+		if e.Tab == entry.Tab && e.ID == entry.ID {
+			continue
+		}
+		if len(out) == recentCap {
+			break
+		}
+		out = append(out, e)
+	}
+	return out
+}
+
+// recentLabel returns the first non-empty text cell of a row, which is the
+// record's name or title on every tab.
+func recentLabel(tab *Tab, row int) string {
+	if row < 0 || row >= len(tab.CellRows) {
+		return ""
+	}
+	for i, c := range tab.CellRows[row] {
+		if i == 0 || c.Kind != cellText {
+			continue
+		}
+		if v := strings.TrimSpace(c.Value); v != "" {
+			return v
+		}
+	}
+	return shortID(tab.Rows[row].ID)
+}
+
+// openRecentPicker shows the recently viewed list, leaving out the record
+// that is currently selected.
+func (m *Model) openRecentPicker() {
+	entries := m.recent
+	if m.noteRecent() {
+		// The front entry is the record under the cursor.
+		entries = m.recent[1:]
+	}
+	if len(entries) == 0 {
+		m.setStatusInfo("No recently viewed records yet.")
+		return
+	}
+	m.recentPicker = &recentPickerState{
+		Entries: append([]recentEntry(nil), entries...),
+	}
+}
+
+// recentJump switches to the selected record and closes the picker.
+func (m *Model) recentJump() {
+	rp := m.recentPicker
+	m.recentPicker = nil
+	if rp == nil || rp.Cursor < 0 || rp.Cursor >= len(rp.Entries) {
+		return
+	}
+	entry := rp.Entries[rp.Cursor]
+	m.showDashboard = false
+	m.closeAllDetails()
+	m.switchToTab(tabIndex(entry.Tab))
+	tab := m.activeTab()
+	if tab == nil || !selectRowByID(tab, entry.ID) {
+		m.setStatusError("Record " + entry.Label + " not found (deleted or filtered?).")
+		return
+	}
+	m.noteRecent()
+}
+
+// handleRecentPickerKey processes keys while the recent picker is open.
+func (m *Model) handleRecentPickerKey(msg tea.KeyPressMsg) tea.Cmd {
+	rp := m.recentPicker
+	if rp == nil {
+		return nil
+	}
+	switch {
+	case key.Matches(msg, m.keys.RecentCancel):
+		m.recentPicker = nil
+	case key.Matches(msg, m.keys.RecentConfirm):
+		m.recentJump()
+	case key.Matches(msg, m.keys.RecentUp):
+		if rp.Cursor > 0 {
+			rp.Cursor--
+		}
+	case key.Matches(msg, m.keys.RecentDown):
+		if rp.Cursor < len(rp.Entries)-1 {
+			rp.Cursor++
+		}
+	}
+	return nil
+}
+
+// buildRecentPickerOverlay renders the recently viewed list as a bordered box.
+func (m *Model) buildRecentPickerOverlay() string {
+	rp := m.recentPicker
+	if rp == nil {
+		return ""
+	}
+
+	contentW := max(24, min(50, m.effectiveWidth()-12))
+	innerW := contentW - appStyles.OverlayBox().GetHorizontalFrameSize()
+
+	var b strings.Builder
+	b.WriteString(m.styles.HeaderSection().Render(" Recently Viewed "))
+	b.WriteString("\n\n")
+
+	for i, e := range rp.Entries {
+		title := e.Label + " " + m.styles.HeaderHint().Render(e.Tab.String())
+		line := "  " + title
+		if i == rp.Cursor {
+			line = appStyles.AccentBold().Render("▸ ") + title
+		}
+		if lipgloss.Width(line) > innerW {
+			line = appStyles.Base().MaxWidth(innerW).Render(line)
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(joinWithSeparator(
+		m.helpSeparator(),
+		m.helpItem(symReturn, "jump"),
+		m.helpItem(keyEsc, "cancel"),
+	))
+
+	return appStyles.OverlayBox().
+		Width(contentW).
+		Render(b.String())
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPushRecentDedupesAndCaps(t *testing.T) {
+	t.Parallel()
+	var list []recentEntry
+	for i := range recentCap + 5 {
+		list = pushRecent(list, recentEntry{Tab: tabProjects, ID: fmt.Sprint(i)})
+	}
+	require.Len(t, list, recentCap)
+	assert.Equal(t, fmt.Sprint(recentCap+4), list[0].ID)
+
+	list = pushRecent(list, recentEntry{Tab: tabProjects, ID: "10"})
+	require.Len(t, list, recentCap)
+	assert.Equal(t, "10", list[0].ID)
+	seen := 0
+	for _, e := range list {
+		if e.ID == "10" {
+			seen++
+		}
+	}
+	assert.Equal(t, 1, seen, "revisiting a record should move it, not duplicate it")
+}
+
+func TestPushRecentKeepsSameIDOnDifferentTabs(t *testing.T) {
+	t.Parallel()
+	list := pushRecent(nil, recentEntry{Tab: tabProjects, ID: "1"})
+	list = pushRecent(list, recentEntry{Tab: tabAppliances, ID: "1"})
+	assert.Len(t, list, 2)
+}
+
+func TestRecentPickerEmpty(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	sendKey(m, keyCtrlR)
+	assert.Nil(t, m.recentPicker)
+	assert.Contains(t, m.statusView(), "No recently viewed")
+}
+
+func TestRecentPickerJumpsBack(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithDemoData(t, testSeed)
+	m.showDashboard = false
+	m.switchToTab(tabIndex(tabProjects))
+	tab := m.activeTab()
+	require.Greater(t, len(tab.Rows), 1)
+	tab.Table.SetCursor(1)
+	wantID := tab.Rows[1].ID
+
+	m.switchToTab(tabIndex(tabAppliances))
+	require.NotEmpty(t, m.activeTab().Rows)
+
+	sendKey(m, keyCtrlR)
+	require.NotNil(t, m.recentPicker)
+	require.NotEmpty(t, m.recentPicker.Entries)
+	assert.Equal(t, tabProjects, m.recentPicker.Entries[0].Tab)
+	assert.Equal(t, wantID, m.recentPicker.Entries[0].ID)
+	assert.Contains(t, m.buildView(), "Recently Viewed")
+
+	sendKey(m, keyEnter)
+	assert.Nil(t, m.recentPicker)
+	assert.Equal(t, tabProjects, m.effectiveTab().Kind)
+	tab = m.activeTab()
+	assert.Equal(t, wantID, tab.Rows[tab.Table.Cursor()].ID)
+
+	// The appliance we came from is now first in the picker.
+	sendKey(m, keyCtrlR)
+	require.NotNil(t, m.recentPicker)
+	assert.Equal(t, tabAppliances, m.recentPicker.Entries[0].Tab)
+	sendKey(m, keyEsc)
+	assert.Nil(t, m.recentPicker)
+}
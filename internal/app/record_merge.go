@@ -0,0 +1,381 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/micasa-dev/micasa/internal/data"
+)
+
+// mergeCandidate is one record offered for merging.
+type mergeCandidate struct {
+	ID   string
+	Name string
+}
+
+// mergeState holds the state for the merge overlay, which walks groups of
+// records that look like duplicates one group at a time. The record under
+// the cursor is the one kept.
+type mergeState struct {
+	Title string // overlay heading
+	Noun  string // "vendor" or "appliance"
+	Empty string // shown once no group is left
+	// load returns the current groups and what each record is used by,
+	// keyed by ID. Called on open and after every merge or undo.
+	load  func() ([][]mergeCandidate, map[string]string, error)
+	merge func(keepID string, mergeIDs []string) (data.RecordMerge, error)
+
+	Groups  [][]mergeCandidate
+	Usage   map[string]string // record ID -> "2 quotes, 1 service log"
+	Group   int
+	Cursor  int
+	Skipped map[string]bool // records left out of the group's merge
+	// Last is the most recent merge, kept so it can be undone while the
+	// overlay is open.
+	Last     *data.RecordMerge
+	LastName string
+}
+
+func (t *Tab) isVendorTab() bool {
+	return t != nil && t.Handler != nil && t.Handler.FormKind() == formVendor
+}
+
+func (t *Tab) isApplianceTab() bool {
+	return t != nil && t.Handler != nil && t.Handler.FormKind() == formAppliance
+}
+
+// openMerge opens the merge overlay for the active tab: similarly named
+// vendors on the Vendors tab, the marked appliances on the Appliances tab.
+func (m *Model) openMerge() error {
+	tab := m.effectiveTab()
+	var ms *mergeState
+	switch {
+	case tab.isVendorTab():
+		ms = m.vendorMergeState()
+	case tab.isApplianceTab():
+		ids := tab.markedIDs()
+		if meta, ok := m.selectedRowMeta(); ok && !tab.Marked[meta.ID] && !meta.Deleted {
+			ids = append(ids, meta.ID)
+		}
+		if len(ids) < 2 {
+			m.setStatusInfo(fmt.Sprintf(
+				"Mark the appliances to merge with %s, then press %s.", keySpace, keyShiftM,
+			))
+			return nil
+		}
+		ms = m.applianceMergeState(ids)
+	default:
+		return nil
+	}
+	if err := m.reloadMerge(ms); err != nil {
+		return err
+	}
+	if len(ms.Groups) == 0 {
+		m.setStatusInfo(ms.Empty)
+		return nil
+	}
+	m.merge = ms
+	return nil
+}
+
+// vendorMergeState offers each group of similarly named vendors.
+func (m *Model) vendorMergeState() *mergeState {
+	return &mergeState{
+		Title: "Duplicate Vendors",
+		Noun:  "vendor",
+		Empty: "No similarly named vendors.",
+		merge: m.store.MergeVendors,
+		load: func() ([][]mergeCandidate, map[string]string, error) {
+			groups, err := m.store.FindSimilarVendors()
+			if err != nil {
+				return nil, nil, fmt.Errorf("find similar vendors: %w", err)
+			}
+			candidates := make([][]mergeCandidate, len(groups))
+			var ids []string
+			for i, g := range groups {
+				for _, v := range g {
+					candidates[i] = append(candidates[i], mergeCandidate{ID: v.ID, Name: v.Name})
+					ids = append(ids, v.ID)
+				}
+			}
+			quotes, err := m.store.CountQuotesByVendor(ids)
+			if err != nil {
+				return nil, nil, fmt.Errorf("count quotes: %w", err)
+			}
+			logs, err := m.store.CountServiceLogsByVendor(ids)
+			if err != nil {
+				return nil, nil, fmt.Errorf("count service logs: %w", err)
+			}
+			return candidates, mergeUsage(ids, []usageCount{
+				{quotes, "quote"}, {logs, "service log"},
+			}), nil
+		},
+	}
+}
+
+// applianceMergeState offers the given appliances as one group, for as
+// long as at least two of them are left.
+func (m *Model) applianceMergeState(ids []string) *mergeState {
+	return &mergeState{
+		Title: "Merge Appliances",
+		Noun:  "appliance",
+		Empty: "Nothing left to merge.",
+		merge: m.store.MergeAppliances,
+		load: func() ([][]mergeCandidate, map[string]string, error) {
+			var group []mergeCandidate
+			var alive []string
+			for _, id := range ids {
+				a, err := m.store.GetAppliance(id)
+				if err != nil {
+					continue // merged away or deleted
+				}
+				group = append(group, mergeCandidate{ID: a.ID, Name: a.Name})
+				alive = append(alive, a.ID)
+			}
+			if len(group) < 2 {
+				return nil, nil, nil
+			}
+			items, err := m.store.CountMaintenanceByAppliance(alive)
+			if err != nil {
+				return nil, nil, fmt.Errorf("count maintenance: %w", err)
+			}
+			incidents, err := m.store.CountIncidentsByAppliance(alive)
+			if err != nil {
+				return nil, nil, fmt.Errorf("count incidents: %w", err)
+			}
+			return [][]mergeCandidate{group}, mergeUsage(alive, []usageCount{
+				{items, "maintenance item"}, {incidents, "incident"},
+			}), nil
+		},
+	}
+}
+
+// usageCount is how many records of one kind use each merge candidate.
+type usageCount struct {
+	counts map[string]int
+	noun   string
+}
+
+// mergeUsage describes what uses each record, e.g. "2 quotes, 1 service
+// log".
+func mergeUsage(ids []string, uses []usageCount) map[string]string {
+	usage := make(map[string]string, len(ids))
+	for _, id := range ids {
+		var parts []string
+		for _, u := range uses {
+			if n := u.counts[id]; n > 0 {
+				parts = append(parts, countNoun(n, u.noun))
+			}
+		}
+		usage[id] = strings.Join(parts, ", ")
+	}
+	return usage
+}
+
+// reloadMerge refreshes the groups, keeping the current group in range.
+func (m *Model) reloadMerge(ms *mergeState) error {
+	groups, usage, err := ms.load()
+	if err != nil {
+		return err
+	}
+	ms.Groups = groups
+	ms.Usage = usage
+	ms.Skipped = nil
+	ms.Cursor = 0
+	ms.Group = min(ms.Group, max(len(groups)-1, 0))
+	return nil
+}
+
+// countNoun formats n with noun, pluralized with a trailing s.
+func countNoun(n int, noun string) string {
+	if n == 1 {
+		return "1 " + noun
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+// mergeGroup merges the current group's records, minus the skipped ones,
+// into the record under the cursor.
+func (m *Model) mergeGroup() error {
+	ms := m.merge
+	if ms == nil || ms.Group >= len(ms.Groups) {
+		return nil
+	}
+	group := ms.Groups[ms.Group]
+	keep := group[ms.Cursor]
+	var ids []string
+	for _, c := range group {
+		if c.ID != keep.ID && !ms.Skipped[c.ID] {
+			ids = append(ids, c.ID)
+		}
+	}
+	if len(ids) == 0 {
+		m.setStatusInfo("Nothing to merge: every other " + ms.Noun + " is skipped.")
+		return nil
+	}
+	merge, err := ms.merge(keep.ID, ids)
+	if err != nil {
+		return fmt.Errorf("merge %ss: %w", ms.Noun, err)
+	}
+	ms.Last = &merge
+	ms.LastName = keep.Name
+	if tab := m.effectiveTab(); tab != nil {
+		tab.Marked = nil
+	}
+	m.reloadAfterMutation()
+	if err := m.reloadMerge(ms); err != nil {
+		return err
+	}
+	m.setStatusInfo(fmt.Sprintf(
+		"Merged %s into %s. %s to undo.",
+		countNoun(len(ids), ms.Noun), keep.Name, keyU,
+	))
+	return nil
+}
+
+// undoMerge reverses the last merge made in the overlay.
+func (m *Model) undoMerge() error {
+	ms := m.merge
+	if ms == nil || ms.Last == nil {
+		m.setStatusInfo("Nothing to undo.")
+		return nil
+	}
+	if err := m.store.UndoMerge(*ms.Last); err != nil {
+		return fmt.Errorf("undo merge: %w", err)
+	}
+	name := ms.LastName
+	ms.Last = nil
+	ms.LastName = ""
+	m.reloadAfterMutation()
+	if err := m.reloadMerge(ms); err != nil {
+		return err
+	}
+	m.setStatusInfo("Undid the merge into " + name + ".")
+	return nil
+}
+
+// handleMergeKey processes keys while the merge overlay is open.
+func (m *Model) handleMergeKey(msg tea.KeyPressMsg) tea.Cmd {
+	ms := m.merge
+	if ms == nil {
+		return nil
+	}
+	var group []mergeCandidate
+	if ms.Group < len(ms.Groups) {
+		group = ms.Groups[ms.Group]
+	}
+	var err error
+	switch {
+	case key.Matches(msg, m.keys.MergeClose):
+		m.merge = nil
+	case key.Matches(msg, m.keys.MergeUp):
+		if ms.Cursor > 0 {
+			ms.Cursor--
+		}
+	case key.Matches(msg, m.keys.MergeDown):
+		if ms.Cursor < len(group)-1 {
+			ms.Cursor++
+		}
+	case key.Matches(msg, m.keys.MergePrev):
+		if ms.Group > 0 {
+			ms.Group--
+			ms.Cursor = 0
+			ms.Skipped = nil
+		}
+	case key.Matches(msg, m.keys.MergeNext):
+		if ms.Group < len(ms.Groups)-1 {
+			ms.Group++
+			ms.Cursor = 0
+			ms.Skipped = nil
+		}
+	case key.Matches(msg, m.keys.MergeSkip):
+		if ms.Cursor < len(group) {
+			id := group[ms.Cursor].ID
+			if ms.Skipped == nil {
+				ms.Skipped = make(map[string]bool)
+			}
+			ms.Skipped[id] = !ms.Skipped[id]
+		}
+	case key.Matches(msg, m.keys.MergeConfirm):
+		err = m.mergeGroup()
+	case key.Matches(msg, m.keys.MergeUndo):
+		err = m.undoMerge()
+	}
+	if err != nil {
+		m.setStatusError(humanizeError(err))
+	}
+	return nil
+}
+
+// buildMergeOverlay renders the current group as a bordered box.
+func (m *Model) buildMergeOverlay() string {
+	ms := m.merge
+	if ms == nil {
+		return ""
+	}
+
+	contentW := max(32, min(64, m.effectiveWidth()-12))
+	innerW := contentW - appStyles.OverlayBox().GetHorizontalFrameSize()
+
+	var b strings.Builder
+	b.WriteString(m.styles.HeaderSection().Render(" " + ms.Title + " "))
+	if len(ms.Groups) > 1 {
+		b.WriteString(" ")
+		b.WriteString(m.styles.HeaderHint().Render(
+			fmt.Sprintf("%d of %d", ms.Group+1, len(ms.Groups)),
+		))
+	}
+	b.WriteString("\n\n")
+
+	if len(ms.Groups) == 0 {
+		b.WriteString(m.styles.HeaderHint().Render(ms.Empty))
+		b.WriteString("\n")
+	} else {
+		for i, c := range ms.Groups[ms.Group] {
+			box := "[x] "
+			if ms.Skipped[c.ID] {
+				box = "[ ] "
+			}
+			title := c.Name
+			if usage := ms.Usage[c.ID]; usage != "" {
+				title += " " + m.styles.HeaderHint().Render(usage)
+			}
+			line := "  " + box + title
+			if i == ms.Cursor {
+				line = appStyles.AccentBold().Render("▸ ") + "keep " + title
+			}
+			if lipgloss.Width(line) > innerW {
+				line = appStyles.Base().MaxWidth(innerW).Render(line)
+			}
+			b.WriteString(line)
+			b.WriteString("\n")
+		}
+	}
+
+	b.WriteString("\n")
+	var hints []string
+	if len(ms.Groups) > 0 {
+		hints = append(hints,
+			m.helpItem(symReturn, "merge into selected"),
+			m.helpItem(keySpace, "skip"),
+		)
+		if len(ms.Groups) > 1 {
+			hints = append(hints, m.helpItem(keyH+"/"+keyL, "group"))
+		}
+	}
+	if ms.Last != nil {
+		hints = append(hints, m.helpItem(keyU, "undo"))
+	}
+	hints = append(hints, m.helpItem(keyEsc, "close"))
+	b.WriteString(joinWithSeparator(m.helpSeparator(), hints...))
+
+	return appStyles.OverlayBox().
+		Width(contentW).
+		Render(b.String())
+}
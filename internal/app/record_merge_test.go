@@ -0,0 +1,135 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"testing"
+
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// openVendorsForMerge opens the Vendors tab in edit mode.
+func openVendorsForMerge(t *testing.T, m *Model) {
+	t.Helper()
+	m.showDashboard = false
+	m.switchToTab(tabIndex(tabVendors))
+	require.NoError(t, m.reloadActiveTab())
+	m.enterEditMode()
+}
+
+func TestVendorMergeNoDuplicates(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	require.NoError(t, m.store.CreateVendor(&data.Vendor{Name: "Acme"}))
+	require.NoError(t, m.store.CreateVendor(&data.Vendor{Name: "Bright Electric"}))
+	openVendorsForMerge(t, m)
+
+	sendKey(m, keyShiftM)
+	assert.Nil(t, m.merge)
+	assert.Contains(t, m.statusView(), "No similarly named vendors")
+}
+
+func TestVendorMergeAndUndo(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	projID := seedProject(t, m)
+	require.NoError(t, m.store.CreateVendor(&data.Vendor{Name: "Acme"}))
+	require.NoError(t, m.store.CreateQuote(&data.Quote{
+		ProjectID: projID, TotalCents: 50000,
+	}, data.Vendor{Name: "ACME PLUMBING LLC"}))
+	require.NoError(t, m.store.CreateVendor(&data.Vendor{Name: "Acme Plumbing"}))
+	openVendorsForMerge(t, m)
+
+	sendKey(m, keyShiftM)
+	require.NotNil(t, m.merge)
+	view := m.buildView()
+	assert.Contains(t, view, "Duplicate Vendors")
+	assert.Contains(t, view, "1 quote")
+
+	// Keep "Acme Plumbing", leave plain "Acme" out of the merge.
+	require.Equal(t, "ACME PLUMBING LLC", m.merge.Groups[0][0].Name)
+	sendKey(m, keyDown)
+	sendKey(m, keySpace)
+	sendKey(m, keyDown)
+	sendKey(m, keyEnter)
+	assert.Contains(t, m.status.Text, "Merged 1 vendor into Acme Plumbing.")
+
+	vendors, err := m.store.ListVendors(false)
+	require.NoError(t, err)
+	require.Len(t, vendors, 2)
+	assert.Equal(t, "Acme", vendors[0].Name)
+	assert.Equal(t, "Acme Plumbing", vendors[1].Name)
+	quotes, err := m.store.ListQuotesByVendor(vendors[1].ID, false)
+	require.NoError(t, err)
+	assert.Len(t, quotes, 1)
+
+	require.NotNil(t, m.merge, "the overlay stays open for undo")
+	sendKey(m, keyU)
+	assert.Equal(t, "Undid the merge into Acme Plumbing.", m.status.Text)
+	vendors, err = m.store.ListVendors(false)
+	require.NoError(t, err)
+	assert.Len(t, vendors, 3)
+	assert.Len(t, m.merge.Groups[0], 3)
+
+	sendKey(m, keyEsc)
+	assert.Nil(t, m.merge)
+}
+
+func TestVendorMergeOnlyOnVendorsTab(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	require.NoError(t, m.store.CreateVendor(&data.Vendor{Name: "Acme"}))
+	require.NoError(t, m.store.CreateVendor(&data.Vendor{Name: "Acme Inc"}))
+	m.showDashboard = false
+	m.switchToTab(tabIndex(tabProjects))
+	m.enterEditMode()
+
+	sendKey(m, keyShiftM)
+	assert.Nil(t, m.merge)
+}
+
+func TestMergeMarkedAppliances(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	keep := data.Appliance{Name: "Dishwasher"}
+	dup := data.Appliance{Name: "Bosch dishwasher"}
+	other := data.Appliance{Name: "Furnace"}
+	for _, a := range []*data.Appliance{&keep, &dup, &other} {
+		require.NoError(t, m.store.CreateAppliance(a))
+	}
+	m.showDashboard = false
+	m.switchToTab(tabIndex(tabAppliances))
+	require.NoError(t, m.reloadActiveTab())
+	m.enterEditMode()
+
+	selectRow(t, m, keep.ID)
+	sendKey(m, keyShiftM)
+	assert.Nil(t, m.merge, "one appliance is nothing to merge")
+	assert.Contains(t, m.status.Text, "Mark the appliances to merge")
+
+	sendKey(m, keySpace)
+	selectRow(t, m, dup.ID)
+	sendKey(m, keyShiftM)
+	require.NotNil(t, m.merge, "the marked appliance and the selected one")
+	require.Len(t, m.merge.Groups, 1)
+	assert.Len(t, m.merge.Groups[0], 2)
+	assert.Contains(t, m.buildView(), "Merge Appliances")
+
+	require.Equal(t, keep.ID, m.merge.Groups[0][0].ID)
+	sendKey(m, keyEnter)
+	assert.Contains(t, m.status.Text, "Merged 1 appliance into Dishwasher.")
+	assert.Empty(t, m.merge.Groups, "nothing left to merge")
+	assert.Empty(t, m.effectiveTab().Marked)
+	_, err := m.store.GetAppliance(dup.ID)
+	require.Error(t, err)
+
+	sendKey(m, keyU)
+	_, err = m.store.GetAppliance(dup.ID)
+	require.NoError(t, err, "undo restores the duplicate")
+	assert.Len(t, m.merge.Groups, 1)
+	sendKey(m, keyEsc)
+	assert.Nil(t, m.merge)
+}
@@ -31,13 +31,13 @@ func TestProjectRows(t *testing.T) {
 			StartDate:     &start,
 		},
 	}
-	rows, meta, cells := projectRows(projects, nil, nil, cur)
+	rows, meta, cells := projectRows(projects, nil, nil, nil, cur)
 	require.Len(t, rows, 1)
 	assert.Equal(t, "01JTEST00000000000000001", meta[0].ID)
 	assert.False(t, meta[0].Deleted)
 	assert.Equal(t, "Kitchen", cells[0][2].Value)
 	assert.Equal(t, "$1,000.00", cells[0][4].Value)
-	assert.Equal(t, "2025-03-01", cells[0][6].Value)
+	assert.Equal(t, "2025-03-01", cells[0][int(projectColStart)].Value)
 	assert.Equal(t, "Kitchen", rows[0][2])
 }
 
@@ -51,7 +51,7 @@ func TestProjectRowsDeleted(t *testing.T) {
 			DeletedAt: gorm.DeletedAt{Time: time.Now(), Valid: true},
 		},
 	}
-	_, meta, _ := projectRows(projects, nil, nil, cur)
+	_, meta, _ := projectRows(projects, nil, nil, nil, cur)
 	assert.True(t, meta[0].Deleted)
 }
 
@@ -134,7 +134,7 @@ func TestMaintenanceRows(t *testing.T) {
 		},
 	}
 	logCounts := map[string]int{"01JTEST00000000000000001": 4}
-	rows, meta, cells := maintenanceRows(items, logCounts, nil)
+	rows, meta, cells := maintenanceRows(items, logCounts, nil, nil, locale.DefaultCurrency())
 	require.Len(t, rows, 1)
 	assert.Equal(t, "01JTEST00000000000000001", meta[0].ID)
 	assert.Equal(t, "HVAC Filter", cells[0][int(maintenanceColItem)].Value)
@@ -152,7 +152,7 @@ func TestMaintenanceRowsDocCount(t *testing.T) {
 		{ID: "01JTEST00000000000000002", Name: "Gutters", IntervalMonths: 6},
 	}
 	docCounts := map[string]int{"01JTEST00000000000000001": 7}
-	_, _, cells := maintenanceRows(items, nil, docCounts)
+	_, _, cells := maintenanceRows(items, nil, docCounts, nil, locale.DefaultCurrency())
 	require.Len(t, cells, 2)
 	assert.Equal(t, "7", cells[0][int(maintenanceColDocs)].Value)
 	assert.Equal(t, cellDrilldown, cells[0][int(maintenanceColDocs)].Kind)
@@ -168,7 +168,7 @@ func TestMaintenanceRowsNoAppliance(t *testing.T) {
 			Category: data.MaintenanceCategory{Name: "Exterior"},
 		},
 	}
-	_, _, cells := maintenanceRows(items, nil, nil)
+	_, _, cells := maintenanceRows(items, nil, nil, nil, locale.DefaultCurrency())
 	appCol := int(maintenanceColAppliance)
 	assert.Empty(t, cells[0][appCol].Value)
 	assert.True(t, cells[0][appCol].Null, "nil appliance should produce a null cell")
@@ -188,7 +188,7 @@ func TestMaintenanceRowsDueDateUrgencyCell(t *testing.T) {
 			Category: data.MaintenanceCategory{Name: "Exterior"},
 		},
 	}
-	_, _, cells := maintenanceRows(items, nil, nil)
+	_, _, cells := maintenanceRows(items, nil, nil, nil, locale.DefaultCurrency())
 	nextCell := cells[0][int(maintenanceColNext)]
 	// "Next" column shows the due date with cellUrgency kind (same as interval items).
 	assert.Equal(t, "2025-11-01", nextCell.Value)
@@ -210,7 +210,7 @@ func TestMaintenanceRowsSeasonCell(t *testing.T) {
 			Category: data.MaintenanceCategory{Name: "Exterior"},
 		},
 	}
-	_, _, cells := maintenanceRows(items, nil, nil)
+	_, _, cells := maintenanceRows(items, nil, nil, nil, locale.DefaultCurrency())
 	seasonCell := cells[0][int(maintenanceColSeason)]
 	assert.Equal(t, data.SeasonSpring, seasonCell.Value)
 	assert.Equal(t, cellStatus, seasonCell.Kind,
@@ -226,7 +226,7 @@ func TestMaintenanceRowsNoSeason(t *testing.T) {
 			Category: data.MaintenanceCategory{Name: "HVAC"},
 		},
 	}
-	_, _, cells := maintenanceRows(items, nil, nil)
+	_, _, cells := maintenanceRows(items, nil, nil, nil, locale.DefaultCurrency())
 	seasonCell := cells[0][int(maintenanceColSeason)]
 	assert.Empty(t, seasonCell.Value)
 	assert.True(t, seasonCell.Null, "empty season should produce a null cell")
@@ -280,7 +280,7 @@ func TestApplianceRowsNoOptionalFields(t *testing.T) {
 func TestBuildRowsEmpty(t *testing.T) {
 	t.Parallel()
 	cur := locale.DefaultCurrency()
-	rows, meta, cells := projectRows(nil, nil, nil, cur)
+	rows, meta, cells := projectRows(nil, nil, nil, nil, cur)
 	assert.Empty(t, rows)
 	assert.Empty(t, meta)
 	assert.Empty(t, cells)
@@ -423,7 +423,7 @@ func TestProjectRowsNullOptionalFields(t *testing.T) {
 	projects := []data.Project{
 		{ID: "01JTEST00000000000000001", Title: "Minimal", Status: data.ProjectStatusPlanned},
 	}
-	_, _, cells := projectRows(projects, nil, nil, cur)
+	_, _, cells := projectRows(projects, nil, nil, nil, cur)
 	require.Len(t, cells, 1)
 	// Budget (col 4), Actual (col 5), Start (col 6), End (col 7) are all nil.
 	assert.True(t, cells[0][4].Null, "nil budget should be null")
@@ -0,0 +1,289 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/micasa-dev/micasa/internal/data"
+)
+
+// formChange is one field an edit changed, with both values formatted for
+// display. Empty values stay empty.
+type formChange struct {
+	Label string
+	Old   string
+	New   string
+}
+
+// saveReviewState holds the changes the save review overlay asks about.
+type saveReviewState struct {
+	Changes []formChange
+	// Closing is set when the review came from completing the form, so
+	// saving also closes it and going back rebuilds it.
+	Closing bool
+}
+
+// entityIDFields maps form fields holding another record's ID to the
+// document entity kind whose name stands in for the ID.
+var entityIDFields = map[string]string{
+	"ApplianceID":       data.DocumentEntityAppliance,
+	"MaintenanceItemID": data.DocumentEntityMaintenance,
+	"ProjectID":         data.DocumentEntityProject,
+	"VendorID":          data.DocumentEntityVendor,
+}
+
+// reviewSave opens the save review instead of saving when the open form
+// edits an existing record, review is enabled, and something changed.
+// closing is whether the save would also close the form.
+func (m *Model) reviewSave(closing bool) bool {
+	if !m.reviewChanges || m.fs.notesEditMode || !m.fs.formHasRequired {
+		return false
+	}
+	if m.fs.editID == nil && (m.fs.formKind() != formHouse || !m.hasHouse) {
+		return false
+	}
+	changes := m.formChanges()
+	if len(changes) == 0 {
+		return false
+	}
+	m.saveReview = &saveReviewState{Changes: changes, Closing: closing}
+	return true
+}
+
+// formChanges lists the fields whose values differ between the form's
+// snapshot and its current data, in field order. IDs are shown as names.
+func (m *Model) formChanges() []formChange {
+	if m.fs.formData == nil || m.fs.formSnapshot == nil {
+		return nil
+	}
+	before := reflect.ValueOf(m.fs.formSnapshot).Elem()
+	after := reflect.ValueOf(m.fs.formData).Elem()
+	var names entityNameMap
+	lookup := func() entityNameMap {
+		if names == nil {
+			names = buildEntityNameMap(m.store)
+		}
+		return names
+	}
+	var changes []formChange
+	for i := range after.NumField() {
+		field := after.Type().Field(i)
+		if field.Type.Kind() == reflect.Bool || before.Field(i).Equal(after.Field(i)) {
+			continue
+		}
+		changes = append(changes, formChange{
+			Label: fieldLabel(field.Name),
+			Old:   m.formChangeValue(field.Name, before.Field(i).Interface(), lookup),
+			New:   m.formChangeValue(field.Name, after.Field(i).Interface(), lookup),
+		})
+	}
+	return changes
+}
+
+// formChangeValue formats one form field value for the review, resolving
+// IDs to the names the form's selects show.
+func (m *Model) formChangeValue(field string, v any, names func() entityNameMap) string {
+	switch v := v.(type) {
+	case scheduleType:
+		for _, opt := range scheduleTypeOptions() {
+			if opt.Value == v {
+				return opt.Key
+			}
+		}
+	case entityRef:
+		if v.Kind == "" {
+			return ""
+		}
+		return documentEntityLabel(v.Kind, v.ID, names())
+	case string:
+		if v == "" {
+			return ""
+		}
+		switch field {
+		case "ProjectTypeID":
+			for _, t := range m.projectTypes {
+				if t.ID == v {
+					return t.Name
+				}
+			}
+		case "CategoryID":
+			for _, c := range m.maintenanceCategories {
+				if c.ID == v {
+					return c.Name
+				}
+			}
+		}
+		if kind, ok := entityIDFields[field]; ok {
+			if name, found := names()[entityRef{Kind: kind, ID: v}]; found {
+				return name
+			}
+			return "#" + shortID(v)
+		}
+		if extra := extraLineCount(v); extra > 0 {
+			return fmt.Sprintf("%s +%d", firstLine(v), extra)
+		}
+		return v
+	}
+	return fmt.Sprint(v)
+}
+
+// fieldLabel turns a form data field name into a label: "PurchaseDate"
+// becomes "Purchase date", "HOAFee" "HOA fee", and "VendorID" "Vendor".
+func fieldLabel(name string) string {
+	runes := []rune(strings.TrimSuffix(name, "ID"))
+	var words []string
+	start := 0
+	for i := 1; i < len(runes); i++ {
+		prev, cur := runes[i-1], runes[i]
+		nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+		if unicode.IsUpper(cur) && (!unicode.IsUpper(prev) || nextLower) ||
+			unicode.IsDigit(cur) && !unicode.IsDigit(prev) {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+	words = append(words, string(runes[start:]))
+	for i := 1; i < len(words); i++ {
+		if strings.ToUpper(words[i]) != words[i] {
+			words[i] = strings.ToLower(words[i])
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// handleSaveReviewKey processes keys while the save review is open.
+func (m *Model) handleSaveReviewKey(msg tea.KeyPressMsg) tea.Cmd {
+	sr := m.saveReview
+	if sr == nil {
+		return nil
+	}
+	switch {
+	case key.Matches(msg, m.keys.ReviewSave):
+		m.saveReview = nil
+		if sr.Closing {
+			return m.commitForm()
+		}
+		return m.commitFormInPlace()
+	case key.Matches(msg, m.keys.ReviewBack):
+		m.saveReview = nil
+		if sr.Closing {
+			if err := m.resumeForm(); err != nil {
+				m.setStatusError(humanizeError(err))
+				m.exitForm()
+				return nil
+			}
+			return m.formInitCmd()
+		}
+	}
+	return nil
+}
+
+// resumeForm rebuilds a completed form around its current values so
+// editing can continue, keeping the snapshot the changes are measured
+// against.
+func (m *Model) resumeForm() error {
+	values, snapshot, prevMode := m.fs.formData, m.fs.formSnapshot, m.prevMode
+	switch v := values.(type) {
+	case *houseFormData:
+		m.openHouseForm(v)
+	case *projectFormData:
+		m.openProjectForm(v, projectTypeOptions(m.projectTypes))
+	case *quoteFormData:
+		projects, err := m.store.ListProjects(false)
+		if err != nil {
+			return fmt.Errorf("list projects: %w", err)
+		}
+		m.openQuoteForm(v, projectOptions(projects))
+	case *maintenanceFormData:
+		appliances, err := m.store.ListAppliances(false)
+		if err != nil {
+			return fmt.Errorf("list appliances: %w", err)
+		}
+		m.openMaintenanceForm(
+			v, maintenanceOptions(m.maintenanceCategories), applianceOptions(appliances),
+		)
+	case *incidentFormData:
+		appliances, err := m.store.ListAppliances(false)
+		if err != nil {
+			return fmt.Errorf("list appliances: %w", err)
+		}
+		m.openIncidentForm(v, applianceOptions(appliances), vendorOpts("(none)", m.vendors))
+	case *applianceFormData:
+		m.openApplianceForm(v)
+	case *vendorFormData:
+		m.openVendorForm(v)
+	case *serviceLogFormData:
+		m.openServiceLogForm(v, vendorOpts("Self (homeowner)", m.vendors))
+	case *documentFormData:
+		if err := m.openEditDocumentForm(v, len(m.detailStack) > 0); err != nil {
+			return err
+		}
+	default:
+		return errors.New("form cannot be reopened")
+	}
+	m.prevMode = prevMode
+	m.fs.formSnapshot = snapshot
+	m.checkFormDirty()
+	return nil
+}
+
+// buildSaveReviewOverlay renders each changed field as "old -> new" with
+// the keys to save or go back.
+func (m *Model) buildSaveReviewOverlay() string {
+	sr := m.saveReview
+	if sr == nil {
+		return ""
+	}
+	contentW := m.overlayContentWidth()
+	innerW := contentW - m.styles.OverlayBox().GetHorizontalFrameSize()
+
+	labelW := 0
+	for _, c := range sr.Changes {
+		labelW = max(labelW, lipgloss.Width(c.Label))
+	}
+	valueW := max((innerW-labelW-2-lipgloss.Width(symRight)-2)/2, 8)
+	value := func(s string) string {
+		if s == "" {
+			return m.styles.Null().Render(symEmDash)
+		}
+		return m.styles.DashValue().Render(truncateRight(s, valueW))
+	}
+
+	var b strings.Builder
+	title := "Review Changes"
+	if n := len(sr.Changes); n == 1 {
+		title += " " + symMiddleDot + " 1 field"
+	} else {
+		title += fmt.Sprintf(" %s %d fields", symMiddleDot, n)
+	}
+	b.WriteString(m.styles.HeaderSection().Render(" " + title + " "))
+	b.WriteString("\n\n")
+	for _, c := range sr.Changes {
+		label := c.Label + strings.Repeat(" ", labelW-lipgloss.Width(c.Label))
+		b.WriteString(m.styles.DashLabel().Render(label))
+		b.WriteString("  " + value(c.Old) + " " + symRight + " " + value(c.New) + "\n")
+	}
+	b.WriteString("\n")
+	save := "save"
+	if sr.Closing {
+		save = "save and close"
+	}
+	b.WriteString(joinWithSeparator(m.helpSeparator(),
+		m.helpItem(keyY, save),
+		m.helpItem(keyN, "keep editing"),
+	))
+
+	return m.styles.OverlayBox().
+		Width(contentW).
+		MaxHeight(m.overlayMaxHeight()).
+		Render(b.String())
+}
@@ -0,0 +1,166 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"testing"
+
+	"charm.land/huh/v2"
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldLabel(t *testing.T) {
+	t.Parallel()
+	cases := map[string]string{
+		"Name":              "Name",
+		"PurchaseDate":      "Purchase date",
+		"HOAFee":            "HOA fee",
+		"AddressLine1":      "Address line 1",
+		"VendorID":          "Vendor",
+		"MaintenanceItemID": "Maintenance item",
+		"ManualURL":         "Manual URL",
+	}
+	for in, want := range cases {
+		assert.Equal(t, want, fieldLabel(in), in)
+	}
+}
+
+func TestSaveReviewOnCtrlS(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	m.reviewChanges = true
+	openHouseForm(m)
+	values, ok := m.fs.formData.(*houseFormData)
+	require.True(t, ok)
+	old := values.Nickname
+	values.Nickname = "Beach House"
+	m.checkFormDirty()
+
+	sendKey(m, "ctrl+s")
+	require.NotNil(t, m.saveReview, "ctrl+s on an edit opens the review")
+	assert.Equal(t, []formChange{{Label: "Nickname", Old: old, New: "Beach House"}},
+		m.saveReview.Changes)
+	assert.Contains(t, m.buildView(), "Review Changes")
+	require.NoError(t, m.loadHouse())
+	assert.Equal(t, old, m.house.Nickname, "nothing is saved before confirming")
+
+	sendKey(m, "n")
+	assert.Nil(t, m.saveReview)
+	assert.Equal(t, modeForm, m.mode)
+	assert.True(t, m.fs.formDirty, "going back keeps the edit")
+
+	sendKey(m, "ctrl+s")
+	sendKey(m, "y")
+	assert.Nil(t, m.saveReview)
+	assert.Equal(t, modeForm, m.mode, "ctrl+s leaves the form open")
+	assert.False(t, m.fs.formDirty)
+	require.NoError(t, m.loadHouse())
+	assert.Equal(t, "Beach House", m.house.Nickname)
+}
+
+func TestSaveReviewOnCompletion(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	m.reviewChanges = true
+	item := data.Appliance{Name: "Fridge", Brand: "Acme"}
+	require.NoError(t, m.store.CreateAppliance(&item))
+	m.active = tabIndex(tabAppliances)
+	require.NoError(t, m.startEditApplianceForm(item.ID))
+	values, ok := m.fs.formData.(*applianceFormData)
+	require.True(t, ok)
+	values.Brand = "Frosty"
+
+	m.fs.form.State = huh.StateCompleted
+	m.saveForm()
+	require.NotNil(t, m.saveReview)
+	assert.True(t, m.saveReview.Closing)
+
+	sendKey(m, "esc")
+	assert.Nil(t, m.saveReview)
+	require.Equal(t, modeForm, m.mode, "going back reopens the form")
+	assert.Equal(t, huh.StateNormal, m.fs.form.State)
+	values, ok = m.fs.formData.(*applianceFormData)
+	require.True(t, ok)
+	assert.Equal(t, "Frosty", values.Brand, "the reopened form keeps the edit")
+	assert.True(t, m.fs.formDirty)
+
+	m.fs.form.State = huh.StateCompleted
+	m.saveForm()
+	sendKey(m, "enter")
+	assert.Nil(t, m.saveReview)
+	assert.NotEqual(t, modeForm, m.mode, "saving from completion closes the form")
+	got, err := m.store.GetAppliance(item.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Frosty", got.Brand)
+}
+
+func TestSaveReviewSkipped(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+		m := newTestModelWithStore(t)
+		openHouseForm(m)
+		values, ok := m.fs.formData.(*houseFormData)
+		require.True(t, ok)
+		values.Nickname = "Lake House"
+		sendKey(m, "ctrl+s")
+		assert.Nil(t, m.saveReview)
+		require.NoError(t, m.loadHouse())
+		assert.Equal(t, "Lake House", m.house.Nickname)
+	})
+
+	t.Run("new record", func(t *testing.T) {
+		t.Parallel()
+		m := newTestModelWithStore(t)
+		m.reviewChanges = true
+		openAddForm(m)
+		values, ok := m.fs.formData.(*projectFormData)
+		require.True(t, ok)
+		values.Title = "New Deck"
+		sendKey(m, "ctrl+s")
+		assert.Nil(t, m.saveReview)
+		assert.NotNil(t, m.fs.editID, "the project was created")
+	})
+
+	t.Run("unchanged", func(t *testing.T) {
+		t.Parallel()
+		m := newTestModelWithStore(t)
+		m.reviewChanges = true
+		openHouseForm(m)
+		sendKey(m, "ctrl+s")
+		assert.Nil(t, m.saveReview)
+	})
+}
+
+func TestFormChangesResolveIDs(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	vendor := data.Vendor{Name: "Acme Plumbing"}
+	require.NoError(t, m.store.CreateVendor(&vendor))
+	appliance := data.Appliance{Name: "Water heater"}
+	require.NoError(t, m.store.CreateAppliance(&appliance))
+	incident := data.Incident{
+		Title:    "Leak",
+		Status:   data.IncidentStatusOpen,
+		Severity: data.IncidentSeveritySoon,
+	}
+	require.NoError(t, m.store.CreateIncident(&incident))
+	require.NoError(t, m.loadLookups())
+
+	require.NoError(t, m.startEditIncidentForm(incident.ID))
+	values, ok := m.fs.formData.(*incidentFormData)
+	require.True(t, ok)
+	values.ApplianceID = appliance.ID
+	values.VendorID = vendor.ID
+	values.Notes = "first\nsecond\nthird"
+
+	assert.Equal(t, []formChange{
+		{Label: "Appliance", Old: "", New: "Water heater"},
+		{Label: "Vendor", Old: "", New: "Acme Plumbing"},
+		{Label: "Notes", Old: "", New: "first +2"},
+	}, m.formChanges())
+}
@@ -17,14 +17,84 @@ import (
 // Zone ID prefix for clickable search result rows.
 const zoneSearchRow = "search-"
 
+const (
+	// docSearchLimit caps the result list, matching the FTS query limit.
+	docSearchLimit = 50
+
+	// docSearchFuzzyMinRunes: shorter queries skip title fuzzy matching,
+	// which would match nearly every title.
+	docSearchFuzzyMinRunes = 3
+
+	// docSearchFTSScore is the score a full-text hit earns on top of its
+	// title match. Below any title match, so documents whose title
+	// matches the query rank above those that only mention it.
+	docSearchFTSScore = 50
+)
+
+// docSearchScope restricts search to documents linked to one entity kind.
+type docSearchScope struct {
+	Kind   string // data.DocumentEntity* value
+	Prefix string // typed before a colon in the query, e.g. "a:fridge"
+	Label  string // shown in the overlay title
+}
+
+// docSearchScopes lists the scopes in the order the scope key cycles
+// through them. Prefixes match the Entity column letters.
+var docSearchScopes = []docSearchScope{
+	{Kind: data.DocumentEntityAppliance, Prefix: "a", Label: "Appliances"},
+	{Kind: data.DocumentEntityIncident, Prefix: "i", Label: "Incidents"},
+	{Kind: data.DocumentEntityMaintenance, Prefix: "m", Label: "Maintenance"},
+	{Kind: data.DocumentEntityProject, Prefix: "p", Label: "Projects"},
+	{Kind: data.DocumentEntityQuote, Prefix: "q", Label: "Quotes"},
+	{Kind: data.DocumentEntityServiceLog, Prefix: "s", Label: "Service Log"},
+	{Kind: data.DocumentEntityVendor, Prefix: "v", Label: "Vendors"},
+}
+
+// splitSearchScope separates a scope prefix ("a:" or "appliance:") from
+// the rest of the query. Returns a nil scope and the query unchanged when
+// it has no recognized prefix.
+func splitSearchScope(query string) (*docSearchScope, string) {
+	head, rest, ok := strings.Cut(strings.TrimLeft(query, " "), ":")
+	if !ok {
+		return nil, query
+	}
+	head = strings.ToLower(head)
+	for i := range docSearchScopes {
+		sc := &docSearchScopes[i]
+		if head == sc.Prefix || head == sc.Kind {
+			return sc, rest
+		}
+	}
+	return nil, query
+}
+
 // docSearchState holds the state for the document search overlay.
 type docSearchState struct {
 	Input   textinput.Model
+	Scope   *docSearchScope // set by the scope key; a query prefix overrides it
 	Results []data.DocumentSearchResult
-	Cursor  int
-	Names   entityNameMap
+	// TitleMatches[i] holds the rune positions in Results[i].Title that
+	// matched the query, for highlighting. Nil when the title didn't match.
+	TitleMatches [][]int
+	Cursor       int
+	Names        entityNameMap
+	Docs         []data.Document // titles for fuzzy matching, loaded on open
+	// ConfirmDelete is set while the overlay asks whether to delete the
+	// selected result.
+	ConfirmDelete bool
 }
 
+// docSearchHit is a search result with its ranking score.
+type docSearchHit struct {
+	result    data.DocumentSearchResult
+	positions []int
+	score     int
+	index     int
+}
+
+func (h docSearchHit) fuzzyScore() int { return h.score }
+func (h docSearchHit) fuzzyIndex() int { return h.index }
+
 // openDocSearch shows the document search overlay.
 func (m *Model) openDocSearch() tea.Cmd {
 	ti := textinput.New()
@@ -33,9 +103,11 @@ func (m *Model) openDocSearch() tea.Cmd {
 	ti.SetWidth(m.searchInputWidth())
 	blinkCmd := ti.Focus()
 
+	docs, _ := m.store.ListDocuments(false)
 	m.docSearch = &docSearchState{
 		Input: ti,
 		Names: buildEntityNameMap(m.store),
+		Docs:  docs,
 	}
 	return blinkCmd
 }
@@ -64,6 +136,16 @@ func (m *Model) handleDocSearchKey(msg tea.KeyPressMsg) tea.Cmd {
 		return nil
 	}
 
+	if ds.ConfirmDelete {
+		switch {
+		case key.Matches(msg, m.keys.ConfirmYes):
+			m.docSearchDelete()
+		case key.Matches(msg, m.keys.ConfirmNo):
+			ds.ConfirmDelete = false
+		}
+		return nil
+	}
+
 	switch {
 	case key.Matches(msg, m.keys.DocSearchCancel):
 		m.closeDocSearch()
@@ -81,6 +163,18 @@ func (m *Model) handleDocSearchKey(msg tea.KeyPressMsg) tea.Cmd {
 			ds.Cursor++
 		}
 		return nil
+	case key.Matches(msg, m.keys.DocSearchScope):
+		ds.cycleScope()
+		m.runDocSearch()
+		return nil
+	case key.Matches(msg, m.keys.DocSearchEdit):
+		m.docSearchEdit()
+		return nil
+	case key.Matches(msg, m.keys.DocSearchDelete):
+		if len(ds.Results) > 0 {
+			ds.ConfirmDelete = true
+		}
+		return nil
 	default:
 		// Forward to textinput for typing.
 		var cmd tea.Cmd
@@ -90,25 +184,72 @@ func (m *Model) handleDocSearchKey(msg tea.KeyPressMsg) tea.Cmd {
 	}
 }
 
-// runDocSearch queries the FTS index with the current input value.
+// cycleScope advances the scope toggle: all documents, then each entity
+// kind in turn, then back to all.
+func (ds *docSearchState) cycleScope() {
+	if ds.Scope == nil {
+		ds.Scope = &docSearchScopes[0]
+		return
+	}
+	for i := range docSearchScopes {
+		if ds.Scope == &docSearchScopes[i] {
+			if i+1 < len(docSearchScopes) {
+				ds.Scope = &docSearchScopes[i+1]
+			} else {
+				ds.Scope = nil
+			}
+			return
+		}
+	}
+	ds.Scope = nil
+}
+
+// activeScope returns the scope in effect and the query without its scope
+// prefix. A prefix typed in the query wins over the toggle.
+func (ds *docSearchState) activeScope() (*docSearchScope, string) {
+	scope, query := splitSearchScope(ds.Input.Value())
+	if scope == nil {
+		scope = ds.Scope
+	}
+	return scope, query
+}
+
+// runDocSearch queries the FTS index with the current input value and
+// merges in documents whose titles fuzzily match it, limited to the
+// active scope.
 func (m *Model) runDocSearch() {
 	ds := m.docSearch
 	if ds == nil {
 		return
 	}
-	query := ds.Input.Value()
+	scope, query := ds.activeScope()
+	kind := data.DocumentEntityNone
+	if scope != nil {
+		kind = scope.Kind
+	}
 	if strings.TrimSpace(query) == "" {
 		ds.Results = nil
+		ds.TitleMatches = nil
 		ds.Cursor = 0
 		return
 	}
-	results, err := m.store.SearchDocuments(query)
+	results, err := m.store.SearchDocumentsOfKind(query, kind)
 	if err != nil {
 		ds.Results = nil
+		ds.TitleMatches = nil
 		ds.Cursor = 0
 		return
 	}
-	ds.Results = results
+	docs := ds.Docs
+	if kind != data.DocumentEntityNone {
+		docs = make([]data.Document, 0, len(ds.Docs))
+		for _, d := range ds.Docs {
+			if d.EntityKind == kind {
+				docs = append(docs, d)
+			}
+		}
+	}
+	ds.Results, ds.TitleMatches = rankDocSearch(query, results, docs)
 	if ds.Cursor >= len(ds.Results) {
 		ds.Cursor = len(ds.Results) - 1
 	}
@@ -117,6 +258,65 @@ func (m *Model) runDocSearch() {
 	}
 }
 
+// rankDocSearch orders full-text results and fuzzy title matches by score.
+// Full-text hits keep their relevance order among equals; documents whose
+// titles match the query, typos included, rank above content-only hits.
+func rankDocSearch(
+	query string,
+	fts []data.DocumentSearchResult,
+	docs []data.Document,
+) ([]data.DocumentSearchResult, [][]int) {
+	query = strings.TrimSpace(query)
+	fuzzy := len([]rune(query)) >= docSearchFuzzyMinRunes
+
+	hits := make([]docSearchHit, 0, len(fts))
+	seen := make(map[string]bool, len(fts))
+	for i, r := range fts {
+		hit := docSearchHit{result: r, score: docSearchFTSScore, index: i}
+		if fuzzy {
+			score, pos := fuzzyTypoMatch(query, r.Title)
+			hit.score += score
+			hit.positions = pos
+		}
+		hits = append(hits, hit)
+		seen[r.ID] = true
+	}
+	if fuzzy {
+		for _, d := range docs {
+			if seen[d.ID] {
+				continue
+			}
+			score, pos := fuzzyTypoMatch(query, d.Title)
+			if score == 0 {
+				continue
+			}
+			hits = append(hits, docSearchHit{
+				result: data.DocumentSearchResult{
+					ID:         d.ID,
+					Title:      d.Title,
+					FileName:   d.FileName,
+					EntityKind: d.EntityKind,
+					EntityID:   d.EntityID,
+					UpdatedAt:  d.UpdatedAt,
+				},
+				positions: pos,
+				score:     score,
+				index:     len(hits),
+			})
+		}
+	}
+
+	sortFuzzyScored(hits)
+	hits = hits[:min(len(hits), docSearchLimit)]
+	results := make([]data.DocumentSearchResult, len(hits))
+	matches := make([][]int, len(hits))
+	for i, h := range hits {
+		results[i] = h.result
+		matches[i] = h.positions
+	}
+	return results, matches
+}
+
 // docSearchNavigate jumps to the selected search result: switches to the
 // Documents tab and selects the matching row.
 func (m *Model) docSearchNavigate() {
@@ -146,6 +346,54 @@ func (m *Model) docSearchNavigate() {
 	}
 }
 
+// docSearchEdit jumps to the selected result and opens its edit form.
+func (m *Model) docSearchEdit() {
+	ds := m.docSearch
+	if ds == nil || len(ds.Results) == 0 {
+		return
+	}
+	id := ds.Results[ds.Cursor].ID
+	m.docSearchNavigate()
+	tab := m.effectiveTab()
+	if tab == nil {
+		return
+	}
+	if err := tab.Handler.StartEditForm(m, id); err != nil {
+		m.setStatusError(humanizeError(err))
+	}
+}
+
+// docSearchDelete soft-deletes the selected result and drops it from the
+// overlay, which stays open so the search can continue.
+func (m *Model) docSearchDelete() {
+	ds := m.docSearch
+	ds.ConfirmDelete = false
+	tab := m.effectiveTab()
+	if len(ds.Results) == 0 || tab == nil {
+		return
+	}
+	id := ds.Results[ds.Cursor].ID
+	if err := tab.Handler.Delete(m.store, id); err != nil {
+		m.setStatusError(humanizeError(err))
+		return
+	}
+	tab.LastDeleted = &id
+	if !tab.showDeletedExplicit {
+		tab.ShowDeleted = true
+	}
+	m.setStatusInfo("Deleted. Press d to restore.")
+	m.surfaceError(m.reloadEffectiveTab())
+
+	docs := ds.Docs[:0]
+	for _, d := range ds.Docs {
+		if d.ID != id {
+			docs = append(docs, d)
+		}
+	}
+	ds.Docs = docs
+	m.runDocSearch()
+}
+
 // buildDocSearchOverlay renders the search overlay as a bordered box.
 func (m *Model) buildDocSearchOverlay() string {
 	ds := m.docSearch
@@ -158,18 +406,29 @@ func (m *Model) buildDocSearchOverlay() string {
 
 	var b strings.Builder
 
-	// Title.
-	b.WriteString(m.styles.HeaderSection().Render(" Search Documents "))
+	// Title, naming the scope when one is active.
+	scope, query := ds.activeScope()
+	title := " Search Documents "
+	if scope != nil {
+		title = " Search Documents: " + scope.Label + " "
+	}
+	b.WriteString(m.styles.HeaderSection().Render(title))
 	b.WriteString("\n\n")
 
 	// Input field.
 	b.WriteString(ds.Input.View())
 	b.WriteString("\n\n")
 
-	query := strings.TrimSpace(ds.Input.Value())
+	query = strings.TrimSpace(query)
 
-	if query == "" {
-		b.WriteString(m.styles.Empty().Render("type to search across all documents"))
+	if query == "" && scope != nil {
+		b.WriteString(m.styles.Empty().Render(
+			"type to search " + strings.ToLower(scope.Label) + " documents",
+		))
+	} else if query == "" {
+		b.WriteString(m.styles.Empty().Render(
+			"type to search across all documents (a: p: v: ... to scope)",
+		))
 	} else if len(ds.Results) == 0 {
 		b.WriteString(m.styles.Empty().Render("no matches"))
 	} else {
@@ -186,7 +445,7 @@ func (m *Model) buildDocSearchOverlay() string {
 			result := ds.Results[i]
 			selected := i == ds.Cursor
 
-			line := m.renderSearchResult(result, selected, innerW)
+			line := m.renderSearchResult(result, ds.TitleMatches[i], selected, innerW)
 			zoned := m.zones.Mark(fmt.Sprintf("%s%d", zoneSearchRow, i), line)
 			b.WriteString(zoned)
 
@@ -204,13 +463,27 @@ func (m *Model) buildDocSearchOverlay() string {
 	}
 
 	b.WriteString("\n\n")
-	hints := joinWithSeparator(
-		m.helpSeparator(),
-		m.helpItem(symReturn, "open"),
-		m.helpItem(symUp+"/"+symDown, "nav"),
-		m.helpItem(keyEsc, "close"),
-	)
-	b.WriteString(hints)
+	if ds.ConfirmDelete && len(ds.Results) > 0 {
+		b.WriteString(m.styles.Error().Render(
+			fmt.Sprintf("Delete %q?", ds.Results[ds.Cursor].Title),
+		))
+		b.WriteString(" ")
+		b.WriteString(joinWithSeparator(
+			m.helpSeparator(),
+			m.helpItem(keyY, "delete"),
+			m.helpItem(keyN, "cancel"),
+		))
+	} else {
+		b.WriteString(joinWithSeparator(
+			m.helpSeparator(),
+			m.helpItem(symReturn, "open"),
+			m.helpItem(symUp+"/"+symDown, "nav"),
+			m.helpItem(keyTab, "scope"),
+			m.helpItem("^e", "edit"),
+			m.helpItem("^d", "delete"),
+			m.helpItem(keyEsc, "close"),
+		))
+	}
 
 	return appStyles.OverlayBox().
 		Width(contentW).
@@ -221,20 +494,24 @@ func (m *Model) buildDocSearchOverlay() string {
 // renderSearchResult renders a single search result entry.
 func (m *Model) renderSearchResult(
 	result data.DocumentSearchResult,
+	titleMatches []int,
 	selected bool,
 	maxW int,
 ) string {
 	var lines []string
 
-	// Line 1: pointer + title + entity association.
+	// Line 1: pointer + title (matched characters highlighted) + entity
+	// association.
 	pointer := "  "
 	titleStyle := m.styles.HeaderHint()
+	matchStyle := appStyles.AccentBold()
 	if selected {
 		pointer = appStyles.AccentBold().Render(symTriRightSm) + " "
 		titleStyle = appStyles.AccentBold()
+		matchStyle = appStyles.AccentBold().Underline(true)
 	}
 
-	title := titleStyle.Render(result.Title)
+	title := highlightFuzzyPositions(result.Title, titleMatches, titleStyle, matchStyle)
 
 	// Entity label (e.g., "P Kitchen Reno").
 	var entityLabel string
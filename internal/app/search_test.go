@@ -4,8 +4,10 @@
 package app
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/charmbracelet/x/ansi"
 	"github.com/micasa-dev/micasa/internal/data"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -267,3 +269,251 @@ func TestDocSearchSnippetHighlighting(t *testing.T) {
 	assert.Contains(t, snippet, "before")
 	assert.Contains(t, snippet, "after")
 }
+
+func TestFuzzyTypoMatch(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		query, target string
+		match         bool
+	}{
+		{"ktchen", "Kitchen Remodel", true},  // dropped letter: subsequence
+		{"kithcen", "Kitchen Remodel", true}, // transposition: edit distance
+		{"remdoel kit", "Kitchen Remodel", true},
+		{"warrenty", "Dishwasher Warranty", true},
+		{"kitchen", "Kit for checking entries", false}, // scattered letters
+		{"hvac", "Kitchen Remodel", false},
+		{"abc", "ABD Plumbing", false}, // short words need an exact prefix
+	}
+	for _, tt := range tests {
+		score, _ := fuzzyTypoMatch(tt.query, tt.target)
+		assert.Equal(t, tt.match, score > 0, "%q vs %q", tt.query, tt.target)
+	}
+
+	clean, _ := fuzzyTypoMatch("kitchen", "Kitchen Remodel")
+	typo, pos := fuzzyTypoMatch("kithcen", "Kitchen Remodel")
+	assert.Greater(t, clean, typo, "typos score below clean matches")
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6}, pos, "the matched word is highlighted")
+}
+
+func TestDocSearchToleratesTypos(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	switchToDocsTab(m)
+
+	require.NoError(t, m.store.CreateDocument(&data.Document{
+		Title:    "Kitchen Remodel Contract",
+		FileName: "contract.pdf",
+	}))
+	require.NoError(t, m.store.CreateDocument(&data.Document{
+		Title:    "HVAC Manual",
+		FileName: "hvac.pdf",
+	}))
+
+	sendKey(m, keyCtrlF)
+	require.NotNil(t, m.docSearch)
+	for _, r := range "ktchen" {
+		sendKey(m, string(r))
+	}
+
+	require.Len(t, m.docSearch.Results, 1)
+	assert.Equal(t, "Kitchen Remodel Contract", m.docSearch.Results[0].Title)
+	assert.NotEmpty(t, m.docSearch.TitleMatches[0])
+	assert.Contains(t, ansi.Strip(m.buildDocSearchOverlay()), "Kitchen Remodel Contract")
+}
+
+func TestDocSearchRanksTitleMatchesFirst(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	switchToDocsTab(m)
+
+	// Created first, so FTS alone might list it first.
+	require.NoError(t, m.store.CreateDocument(&data.Document{
+		Title:         "Contractor Invoice",
+		FileName:      "invoice.pdf",
+		ExtractedText: "gutter cleaning and gutter guard install",
+	}))
+	require.NoError(t, m.store.CreateDocument(&data.Document{
+		Title:    "Gutter Warranty",
+		FileName: "warranty.pdf",
+	}))
+
+	sendKey(m, keyCtrlF)
+	require.NotNil(t, m.docSearch)
+	for _, r := range "gutter" {
+		sendKey(m, string(r))
+	}
+
+	require.Len(t, m.docSearch.Results, 2)
+	assert.Equal(t, "Gutter Warranty", m.docSearch.Results[0].Title)
+	assert.Equal(t, "Contractor Invoice", m.docSearch.Results[1].Title)
+	assert.Nil(t, m.docSearch.TitleMatches[1], "content-only hit has no title highlight")
+}
+
+// createScopedDocs adds a kitchen document linked to an appliance and one
+// linked to a project.
+func createScopedDocs(t *testing.T, m *Model) {
+	t.Helper()
+	require.NoError(t, m.store.CreateDocument(&data.Document{
+		Title:         "Fridge Manual",
+		FileName:      "fridge.pdf",
+		EntityKind:    data.DocumentEntityAppliance,
+		EntityID:      "01JTEST00000000000000001",
+		ExtractedText: "kitchen refrigerator care",
+	}))
+	require.NoError(t, m.store.CreateDocument(&data.Document{
+		Title:         "Remodel Contract",
+		FileName:      "contract.pdf",
+		EntityKind:    data.DocumentEntityProject,
+		EntityID:      "01JTEST00000000000000002",
+		ExtractedText: "kitchen remodel scope",
+	}))
+}
+
+func TestDocSearchScopePrefix(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	switchToDocsTab(m)
+	createScopedDocs(t, m)
+
+	sendKey(m, keyCtrlF)
+	require.NotNil(t, m.docSearch)
+	for _, r := range "a:kitchen" {
+		sendKey(m, string(r))
+	}
+
+	require.Len(t, m.docSearch.Results, 1)
+	assert.Equal(t, "Fridge Manual", m.docSearch.Results[0].Title)
+	assert.Contains(t, ansi.Strip(m.buildDocSearchOverlay()), "Search Documents: Appliances")
+}
+
+func TestDocSearchScopeToggle(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	switchToDocsTab(m)
+	createScopedDocs(t, m)
+
+	sendKey(m, keyCtrlF)
+	require.NotNil(t, m.docSearch)
+	for _, r := range "kitchen" {
+		sendKey(m, string(r))
+	}
+	require.Len(t, m.docSearch.Results, 2)
+
+	sendKey(m, keyTab)
+	require.NotNil(t, m.docSearch.Scope)
+	assert.Equal(t, data.DocumentEntityAppliance, m.docSearch.Scope.Kind)
+	require.Len(t, m.docSearch.Results, 1)
+	assert.Equal(t, "Fridge Manual", m.docSearch.Results[0].Title)
+
+	// Cycle through every scope and back to all documents.
+	for range len(docSearchScopes) {
+		sendKey(m, keyTab)
+	}
+	assert.Nil(t, m.docSearch.Scope)
+	assert.Len(t, m.docSearch.Results, 2)
+	assert.Contains(t, ansi.Strip(m.buildDocSearchOverlay()), "Search Documents ")
+}
+
+func TestSplitSearchScope(t *testing.T) {
+	t.Parallel()
+	scope, rest := splitSearchScope("p:kitchen")
+	require.NotNil(t, scope)
+	assert.Equal(t, data.DocumentEntityProject, scope.Kind)
+	assert.Equal(t, "kitchen", rest)
+
+	scope, rest = splitSearchScope("Appliance: fridge")
+	require.NotNil(t, scope)
+	assert.Equal(t, data.DocumentEntityAppliance, scope.Kind)
+	assert.Equal(t, " fridge", rest)
+
+	scope, rest = splitSearchScope("note: fridge")
+	assert.Nil(t, scope, "unknown prefixes stay in the query")
+	assert.Equal(t, "note: fridge", rest)
+}
+
+func TestDocSearchScopePrefixesMatchEntityLetters(t *testing.T) {
+	t.Parallel()
+	for _, sc := range docSearchScopes {
+		if letter, ok := entityKindLetter[sc.Kind]; ok {
+			assert.Equal(t, strings.ToLower(letter), sc.Prefix, sc.Kind)
+		}
+	}
+}
+
+func TestDocSearchEditSelectedResult(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	switchToDocsTab(m)
+	require.NoError(t, m.store.CreateDocument(&data.Document{
+		Title:    "Furnace Manual",
+		FileName: "furnace.pdf",
+	}))
+	require.NoError(t, m.reloadAllTabs())
+
+	sendKey(m, keyCtrlF)
+	for _, r := range "furnace" {
+		sendKey(m, string(r))
+	}
+	require.Len(t, m.docSearch.Results, 1)
+
+	sendKey(m, keyCtrlE)
+
+	assert.Nil(t, m.docSearch, "overlay closes when the edit form opens")
+	assert.Equal(t, modeForm, m.mode)
+	require.NotNil(t, m.fs.editID)
+	assert.Equal(t, m.tabs[m.active].Rows[m.tabs[m.active].Table.Cursor()].ID, *m.fs.editID,
+		"the edited document is selected behind the form")
+}
+
+func TestDocSearchDeleteSelectedResult(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	switchToDocsTab(m)
+	for _, title := range []string{"Roof Quote", "Roof Photos"} {
+		require.NoError(t, m.store.CreateDocument(&data.Document{
+			Title:    title,
+			FileName: "roof.pdf",
+		}))
+	}
+	require.NoError(t, m.reloadAllTabs())
+
+	sendKey(m, keyCtrlF)
+	for _, r := range "roof" {
+		sendKey(m, string(r))
+	}
+	require.Len(t, m.docSearch.Results, 2)
+	target := m.docSearch.Results[0]
+
+	// ctrl+d asks first; n cancels.
+	sendKey(m, keyCtrlD)
+	require.True(t, m.docSearch.ConfirmDelete)
+	assert.Contains(t, ansi.Strip(m.buildDocSearchOverlay()), "Delete \""+target.Title+"\"?")
+	sendKey(m, keyN)
+	assert.False(t, m.docSearch.ConfirmDelete)
+	require.Len(t, m.docSearch.Results, 2)
+
+	sendKey(m, keyCtrlD)
+	sendKey(m, keyY)
+
+	require.NotNil(t, m.docSearch, "overlay stays open after deleting")
+	require.Len(t, m.docSearch.Results, 1)
+	assert.NotEqual(t, target.ID, m.docSearch.Results[0].ID)
+	_, err := m.store.GetDocument(target.ID)
+	require.Error(t, err, "document is soft-deleted")
+	tab := m.effectiveTab()
+	require.NotNil(t, tab.LastDeleted)
+	assert.Equal(t, target.ID, *tab.LastDeleted)
+}
+
+func TestDocSearchActionsIgnoredWithoutResults(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	switchToDocsTab(m)
+
+	sendKey(m, keyCtrlF)
+	sendKey(m, keyCtrlD)
+	assert.False(t, m.docSearch.ConfirmDelete)
+	sendKey(m, keyCtrlE)
+	assert.NotNil(t, m.docSearch)
+	assert.NotEqual(t, modeForm, m.mode)
+}
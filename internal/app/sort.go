@@ -14,29 +14,147 @@ import (
 	"github.com/micasa-dev/micasa/internal/data"
 )
 
+// defaultSortTabs maps [sort] config keys to the tabs they sort.
+var defaultSortTabs = map[string]TabKind{
+	"projects":    tabProjects,
+	"quotes":      tabQuotes,
+	"maintenance": tabMaintenance,
+	"incidents":   tabIncidents,
+	"appliances":  tabAppliances,
+	"vendors":     tabVendors,
+	"documents":   tabDocuments,
+}
+
+// parseSortSpec parses a configured sort such as "next asc" or
+// "status, end desc" into sort entries for the given columns. Column names
+// match spec titles case-insensitively; the direction defaults to asc.
+func parseSortSpec(specs []columnSpec, spec string) ([]sortEntry, error) {
+	var entries []sortEntry
+	for _, part := range strings.Split(spec, ",") {
+		fields := strings.Fields(part)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) > 2 {
+			return nil, fmt.Errorf("%q: expected a column and an optional asc or desc", strings.TrimSpace(part))
+		}
+		col := -1
+		titles := make([]string, len(specs))
+		for i, s := range specs {
+			titles[i] = strings.ToLower(s.Title)
+			if strings.EqualFold(s.Title, fields[0]) {
+				col = i
+			}
+		}
+		if col < 0 {
+			return nil, fmt.Errorf(
+				"unknown column %q -- choose from %s",
+				fields[0], strings.Join(titles, ", "),
+			)
+		}
+		dir := sortAsc
+		if len(fields) == 2 {
+			switch strings.ToLower(fields[1]) {
+			case "asc":
+			case "desc":
+				dir = sortDesc
+			default:
+				return nil, fmt.Errorf("%q: direction must be asc or desc, got %q", strings.TrimSpace(part), fields[1])
+			}
+		}
+		for _, e := range entries {
+			if e.Col == col {
+				return nil, fmt.Errorf("column %q is listed twice", fields[0])
+			}
+		}
+		entries = append(entries, sortEntry{Col: col, Dir: dir})
+	}
+	return entries, nil
+}
+
+// applyDefaultSorts sets each tab's starting sort from the [sort] config,
+// keyed by config key (e.g. "maintenance").
+func (m *Model) applyDefaultSorts(sorts map[string]string) error {
+	keys := make([]string, 0, len(sorts))
+	for k := range sorts {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		kind, ok := defaultSortTabs[k]
+		if !ok {
+			return fmt.Errorf("sort.%s: unknown tab", k)
+		}
+		tab := &m.tabs[tabIndex(kind)]
+		entries, err := parseSortSpec(tab.Specs, sorts[k])
+		if err != nil {
+			return fmt.Errorf("sort.%s: %w", k, err)
+		}
+		tab.Sorts = entries
+	}
+	return nil
+}
+
 // toggleSort cycles the sort on colIdx: none -> asc -> desc -> none.
 // If the column is already in the sort stack, it advances its direction
-// or removes it. If not present, it appends as ascending.
+// or removes it. If not present, it appends in the direction the column
+// was last sorted (ascending the first time), and the cycle runs from
+// there: a column last sorted descending goes none -> desc -> asc -> none.
 func toggleSort(tab *Tab, colIdx int) {
+	start := tab.SortDirs[colIdx]
 	for i, entry := range tab.Sorts {
 		if entry.Col == colIdx {
-			if entry.Dir == sortAsc {
-				tab.Sorts[i].Dir = sortDesc
+			if entry.Dir == start {
+				tab.Sorts[i].Dir = flipSortDir(entry.Dir)
 			} else {
-				// Was desc; remove from stack.
+				// Second direction; remove from stack.
+				rememberSortDir(tab, entry)
 				tab.Sorts = append(tab.Sorts[:i], tab.Sorts[i+1:]...)
 			}
 			return
 		}
 	}
-	tab.Sorts = append(tab.Sorts, sortEntry{Col: colIdx, Dir: sortAsc})
+	tab.Sorts = append(tab.Sorts, sortEntry{Col: colIdx, Dir: start})
 }
 
-// clearSorts removes all sort entries from the tab.
+// nextSortDir reports what toggleSort would do to colIdx: the direction it
+// would sort in, or ok=false when the column would leave the sort stack.
+func nextSortDir(tab *Tab, colIdx int) (sortDir, bool) {
+	start := tab.SortDirs[colIdx]
+	for _, entry := range tab.Sorts {
+		if entry.Col == colIdx {
+			if entry.Dir == start {
+				return flipSortDir(entry.Dir), true
+			}
+			return 0, false
+		}
+	}
+	return start, true
+}
+
+// clearSorts removes all sort entries from the tab, remembering each
+// column's direction for the next time it is sorted.
 func clearSorts(tab *Tab) {
+	for _, entry := range tab.Sorts {
+		rememberSortDir(tab, entry)
+	}
 	tab.Sorts = nil
 }
 
+func rememberSortDir(tab *Tab, entry sortEntry) {
+	if tab.SortDirs == nil {
+		tab.SortDirs = make(map[int]sortDir)
+	}
+	tab.SortDirs[entry.Col] = entry.Dir
+}
+
+func flipSortDir(d sortDir) sortDir {
+	if d == sortAsc {
+		return sortDesc
+	}
+	return sortAsc
+}
+
 // cmpOrdered returns -1, 0, or 1 for any ordered type.
 func cmpOrdered[T ~string | ~float64 | ~int](a, b T) int {
 	if a < b {
@@ -122,19 +240,117 @@ func compareCells(tab *Tab, col, a, b int) int {
 			return cmpOrdered(strings.ToLower(va), strings.ToLower(vb))
 		}
 		return ta.Compare(tb)
+	case cellDuration:
+		return compareNumeric(va, vb, parseDuration)
+	case cellNumber:
+		return compareNumeric(va, vb, parseNumber)
 	case cellReadonly, cellDrilldown, cellOps:
-		na, errA := strconv.ParseFloat(va, 64)
-		nb, errB := strconv.ParseFloat(vb, 64)
-		if errA != nil || errB != nil {
-			return cmpOrdered(strings.ToLower(va), strings.ToLower(vb))
-		}
-		return cmpOrdered(na, nb)
+		// Plain counts and IDs parse as numbers; computed spans like the
+		// appliance Age column fall through to the duration parser.
+		return compareNumeric(va, vb, func(s string) (float64, bool) {
+			if n, ok := parseNumber(s); ok {
+				return n, true
+			}
+			return parseDuration(s)
+		})
 	case cellText, cellStatus, cellNotes, cellEntity, cellTelephoneNumber:
 		return cmpOrdered(strings.ToLower(va), strings.ToLower(vb))
 	}
 	panic(fmt.Sprintf("unhandled cellKind: %d", kind))
 }
 
+// compareNumeric compares two display values by the number parse extracts
+// from them. Falls back to case-insensitive text comparison when either
+// value doesn't parse, so mixed columns still get a stable order.
+func compareNumeric(a, b string, parse func(string) (float64, bool)) int {
+	na, okA := parse(a)
+	nb, okB := parse(b)
+	if !okA || !okB {
+		return cmpOrdered(strings.ToLower(a), strings.ToLower(b))
+	}
+	return cmpOrdered(na, nb)
+}
+
+// numberUnits scales a display suffix to a common base so values in
+// different units compare correctly ("900 KB" < "1.2 MB").
+var numberUnits = map[string]float64{
+	"":   1,
+	"b":  1,
+	"kb": 1 << 10,
+	"mb": 1 << 20,
+	"gb": 1 << 30,
+}
+
+// parseNumber extracts the numeric value of a display string like "42",
+// "1,234", "1.2 MB", or "15/yr": a leading number, optionally followed by
+// a byte-size unit, with any "/period" suffix ignored.
+func parseNumber(s string) (float64, bool) {
+	s = strings.TrimSpace(s)
+	end := 0
+	for end < len(s) && (s[end] == '-' || s[end] == '.' || s[end] == ',' ||
+		(s[end] >= '0' && s[end] <= '9')) {
+		end++
+	}
+	if end == 0 {
+		return 0, false
+	}
+	n, err := strconv.ParseFloat(strings.ReplaceAll(s[:end], ",", ""), 64)
+	if err != nil {
+		return 0, false
+	}
+	unit := strings.ToLower(strings.TrimSpace(s[end:]))
+	if i := strings.IndexByte(unit, '/'); i >= 0 {
+		unit = strings.TrimSpace(unit[:i])
+	}
+	scale, ok := numberUnits[unit]
+	if !ok {
+		return 0, false
+	}
+	return n * scale, true
+}
+
+// durationUnits gives the length in days of each span suffix. "m" is
+// months, matching formatInterval and applianceAge.
+var durationUnits = map[string]float64{
+	"d":  1,
+	"w":  7,
+	"m":  365.25 / 12,
+	"mo": 365.25 / 12,
+	"y":  365.25,
+}
+
+// parseDuration returns the length in days of a span like "3m", "2w",
+// "1y 6m", or "<1m" (which counts as zero).
+func parseDuration(s string) (float64, bool) {
+	if rest, ok := strings.CutPrefix(s, "<"); ok {
+		if _, ok := parseDuration(rest); ok {
+			return 0, true
+		}
+		return 0, false
+	}
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return 0, false
+	}
+	var total float64
+	for _, f := range fields {
+		i := strings.IndexFunc(f, func(r rune) bool { return r < '0' || r > '9' })
+		if i <= 0 {
+			return 0, false
+		}
+		n, err := strconv.Atoi(f[:i])
+		if err != nil {
+			return 0, false
+		}
+		days, ok := durationUnits[f[i:]]
+		if !ok {
+			return 0, false
+		}
+		total += float64(n) * days
+	}
+	return total, true
+}
+
 // withPKTiebreaker appends a PK (col 0) ascending entry if col 0 is not
 // already in the stack, ensuring a stable deterministic order.
 func withPKTiebreaker(sorts []sortEntry) []sortEntry {
@@ -4,8 +4,11 @@
 package app
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/micasa-dev/micasa/internal/data"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -66,6 +69,60 @@ func TestToggleSortCycle(t *testing.T) {
 	assert.Empty(t, tab.Sorts)
 }
 
+func TestToggleSortResumesLastDirection(t *testing.T) {
+	t.Parallel()
+	tab := &Tab{}
+
+	toggleSort(tab, 1) // asc
+	toggleSort(tab, 1) // desc
+	toggleSort(tab, 1) // removed, remembers desc
+	require.Empty(t, tab.Sorts)
+
+	// Re-sorting resumes desc, then flips to asc, then removes.
+	toggleSort(tab, 1)
+	require.Len(t, tab.Sorts, 1)
+	assert.Equal(t, sortDesc, tab.Sorts[0].Dir)
+	toggleSort(tab, 1)
+	assert.Equal(t, sortAsc, tab.Sorts[0].Dir)
+	toggleSort(tab, 1)
+	assert.Empty(t, tab.Sorts)
+
+	// Other columns still start ascending.
+	toggleSort(tab, 2)
+	assert.Equal(t, sortAsc, tab.Sorts[0].Dir)
+}
+
+func TestClearSortsRemembersDirection(t *testing.T) {
+	t.Parallel()
+	tab := &Tab{}
+	toggleSort(tab, 0)
+	toggleSort(tab, 0) // desc
+	clearSorts(tab)
+
+	toggleSort(tab, 0)
+	require.Len(t, tab.Sorts, 1)
+	assert.Equal(t, sortDesc, tab.Sorts[0].Dir)
+}
+
+func TestSortHint(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.active = tabIndex(tabProjects)
+	tab := m.effectiveTab()
+	tab.ColCursor = int(projectColBudget)
+
+	assert.Equal(t, "sort by Budget", m.sortHint())
+	toggleSort(tab, tab.ColCursor)
+	assert.Equal(t, "sort Budget desc", m.sortHint())
+	toggleSort(tab, tab.ColCursor)
+	assert.Equal(t, "unsort Budget", m.sortHint())
+	toggleSort(tab, tab.ColCursor)
+	assert.Equal(t, "sort by Budget desc", m.sortHint())
+
+	help := m.statusView()
+	assert.Contains(t, help, "sort by Budget")
+}
+
 func TestToggleSortMultiColumn(t *testing.T) {
 	t.Parallel()
 	tab := &Tab{}
@@ -143,6 +200,124 @@ func TestApplySortsByDateDesc(t *testing.T) {
 	assert.Equal(t, []string{"2025-03-01", "2025-02-10", "2025-01-15"}, dates)
 }
 
+func TestApplySortsIntervalNumeric(t *testing.T) {
+	t.Parallel()
+	tab := &Tab{
+		Specs: []columnSpec{
+			{Title: "ID", Kind: cellReadonly},
+			{Title: "Every", Kind: cellDuration},
+		},
+		CellRows: [][]cell{
+			{{Value: "1"}, {Value: "1y", Kind: cellDuration}},
+			{{Value: "2"}, {Value: "3m", Kind: cellDuration}},
+			{{Value: "3"}, {Value: "2y 6m", Kind: cellDuration}},
+			{{Value: "4"}, {Value: "12y", Kind: cellDuration}},
+			{{Value: "5"}, {Value: "11m", Kind: cellDuration}},
+		},
+		Rows: []rowMeta{{ID: "1"}, {ID: "2"}, {ID: "3"}, {ID: "4"}, {ID: "5"}},
+	}
+	toggleSort(tab, 1)
+	applySorts(tab)
+	assert.Equal(t, []string{"3m", "11m", "1y", "2y 6m", "12y"}, collectCol(tab, 1))
+
+	toggleSort(tab, 1)
+	applySorts(tab)
+	assert.Equal(t, []string{"12y", "2y 6m", "1y", "11m", "3m"}, collectCol(tab, 1))
+}
+
+func TestApplySortsAgeNumeric(t *testing.T) {
+	t.Parallel()
+	tab := &Tab{
+		Specs: []columnSpec{
+			{Title: "ID", Kind: cellReadonly},
+			{Title: "Age", Kind: cellReadonly},
+		},
+		CellRows: [][]cell{
+			{{Value: "1"}, {Value: "10y", Kind: cellReadonly}},
+			{{Value: "2"}, {Value: "2y", Kind: cellReadonly}},
+			{{Value: "3"}, {Value: "<1m", Kind: cellReadonly}},
+			{{Value: "4"}, {Value: "2y 3m", Kind: cellReadonly}},
+		},
+		Rows: []rowMeta{{ID: "1"}, {ID: "2"}, {ID: "3"}, {ID: "4"}},
+	}
+	toggleSort(tab, 1)
+	applySorts(tab)
+	assert.Equal(t, []string{"<1m", "2y", "2y 3m", "10y"}, collectCol(tab, 1))
+}
+
+func TestApplySortsNumberWithUnits(t *testing.T) {
+	t.Parallel()
+	tab := &Tab{
+		Specs: []columnSpec{
+			{Title: "ID", Kind: cellReadonly},
+			{Title: "Size", Kind: cellNumber},
+		},
+		CellRows: [][]cell{
+			{{Value: "1"}, {Value: "1.2 MB", Kind: cellNumber}},
+			{{Value: "2"}, {Value: "900 B", Kind: cellNumber}},
+			{{Value: "3"}, {Value: "10.0 KB", Kind: cellNumber}},
+			{{Value: "4"}, {Value: "2.0 GB", Kind: cellNumber}},
+			{{Value: "5"}, {Value: "512.0 KB", Kind: cellNumber}},
+		},
+		Rows: []rowMeta{{ID: "1"}, {ID: "2"}, {ID: "3"}, {ID: "4"}, {ID: "5"}},
+	}
+	toggleSort(tab, 1)
+	applySorts(tab)
+	assert.Equal(t,
+		[]string{"900 B", "10.0 KB", "512.0 KB", "1.2 MB", "2.0 GB"},
+		collectCol(tab, 1),
+	)
+}
+
+func TestParseDuration(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		in   string
+		want float64
+		ok   bool
+	}{
+		{"5d", 5, true},
+		{"2w", 14, true},
+		{"1y", 365.25, true},
+		{"12m", 365.25, true},
+		{"2y 6m", 2.5 * 365.25, true},
+		{"3mo", 3 * 365.25 / 12, true},
+		{"<1m", 0, true},
+		{"", 0, false},
+		{"abc", 0, false},
+		{"3x", 0, false},
+		{"<abc", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := parseDuration(tt.in)
+		assert.Equal(t, tt.ok, ok, tt.in)
+		assert.InDelta(t, tt.want, got, 1e-9, tt.in)
+	}
+}
+
+func TestParseNumber(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		in   string
+		want float64
+		ok   bool
+	}{
+		{"42", 42, true},
+		{"1,234", 1234, true},
+		{"1.5 KB", 1536, true},
+		{"2 MB", 2 << 20, true},
+		{"15/yr", 15, true},
+		{"3 mo", 0, false},
+		{"abc", 0, false},
+		{"", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := parseNumber(tt.in)
+		assert.Equal(t, tt.ok, ok, tt.in)
+		assert.InDelta(t, tt.want, got, 1e-9, tt.in)
+	}
+}
+
 func TestApplySortsNullLastRegardlessOfDirection(t *testing.T) {
 	t.Parallel()
 	tab := &Tab{
@@ -300,3 +475,57 @@ func collectCol(tab *Tab, col int) []string {
 	}
 	return vals
 }
+
+func TestParseSortSpec(t *testing.T) {
+	t.Parallel()
+	specs := newSortTab().Specs
+
+	entries, err := parseSortSpec(specs, "date desc, Name")
+	require.NoError(t, err)
+	assert.Equal(t, []sortEntry{{Col: 3, Dir: sortDesc}, {Col: 1, Dir: sortAsc}}, entries)
+
+	entries, err = parseSortSpec(specs, " ")
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	for spec, want := range map[string]string{
+		"nmae":           `unknown column "nmae" -- choose from id, name, cost, date`,
+		"name sideways":  "direction must be asc or desc",
+		"name asc extra": "expected a column and an optional asc or desc",
+		"cost, cost":     `column "cost" is listed twice`,
+	} {
+		_, err := parseSortSpec(specs, spec)
+		require.Error(t, err, spec)
+		assert.Contains(t, err.Error(), want, spec)
+	}
+}
+
+func TestNewModelAppliesDefaultSorts(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "test.db")
+	require.NoError(t, os.WriteFile(path, templateBytes, 0o600))
+	store, err := data.Open(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+
+	m, err := NewModel(store, Options{
+		DBPath:       path,
+		DefaultSorts: map[string]string{"maintenance": "next asc, item desc"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t,
+		[]sortEntry{
+			{Col: int(maintenanceColNext), Dir: sortAsc},
+			{Col: int(maintenanceColItem), Dir: sortDesc},
+		},
+		m.tabs[tabIndex(tabMaintenance)].Sorts,
+	)
+	assert.Empty(t, m.tabs[tabIndex(tabProjects)].Sorts)
+
+	_, err = NewModel(store, Options{
+		DBPath:       path,
+		DefaultSorts: map[string]string{"maintenance": "nxt"},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `sort.maintenance: unknown column "nxt"`)
+}
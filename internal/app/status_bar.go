@@ -0,0 +1,126 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+)
+
+// Status bar segments selectable with ui.status_bar.
+const (
+	statusSegMode     = "mode"     // NAV/EDIT badge
+	statusSegHints    = "hints"    // key hints in table modes
+	statusSegDirty    = "dirty"    // saved/unsaved marker in forms
+	statusSegCurrency = "currency" // currency code
+	statusSegDB       = "db"       // database file name
+)
+
+// defaultStatusSegments is the status bar when ui.status_bar is unset.
+var defaultStatusSegments = []string{statusSegMode, statusSegDirty, statusSegHints}
+
+// activeStatusSegments returns the configured segments, or the defaults
+// when none were configured. An empty, non-nil list hides them all.
+func (m *Model) activeStatusSegments() []string {
+	if m.statusSegments == nil {
+		return defaultStatusSegments
+	}
+	return m.statusSegments
+}
+
+// showsStatusSegment reports whether the status bar includes seg.
+func (m *Model) showsStatusSegment(seg string) bool {
+	return slices.Contains(m.activeStatusSegments(), seg)
+}
+
+// modeStatusHelp renders the table-mode status line from the configured
+// segments. Hints are dropped from the end to fit maxW, always keeping the
+// first (help) hint.
+func (m *Model) modeStatusHelp(modeBadge string, maxW int) string {
+	sep := m.helpSeparator()
+	hints := m.shortHelpItems()
+
+	segments := m.activeStatusSegments()
+	render := func(nHints int) string {
+		items := make([]string, 0, len(segments)+nHints)
+		for _, seg := range segments {
+			switch seg {
+			case statusSegMode:
+				items = append(items, modeBadge)
+			case statusSegHints:
+				items = append(items, hints[:nHints]...)
+			case statusSegCurrency:
+				items = append(items, m.styles.HeaderHint().Render(m.cur.Code()))
+			case statusSegDB:
+				if m.dbPath != "" {
+					items = append(items, m.styles.HeaderHint().Render(filepath.Base(m.dbPath)))
+				}
+			}
+		}
+		return joinWithSeparator(sep, items...)
+	}
+
+	n := len(hints)
+	line := render(n)
+	for n > 1 && lipgloss.Width(line) > maxW {
+		n--
+		line = render(n)
+	}
+	return line
+}
+
+// shortHelpItems renders the enabled short-help bindings, marking the
+// clickable ones.
+func (m *Model) shortHelpItems() []string {
+	bindings := m.ShortHelp()
+	items := make([]string, 0, len(bindings))
+	for _, kb := range bindings {
+		if !kb.Enabled() {
+			continue
+		}
+		h := kb.Help()
+		item := m.helpItem(h.Key, h.Desc)
+		if id := hintZoneID(kb.Keys()); id != "" {
+			item = m.zones.Mark(zoneHint+id, item)
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// compactStatusLine renders the status bar as a single row for
+// ui.compact_status. Indicators (sync, background extractions) lead when
+// requested and a model pull follows them; a status message then takes the
+// place of the hints, which get whatever width is left.
+func (m *Model) compactStatusLine(indicators bool, help func(maxW int) string) string {
+	width := m.effectiveWidth()
+	var parts []string
+	if indicators {
+		if ind := m.syncIndicator(); ind != "" {
+			parts = append(parts, m.zones.Mark("sync-indicator", ind))
+		}
+		if ind := m.bgExtractionIndicator(); ind != "" {
+			parts = append(parts, ind)
+		}
+	}
+	if m.pull.display != "" {
+		parts = append(parts, m.styles.TextDim().Render(m.pull.display))
+	}
+
+	if msg := m.renderStatusMessage(); msg != "" {
+		parts = append(parts, msg)
+	} else {
+		used := lipgloss.Width(strings.Join(parts, "  "))
+		if len(parts) > 0 {
+			used += 2
+		}
+		if h := help(width - used); h != "" {
+			parts = append(parts, h)
+		}
+	}
+	return truncateToWidth(strings.Join(parts, "  "), width)
+}
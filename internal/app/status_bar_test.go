@@ -0,0 +1,106 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"strings"
+	"testing"
+
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusBarDefaultSegments(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.dbPath = "/home/me/house.db"
+
+	status := ansi.Strip(m.statusView())
+	assert.Contains(t, status, "NAV")
+	assert.Contains(t, status, "help")
+	assert.NotContains(t, status, "USD")
+	assert.NotContains(t, status, "house.db")
+}
+
+func TestStatusBarConfiguredSegments(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.dbPath = "/home/me/house.db"
+	m.statusSegments = []string{statusSegHints, statusSegCurrency, statusSegDB}
+
+	status := ansi.Strip(m.statusView())
+	assert.NotContains(t, status, "NAV")
+	assert.Contains(t, status, "help")
+	assert.Contains(t, status, m.cur.Code())
+	assert.Contains(t, status, "house.db")
+	assert.NotContains(t, status, "/home/me", "only the file name is shown")
+	assert.Less(t, strings.Index(status, "help"), strings.Index(status, "house.db"), "segments keep their order")
+
+	m.statusSegments = []string{}
+	assert.Empty(t, ansi.Strip(m.statusView()))
+}
+
+func TestStatusBarSegmentsKeepHelpWhenNarrow(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.width = 30
+	m.statusSegments = []string{statusSegMode, statusSegCurrency, statusSegHints}
+
+	status := ansi.Strip(m.statusView())
+	assert.Contains(t, status, "NAV")
+	assert.Contains(t, status, m.cur.Code())
+	assert.Contains(t, status, "help")
+	assert.NotContains(t, status, "edit", "later hints are dropped to fit")
+}
+
+func TestStatusBarDirtySegmentInForms(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	openHouseForm(m)
+	require.Contains(t, m.statusView(), "saved")
+
+	m.statusSegments = []string{statusSegMode, statusSegHints}
+	status := m.statusView()
+	assert.NotContains(t, status, "saved")
+	assert.Contains(t, status, "save", "form hints stay")
+}
+
+func TestCompactStatusBarSingleRow(t *testing.T) {
+	t.Parallel()
+	m := newExtractionModel(t, map[extractionStep]stepStatus{
+		stepExtract: stepRunning,
+	})
+	m.ex.extraction.Filename = "scan.pdf"
+	sendExtractionKey(m, keyCtrlB)
+	require.Len(t, m.ex.bgExtractions, 1)
+
+	assert.Equal(t, 2, lipgloss.Height(m.statusView()), "indicator row above the hints")
+
+	m.compactStatus = true
+	status := m.statusView()
+	assert.Equal(t, 1, lipgloss.Height(status))
+	assert.Contains(t, status, "1 extracting")
+	assert.Contains(t, status, "NAV")
+	assert.LessOrEqual(t, lipgloss.Width(status), m.effectiveWidth())
+
+	m.setStatusInfo("Saved.")
+	status = m.statusView()
+	assert.Equal(t, 1, lipgloss.Height(status))
+	assert.Contains(t, status, "Saved.")
+	assert.NotContains(t, status, "NAV", "the message takes the place of the hints")
+}
+
+func TestCompactStatusBarInForms(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	m.compactStatus = true
+	openHouseForm(m)
+
+	m.setStatusError("bad value")
+	status := m.statusView()
+	assert.Equal(t, 1, lipgloss.Height(status))
+	assert.Contains(t, status, "bad value")
+}
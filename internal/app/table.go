@@ -148,6 +148,7 @@ func computeTableViewport(
 	termWidth int,
 	normalSep string,
 	currencySymbol string,
+	noteLines int,
 ) tableViewport {
 	var vp tableViewport
 	if tab == nil {
@@ -164,9 +165,9 @@ func computeTableViewport(
 	hasPins := len(tab.Pins) > 0 && len(tab.FullCellRows) > 0
 	var visNatural []int
 	if hasPins {
-		visNatural = naturalWidthsIndirect(visSpecs, tab.FullCellRows, visToFull, currencySymbol)
+		visNatural = naturalWidthsIndirect(visSpecs, tab.FullCellRows, visToFull, currencySymbol, noteLines)
 	} else {
-		visNatural = naturalWidths(visSpecs, visCells, currencySymbol)
+		visNatural = naturalWidths(visSpecs, visCells, currencySymbol, noteLines)
 	}
 
 	sepW := lipgloss.Width(normalSep)
@@ -358,6 +359,7 @@ func renderRows(
 	cursor int,
 	colCursor int,
 	height int,
+	noteLines int,
 	pinCtx pinRenderContext,
 	zones *zone.Manager,
 	rowZonePrefix string,
@@ -366,17 +368,27 @@ func renderRows(
 	if total == 0 {
 		return nil
 	}
-	if height <= 0 {
-		height = total
+	var start, end int
+	switch {
+	case height <= 0:
+		start, end = 0, total
+	case noteLines > 1:
+		heights := make([]int, total)
+		for i, row := range rows {
+			heights[i] = rowLineCount(specs, row, widths, noteLines)
+		}
+		start, end = visibleRangeLines(heights, height, cursor)
+	default:
+		start, end = visibleRange(total, height, cursor)
 	}
-	start, end := visibleRange(total, height, cursor)
 	count := end - start
 	mid := start + count/2
 	rendered := make([]string, 0, count)
 	for i := start; i < end; i++ {
 		selected := i == cursor
 		deleted := i < len(meta) && meta[i].Deleted
-		dimmed := i < len(meta) && meta[i].Dimmed
+		dimmed := i < len(meta) && (meta[i].Dimmed || meta[i].Archived)
+		marked := i < len(meta) && meta[i].Marked
 		// Show ⋯ on first, middle, and last visible rows only.
 		seps := plainSeps
 		if i == start || i == mid || i == end-1 {
@@ -390,7 +402,9 @@ func renderRows(
 			selected,
 			deleted,
 			dimmed,
+			marked,
 			colCursor,
+			noteLines,
 			pinCtx,
 			i,
 		)
@@ -416,11 +430,16 @@ func renderRow(
 	selected bool,
 	deleted bool,
 	dimmed bool,
+	marked bool,
 	colCursor int,
+	noteLines int,
 	pinCtx pinRenderContext,
 	rowIdx int,
 ) string {
 	cells := make([]string, 0, len(specs))
+	// Continuation lines of wrapped notes, per column; nil when the row is
+	// a single line.
+	var more [][]string
 	for i, spec := range specs {
 		width := safeWidth(widths, i)
 		var cellValue cell
@@ -436,8 +455,9 @@ func renderRow(
 		// Use raw (pre-transform) cell for pin matching so the comparison
 		// stays consistent with how pins were stored, regardless of
 		// display transforms (compact money, mag mode).
-		pinMatch := false
-		if len(pinCtx.Pins) > 0 {
+		// Marked rows reuse the pin match highlight.
+		pinMatch := marked
+		if !marked && len(pinCtx.Pins) > 0 {
 			rawCell := cellValue
 			if rowIdx < len(pinCtx.RawCells) && i < len(pinCtx.RawCells[rowIdx]) {
 				rawCell = pinCtx.RawCells[rowIdx][i]
@@ -454,10 +474,93 @@ func renderRow(
 				pinMatch = !pinMatch
 			}
 		}
+		if noteLines > 1 && cellValue.Kind == cellNotes && !cellValue.Null {
+			if lines := wrapNoteLines(cellValue.Value, width, noteLines); len(lines) > 1 {
+				if more == nil {
+					more = make([][]string, len(specs))
+				}
+				for _, line := range lines[1:] {
+					more[i] = append(more[i],
+						renderCell(cell{Value: line, Kind: cellNotes}, spec, width, hl, deleted, dimmed, pinMatch))
+				}
+				cellValue.Value = lines[0]
+			}
+		}
 		rendered := renderCell(cellValue, spec, width, hl, deleted, dimmed, pinMatch)
 		cells = append(cells, rendered)
 	}
-	return joinCells(cells, separators)
+	if more == nil {
+		return joinCells(cells, separators)
+	}
+	return joinWrappedRow(cells, more, widths, separators, selected)
+}
+
+// joinWrappedRow joins a row whose notes cells wrap onto extra lines.
+// Columns without a continuation line are padded with blanks, shaded like
+// the row when it is selected.
+func joinWrappedRow(
+	first []string,
+	more [][]string,
+	widths []int,
+	separators []string,
+	selected bool,
+) string {
+	height := 0
+	for _, m := range more {
+		height = max(height, len(m))
+	}
+	lines := make([]string, 0, height+1)
+	lines = append(lines, joinCells(first, separators))
+	blank := lipgloss.NewStyle()
+	if selected {
+		blank = blank.Background(surfacePair.resolve(appIsDark))
+	}
+	for k := range height {
+		parts := make([]string, len(first))
+		for i := range first {
+			if k < len(more[i]) {
+				parts[i] = more[i][k]
+			} else {
+				parts[i] = blank.Render(strings.Repeat(" ", max(safeWidth(widths, i), 1)))
+			}
+		}
+		lines = append(lines, joinCells(parts, separators))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// wrapNoteLines word-wraps a note to width and keeps at most maxLines
+// lines. When lines are cut, the last kept line carries the rest after a
+// newline so renderCell shows a "+N" count of what's hidden.
+func wrapNoteLines(note string, width, maxLines int) []string {
+	note = strings.TrimSpace(note)
+	if note == "" {
+		return nil
+	}
+	var lines []string
+	for _, src := range strings.Split(note, "\n") {
+		src = strings.TrimRight(src, "\r \t")
+		lines = append(lines, strings.Split(ansi.Wrap(src, max(width, 1), ""), "\n")...)
+	}
+	if len(lines) <= maxLines {
+		return lines
+	}
+	kept := lines[:maxLines]
+	kept[maxLines-1] = strings.Join(lines[maxLines-1:], "\n")
+	return kept
+}
+
+// rowLineCount returns how many terminal lines a row takes when notes may
+// wrap onto up to noteLines lines.
+func rowLineCount(specs []columnSpec, row []cell, widths []int, noteLines int) int {
+	n := 1
+	for i, spec := range specs {
+		if spec.Kind != cellNotes || i >= len(row) || row[i].Null {
+			continue
+		}
+		n = max(n, len(wrapNoteLines(row[i].Value, safeWidth(widths, i), noteLines)))
+	}
+	return n
 }
 
 // columnHasPin reports whether any pin targets the given column index.
@@ -635,10 +738,10 @@ func cellStyle(kind cellKind) lipgloss.Style {
 	switch kind {
 	case cellMoney:
 		return appStyles.Money()
-	case cellReadonly:
+	case cellReadonly, cellNumber:
 		return appStyles.Readonly()
 	case cellText, cellDate, cellStatus, cellDrilldown, cellWarranty,
-		cellUrgency, cellNotes, cellEntity, cellOps, cellTelephoneNumber:
+		cellUrgency, cellNotes, cellEntity, cellOps, cellTelephoneNumber, cellDuration:
 		return defaultStyle
 	}
 	panic(fmt.Sprintf("unhandled cellKind: %d", kind))
@@ -793,6 +896,36 @@ func visibleRange(total, height, cursor int) (int, int) {
 	return start, end
 }
 
+// visibleRangeLines is visibleRange for rows of varying height: it returns
+// the rows around cursor whose heights fit in budget lines, keeping the
+// cursor row roughly centered.
+func visibleRangeLines(heights []int, budget, cursor int) (int, int) {
+	total := len(heights)
+	if total == 0 {
+		return 0, 0
+	}
+	cursor = min(max(cursor, 0), total-1)
+	start, end := cursor, cursor+1
+	used := heights[cursor]
+	// Fill half the spare lines above the cursor, then below, then whatever
+	// room is left above (when the cursor is near the bottom).
+	above := 0
+	for start > 0 && above+heights[start-1] <= (budget-heights[cursor])/2 {
+		start--
+		above += heights[start]
+	}
+	used += above
+	for end < total && used+heights[end] <= budget {
+		used += heights[end]
+		end++
+	}
+	for start > 0 && used+heights[start-1] <= budget {
+		start--
+		used += heights[start]
+	}
+	return start, end
+}
+
 func columnWidths(
 	specs []columnSpec,
 	rows [][]cell,
@@ -808,7 +941,7 @@ func columnWidths(
 
 	natural := precompNatural
 	if natural == nil {
-		natural = naturalWidths(specs, rows, "$")
+		natural = naturalWidths(specs, rows, "$", 1)
 	}
 
 	// If content-driven widths fit, use them — no truncation.
@@ -829,7 +962,8 @@ func columnWidths(
 	// Content exceeds terminal width — apply Max constraints.
 	widths := make([]int, columnCount)
 	for i, w := range natural {
-		if specs[i].Max > 0 && //nolint:gosec // specs and natural have equal length (columnCount)
+		if specs[i].Width == 0 && //nolint:gosec // specs and natural have equal length (columnCount)
+			specs[i].Max > 0 && //nolint:gosec // same bounds
 			w > specs[i].Max { //nolint:gosec // same bounds
 			w = specs[i].Max //nolint:gosec // same bounds
 		}
@@ -865,8 +999,11 @@ func columnWidths(
 // naturalWidths returns the content-driven width for each column (header,
 // fixed values, and actual cell values) floored by Min. Notes columns are
 // capped at Max to prevent LLM-extracted summaries from dominating the layout.
-func naturalWidths(specs []columnSpec, rows [][]cell, currencySymbol string) []int {
-	return computeNaturalWidths(specs, rows, func(i int) int { return i }, currencySymbol)
+// When notes wrap (noteLines > 1) they are measured by their widest line
+// instead of their first. A column with a Width override reports exactly
+// that width, and columnWidths neither grows nor shrinks it.
+func naturalWidths(specs []columnSpec, rows [][]cell, currencySymbol string, noteLines int) []int {
+	return computeNaturalWidths(specs, rows, func(i int) int { return i }, currencySymbol, noteLines)
 }
 
 // naturalWidthsIndirect computes natural widths using fullRows indexed
@@ -876,12 +1013,14 @@ func naturalWidthsIndirect(
 	fullRows [][]cell,
 	visToFull []int,
 	currencySymbol string,
+	noteLines int,
 ) []int {
 	return computeNaturalWidths(
 		specs,
 		fullRows,
 		func(vi int) int { return visToFull[vi] },
 		currencySymbol,
+		noteLines,
 	)
 }
 
@@ -892,10 +1031,15 @@ func computeNaturalWidths(
 	rows [][]cell,
 	colIndex func(int) int,
 	currencySymbol string,
+	noteLines int,
 ) []int {
 	widths := make([]int, len(specs))
 	colCount := len(specs)
 	for i, spec := range specs {
+		if spec.Width > 0 {
+			widths[i] = spec.Width
+			continue
+		}
 		ci := colIndex(i)
 		w := headerTitleWidth(spec, colCount, currencySymbol)
 		for _, fv := range spec.FixedValues {
@@ -912,7 +1056,11 @@ func computeNaturalWidths(
 				continue
 			}
 			cw := lipgloss.Width(value)
-			if spec.Kind == cellNotes {
+			if spec.Kind == cellNotes && noteLines > 1 {
+				for _, line := range strings.Split(row[ci].Value, "\n") {
+					cw = max(cw, lipgloss.Width(strings.TrimSpace(line)))
+				}
+			} else if spec.Kind == cellNotes {
 				if n := extraLineCount(row[ci].Value); n > 0 {
 					cw += 1 + 1 + noteSuffixWidth(n)
 				}
@@ -968,7 +1116,7 @@ func distribute(
 	for amount > 0 {
 		changed := false
 		for _, idx := range indices {
-			if idx >= len(widths) {
+			if idx >= len(widths) || specs[idx].Width > 0 {
 				continue
 			}
 			if grow {
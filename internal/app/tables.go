@@ -169,10 +169,12 @@ func applianceMaintenanceRows(
 	items []data.MaintenanceItem,
 	logCounts map[string]int,
 	docCounts map[string]int,
+	estimates map[string]int64,
+	cur locale.Currency,
 ) ([]table.Row, []rowMeta, [][]cell) {
 	return buildRows(items, func(item data.MaintenanceItem) rowSpec {
 		intervalCell := maintenanceIntervalCell(item)
-		nextDue := data.ComputeNextDue(item.LastServicedAt, item.IntervalMonths, item.DueDate)
+		nextDue := data.ComputeNextDue(item.LastServicedAt, item.Interval(), item.DueDate)
 		return rowSpec{
 			ID:      item.ID,
 			Deleted: item.DeletedAt.Valid,
@@ -181,9 +183,11 @@ func applianceMaintenanceRows(
 				{Value: item.Name, Kind: cellText},
 				{Value: item.Category.Name, Kind: cellText},
 				maintenanceSeasonCell(item.Season),
+				maintenanceMonthCell(item.Month),
 				dateCell(item.LastServicedAt, cellDate),
 				dateCell(nextDue, cellUrgency),
 				intervalCell,
+				estimateCell(estimates, item.ID, cur),
 				{Value: countStr(logCounts, item.ID), Kind: cellDrilldown},
 				{Value: countStr(docCounts, item.ID), Kind: cellDrilldown},
 			},
@@ -231,8 +235,9 @@ func applianceRows(
 			ageCell.Value = applianceAge(a.PurchaseDate, now)
 		}
 		return rowSpec{
-			ID:      a.ID,
-			Deleted: a.DeletedAt.Valid,
+			ID:       a.ID,
+			Deleted:  a.DeletedAt.Valid,
+			Archived: a.ArchivedAt != nil,
 			Cells: []cell{
 				{Value: shortID(a.ID), Kind: cellReadonly},
 				{Value: a.Name, Kind: cellText},
@@ -243,7 +248,7 @@ func applianceRows(
 				dateCell(a.PurchaseDate, cellDate),
 				ageCell,
 				dateCell(a.WarrantyExpiry, cellWarranty),
-				centsCell(a.CostCents, cur),
+				centsCell(a.CostCents, recordCurrency(cur, a.CostCurrency)),
 				{Value: countStr(maintCounts, a.ID), Kind: cellDrilldown},
 				{Value: countStr(docCounts, a.ID), Kind: cellDrilldown},
 			},
@@ -251,31 +256,20 @@ func applianceRows(
 	})
 }
 
-// formatInterval returns a compact interval string: "3m", "1y", "2y 6m".
-// Returns empty for non-positive values.
 // maintenanceIntervalCell returns the cell for the "Every" column.
 // Items with no interval return a NULL cell.
 func maintenanceIntervalCell(item data.MaintenanceItem) cell {
-	v := formatInterval(item.IntervalMonths)
+	v := item.Interval().String()
 	if v == "" {
-		return cell{Kind: cellText, Null: true}
+		return cell{Kind: cellDuration, Null: true}
 	}
-	return cell{Value: v, Kind: cellText}
+	return cell{Value: v, Kind: cellDuration}
 }
 
+// formatInterval returns a compact interval string: "3m", "1y", "2y 6m".
+// Returns empty for non-positive values.
 func formatInterval(months int) string {
-	if months <= 0 {
-		return ""
-	}
-	y := months / 12
-	m := months % 12
-	if y == 0 {
-		return fmt.Sprintf("%dm", m)
-	}
-	if m == 0 {
-		return fmt.Sprintf("%dy", y)
-	}
-	return fmt.Sprintf("%dy %dm", y, m)
+	return data.Interval{Months: months}.String()
 }
 
 // applianceAge returns a human-readable age string from purchase date to now.
@@ -388,9 +382,15 @@ func projectRows(
 	projects []data.Project,
 	quoteCounts map[string]int,
 	docCounts map[string]int,
+	spent map[string]int64,
 	cur locale.Currency,
 ) ([]table.Row, []rowMeta, [][]cell) {
 	return buildRows(projects, func(p data.Project) rowSpec {
+		var spentCents *int64
+		if v, ok := spent[p.ID]; ok {
+			spentCents = &v
+		}
+		budgetCur := recordCurrency(cur, p.BudgetCurrency)
 		return rowSpec{
 			ID:      p.ID,
 			Deleted: p.DeletedAt.Valid,
@@ -399,8 +399,9 @@ func projectRows(
 				{Value: p.ProjectType.Name, Kind: cellText},
 				{Value: p.Title, Kind: cellText},
 				{Value: p.Status, Kind: cellStatus},
-				centsCell(p.BudgetCents, cur),
-				centsCell(p.ActualCents, cur),
+				centsCell(p.BudgetCents, budgetCur),
+				centsCell(p.ActualCents, budgetCur),
+				centsCell(spentCents, cur),
 				dateCell(p.StartDate, cellDate),
 				dateCell(p.EndDate, cellDate),
 				{Value: countStr(quoteCounts, p.ID), Kind: cellDrilldown},
@@ -419,7 +420,7 @@ func quoteRowSpec(
 	cur locale.Currency,
 	includeProject, includeVendor bool,
 ) rowSpec {
-	cells := make([]cell, 0, 9)
+	cells := make([]cell, 0, 11)
 	cells = append(cells, cell{Value: shortID(q.ID), Kind: cellReadonly})
 	if includeProject {
 		projectName := q.Project.Title
@@ -436,7 +437,9 @@ func quoteRowSpec(
 		centsCell(q.LaborCents, cur),
 		centsCell(q.MaterialsCents, cur),
 		centsCell(q.OtherCents, cur),
+		centsCell(q.TaxCents, cur),
 		dateCell(q.ReceivedDate, cellDate),
+		dateCell(q.AcceptedDate, cellDate),
 		cell{Value: countStr(docCounts, q.ID), Kind: cellDrilldown},
 	)
 	return rowSpec{ID: q.ID, Deleted: q.DeletedAt.Valid, Cells: cells}
@@ -459,10 +462,20 @@ func maintenanceSeasonCell(season string) cell {
 	return cell{Value: season, Kind: cellStatus}
 }
 
+// maintenanceMonthCell renders a 1-12 month tag as its short name ("May").
+func maintenanceMonthCell(month int) cell {
+	if month < 1 || month > 12 {
+		return cell{Kind: cellText, Null: true}
+	}
+	return cell{Value: shortMonthName(month), Kind: cellText}
+}
+
 func maintenanceRows(
 	items []data.MaintenanceItem,
 	logCounts map[string]int,
 	docCounts map[string]int,
+	estimates map[string]int64,
+	cur locale.Currency,
 ) ([]table.Row, []rowMeta, [][]cell) {
 	return buildRows(items, func(item data.MaintenanceItem) rowSpec {
 		intervalCell := maintenanceIntervalCell(item)
@@ -472,7 +485,7 @@ func maintenanceRows(
 		} else {
 			appCell = cell{Kind: cellText, Null: true}
 		}
-		nextDue := data.ComputeNextDue(item.LastServicedAt, item.IntervalMonths, item.DueDate)
+		nextDue := data.ComputeNextDue(item.LastServicedAt, item.Interval(), item.DueDate)
 		return rowSpec{
 			ID:      item.ID,
 			Deleted: item.DeletedAt.Valid,
@@ -481,10 +494,12 @@ func maintenanceRows(
 				{Value: item.Name, Kind: cellText},
 				{Value: item.Category.Name, Kind: cellText},
 				maintenanceSeasonCell(item.Season),
+				maintenanceMonthCell(item.Month),
 				appCell,
 				dateCell(item.LastServicedAt, cellDate),
 				dateCell(nextDue, cellUrgency),
 				intervalCell,
+				estimateCell(estimates, item.ID, cur),
 				{Value: countStr(logCounts, item.ID), Kind: cellDrilldown},
 				{Value: countStr(docCounts, item.ID), Kind: cellDrilldown},
 			},
@@ -516,9 +531,10 @@ func cellsToRow(cells []cell) table.Row {
 
 // rowSpec describes one table row from an entity.
 type rowSpec struct {
-	ID      string
-	Deleted bool
-	Cells   []cell
+	ID       string
+	Deleted  bool
+	Archived bool
+	Cells    []cell
 }
 
 // entityIDs extracts the ID from each element using the given accessor.
@@ -532,7 +548,7 @@ func entityIDs[T any](items []T, id func(T) string) []string {
 
 // buildRows converts a slice of entities into the three parallel slices that
 // the table and sort systems consume. The toRow function maps each entity to
-// its ID, deletion status, and cell values.
+// its ID, deletion and archive status, and cell values.
 func buildRows[T any](items []T, toRow func(T) rowSpec) ([]table.Row, []rowMeta, [][]cell) {
 	rows := make([]table.Row, 0, len(items))
 	meta := make([]rowMeta, 0, len(items))
@@ -541,11 +557,31 @@ func buildRows[T any](items []T, toRow func(T) rowSpec) ([]table.Row, []rowMeta,
 		spec := toRow(item)
 		rows = append(rows, cellsToRow(spec.Cells))
 		cells = append(cells, spec.Cells)
-		meta = append(meta, rowMeta{ID: spec.ID, Deleted: spec.Deleted})
+		meta = append(meta, rowMeta{ID: spec.ID, Deleted: spec.Deleted, Archived: spec.Archived})
 	}
 	return rows, meta, cells
 }
 
+// withoutArchived drops rows for archived appliances and their maintenance.
+func withoutArchived(
+	rows []table.Row,
+	meta []rowMeta,
+	cellRows [][]cell,
+) ([]table.Row, []rowMeta, [][]cell) {
+	keptRows := rows[:0]
+	keptMeta := meta[:0]
+	keptCells := cellRows[:0]
+	for i := range meta {
+		if meta[i].Archived {
+			continue
+		}
+		keptRows = append(keptRows, rows[i])
+		keptMeta = append(keptMeta, meta[i])
+		keptCells = append(keptCells, cellRows[i])
+	}
+	return keptRows, keptMeta, keptCells
+}
+
 // vendorQuoteColumnSpecs defines the columns for quotes scoped to a vendor.
 // Omits the Vendor column since the parent context provides that.
 func vendorQuoteColumnSpecs() []columnSpec {
@@ -597,12 +633,34 @@ func projectQuoteRows(
 }
 
 // centsCell returns a cell for an optional money value. NULL pointer produces
-// a null cell; non-nil produces a formatted money cell.
+// a null cell; non-nil produces a money cell formatted in, and tagged with,
+// cur.
 func centsCell(cents *int64, cur locale.Currency) cell {
 	if cents == nil {
 		return cell{Kind: cellMoney, Null: true}
 	}
-	return cell{Value: cur.FormatCents(*cents), Kind: cellMoney}
+	return cell{Value: cur.FormatCents(*cents), Kind: cellMoney, Currency: cur.Code()}
+}
+
+// recordCurrency returns the currency a record's amounts are in: code
+// displayed like the house currency cur, or cur itself when code is empty
+// or not a currency micasa knows.
+func recordCurrency(cur locale.Currency, code string) locale.Currency {
+	rec, err := cur.WithCode(code)
+	if err != nil {
+		return cur
+	}
+	return rec
+}
+
+// estimateCell returns the next-service cost estimate for an item, or a
+// null money cell when the item has no costed service history.
+func estimateCell(estimates map[string]int64, id string, cur locale.Currency) cell {
+	v, ok := estimates[id]
+	if !ok {
+		return cell{Kind: cellMoney, Null: true}
+	}
+	return centsCell(&v, cur)
 }
 
 // dateCell returns a cell for an optional date value. NULL pointer produces
@@ -691,7 +749,7 @@ func documentRows(docs []data.Document, names entityNameMap) ([]table.Row, []row
 					LinkID: d.EntityID,
 				},
 				{Value: d.MIMEType, Kind: cellText},
-				{Value: formatFileSize(docSizeBytes(d)), Kind: cellReadonly},
+				{Value: formatFileSize(docSizeBytes(d)), Kind: cellNumber},
 				{Value: d.ExtractionModel, Kind: cellReadonly},
 				opsCell(d.ExtractionOps),
 				{Value: d.Notes, Kind: cellNotes},
@@ -710,7 +768,7 @@ func entityDocumentRows(docs []data.Document) ([]table.Row, []rowMeta, [][]cell)
 				{Value: shortID(d.ID), Kind: cellReadonly},
 				{Value: d.Title, Kind: cellText},
 				{Value: d.MIMEType, Kind: cellText},
-				{Value: formatFileSize(docSizeBytes(d)), Kind: cellReadonly},
+				{Value: formatFileSize(docSizeBytes(d)), Kind: cellNumber},
 				{Value: d.ExtractionModel, Kind: cellReadonly},
 				{Value: d.Notes, Kind: cellNotes},
 				{Value: d.UpdatedAt.Format(data.DateLayout), Kind: cellReadonly},
@@ -12,8 +12,10 @@ import (
 	tea "charm.land/bubbletea/v2"
 	"charm.land/huh/v2"
 	"github.com/micasa-dev/micasa/internal/crypto"
+	"github.com/micasa-dev/micasa/internal/data"
 	"github.com/micasa-dev/micasa/internal/extract"
 	"github.com/micasa-dev/micasa/internal/llm"
+	"github.com/micasa-dev/micasa/internal/locale"
 )
 
 type Mode int
@@ -45,10 +47,13 @@ const (
 type confirmKind int
 
 const (
-	confirmNone            confirmKind = iota
-	confirmHardDelete                  // permanent incident deletion (y/n)
-	confirmFormDiscard                 // discard dirty form changes, stay in app
-	confirmFormQuitDiscard             // discard dirty form changes and quit
+	confirmNone             confirmKind = iota
+	confirmHardDelete                   // permanent incident deletion (y/n)
+	confirmFormDiscard                  // discard dirty form changes, stay in app
+	confirmFormQuitDiscard              // discard dirty form changes and quit
+	confirmScheduleNext                 // schedule the next run of a recurring project (y/n)
+	confirmCompleteProjects             // mark projects completed (y/t/n)
+	confirmUndoComplete                 // undo the completion just made (u, any other key dismisses)
 )
 
 // isFormConfirm reports whether the confirmation is a form-related dialog.
@@ -74,12 +79,13 @@ type formState struct {
 	notesEditMode   bool
 	notesFieldPtr   *string
 	pendingEditor   *editorState
-	postalCodeField huh.Field  // non-nil when house form is active
-	cityInput       *huh.Input // city field for autofill value sync
-	stateInput      *huh.Input // state field for autofill value sync
-	lastPostalCode  string     // last postal code value that triggered a lookup
-	autoFilledCity  string     // city value set by autofill (empty = user-typed or not set)
-	autoFilledState string     // state value set by autofill (empty = user-typed or not set)
+	postalCodeField huh.Field                  // non-nil when house form is active
+	cityInput       *huh.Input                 // city field for autofill value sync
+	stateInput      *huh.Input                 // state field for autofill value sync
+	lastPostalCode  string                     // last postal code value that triggered a lookup
+	autoFilledCity  string                     // city value set by autofill (empty = user-typed or not set)
+	autoFilledState string                     // state value set by autofill (empty = user-typed or not set)
+	links           map[huh.Field]linkedSelect // selects that can add their record
 }
 
 // formKind returns the FormKind of the current form data, or formNone when no
@@ -103,6 +109,11 @@ type extractState struct {
 	extractionEnabled  bool
 	ocrTSV             bool
 	ocrConfThreshold   int
+	maxInputChars      int
+	contextTokens      int               // configured context window; 0 asks the server
+	promptVariants     map[string]string // model name -> extract.Prompt* variant
+	skipLLMOnImport    bool              // imports stop after OCR; the LLM runs on demand
+	strict             bool              // minimal prompt and a fixed seed for every model
 	extractionClient   llm.ExtractionProvider
 	extractors         []extract.Extractor
 	extractionReady    bool
@@ -110,6 +121,7 @@ type extractState struct {
 	pendingExtractionDocID *string
 	extraction             *extractionLogState
 	bgExtractions          []*extractionLogState
+	queue                  []queuedExtraction // waiting for the overlay, oldest first
 }
 
 type TabKind int
@@ -188,9 +200,11 @@ func (k TabKind) plural() string {
 }
 
 type rowMeta struct {
-	ID      string
-	Deleted bool
-	Dimmed  bool // true in pin preview mode for non-matching rows
+	ID       string
+	Deleted  bool
+	Archived bool // true for archived appliances and their maintenance
+	Dimmed   bool // true in pin preview mode for non-matching rows
+	Marked   bool // true when marked for a bulk action
 }
 
 type sortDir int
@@ -223,10 +237,13 @@ type Tab struct {
 	ColCursor           int
 	ViewOffset          int // first visible column in horizontal scroll viewport
 	LastDeleted         *string
+	Marked              map[string]bool // row IDs marked for a bulk action
 	ShowDeleted         bool
 	showDeletedExplicit bool // sticky: once true (user pressed 'x'), never cleared; suppresses auto-enable on delete
+	ShowArchived        bool // keep rows for archived appliances; toggled with 't'
 	Sorts               []sortEntry
-	Stale               bool // true when data may be outdated; cleared on reload
+	SortDirs            map[int]sortDir // last direction per column; resumes on re-sort
+	Stale               bool            // true when data may be outdated; cleared on reload
 
 	// Pin-and-filter state.
 	Pins           []filterPin // active pins; AND across columns, OR within
@@ -277,7 +294,69 @@ type Options struct {
 	ExtractionConfig extractionConfig
 	AddressAutofill  bool
 	AddressCountry   string
-	syncCfg          *syncConfig
+	// MaintenanceGraceDays is how many days past due a maintenance item
+	// stays in the dashboard's upcoming list before it is flagged overdue.
+	MaintenanceGraceDays int
+	// SnoozeDays is how long the snooze action hides a maintenance item
+	// from the dashboard.
+	SnoozeDays int
+	// WarrantyWindow is which warranty expirations the dashboard lists.
+	// The zero value means data.DefaultWarrantyWindow.
+	WarrantyWindow data.WarrantyWindow
+	// IntervalUnit is the unit a bare number typed as a maintenance
+	// interval is read in. Empty means months.
+	IntervalUnit data.IntervalUnit
+	// DefaultPerformer names the vendor preselected when logging new
+	// service. Empty, or a name with no vendor, means self.
+	DefaultPerformer string
+	// ReviewChanges lists an edit's changed fields and asks before saving
+	// it.
+	ReviewChanges bool
+	// DueSummary shows a one-line count of overdue and due-soon
+	// maintenance and expiring warranties on startup when the dashboard
+	// is hidden.
+	DueSummary bool
+	// AutoLinkDocuments links a document imported while an entity row is
+	// selected to that entity.
+	AutoLinkDocuments bool
+	// DocumentTitles turns an imported file's name into the document's
+	// default title.
+	DocumentTitles data.TitleRules
+	// DocStorageWarning flags the document storage total once it
+	// passes this many bytes. Zero disables the warning.
+	DocStorageWarning uint64
+	// Display formats dates and counts for the configured UI locale.
+	Display locale.Display
+	// CurrencyRates converts other currencies into the house currency for
+	// the dashboard's approximate total. No rates means no total.
+	CurrencyRates locale.Rates
+	// IdleLock blanks the screen after this long without input until a key
+	// is pressed. Zero disables it.
+	IdleLock time.Duration
+	// NoteLines is how many wrapped lines a notes cell may take in a table
+	// row. Values below 2 show only the first line.
+	NoteLines int
+	// StatusSegments lists the status bar segments to show, in order
+	// (see config.StatusSegmentNames). Nil shows the default set.
+	StatusSegments []string
+	// CompactStatus keeps the status bar to a single row.
+	CompactStatus bool
+	// MinWidth and MinHeight are the smallest terminal size to lay out
+	// for. Zero uses the 80x24 default.
+	MinWidth  int
+	MinHeight int
+	// DisableMouse turns off mouse reporting for keyboard-only use.
+	DisableMouse bool
+	// HomeKey overrides the key that jumps to the dashboard from anywhere.
+	// Empty keeps the default.
+	HomeKey string
+	// DefaultSorts holds each tab's starting sort from the [sort] config,
+	// keyed by config key (e.g. "maintenance" -> "next asc").
+	DefaultSorts map[string]string
+	// FormFields tailors each record type's forms from the [forms] config,
+	// keyed by config key (e.g. "appliances").
+	FormFields map[string]FormFields
+	syncCfg    *syncConfig
 }
 
 // SetSync configures the background sync pipeline on the Options.
@@ -294,14 +373,17 @@ func (o *Options) SetSync(relayURL, token, householdID string, key crypto.Househ
 // loading the TOML config. Kept as a separate type so the app package
 // doesn't import config directly.
 type chatConfig struct {
-	Enabled      bool
-	Provider     string
-	BaseURL      string
-	Model        string
-	APIKey       string
-	ExtraContext string
-	Timeout      time.Duration // inference context deadline
-	Effort       string        // reasoning effort: none|low|medium|high|auto
+	Enabled       bool
+	Provider      string
+	BaseURL       string
+	Model         string
+	APIKey        string
+	ExtraContext  string
+	Language      string        // answer language; empty means English
+	SchemaTokens  int           // SQL prompt schema cap; 0 derives it from ContextTokens
+	ContextTokens int           // model context window; 0 asks the server
+	Timeout       time.Duration // inference context deadline
+	Effort        string        // reasoning effort: none|low|medium|high|auto
 }
 
 // extractionConfig holds resolved extraction pipeline settings.
@@ -317,6 +399,11 @@ type extractionConfig struct {
 	Enabled          bool                // LLM extraction enabled
 	OCRTSV           bool                // send spatial layout annotations to LLM
 	OCRConfThreshold int                 // confidence threshold for spatial annotations
+	MaxInputChars    int                 // document text budget for the LLM prompt; 0 = no limit
+	ContextTokens    int                 // model context window; 0 asks the server
+	PromptVariants   map[string]string   // prompt variant per model; unlisted = full
+	SkipLLMOnImport  bool                // imports stop after OCR; the LLM runs on demand
+	Strict           bool                // minimal prompt and a fixed seed for every model
 }
 
 // SetExtraction configures the extraction pipeline on the Options.
@@ -328,6 +415,11 @@ func (o *Options) SetExtraction(
 	enabled bool,
 	ocrTSV bool,
 	ocrConfThreshold int,
+	maxInputChars int,
+	contextTokens int,
+	promptVariants map[string]string,
+	llmOnImport bool,
+	strict bool,
 ) {
 	o.ExtractionConfig = extractionConfig{
 		Provider:         provider,
@@ -340,6 +432,11 @@ func (o *Options) SetExtraction(
 		Enabled:          enabled,
 		OCRTSV:           ocrTSV,
 		OCRConfThreshold: ocrConfThreshold,
+		MaxInputChars:    maxInputChars,
+		ContextTokens:    contextTokens,
+		PromptVariants:   promptVariants,
+		SkipLLMOnImport:  !llmOnImport,
+		Strict:           strict,
 	}
 }
 
@@ -347,19 +444,23 @@ func (o *Options) SetExtraction(
 // only when enabled is true and model is non-empty.
 func (o *Options) SetChat(
 	enabled bool,
-	provider, baseURL, model, apiKey, extraContext string,
+	provider, baseURL, model, apiKey, extraContext, answerLanguage string,
+	maxSchemaTokens, contextTokens int,
 	timeout time.Duration,
 	effort string,
 ) {
 	o.ChatConfig = chatConfig{
-		Enabled:      enabled && model != "",
-		Provider:     provider,
-		BaseURL:      baseURL,
-		Model:        model,
-		APIKey:       apiKey,
-		ExtraContext: extraContext,
-		Timeout:      timeout,
-		Effort:       effort,
+		Enabled:       enabled && model != "",
+		Provider:      provider,
+		BaseURL:       baseURL,
+		Model:         model,
+		APIKey:        apiKey,
+		ExtraContext:  extraContext,
+		Language:      answerLanguage,
+		SchemaTokens:  maxSchemaTokens,
+		ContextTokens: contextTokens,
+		Timeout:       timeout,
+		Effort:        effort,
 	}
 }
 
@@ -385,6 +486,8 @@ const (
 	cellEntity          // entity ref with colored kind-letter prefix
 	cellOps             // extraction ops count; opens tree overlay on enter
 	cellTelephoneNumber // formatted phone number; passthrough for styling
+	cellDuration        // span like "3m", "2w", or "1y 6m"; sorts by length
+	cellNumber          // computed number with a display unit, e.g. "1.2 MB"; read-only
 )
 
 type cell struct {
@@ -392,6 +495,9 @@ type cell struct {
 	Kind   cellKind
 	Null   bool   // true when the database value is NULL (not just empty)
 	LinkID string // FK target ID for cross-tab navigation; "" = no link
+	// Currency is the ISO code a money cell's amount is in; "" means the
+	// house currency.
+	Currency string
 }
 
 // nullPinKey is the internal key used by the pin/filter system to represent
@@ -413,6 +519,7 @@ type columnSpec struct {
 	Link        *columnLink // non-nil if this column references another tab
 	FixedValues []string    // all possible values; used to stabilize column width
 	HideOrder   int         // 0 = visible; >0 = hidden (higher = more recently hidden)
+	Width       int         // user override; 0 = computed from content and Min/Max
 }
 
 // inlineInputState holds state for a single-field text edit rendered in the
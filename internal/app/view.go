@@ -17,11 +17,18 @@ import (
 )
 
 func (m *Model) buildView() string {
+	if m.locked {
+		return m.buildLockedView()
+	}
 	if m.terminalTooSmall() {
 		return m.buildTerminalTooSmallView()
 	}
 
 	if m.mode == modeForm && m.fs.form != nil && m.fs.formKind() == formHouse {
+		if m.saveReview != nil {
+			fg := m.zones.Mark(zoneOverlay, cancelFaint(m.buildSaveReviewOverlay()))
+			return compositeOverlay(fg, dimBackground(m.formFullScreen()))
+		}
 		return m.formFullScreen()
 	}
 
@@ -38,6 +45,11 @@ func (m *Model) buildView() string {
 		{m.notePreview != nil, m.buildNotePreviewOverlay},
 		{m.opsTree != nil, m.buildOpsTreeOverlay},
 		{m.columnFinder != nil, m.buildColumnFinderOverlay},
+		{m.recentPicker != nil, m.buildRecentPickerOverlay},
+		{m.quoteCompare != nil, m.buildQuoteCompareOverlay},
+		{m.merge != nil, m.buildMergeOverlay},
+		{m.saveReview != nil, m.buildSaveReviewOverlay},
+		{m.viewsPicker != nil, m.buildViewsPickerOverlay},
 		{m.docSearch != nil, m.buildDocSearchOverlay},
 		{m.ex.extraction != nil && m.ex.extraction.Visible, m.buildExtractionOverlay},
 		{m.chat != nil && m.chat.Visible, m.buildChatOverlay},
@@ -75,20 +87,16 @@ func (m *Model) buildView() string {
 func (m *Model) buildTerminalTooSmallView() string {
 	width := m.effectiveWidth()
 	height := m.effectiveHeight()
+	minW, minH := m.minTerminalSize()
 
 	panel := lipgloss.JoinVertical(
 		lipgloss.Center,
 		m.styles.Error().Render("Terminal too small"),
 		"",
 		m.styles.HeaderHint().Render(
-			fmt.Sprintf(
-				"%dx%d — need at least %dx%d",
-				width,
-				height,
-				minUsableWidth,
-				minUsableHeight,
-			),
+			fmt.Sprintf("%dx%d — need at least %dx%d", width, height, minW, minH),
 		),
+		m.styles.TextDim().Render("Enlarge the window to continue."),
 	)
 
 	return lipgloss.Place(
@@ -294,6 +302,15 @@ func (m *Model) statusView() string {
 		)
 		return m.withPullProgress(prompt + "  " + hints)
 	}
+	if m.confirm == confirmScheduleNext {
+		return m.withPullProgress(m.scheduleNextPrompt())
+	}
+	if m.confirm == confirmCompleteProjects {
+		return m.withPullProgress(m.completeProjectsPrompt())
+	}
+	if m.confirm == confirmUndoComplete {
+		return m.withPullProgress(m.undoCompletePrompt())
+	}
 	if m.mode == modeForm {
 		if m.confirm.isFormConfirm() {
 			prompt := m.styles.FormDirty().Render("Discard unsaved changes?")
@@ -304,14 +321,15 @@ func (m *Model) statusView() string {
 			)
 			return m.withPullProgress(prompt + "  " + hints)
 		}
-		dirtyIndicator := m.styles.FormClean().Render("○ saved")
-		if m.fs.formDirty {
-			dirtyIndicator = m.styles.FormDirty().Render("● unsaved")
-		}
-		parts := []string{
-			dirtyIndicator,
-			m.helpItem(keyCtrlS, "save"),
+		var parts []string
+		if m.showsStatusSegment(statusSegDirty) {
+			dirtyIndicator := m.styles.FormClean().Render("○ saved")
+			if m.fs.formDirty {
+				dirtyIndicator = m.styles.FormDirty().Render("● unsaved")
+			}
+			parts = append(parts, dirtyIndicator)
 		}
+		parts = append(parts, m.helpItem(keyCtrlS, "save"))
 		if m.fs.notesEditMode {
 			parts = append(parts, m.helpItem(keyCtrlE, "editor"))
 		}
@@ -320,12 +338,18 @@ func (m *Model) statusView() string {
 			m.helpItem(keyCtrlQ, "quit"),
 		)
 		help := joinWithSeparator(m.helpSeparator(), parts...)
+		if m.compactStatus {
+			return m.compactStatusLine(false, func(int) string { return help })
+		}
 		return m.withPullProgress(m.withStatusMessage(help))
 	}
 
 	// When overlays are active, don't show main tab keybindings since they're
 	// not accessible. Overlays show their own relevant hints.
 	if m.hasActiveOverlay() {
+		if m.compactStatus {
+			return m.compactStatusLine(false, func(int) string { return "" })
+		}
 		return m.withPullProgress(m.withStatusMessage(""))
 	}
 
@@ -345,7 +369,12 @@ func (m *Model) statusView() string {
 			Render("EDIT")
 	}
 
-	help := m.modeStatusHelp(modeBadge)
+	if m.compactStatus {
+		return m.compactStatusLine(true, func(maxW int) string {
+			return m.modeStatusHelp(modeBadge, maxW)
+		})
+	}
+	help := m.modeStatusHelp(modeBadge, m.effectiveWidth())
 
 	return m.withSyncIndicator(
 		m.withBgExtractionIndicator(m.withPullProgress(m.withStatusMessage(help))),
@@ -353,12 +382,22 @@ func (m *Model) statusView() string {
 }
 
 // withBgExtractionIndicator prepends a background extraction indicator when
-// extractions are running or awaiting review in the background.
+// extractions are running or awaiting review in the background, or waiting
+// in the queue.
 func (m *Model) withBgExtractionIndicator(statusOutput string) string {
-	n := len(m.ex.bgExtractions)
-	if n == 0 {
+	indicator := m.bgExtractionIndicator()
+	if indicator == "" {
 		return statusOutput
 	}
+	return lipgloss.JoinVertical(lipgloss.Left, indicator, statusOutput)
+}
+
+// bgExtractionIndicator summarizes background and queued extractions, or
+// returns "" when there are none.
+func (m *Model) bgExtractionIndicator() string {
+	if len(m.ex.bgExtractions) == 0 && len(m.ex.queue) == 0 {
+		return ""
+	}
 	var running, ready int
 	for _, bg := range m.ex.bgExtractions {
 		if bg.Done {
@@ -379,8 +418,12 @@ func (m *Model) withBgExtractionIndicator(statusOutput string) string {
 			fmt.Sprintf("%d ready", ready),
 		))
 	}
-	indicator := strings.Join(parts, "  ")
-	return lipgloss.JoinVertical(lipgloss.Left, indicator, statusOutput)
+	if q := len(m.ex.queue); q > 0 {
+		parts = append(parts, appStyles.AccentText().Render(
+			fmt.Sprintf("%d queued", q),
+		))
+	}
+	return strings.Join(parts, "  ")
 }
 
 func (m *Model) inlineInputStatusView() string {
@@ -396,39 +439,6 @@ func (m *Model) inlineInputStatusView() string {
 	return m.withStatusMessage(prompt)
 }
 
-func (m *Model) modeStatusHelp(modeBadge string) string {
-	maxW := m.effectiveWidth()
-	sep := m.helpSeparator()
-	bindings := m.ShortHelp()
-
-	items := make([]string, 0, len(bindings)+1)
-	items = append(items, modeBadge)
-
-	for _, kb := range bindings {
-		if !kb.Enabled() {
-			continue
-		}
-		h := kb.Help()
-		item := m.helpItem(h.Key, h.Desc)
-		if id := hintZoneID(kb.Keys()); id != "" {
-			item = m.zones.Mark(zoneHint+id, item)
-		}
-		items = append(items, item)
-	}
-
-	// Fit within available width by dropping optional items from the end,
-	// keeping the mode badge (index 0) and help hint (index 1) always.
-	for len(items) > 2 {
-		line := joinWithSeparator(sep, items...)
-		if lipgloss.Width(line) <= maxW {
-			return line
-		}
-		items = items[:len(items)-1]
-	}
-
-	return joinWithSeparator(sep, items...)
-}
-
 // hintZoneID maps a keybinding's trigger keys to its mouse zone
 // identifier for handleHintClick. Uses the actual key triggers
 // (key.Binding.Keys()) rather than display strings so that
@@ -463,19 +473,28 @@ func hintZoneID(keys []string) string {
 
 // withStatusMessage renders the help line, prepending the status message if set.
 func (m *Model) withStatusMessage(helpLine string) string {
-	if m.status.Text == "" {
+	rendered := m.renderStatusMessage()
+	if rendered == "" {
 		return helpLine
 	}
-	var rendered string
+	return lipgloss.JoinVertical(lipgloss.Left, rendered, helpLine)
+}
+
+// renderStatusMessage styles the status message by kind, or returns ""
+// when there is none.
+func (m *Model) renderStatusMessage() string {
+	if m.status.Text == "" {
+		return ""
+	}
 	switch m.status.Kind {
 	case statusStyled:
-		rendered = m.status.Text
+		return m.status.Text
 	case statusError:
-		rendered = m.styles.Error().Render(m.status.Text)
+		return m.styles.Error().Render(m.status.Text)
 	case statusInfo:
-		rendered = m.styles.Info().Render(m.status.Text)
+		return m.styles.Info().Render(m.status.Text)
 	}
-	return lipgloss.JoinVertical(lipgloss.Left, rendered, helpLine)
+	return ""
 }
 
 // withPullProgress appends the model download progress line below the status
@@ -504,7 +523,7 @@ func (m *Model) editHint() string {
 			return "follow " + linkArrow
 		}
 	}
-	if spec.Kind == cellReadonly {
+	if spec.Kind == cellReadonly || spec.Kind == cellNumber {
 		return "edit"
 	}
 	return "edit: " + spec.Title
@@ -539,6 +558,59 @@ func (m *Model) enterHint() string {
 	return ""
 }
 
+// tableBodyHeight returns the number of data rows the table renders, after
+// subtracting the hidden-column badge line and the row count line from the
+// table's allotted height. Paging moves by this amount so a page jump
+// never skips rows that were never on screen.
+func tableBodyHeight(tab *Tab) int {
+	// Badge line accounts for 1 row of vertical space when visible.
+	badgeChrome := 0
+	if renderHiddenBadges(tab.Specs, tab.ColCursor) != "" {
+		badgeChrome = 1
+	}
+
+	// Row count line accounts for 1 row when visible (hidden when empty).
+	rowCountChrome := 0
+	if len(tab.Rows) > 0 {
+		rowCountChrome = 1
+	}
+
+	// Money totals line sits under the rows.
+	footerChrome := 0
+	if hasMoneyFooter(tab) {
+		footerChrome = 1
+	}
+
+	return max(tab.Table.Height()-badgeChrome-rowCountChrome-footerChrome, 2)
+}
+
+// sortHint returns a short label for what the sort key does on the current
+// column, e.g. "sort by Budget", "sort Budget desc", or "unsort Budget".
+func (m *Model) sortHint() string {
+	tab := m.effectiveTab()
+	if tab == nil {
+		return ""
+	}
+	col := tab.ColCursor
+	if col < 0 || col >= len(tab.Specs) || tab.Specs[col].Title == "" {
+		return ""
+	}
+	title := tab.Specs[col].Title
+	dir, ok := nextSortDir(tab, col)
+	switch {
+	case !ok:
+		return "unsort " + title
+	case sortIndicator(tab.Sorts, col) != "":
+		if dir == sortDesc {
+			return "sort " + title + " desc"
+		}
+		return "sort " + title + " asc"
+	case dir == sortDesc:
+		return "sort by " + title + " desc"
+	}
+	return "sort by " + title
+}
+
 // drilldownHint returns a short label for the drilldown target based on the
 // current tab and column. Used in status bar hints.
 func (m *Model) drilldownHint(_ *Tab, _ columnSpec) string {
@@ -619,6 +691,19 @@ func (m *Model) centerPanel(panel string, minPadTop int) string {
 	return b.String()
 }
 
+// moneyDisplayCells applies the active money display transform (mag,
+// exact, or compact) to a cell grid.
+func (m *Model) moneyDisplayCells(rows [][]cell) [][]cell {
+	switch {
+	case m.magMode:
+		return magTransformCells(rows, m.cur.Symbol())
+	case m.exactMoney:
+		return exactMoneyCells(rows, m.cur)
+	default:
+		return compactMoneyCells(rows, m.cur)
+	}
+}
+
 // tableView orchestrates the full table rendering: visible projection,
 // column sizing, horizontal scroll viewport, header/divider/rows, and
 // hidden-column badge line.
@@ -650,42 +735,27 @@ func (m *Model) tableView(tab *Tab) string {
 	)
 	divider := renderDivider(vp.Widths, vp.PlainSeps, normalDiv, m.styles.TableSeparator())
 
-	// Badge line accounts for 1 row of vertical space when visible.
 	badges := renderHiddenBadges(tab.Specs, tab.ColCursor)
-	badgeChrome := 0
-	if badges != "" {
-		badgeChrome = 1
-	}
-
-	// Row count line accounts for 1 row when visible (hidden when empty).
-	rowCountChrome := 0
-	if len(tab.Rows) > 0 {
-		rowCountChrome = 1
-	}
-
-	effectiveHeight := tab.Table.Height() - badgeChrome - rowCountChrome
-	effectiveHeight = max(effectiveHeight, 2)
-	// Mag and compact transforms are mutually exclusive: mag replaces
-	// values with order-of-magnitude notation, compact abbreviates them.
-	// Both strip the $ prefix since the header carries the unit.
-	var displayCells [][]cell
-	if m.magMode {
-		displayCells = magTransformCells(vp.Cells, m.cur.Symbol())
-	} else {
-		displayCells = compactMoneyCells(vp.Cells, m.cur)
-	}
+	effectiveHeight := tableBodyHeight(tab)
+	// Mag, exact, and compact transforms are mutually exclusive: mag
+	// replaces values with order-of-magnitude notation, compact abbreviates
+	// them, and exact keeps full precision. All strip the $ prefix since
+	// the header carries the unit.
+	displayCells := m.moneyDisplayCells(vp.Cells)
+	displayCells = localizeCells(displayCells, m.display)
 	// Translate pin column indices from tab-space to viewport-space.
 	pinCtx := m.viewportPinContext(tab, vp)
 	rows := renderRows(
 		vp.Specs,
 		displayCells,
-		tab.Rows,
+		tab.markedRows(),
 		vp.Widths,
 		vp.PlainSeps,
 		vp.CollapsedSeps,
 		tab.Table.Cursor(),
 		vp.Cursor,
 		effectiveHeight,
+		m.noteLines,
 		pinCtx,
 		m.zones,
 		zoneRow,
@@ -702,6 +772,12 @@ func (m *Model) tableView(tab *Tab) string {
 	} else {
 		bodyParts = append(bodyParts, strings.Join(rows, "\n"))
 	}
+	if hasMoneyFooter(tab) && len(rows) > 0 {
+		footer := m.moneyDisplayCells(
+			[][]cell{moneyFooterCells(vp.Specs, vp.Cells, tab.Rows, m.cur)},
+		)
+		bodyParts = append(bodyParts, renderFooterRow(vp.Specs, footer[0], vp.Widths, vp.PlainSeps))
+	}
 	if badges != "" {
 		tableWidth := sumInts(vp.Widths)
 		if len(vp.Widths) > 1 {
@@ -728,11 +804,36 @@ func (m *Model) tableView(tab *Tab) string {
 				label += " · " + m.styles.DeletedLabel().Render(suffix)
 			}
 		}
+		if tab.Kind == tabDocuments && !m.inDetail() {
+			if storage := m.documentStorageLabel(); storage != "" {
+				label += " · " + storage
+			}
+		}
 		bodyParts = append(bodyParts, m.styles.Empty().Render(label))
 	}
 	return joinVerticalNonEmpty(bodyParts...)
 }
 
+// documentStorageLabel summarizes how much space document files take in
+// the database, styled as a warning once it passes the configured limit.
+// Returns "" when nothing is stored.
+func (m *Model) documentStorageLabel() string {
+	st := m.docStorage
+	if st.Bytes <= 0 {
+		return ""
+	}
+	label := formatFileSize(uint64(st.Bytes)) + " stored"
+	if st.DeletedBytes > 0 {
+		label += fmt.Sprintf(" (%s deleted)", formatFileSize(uint64(st.DeletedBytes)))
+	}
+	if m.docStorageWarn > 0 && uint64(st.Bytes) > m.docStorageWarn {
+		return m.styles.Warning().Render(
+			label + " · over " + formatFileSize(m.docStorageWarn),
+		)
+	}
+	return label
+}
+
 // viewportPinContext translates the tab's pin column indices into viewport
 // coordinate space so the renderer can identify pinned cells.
 func (m *Model) viewportPinContext(tab *Tab, vp tableViewport) pinRenderContext {
@@ -40,17 +40,25 @@ func (m *Model) helpSections() []helpSection {
 				fromBinding(m.keys.ToggleSettled),
 				fromBinding(m.keys.DocSearch),
 				fromBinding(m.keys.ColFinder),
+				fromBinding(m.keys.Recent),
+				fromBinding(m.keys.QuoteCompare),
+				fromBinding(m.keys.Views),
 				fromBinding(m.keys.ColHide),
+				fromBinding(m.keys.ColNarrow),
+				fromBinding(m.keys.ColWidthReset),
 				fromBinding(m.keys.FilterToggle),
 				fromBinding(m.keys.FilterPin),
 				fromBinding(m.keys.FilterNegate),
 				fromBinding(m.keys.FilterClear),
 				fromBinding(m.keys.Enter),
 				fromBinding(m.keys.YankCell),
+				fromBinding(m.keys.YankView),
 				fromBinding(m.keys.DocOpen),
 				fromBinding(m.keys.HouseToggle),
 				fromBinding(m.keys.ToggleUnits),
+				fromBinding(m.keys.ToggleMoney),
 				fromBinding(m.keys.Dashboard),
+				fromBinding(m.keys.Home),
 				fromBinding(m.keys.Chat),
 				fromBinding(m.keys.EnterEditMode),
 				fromBinding(m.keys.Help),
@@ -62,10 +70,16 @@ func (m *Model) helpSections() []helpSection {
 			entries: []helpEntry{
 				fromBinding(m.keys.Add),
 				fromBinding(m.keys.QuickAdd),
+				fromBinding(m.keys.PasteImage),
 				fromBinding(m.keys.EditCell),
 				fromBinding(m.keys.EditFull),
 				fromBinding(m.keys.Delete),
 				fromBinding(m.keys.HardDelete),
+				{keyZ, "snooze maintenance / archive appliance"},
+				{keyC, "clone last service log entry"},
+				{keySpace, "mark project or appliance for a bulk action"},
+				{keyShiftC, "mark project(s) completed"},
+				{keyShiftM, "merge similar vendors or marked appliances"},
 				{keyCtrlD, "half page down"},
 				fromBinding(m.keys.ShowDeleted),
 				fromBinding(m.keys.HouseEdit),
@@ -78,6 +92,7 @@ func (m *Model) helpSections() []helpSection {
 				fromBinding(m.keys.FormNextField),
 				fromBinding(m.keys.FormPrevField),
 				{"1-9", "jump to Nth option"},
+				fromBinding(m.keys.FormNewRecord),
 				fromBinding(m.keys.FormHiddenFiles),
 				fromBinding(m.keys.FormEditor),
 				fromBinding(m.keys.FormSave),
@@ -90,6 +105,7 @@ func (m *Model) helpSections() []helpSection {
 				fromBinding(m.keys.ChatSend),
 				fromBinding(m.keys.ChatToggleSQL),
 				fromBinding(m.keys.ChatHistoryUp),
+				fromBinding(m.keys.ChatLinks),
 				fromBinding(m.keys.ChatHide),
 			},
 		},
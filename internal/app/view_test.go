@@ -7,10 +7,12 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
+	"charm.land/bubbles/v2/table"
 	"charm.land/lipgloss/v2"
 	zone "github.com/lrstanley/bubblezone/v2"
 	"github.com/micasa-dev/micasa/internal/data"
@@ -80,6 +82,24 @@ func TestBuildViewDoesNotShowTerminalTooSmallMessageAtMinimumSize(t *testing.T)
 	assert.NotContains(t, output, "Terminal too small")
 }
 
+func TestBuildViewHonorsConfiguredMinimumSize(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.minWidth = 60
+	m.minHeight = 16
+	m.width = 70
+	m.height = 20
+
+	assert.NotContains(t, m.buildView(), "Terminal too small",
+		"a lowered minimum lets smaller terminals render the UI")
+
+	m.height = 15
+	output := m.buildView()
+	assert.Contains(t, output, "Terminal too small")
+	assert.Contains(t, output, "70x15 — need at least 60x16")
+	assert.Contains(t, output, "Enlarge the window")
+}
+
 func TestNaturalWidthsIgnoreMax(t *testing.T) {
 	t.Parallel()
 	specs := []columnSpec{
@@ -89,7 +109,7 @@ func TestNaturalWidthsIgnoreMax(t *testing.T) {
 	rows := [][]cell{
 		{{Value: "1"}, {Value: "A very long name indeed"}},
 	}
-	natural := naturalWidths(specs, rows, "$")
+	natural := naturalWidths(specs, rows, "$", 1)
 	// "A very long name indeed" is 23 chars, well past Max of 12.
 	assert.Greater(t, natural[1], 12)
 }
@@ -138,6 +158,31 @@ func TestColumnWidthsTruncatedColumnsGetExtraFirst(t *testing.T) {
 	assert.GreaterOrEqual(t, widths[1], 15)
 }
 
+func TestColumnWidthsHonorWidthOverride(t *testing.T) {
+	t.Parallel()
+	specs := []columnSpec{
+		{Title: "ID", Min: 4, Max: 6},
+		{Title: "Name", Min: 8, Max: 12, Flex: true, Width: 30},
+		{Title: "Serial", Min: 8, Max: 14, Flex: true, Width: 5},
+	}
+	rows := [][]cell{
+		{{Value: "1"}, {Value: "Fridge"}, {Value: "SN-0001-XYZ"}},
+	}
+	natural := naturalWidths(specs, rows, "$", 1)
+	assert.Equal(t, 30, natural[1], "override replaces the content width")
+	assert.Equal(t, 5, natural[2], "override may be narrower than content and Min")
+
+	// Room to spare: overridden columns don't soak up the extra.
+	widths := columnWidths(specs, rows, 200, 3, nil)
+	assert.Equal(t, 30, widths[1])
+	assert.Equal(t, 5, widths[2])
+
+	// Too narrow: the override survives Max capping and flex shrinking.
+	widths = columnWidths(specs, rows, 40, 3, nil)
+	assert.Equal(t, 30, widths[1])
+	assert.Equal(t, 5, widths[2])
+}
+
 func TestWidenTruncated(t *testing.T) {
 	t.Parallel()
 	t.Run("distributes all extra space", func(t *testing.T) {
@@ -566,6 +611,81 @@ func TestEnsureCursorVisibleNoopWhenVisible(t *testing.T) {
 	assert.Equal(t, 0, tab.ViewOffset)
 }
 
+// fillPagingTab loads n synthetic rows into the active tab so paging has
+// something to move through.
+func fillPagingTab(m *Model, n int) *Tab {
+	tab := m.effectiveTab()
+	cols := len(tab.Specs)
+	rows := make([]table.Row, n)
+	tab.Rows = make([]rowMeta, n)
+	tab.CellRows = make([][]cell, n)
+	for i := range n {
+		row := make(table.Row, cols)
+		cells := make([]cell, cols)
+		for c := range cols {
+			row[c] = fmt.Sprintf("r%d-c%d", i, c)
+			cells[c] = cell{Value: row[c], Kind: cellText}
+		}
+		rows[i] = row
+		tab.Rows[i] = rowMeta{ID: strconv.Itoa(i)}
+		tab.CellRows[i] = cells
+	}
+	tab.Table.SetRows(rows)
+	tab.Table.SetHeight(12)
+	tab.Table.SetCursor(0)
+	return tab
+}
+
+func TestPageDownMovesByRenderedHeight(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.showDashboard = false
+	m.width = 200
+	m.height = 40
+	tab := fillPagingTab(m, 100)
+
+	body := tableBodyHeight(tab)
+	require.Less(t, body, tab.Table.Height(), "body excludes chrome lines")
+
+	sendKey(m, "pgdown")
+	assert.Equal(t, body, tab.Table.Cursor())
+	sendKey(m, "pgup")
+	assert.Equal(t, 0, tab.Table.Cursor())
+
+	sendKey(m, "ctrl+d")
+	assert.Equal(t, body/2, tab.Table.Cursor())
+	sendKey(m, "ctrl+u")
+	assert.Equal(t, 0, tab.Table.Cursor())
+
+	// Clamped at both ends.
+	sendKey(m, "pgup")
+	assert.Equal(t, 0, tab.Table.Cursor())
+	tab.Table.SetCursor(98)
+	sendKey(m, "pgdown")
+	assert.Equal(t, 99, tab.Table.Cursor())
+}
+
+func TestPagingKeepsColumnCursorVisible(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.showDashboard = false
+	m.width = 80
+	m.height = 40
+	tab := fillPagingTab(m, 100)
+
+	// Column cursor far right with a stale offset that hides it.
+	tab.ColCursor = len(tab.Specs) - 1
+	tab.ViewOffset = 0
+
+	sendKey(m, "pgdown")
+
+	vp := m.tabViewport(tab)
+	require.True(t, vp.HasLeft, "table should be scrolled horizontally")
+	assert.GreaterOrEqual(t, vp.Cursor, 0, "active column should be in the viewport")
+	assert.Contains(t, m.buildView(), fmt.Sprintf("r%d-c", tab.Table.Cursor()),
+		"selected row should be rendered")
+}
+
 func TestViewportSorts(t *testing.T) {
 	t.Parallel()
 	t.Run("adjusts column indices by offset", func(t *testing.T) {
@@ -966,8 +1086,9 @@ func TestNormalModeOmitsDiscoveryHints(t *testing.T) {
 	status := m.statusView()
 
 	// These keybinding hints should be discoverable only via the help
-	// overlay, not cluttering the status bar.
-	for _, removed := range []string{"find col", "hide col", "sort", "pin"} {
+	// overlay, not cluttering the status bar. Sort is the exception: it
+	// gets a contextual "sort by <column>" hint (see TestSortHint).
+	for _, removed := range []string{"find col", "hide col", "pin"} {
 		assert.NotContains(t, status, removed,
 			"did not expect %q hint in redesigned normal-mode status bar", removed)
 	}
@@ -1345,8 +1466,8 @@ func TestNaturalWidthsIndirectMatchesDirect(t *testing.T) {
 		{{Value: "2"}, {Value: "beta"}, {Value: "extra"}},
 	}
 	visToFull := []int{0, 1}
-	indirect := naturalWidthsIndirect(specs, fullRows, visToFull, "$")
-	direct := naturalWidths(specs, fullRows, "$")
+	indirect := naturalWidthsIndirect(specs, fullRows, visToFull, "$", 1)
+	direct := naturalWidths(specs, fullRows, "$", 1)
 	assert.Equal(t, direct, indirect)
 }
 
@@ -1359,7 +1480,7 @@ func TestNaturalWidthsIndirectRemappedColumns(t *testing.T) {
 		{{Value: "1"}, {Value: "alpha"}, {Value: "extra"}},
 	}
 	visToFull := []int{1}
-	widths := naturalWidthsIndirect(specs, fullRows, visToFull, "$")
+	widths := naturalWidthsIndirect(specs, fullRows, visToFull, "$", 1)
 	require.Len(t, widths, 1)
 	assert.GreaterOrEqual(t, widths[0], lipgloss.Width("alpha"))
 }
@@ -1373,7 +1494,7 @@ func TestComputeNaturalWidthsSkipsShortRows(t *testing.T) {
 	rows := [][]cell{
 		{{Value: "x"}},
 	}
-	widths := naturalWidths(specs, rows, "$")
+	widths := naturalWidths(specs, rows, "$", 1)
 	require.Len(t, widths, 2)
 	assert.GreaterOrEqual(t, widths[0], 2)
 	assert.GreaterOrEqual(t, widths[1], 2)
@@ -0,0 +1,316 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/micasa-dev/micasa/internal/data"
+)
+
+// viewsPickerState holds the state for the saved views overlay. Name is
+// the text typed into the overlay; it names the view saved with ctrl+s.
+type viewsPickerState struct {
+	Tab    TabKind
+	Views  []data.SavedView
+	Name   string
+	Cursor int
+}
+
+// savedViewsKey returns the settings key suffix for a tab's saved views.
+func savedViewsKey(kind TabKind) string {
+	return strings.ToLower(kind.String())
+}
+
+// openViewsPicker shows the saved views for the active tab. Views belong to
+// top-level tabs; detail views have no saved views of their own.
+func (m *Model) openViewsPicker() {
+	if m.inDetail() {
+		m.setStatusInfo("Saved views are not available in detail views.")
+		return
+	}
+	tab := m.activeTab()
+	if tab == nil || m.store == nil {
+		return
+	}
+	views, err := m.store.GetSavedViews(savedViewsKey(tab.Kind))
+	if err != nil {
+		m.setStatusError(humanizeError(err))
+		return
+	}
+	m.viewsPicker = &viewsPickerState{Tab: tab.Kind, Views: views}
+}
+
+// captureView snapshots the tab's sorts and pins as a saved view.
+func captureView(tab *Tab, name string, magMode bool) data.SavedView {
+	view := data.SavedView{
+		Name:           name,
+		FilterActive:   tab.FilterActive,
+		FilterInverted: tab.FilterInverted,
+		MagMode:        magMode && len(tab.Pins) > 0,
+	}
+	for _, s := range tab.Sorts {
+		if s.Col < len(tab.Specs) {
+			view.Sorts = append(view.Sorts, data.SavedSort{
+				Column: tab.Specs[s.Col].Title,
+				Desc:   s.Dir == sortDesc,
+			})
+		}
+	}
+	for _, pin := range tab.Pins {
+		if pin.Col >= len(tab.Specs) {
+			continue
+		}
+		values := make([]string, 0, len(pin.Values))
+		for v := range pin.Values {
+			values = append(values, v)
+		}
+		slices.Sort(values)
+		view.Pins = append(view.Pins, data.SavedPin{
+			Column: tab.Specs[pin.Col].Title,
+			Values: values,
+		})
+	}
+	return view
+}
+
+// restoreView replaces the tab's sorts and pins with those of a saved view.
+// Columns that no longer exist are skipped. Returns false if any were.
+func restoreView(tab *Tab, view data.SavedView) bool {
+	colIndex := func(title string) int {
+		for i, s := range tab.Specs {
+			if s.Title == title {
+				return i
+			}
+		}
+		return -1
+	}
+	complete := true
+
+	tab.Sorts = nil
+	for _, s := range view.Sorts {
+		col := colIndex(s.Column)
+		if col < 0 {
+			complete = false
+			continue
+		}
+		dir := sortAsc
+		if s.Desc {
+			dir = sortDesc
+		}
+		tab.Sorts = append(tab.Sorts, sortEntry{Col: col, Dir: dir})
+	}
+
+	tab.Pins = nil
+	for _, p := range view.Pins {
+		col := colIndex(p.Column)
+		if col < 0 || len(p.Values) == 0 {
+			complete = complete && col >= 0
+			continue
+		}
+		values := make(map[string]bool, len(p.Values))
+		for _, v := range p.Values {
+			values[v] = true
+		}
+		tab.Pins = append(tab.Pins, filterPin{Col: col, Values: values})
+	}
+	tab.FilterActive = view.FilterActive
+	tab.FilterInverted = view.FilterInverted
+	return complete
+}
+
+// applySavedView applies the selected view to its tab and closes the picker.
+func (m *Model) applySavedView() {
+	vp := m.viewsPicker
+	m.viewsPicker = nil
+	if vp == nil || vp.Cursor < 0 || vp.Cursor >= len(vp.Views) {
+		return
+	}
+	tab := m.activeTab()
+	if tab == nil || tab.Kind != vp.Tab {
+		return
+	}
+	view := vp.Views[vp.Cursor]
+	complete := restoreView(tab, view)
+	if view.MagMode != m.magMode {
+		translatePins(tab, m.magMode, m.cur.Symbol())
+	}
+	m.refreshTable(tab)
+	if !complete {
+		m.setStatusInfo(fmt.Sprintf("View %q applied; some columns no longer exist.", view.Name))
+		return
+	}
+	m.setStatusInfo(fmt.Sprintf("View %q applied.", view.Name))
+}
+
+// saveCurrentView stores the active tab's sorts and pins under the typed
+// name, replacing any view with the same name.
+func (m *Model) saveCurrentView() {
+	vp := m.viewsPicker
+	if vp == nil {
+		return
+	}
+	name := strings.TrimSpace(vp.Name)
+	if name == "" {
+		m.setStatusError("Type a name for the view first.")
+		return
+	}
+	tab := m.activeTab()
+	if tab == nil || tab.Kind != vp.Tab {
+		return
+	}
+	view := captureView(tab, name, m.magMode)
+	views := slices.Clone(vp.Views)
+	idx := slices.IndexFunc(views, func(v data.SavedView) bool {
+		return strings.EqualFold(v.Name, name)
+	})
+	if idx >= 0 {
+		views[idx] = view
+	} else {
+		views = append(views, view)
+		idx = len(views) - 1
+	}
+	if err := m.store.PutSavedViews(savedViewsKey(vp.Tab), views); err != nil {
+		m.setStatusError(humanizeError(err))
+		return
+	}
+	vp.Views = views
+	vp.Cursor = idx
+	vp.Name = ""
+	m.setStatusInfo(fmt.Sprintf("View %q saved.", name))
+}
+
+// deleteSelectedView removes the highlighted view.
+func (m *Model) deleteSelectedView() {
+	vp := m.viewsPicker
+	if vp == nil || vp.Cursor < 0 || vp.Cursor >= len(vp.Views) {
+		return
+	}
+	name := vp.Views[vp.Cursor].Name
+	views := slices.Delete(slices.Clone(vp.Views), vp.Cursor, vp.Cursor+1)
+	if err := m.store.PutSavedViews(savedViewsKey(vp.Tab), views); err != nil {
+		m.setStatusError(humanizeError(err))
+		return
+	}
+	vp.Views = views
+	vp.Cursor = min(vp.Cursor, max(0, len(views)-1))
+	m.setStatusInfo(fmt.Sprintf("View %q deleted.", name))
+}
+
+// handleViewsPickerKey processes keys while the saved views overlay is open.
+func (m *Model) handleViewsPickerKey(msg tea.KeyPressMsg) tea.Cmd {
+	vp := m.viewsPicker
+	if vp == nil {
+		return nil
+	}
+	switch {
+	case key.Matches(msg, m.keys.ViewsCancel):
+		m.viewsPicker = nil
+	case key.Matches(msg, m.keys.ViewsConfirm):
+		m.applySavedView()
+	case key.Matches(msg, m.keys.ViewsSave):
+		m.saveCurrentView()
+	case key.Matches(msg, m.keys.ViewsDelete):
+		m.deleteSelectedView()
+	case key.Matches(msg, m.keys.ViewsUp):
+		if vp.Cursor > 0 {
+			vp.Cursor--
+		}
+	case key.Matches(msg, m.keys.ViewsDown):
+		if vp.Cursor < len(vp.Views)-1 {
+			vp.Cursor++
+		}
+	case key.Matches(msg, m.keys.ViewsBackspace):
+		if len(vp.Name) > 0 {
+			_, size := utf8.DecodeLastRuneInString(vp.Name)
+			vp.Name = vp.Name[:len(vp.Name)-size]
+		}
+	default:
+		if msg.Text != "" {
+			vp.Name += msg.Text
+		}
+	}
+	return nil
+}
+
+// buildViewsPickerOverlay renders the saved views list as a bordered box.
+func (m *Model) buildViewsPickerOverlay() string {
+	vp := m.viewsPicker
+	if vp == nil {
+		return ""
+	}
+
+	contentW := max(30, min(56, m.effectiveWidth()-12))
+	innerW := contentW - appStyles.OverlayBox().GetHorizontalFrameSize()
+
+	var b strings.Builder
+	b.WriteString(m.styles.HeaderSection().Render(" " + vp.Tab.String() + " Views "))
+	b.WriteString("\n\n")
+
+	if len(vp.Views) == 0 {
+		b.WriteString(m.styles.Empty().Render("No saved views"))
+		b.WriteString("\n")
+	}
+	for i, v := range vp.Views {
+		title := v.Name
+		if summary := savedViewSummary(v); summary != "" {
+			title += " " + m.styles.HeaderHint().Render(summary)
+		}
+		line := "  " + title
+		if i == vp.Cursor {
+			line = appStyles.AccentBold().Render("▸ ") + title
+		}
+		if lipgloss.Width(line) > innerW {
+			line = appStyles.Base().MaxWidth(innerW).Render(line)
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+
+	// Name input for saving the current filter and sort.
+	b.WriteString("\n")
+	cursor := m.styles.BlinkCursor().Render("│")
+	nameText := vp.Name + cursor
+	if vp.Name == "" {
+		nameText = cursor + m.styles.Empty().Render("name to save current view")
+	}
+	b.WriteString(m.styles.Keycap().Render("+") + " " + nameText)
+	b.WriteString("\n\n")
+
+	b.WriteString(joinWithSeparator(
+		m.helpSeparator(),
+		m.helpItem(symReturn, "apply"),
+		m.helpItem("^s", "save"),
+		m.helpItem("^d", "delete"),
+		m.helpItem(keyEsc, "cancel"),
+	))
+
+	return appStyles.OverlayBox().
+		Width(contentW).
+		Render(b.String())
+}
+
+// savedViewSummary describes a view's contents, e.g. "2 pins · 1 sort".
+func savedViewSummary(v data.SavedView) string {
+	count := func(n int, noun string) string {
+		if n == 1 {
+			return "1 " + noun
+		}
+		return fmt.Sprintf("%d %ss", n, noun)
+	}
+	var parts []string
+	if n := len(v.Pins); n > 0 {
+		parts = append(parts, count(n, "pin"))
+	}
+	if n := len(v.Sorts); n > 0 {
+		parts = append(parts, count(n, "sort"))
+	}
+	return strings.Join(parts, " · ")
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package app
+
+import (
+	"testing"
+
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func typeText(m *Model, s string) {
+	for _, r := range s {
+		sendKey(m, string(r))
+	}
+}
+
+func TestSavedViewRoundTrip(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithDemoData(t, testSeed)
+	m.switchToTab(tabIndex(tabProjects))
+	tab := m.activeTab()
+	statusCol := statusColumnIndex(tab.Specs)
+	require.GreaterOrEqual(t, statusCol, 0)
+
+	// Pin the first row's status, filter, and sort by status descending.
+	tab.ColCursor = statusCol
+	m.togglePinAtCursor()
+	m.toggleFilterActivation()
+	toggleSort(tab, statusCol)
+	toggleSort(tab, statusCol)
+	applySorts(tab)
+	wantPins := tab.Pins[0].Values
+	wantRows := len(tab.Rows)
+
+	sendKey(m, keyV)
+	require.NotNil(t, m.viewsPicker)
+	typeText(m, "mine")
+	sendKey(m, keyCtrlS)
+	require.Len(t, m.viewsPicker.Views, 1)
+	assert.Equal(t, "mine", m.viewsPicker.Views[0].Name)
+	assert.Contains(t, m.buildView(), "Projects Views")
+	sendKey(m, keyEsc)
+	assert.Nil(t, m.viewsPicker)
+
+	// Clear everything, then re-apply the saved view.
+	m.clearAllPins()
+	clearSorts(tab)
+	applySorts(tab)
+	require.Empty(t, tab.Pins)
+
+	sendKey(m, keyV)
+	require.NotNil(t, m.viewsPicker)
+	sendKey(m, keyEnter)
+	assert.Nil(t, m.viewsPicker)
+	require.Len(t, tab.Pins, 1)
+	assert.Equal(t, statusCol, tab.Pins[0].Col)
+	assert.Equal(t, wantPins, tab.Pins[0].Values)
+	assert.True(t, tab.FilterActive)
+	assert.Equal(t, []sortEntry{{Col: statusCol, Dir: sortDesc}}, tab.Sorts)
+	assert.Len(t, tab.Rows, wantRows)
+	assert.Contains(t, m.statusView(), `View "mine" applied.`)
+}
+
+func TestSavedViewsArePerTab(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.switchToTab(tabIndex(tabProjects))
+	sendKey(m, keyV)
+	typeText(m, "p")
+	sendKey(m, keyCtrlS)
+	sendKey(m, keyEsc)
+
+	m.switchToTab(tabIndex(tabVendors))
+	sendKey(m, keyV)
+	require.NotNil(t, m.viewsPicker)
+	assert.Empty(t, m.viewsPicker.Views)
+}
+
+func TestSaveViewRequiresName(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	sendKey(m, keyV)
+	sendKey(m, keyCtrlS)
+	require.NotNil(t, m.viewsPicker)
+	assert.Empty(t, m.viewsPicker.Views)
+	assert.Contains(t, m.statusView(), "Type a name")
+}
+
+func TestDeleteSavedView(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	tab := m.activeTab()
+	require.NoError(t, m.store.PutSavedViews(savedViewsKey(tab.Kind), []data.SavedView{
+		{Name: "a"}, {Name: "b"},
+	}))
+	sendKey(m, keyV)
+	sendKey(m, keyDown)
+	sendKey(m, keyCtrlD)
+	require.Len(t, m.viewsPicker.Views, 1)
+	assert.Equal(t, "a", m.viewsPicker.Views[0].Name)
+
+	views, err := m.store.GetSavedViews(savedViewsKey(tab.Kind))
+	require.NoError(t, err)
+	assert.Equal(t, []data.SavedView{{Name: "a"}}, views)
+}
+
+func TestRestoreViewSkipsMissingColumns(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	tab := m.activeTab()
+	complete := restoreView(tab, data.SavedView{
+		Sorts: []data.SavedSort{{Column: "Gone"}, {Column: tab.Specs[1].Title, Desc: true}},
+	})
+	assert.False(t, complete)
+	assert.Equal(t, []sortEntry{{Col: 1, Dir: sortDesc}}, tab.Sorts)
+}
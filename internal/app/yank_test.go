@@ -336,3 +336,63 @@ func TestYankCellDashboardReturnsNilCmd(t *testing.T) {
 	assert.Nil(t, cmd, "dashboard should block yank")
 	assert.Equal(t, "before", m.status.Text)
 }
+
+func TestYankViewCopiesVisibleTable(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	createProjectAndReload(t, m, "Roof Repair")
+	createProjectAndReload(t, m, "Attic Insulation")
+
+	tab := m.activeTab()
+	require.Len(t, tab.CellRows, 2)
+	tab.Specs[projectColBudget].HideOrder = 1
+	toggleSort(tab, int(projectColTitle))
+	applySorts(tab)
+
+	_, cmd := m.Update(keyPress(keyShiftY))
+	require.NotNil(t, cmd, "expected clipboard command")
+	assert.Equal(t, "Copied 2 rows of Projects.", m.status.Text)
+
+	lines := strings.Split(strings.TrimSpace(fmt.Sprint(cmd())), "\n")
+	require.Len(t, lines, 3, "header plus one line per row")
+	assert.Contains(t, lines[0], tab.Specs[projectColTitle].Title)
+	assert.NotContains(t, lines[0], tab.Specs[projectColBudget].Title,
+		"hidden columns are left out")
+	assert.Contains(t, lines[1], "Attic Insulation", "rows keep the sort order")
+	assert.Contains(t, lines[2], "Roof Repair")
+}
+
+func TestYankViewSkipsDimmedRows(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	createProjectAndReload(t, m, "Roof Repair")
+	createProjectAndReload(t, m, "Attic Insulation")
+
+	tab := m.activeTab()
+	for i, row := range tab.CellRows {
+		if row[projectColTitle].Value == "Roof Repair" {
+			tab.Table.SetCursor(i)
+		}
+	}
+	tab.ColCursor = int(projectColTitle)
+	m.togglePinAtCursor()
+	require.False(t, tab.FilterActive, "pins preview until the filter is on")
+	require.Len(t, tab.CellRows, 2)
+
+	_, cmd := m.Update(keyPress(keyShiftY))
+	require.NotNil(t, cmd, "expected clipboard command")
+	assert.Equal(t, "Copied 1 row of Projects.", m.status.Text)
+	text := fmt.Sprint(cmd())
+	assert.Contains(t, text, "Roof Repair")
+	assert.NotContains(t, text, "Attic Insulation", "dimmed rows are left out")
+}
+
+func TestYankViewNoRowsShowsNothing(t *testing.T) {
+	t.Parallel()
+	m := newTestModelWithStore(t)
+	require.Empty(t, m.activeTab().CellRows)
+
+	_, cmd := m.Update(keyPress(keyShiftY))
+	assert.Nil(t, cmd)
+	assert.Equal(t, "Nothing to copy.", m.status.Text)
+}
@@ -22,12 +22,17 @@ type cmdFactory func(ctx context.Context, args ...string) *exec.Cmd
 
 // Client implements llm.Provider by shelling out to the claude CLI binary.
 type Client struct {
-	model   string
-	effort  string
-	timeout time.Duration
-	makeCmd cmdFactory
+	model         string
+	effort        string
+	timeout       time.Duration
+	contextTokens int // configured context window; 0 = claudeContextTokens
+	makeCmd       cmdFactory
 }
 
+// claudeContextTokens is the context window of the Claude models the CLI
+// runs.
+const claudeContextTokens = 200_000
+
 // Option configures the client.
 type Option func(*Client) error
 
@@ -88,6 +93,15 @@ func (c *Client) Timeout() time.Duration       { return llm.QuickOpTimeout }
 func (c *Client) IsLocalServer() bool          { return false }
 func (c *Client) SupportsModelListing() bool   { return false }
 func (c *Client) Ping(_ context.Context) error { return nil }
+func (c *Client) SetContextTokens(n int)       { c.contextTokens = n }
+
+// ContextTokens returns the configured context window, or Claude's.
+func (c *Client) ContextTokens(_ context.Context) int {
+	if c.contextTokens > 0 {
+		return c.contextTokens
+	}
+	return claudeContextTokens
+}
 
 // baseArgs returns the common CLI flags for all invocations.
 func (c *Client) baseArgs(outputFormat string) []string {
@@ -6,9 +6,11 @@ package config
 import (
 	"fmt"
 	"io/fs"
+	"maps"
 	"os"
 	"path/filepath"
 	"reflect"
+	"regexp"
 	"runtime"
 	"slices"
 	"strconv"
@@ -19,16 +21,25 @@ import (
 	"github.com/adrg/xdg"
 
 	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/micasa-dev/micasa/internal/locale"
 )
 
 // Config is the top-level application configuration, loaded from a TOML file.
 // Each section is self-contained; no section's values affect another section.
 type Config struct {
-	Chat       Chat       `toml:"chat"       doc:"Chat (NL-to-SQL) pipeline and its LLM settings."`
-	Extraction Extraction `toml:"extraction" doc:"Document extraction pipeline: LLM, OCR, and pdftotext."`
-	Documents  Documents  `toml:"documents"  doc:"Document attachment limits and caching."`
-	Locale     Locale     `toml:"locale"     doc:"Locale and currency settings."`
-	Address    Address    `toml:"address"    doc:"Postal code auto-fill settings."`
+	Chat        Chat        `toml:"chat"       doc:"Chat (NL-to-SQL) pipeline and its LLM settings."`
+	Extraction  Extraction  `toml:"extraction" doc:"Document extraction pipeline: LLM, OCR, and pdftotext."`
+	Documents   Documents   `toml:"documents"  doc:"Document attachment limits and caching."`
+	Backup      Backup      `toml:"backup"     doc:"Automatic database backups on startup."`
+	Locale      Locale      `toml:"locale"     doc:"Locale and currency settings."`
+	Currency    Currency    `toml:"currency"   doc:"Conversion rates for approximate totals across currencies."`
+	Address     Address     `toml:"address"    doc:"Postal code auto-fill settings."`
+	Dashboard   Dashboard   `toml:"dashboard"  doc:"Dashboard display settings."`
+	Maintenance Maintenance `toml:"maintenance" doc:"Maintenance scheduling settings."`
+	UI          UI          `toml:"ui"         doc:"Display settings: dates, numbers, and the idle lock."`
+	Sort        Sort        `toml:"sort"       doc:"Default sort order for each tab."`
+	Forms       Forms       `toml:"forms"      doc:"Fields each record type's forms show and require."`
+	Seed        Seed        `toml:"seed"       doc:"Project types and maintenance categories added to the built-ins."`
 
 	// Warnings collects non-fatal messages (e.g. deprecations) during load.
 	// Not serialized; the caller decides how to display them.
@@ -40,6 +51,108 @@ type Locale struct {
 	// Currency is the ISO 4217 code (e.g. "USD", "EUR", "GBP").
 	// Used as the default when the database has no currency set yet.
 	Currency string `toml:"currency"`
+
+	// Rounding controls how fractional cents from document extraction are
+	// resolved to whole cents. Supported: half_up, half_even (banker's
+	// rounding), truncate. Default: half_up.
+	Rounding string `toml:"rounding" default:"half_up" validate:"omitempty,oneof=half_up half_even truncate"`
+
+	// SymbolSpacing controls the space between the currency symbol and the
+	// amount: auto (the locale's convention), space (always), or none
+	// (never). Default: auto.
+	SymbolSpacing string `toml:"symbol_spacing" default:"auto" validate:"omitempty,oneof=auto space none"`
+
+	// Currencies maps currency codes to custom display formats, written as
+	// the amount 1234.56 the way it should look (e.g. "1.234,56 ₫"). Used
+	// for codes CLDR doesn't know or that the detected locale formats
+	// poorly. Default: empty.
+	Currencies map[string]string `toml:"currencies" validate:"dive,keys,currency_code,endkeys,currency_format"`
+
+	// NumericInput parses money typed into forms with the grouping and
+	// decimal separators of LC_NUMERIC instead of the display locale.
+	// Default: false.
+	NumericInput *bool `toml:"numeric_input,omitempty"`
+}
+
+// IsNumericInputEnabled returns whether typed money follows LC_NUMERIC.
+func (l Locale) IsNumericInputEnabled() bool {
+	return l.NumericInput != nil && *l.NumericInput
+}
+
+// SymbolSpacingMode returns the parsed symbol spacing. Validation
+// guarantees the value is supported; anything else falls back to auto.
+func (l Locale) SymbolSpacingMode() locale.SymbolSpacing {
+	s, err := locale.ParseSymbolSpacing(l.SymbolSpacing)
+	if err != nil {
+		return locale.SpacingAuto
+	}
+	return s
+}
+
+// CurrencyFormats returns the parsed custom currency formats keyed by
+// upper-case code. Validation guarantees every pattern parses.
+func (l Locale) CurrencyFormats() map[string]locale.CurrencyFormat {
+	formats := make(map[string]locale.CurrencyFormat, len(l.Currencies))
+	for code, pattern := range l.Currencies {
+		if f, err := locale.ParseCurrencyFormat(pattern); err == nil {
+			formats[strings.ToUpper(code)] = f
+		}
+	}
+	return formats
+}
+
+// RoundingMode returns the parsed rounding mode. Validation guarantees the
+// value is supported; anything else falls back to half-up.
+func (l Locale) RoundingMode() locale.Rounding {
+	r, err := locale.ParseRounding(l.Rounding)
+	if err != nil {
+		return locale.RoundHalfUp
+	}
+	return r
+}
+
+// Currency holds the conversion rates the dashboard uses to add up
+// amounts recorded in different currencies. The converted figures are
+// approximate and only displayed; stored amounts are never converted.
+type Currency struct {
+	// BaseCode is the currency totals are added up in and the rates are
+	// quoted in. Records keep displaying in their own currency. Default: ""
+	// (the house currency).
+	BaseCode string `toml:"base" validate:"omitempty,currency_code"`
+
+	// Rates maps currency codes to the value of one unit in BaseCode, e.g.
+	// EUR = 1.08 with a USD base. Default: empty (no conversion).
+	Rates map[string]float64 `toml:"rates" validate:"dive,keys,currency_code,endkeys,gt=0"`
+
+	// FetchRates downloads the day's rates from RatesURL on startup,
+	// caching them so later starts that day and offline starts reuse them.
+	// Rates is the fallback when nothing was ever fetched. Default: false.
+	FetchRates *bool `toml:"fetch_rates,omitempty"`
+
+	// RatesURL is the exchange rate endpoint. It is asked for
+	// ?base=<base currency> and must answer with {"base", "date",
+	// "rates"} JSON. Default: https://api.frankfurter.app/latest.
+	RatesURL string `toml:"rates_url" default:"https://api.frankfurter.app/latest" validate:"omitempty,http_url"`
+}
+
+// IsFetchRatesEnabled returns whether rates are fetched on startup.
+// Defaults to false.
+func (c Currency) IsFetchRatesEnabled() bool {
+	return c.FetchRates != nil && *c.FetchRates
+}
+
+// ConversionRates returns the configured rates. An empty base is filled
+// in with the house currency by the caller.
+func (c Currency) ConversionRates() locale.Rates {
+	return locale.NewRates(c.BaseCode, c.Rates)
+}
+
+// ResolvedBase returns the base currency code, falling back to house.
+func (c Currency) ResolvedBase(house string) string {
+	if c.BaseCode != "" {
+		return strings.ToUpper(c.BaseCode)
+	}
+	return strings.ToUpper(house)
 }
 
 // Address holds settings for postal code auto-fill in the house form.
@@ -59,6 +172,327 @@ func (a Address) IsAutofillEnabled() bool {
 	return a.Autofill != nil && *a.Autofill
 }
 
+// Backup holds settings for automatic database backups.
+type Backup struct {
+	// OnStart copies the database into Dir each time the TUI launches,
+	// before anything is written. The --backup-on-start flag turns it on
+	// for a single run. Default: false.
+	OnStart *bool `toml:"on_start,omitempty"`
+
+	// Keep is how many startup backups to retain; older ones are deleted.
+	// Backups taken before a schema migration are pruned to the same
+	// count separately. 0 keeps them all. Default: 5.
+	Keep int `toml:"keep" default:"5" validate:"min=0"`
+
+	// Dir is where startup backups are written. Default: a "backups"
+	// directory next to the database file.
+	Dir string `toml:"dir"`
+}
+
+// IsOnStartEnabled returns whether startup backups are enabled. Defaults
+// to false.
+func (b Backup) IsOnStartEnabled() bool {
+	return b.OnStart != nil && *b.OnStart
+}
+
+// ResolvedDir returns the startup backup directory for the database at
+// dbPath.
+func (b Backup) ResolvedDir(dbPath string) string {
+	if b.Dir != "" {
+		return data.ExpandHome(b.Dir)
+	}
+	return filepath.Join(filepath.Dir(dbPath), "backups")
+}
+
+// Dashboard holds settings for the dashboard overview.
+type Dashboard struct {
+	// MaintenanceGraceDays is how many days past due a maintenance item
+	// can be before the dashboard flags it as overdue. Within the grace
+	// period it stays in the upcoming list. Default: 0 (flag immediately).
+	MaintenanceGraceDays int `toml:"maintenance_grace_days" validate:"min=0"`
+
+	// SnoozeDays is how long the snooze action hides a maintenance item
+	// from the dashboard. Default: 7.
+	SnoozeDays int `toml:"snooze_days" default:"7" validate:"min=1"`
+
+	// WarrantyLookaheadDays is how many days ahead an expiring warranty
+	// shows under Expiring. Default: 90.
+	WarrantyLookaheadDays int `toml:"warranty_lookahead_days" default:"90" validate:"min=1"`
+
+	// WarrantyLookbackDays is how many days after expiring a warranty
+	// stays under Expiring, marked expired. 0 drops it the day after it
+	// expires. Default: 30.
+	WarrantyLookbackDays int `toml:"warranty_lookback_days" default:"30" validate:"min=0"`
+}
+
+// WarrantyWindow returns the warranty expirations the dashboard lists.
+func (d Dashboard) WarrantyWindow() data.WarrantyWindow {
+	return data.WarrantyWindow{
+		LookBackDays:  d.WarrantyLookbackDays,
+		LookAheadDays: d.WarrantyLookaheadDays,
+	}
+}
+
+// Maintenance holds settings for maintenance schedules.
+type Maintenance struct {
+	// IntervalUnit is the unit a bare number typed as a maintenance
+	// interval is read in: days, weeks, months, or years. Intervals with a
+	// suffix ("2w", "1y 6m") ignore it. Default: months.
+	IntervalUnit string `toml:"interval_unit" default:"months" validate:"omitempty,oneof=days weeks months years"`
+
+	// SyncLastServiced controls whether adding, editing, deleting, or
+	// restoring a service log entry updates the item's last serviced date
+	// (and so its next due date). Default: true.
+	SyncLastServiced *bool `toml:"sync_last_serviced,omitempty"`
+
+	// DefaultPerformer is the name of the vendor preselected as "Performed
+	// by" when logging new service. Matched case-insensitively; a name
+	// with no matching vendor falls back to self. Default: empty (self).
+	DefaultPerformer string `toml:"default_performer"`
+}
+
+// IsSyncLastServicedEnabled returns whether service log changes update the
+// maintenance item's last serviced date. Defaults to true.
+func (m Maintenance) IsSyncLastServicedEnabled() bool {
+	if m.SyncLastServiced != nil {
+		return *m.SyncLastServiced
+	}
+	return true
+}
+
+// UI holds display settings for dates and numbers outside of money, and
+// the idle lock. These are independent of [locale], which only governs
+// currency.
+type UI struct {
+	// Locale is a BCP 47 tag (e.g. "en-GB", "de") that controls date order
+	// and digit grouping in tables and the dashboard. Default: "" (ISO
+	// dates, ungrouped counts).
+	Locale string `toml:"locale" validate:"omitempty,bcp47"`
+
+	// IdleLockMinutes blanks the screen after this many minutes without
+	// input, until a key is pressed. No password is involved; it only hides
+	// records on an unattended terminal. Default: 0 (never lock).
+	IdleLockMinutes int `toml:"idle_lock_minutes" validate:"min=0"`
+
+	// NoteLines is how many wrapped lines a notes cell may take in a table
+	// row. Longer notes end with a "+N" count of the hidden lines.
+	// Default: 1 (first line only).
+	NoteLines int `toml:"note_lines" default:"1" validate:"min=1"`
+
+	// StatusBar lists the status bar segments to show, comma-separated and
+	// in order. See StatusSegmentNames for the choices.
+	// Default: "mode,dirty,hints".
+	StatusBar string `toml:"status_bar" default:"mode,dirty,hints" validate:"status_segments"`
+
+	// CompactStatus keeps the status bar to a single row: a status message
+	// replaces the key hints while it shows, and background indicators
+	// share the row. For short terminals. Default: false.
+	CompactStatus bool `toml:"compact_status"`
+
+	// MinWidth and MinHeight are the smallest terminal size micasa lays
+	// out for. Below either, it shows a notice asking for a larger window
+	// instead of a collapsed UI. Lower them to try a cramped layout anyway.
+	// Default: 80x24.
+	MinWidth  int `toml:"min_width"  default:"80" validate:"min=1"`
+	MinHeight int `toml:"min_height" default:"24" validate:"min=1"`
+
+	// Mouse enables clicking rows, tabs, and hints and scrolling with the
+	// wheel. Turning it off also leaves the terminal's own text selection
+	// alone. Default: true.
+	Mouse *bool `toml:"mouse,omitempty"`
+
+	// HomeKey is the key that closes any drilldown or overlay and shows the
+	// dashboard from anywhere, written the way Bubble Tea names keys (e.g.
+	// "H", "ctrl+g"). Default: "H".
+	HomeKey string `toml:"home_key" default:"H" validate:"keyname"`
+
+	// ReviewChanges lists the fields an edit changed, old and new, and asks
+	// before saving it. New records are never reviewed. Default: true.
+	ReviewChanges *bool `toml:"review_changes,omitempty"`
+}
+
+// IsMouseEnabled returns whether mouse input is enabled. Defaults to true.
+func (u UI) IsMouseEnabled() bool {
+	return u.Mouse == nil || *u.Mouse
+}
+
+// IsReviewChangesEnabled returns whether edits are reviewed before saving.
+// Defaults to true.
+func (u UI) IsReviewChangesEnabled() bool {
+	return u.ReviewChanges == nil || *u.ReviewChanges
+}
+
+// StatusSegmentNames lists the segments ui.status_bar accepts: the
+// NAV/EDIT badge, the key hints, the saved/unsaved marker in forms, the
+// currency code, and the database file name.
+var StatusSegmentNames = []string{"mode", "hints", "dirty", "currency", "db"}
+
+// StatusSegments returns the configured status bar segments in order,
+// dropping blanks.
+func (u UI) StatusSegments() []string {
+	segs := []string{}
+	for _, s := range strings.Split(u.StatusBar, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			segs = append(segs, s)
+		}
+	}
+	return segs
+}
+
+// IdleLockDuration returns the idle lock timeout, or 0 when disabled.
+func (u UI) IdleLockDuration() time.Duration {
+	return time.Duration(u.IdleLockMinutes) * time.Minute
+}
+
+// Sort holds the sort each tab starts with. A value is a comma-separated
+// list of columns, each optionally followed by asc or desc, e.g.
+// "next asc" or "status, end desc". Column names are checked against the
+// tab's columns when the UI starts. Default: "" (no sort).
+type Sort struct {
+	Projects    string `toml:"projects"`
+	Quotes      string `toml:"quotes"`
+	Maintenance string `toml:"maintenance"`
+	Incidents   string `toml:"incidents"`
+	Appliances  string `toml:"appliances"`
+	Vendors     string `toml:"vendors"`
+	Documents   string `toml:"documents"`
+}
+
+// ByTab returns the configured sorts keyed by their config key, skipping
+// tabs without one.
+func (s Sort) ByTab() map[string]string {
+	all := map[string]string{
+		"projects":    s.Projects,
+		"quotes":      s.Quotes,
+		"maintenance": s.Maintenance,
+		"incidents":   s.Incidents,
+		"appliances":  s.Appliances,
+		"vendors":     s.Vendors,
+		"documents":   s.Documents,
+	}
+	for k, v := range all {
+		if strings.TrimSpace(v) == "" {
+			delete(all, k)
+		}
+	}
+	return all
+}
+
+// Forms tailors the add and edit forms of each record type. Field names
+// are checked against the form's fields when the UI starts.
+type Forms struct {
+	Projects    FormFields `toml:"projects"`
+	Quotes      FormFields `toml:"quotes"`
+	Maintenance FormFields `toml:"maintenance"`
+	Incidents   FormFields `toml:"incidents"`
+	Appliances  FormFields `toml:"appliances"`
+	Vendors     FormFields `toml:"vendors"`
+}
+
+// FormFields holds one record type's form fields, each a comma-separated
+// list of field names such as "brand, model_number".
+type FormFields struct {
+	// Add lists the optional fields the add form shows; required fields
+	// are always shown. Default: "" (the built-in essentials).
+	Add string `toml:"add"`
+
+	// Required lists every field that must be filled in. It has to
+	// include the fields the database requires. Default: "" (only those).
+	Required string `toml:"required"`
+}
+
+// ByType returns the configured fields keyed by their config key,
+// skipping record types without any.
+func (f Forms) ByType() map[string]FormFields {
+	all := map[string]FormFields{
+		"projects":    f.Projects,
+		"quotes":      f.Quotes,
+		"maintenance": f.Maintenance,
+		"incidents":   f.Incidents,
+		"appliances":  f.Appliances,
+		"vendors":     f.Vendors,
+	}
+	for k, v := range all {
+		if v.AddFields() == nil && v.RequiredFields() == nil {
+			delete(all, k)
+		}
+	}
+	return all
+}
+
+// AddFields returns the Add field names, or nil when none are listed.
+func (f FormFields) AddFields() []string {
+	return splitFieldList(f.Add)
+}
+
+// RequiredFields returns the Required field names, or nil when none are
+// listed.
+func (f FormFields) RequiredFields() []string {
+	return splitFieldList(f.Required)
+}
+
+// splitFieldList splits a comma-separated field list, dropping blanks.
+// Returns nil when nothing is listed.
+func splitFieldList(s string) []string {
+	var names []string
+	for name := range strings.SplitSeq(s, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// Seed extends the project types and maintenance categories every
+// database is seeded with. Values are comma-separated names; a name that
+// matches one already in the database, ignoring case, isn't added again.
+// Seeding runs on every start, so names added later show up in existing
+// databases too.
+type Seed struct {
+	// ProjectTypes lists project types to add to the built-ins, e.g.
+	// "Pool, Solar". Default: "".
+	ProjectTypes string `toml:"project_types" validate:"seed_names"`
+
+	// MaintenanceCategories lists maintenance categories to add to the
+	// built-ins, e.g. "Pool". Default: "".
+	MaintenanceCategories string `toml:"maintenance_categories" validate:"seed_names"`
+}
+
+// Names returns the configured names, dropping blanks.
+func (s Seed) Names() data.SeedNames {
+	return data.SeedNames{
+		ProjectTypes:          splitFieldList(s.ProjectTypes),
+		MaintenanceCategories: splitFieldList(s.MaintenanceCategories),
+	}
+}
+
+// LoadSeed reads a TOML file holding a [seed] section like the config
+// file's, for --seed-from.
+func LoadSeed(path string) (Seed, error) {
+	var file struct {
+		Seed Seed `toml:"seed"`
+	}
+	md, err := toml.DecodeFile(path, &file)
+	if err != nil {
+		return Seed{}, fmt.Errorf("parse %s: %w", path, err)
+	}
+	if undecoded := md.Undecoded(); len(undecoded) > 0 {
+		return Seed{}, fmt.Errorf(
+			"%s: unknown key %q -- supported: seed.project_types, seed.maintenance_categories",
+			path, undecoded[0].String(),
+		)
+	}
+	for _, f := range [][2]string{
+		{"seed.project_types", file.Seed.ProjectTypes},
+		{"seed.maintenance_categories", file.Seed.MaintenanceCategories},
+	} {
+		if name, err := invalidSeedName(f[1]); err != nil {
+			return Seed{}, fmt.Errorf("%s: %s: %q: %w", path, f[0], name, err)
+		}
+	}
+	return file.Seed, nil
+}
+
 // Chat holds settings for the chat (NL-to-SQL) pipeline.
 type Chat struct {
 	// Enable controls whether the chat feature is available in the UI.
@@ -108,6 +542,21 @@ type ChatLLM struct {
 	// ExtraContext is custom text appended to chat system prompts.
 	// Useful for domain-specific details: house style, location, etc.
 	ExtraContext string `toml:"extra_context"`
+
+	// AnswerLanguage is the language chat answers are written in, e.g.
+	// "French". The prompts stay in English. Empty means English.
+	AnswerLanguage string `toml:"answer_language"`
+
+	// MaxSchemaTokens caps the schema part of the SQL prompt, in estimated
+	// tokens. Over the cap, only the core tables and those relevant to the
+	// question are described. 0 derives the cap from the context window.
+	// Default: 0.
+	MaxSchemaTokens int `toml:"max_schema_tokens" validate:"min=0"`
+
+	// ContextTokens is the model's context window in tokens. Prompts are
+	// trimmed to fit it. 0 asks the server (Ollama, llama.cpp, llamafile)
+	// and otherwise assumes a conservative default. Default: 0.
+	ContextTokens int `toml:"context_tokens" validate:"min=0"`
 }
 
 // TimeoutDuration returns the parsed timeout, falling back to
@@ -125,10 +574,29 @@ type Extraction struct {
 	// LLM holds the LLM connection settings for the extraction pipeline.
 	LLM ExtractionLLM `toml:"llm" doc:"LLM connection settings for extraction."`
 
+	// Prompts maps model names to extraction prompt variants: "full" (the
+	// default) or "minimal", a terser prompt for small models.
+	Prompts map[string]string `toml:"prompts" validate:"dive,oneof=full minimal"`
+
+	// Strict is a preset for reliable structured output from any model:
+	// reasoning off, the minimal prompt, and a fixed sampling seed, on top
+	// of the zero temperature and JSON-schema output extraction always
+	// uses. Overrides llm.effort and prompts. Default: false.
+	Strict bool `toml:"strict"`
+
 	// OCR holds settings for the OCR sub-pipeline.
 	OCR OCR `toml:"ocr" doc:"OCR sub-pipeline. Requires tesseract and pdftocairo."`
 }
 
+// Effort returns the extraction reasoning effort: "none" in strict mode,
+// otherwise llm.effort.
+func (e Extraction) Effort() string {
+	if e.Strict {
+		return "none"
+	}
+	return e.LLM.Effort
+}
+
 // ExtractionLLM holds LLM settings for the extraction pipeline. Each field
 // has its own default; no values are inherited from other config sections.
 type ExtractionLLM struct {
@@ -137,6 +605,12 @@ type ExtractionLLM struct {
 	// to populate the document's stored text. Default: true.
 	Enable *bool `toml:"enable,omitempty"`
 
+	// OnImport controls whether the LLM step runs automatically when a
+	// document is imported. When false, imports only extract text and run
+	// OCR; the LLM can still be run from the extraction overlay. Default:
+	// true.
+	OnImport *bool `toml:"on_import,omitempty"`
+
 	// Provider selects which LLM provider to use. See ChatLLM.Provider
 	// for supported values. Auto-detected when empty.
 	Provider string `toml:"provider" validate:"provider"`
@@ -157,6 +631,16 @@ type ExtractionLLM struct {
 	// Effort controls the model's reasoning effort level.
 	// Supported: none, low, medium, high, auto. Empty = server default.
 	Effort string `toml:"effort,omitempty" deprecated:"thinking" validate:"omitempty,oneof=none low medium high auto"`
+
+	// MaxInputChars caps the document text sent to the model, counted in
+	// characters across all text sources. Longer text keeps its beginning
+	// and end; the middle is omitted. 0 means no limit. Default: 40000.
+	MaxInputChars int `toml:"max_input_chars" default:"40000" validate:"min=0"`
+
+	// ContextTokens is the model's context window in tokens. Document text
+	// is also capped to fit it. 0 asks the server (Ollama, llama.cpp,
+	// llamafile) and otherwise assumes a conservative default. Default: 0.
+	ContextTokens int `toml:"context_tokens" validate:"min=0"`
 }
 
 // IsEnabled returns whether LLM extraction is enabled. Defaults to true.
@@ -167,6 +651,15 @@ func (e ExtractionLLM) IsEnabled() bool {
 	return true
 }
 
+// RunsOnImport returns whether the LLM step runs automatically on
+// import. Defaults to true.
+func (e ExtractionLLM) RunsOnImport() bool {
+	if e.OnImport != nil {
+		return *e.OnImport
+	}
+	return true
+}
+
 // TimeoutDuration returns the parsed timeout, falling back to
 // DefaultLLMTimeout if the value is empty or unparseable.
 func (e ExtractionLLM) TimeoutDuration() time.Duration {
@@ -180,6 +673,17 @@ type OCR struct {
 	// Default: true.
 	Enable *bool `toml:"enable,omitempty"`
 
+	// Cache controls whether rasterized PDF pages are kept on disk, keyed
+	// by the document's checksum, so OCRing the same PDF again skips
+	// rasterization. Entries expire with documents.cache_ttl. Default: true.
+	Cache *bool `toml:"cache,omitempty"`
+
+	// AutoDetectLang guesses each document's script with tesseract's
+	// orientation and script detection and OCRs it with the matching
+	// installed language pack (plus English), instead of tesseract's
+	// default language. Needs the osd language data. Default: false.
+	AutoDetectLang *bool `toml:"auto_detect_lang,omitempty"`
+
 	// TSV holds settings for spatial layout annotations from tesseract OCR.
 	TSV OCRTSV `toml:"tsv" doc:"Spatial layout annotations from tesseract OCR."`
 }
@@ -192,6 +696,21 @@ func (o OCR) IsEnabled() bool {
 	return true
 }
 
+// IsCacheEnabled returns whether rasterized PDF pages are cached.
+// Defaults to true.
+func (o OCR) IsCacheEnabled() bool {
+	if o.Cache != nil {
+		return *o.Cache
+	}
+	return true
+}
+
+// IsAutoDetectLangEnabled returns whether OCR guesses each document's
+// language. Defaults to false.
+func (o OCR) IsAutoDetectLangEnabled() bool {
+	return o.AutoDetectLang != nil && *o.AutoDetectLang
+}
+
 // OCRTSV holds settings for spatial layout annotations (line-level bounding
 // boxes and confidence scores) sent from tesseract OCR to the LLM.
 type OCRTSV struct {
@@ -258,6 +777,97 @@ type Documents struct {
 	// FilePickerDir is the starting directory for the document file picker.
 	// Default: the system Downloads folder (e.g. ~/Downloads).
 	FilePickerDir string `toml:"file_picker_dir"`
+
+	// AutoLink controls whether a document imported while an entity row is
+	// selected is linked to that entity instead of relying on the
+	// extraction LLM to pick one. Default: true.
+	AutoLink *bool `toml:"auto_link,omitempty"`
+
+	// StorageWarning flags the Documents tab's storage total once document
+	// files in the database pass this size. Accepts unitized strings
+	// ("500 MiB") or bare integers (bytes). 0 disables the warning.
+	// Default: 500 MiB.
+	StorageWarning ByteSize `toml:"storage_warning" default:"524288000"`
+
+	// Storage selects where document file contents live: "database" keeps
+	// them in the SQLite file, "files" writes them under BlobDir and keeps
+	// only their checksum in the database. Existing documents move to the
+	// selected backend on startup. Default: "database".
+	Storage string `toml:"storage" default:"database" validate:"omitempty,oneof=database files"`
+
+	// BlobDir is the directory for document files when Storage is "files".
+	// Default: a "documents" directory next to the database file.
+	BlobDir string `toml:"blob_dir"`
+
+	// Title holds the rules that turn an imported file's name into the
+	// document's default title.
+	Title DocumentTitle `toml:"title"`
+}
+
+// DocumentTitle customizes the title derived from an imported file's name.
+// Extraction may still replace it.
+type DocumentTitle struct {
+	// Strip is a regular expression whose matches are removed from the
+	// file name (without its extension). Default: empty.
+	Strip string `toml:"strip" validate:"omitempty,regexp"`
+
+	// Rewrite maps regular expressions to replacements applied to the file
+	// name after Strip, in key order. Replacements may refer to groups as
+	// ${1}. Default: empty.
+	Rewrite map[string]string `toml:"rewrite" validate:"dive,keys,regexp,endkeys"`
+
+	// Dates spells out year-month(-day) numbers: keep, short ("Jan
+	// 2025"), or long ("January 2025"). Default: keep.
+	Dates string `toml:"dates" default:"keep" validate:"omitempty,oneof=keep short long"`
+
+	// Case capitalizes the words: title (every word), sentence (the first
+	// word), or lower. Default: title.
+	Case string `toml:"case" default:"title" validate:"omitempty,oneof=title sentence lower"`
+
+	// Words replaces whole words, matched case-insensitively, with the
+	// value as written (e.g. inv = "Invoice"). Default: empty.
+	Words map[string]string `toml:"words" validate:"dive,keys,word,endkeys"`
+}
+
+// Rules returns the compiled title rules. Validation guarantees every
+// pattern compiles; any that don't are skipped.
+func (t DocumentTitle) Rules() data.TitleRules {
+	rules := data.TitleRules{Dates: t.Dates, Case: t.Case}
+	if t.Strip != "" {
+		if re, err := regexp.Compile(t.Strip); err == nil {
+			rules.Strip = re
+		}
+	}
+	for _, pattern := range slices.Sorted(maps.Keys(t.Rewrite)) {
+		if re, err := regexp.Compile(pattern); err == nil {
+			rules.Rewrites = append(rules.Rewrites, data.TitleRewrite{
+				Pattern: re,
+				Replace: t.Rewrite[pattern],
+			})
+		}
+	}
+	if len(t.Words) > 0 {
+		rules.Words = make(map[string]string, len(t.Words))
+		for word, repl := range t.Words {
+			rules.Words[strings.ToLower(word)] = repl
+		}
+	}
+	return rules
+}
+
+// IsFileStorage returns whether document contents are stored as files
+// instead of in the database.
+func (d Documents) IsFileStorage() bool {
+	return d.Storage == "files"
+}
+
+// IsAutoLinkEnabled returns whether imported documents are linked to the
+// focused entity. Defaults to true.
+func (d Documents) IsAutoLinkEnabled() bool {
+	if d.AutoLink != nil {
+		return *d.AutoLink
+	}
+	return true
 }
 
 // ResolvedFilePickerDir returns the starting directory for the file picker.
@@ -431,10 +1041,49 @@ func setFieldFromEnv(fv reflect.Value, envVar, val string) error {
 		fv.SetUint(uint64(parsed))
 	case reflect.Pointer:
 		return setFieldFromEnvPtr(fv, envVar, val)
+	case reflect.Map:
+		if fv.Type().Elem().Kind() == reflect.Float64 {
+			return setFloatMapFromEnv(fv, envVar, val)
+		}
+		m := make(map[string]string)
+		var last string
+		for pair := range strings.SplitSeq(val, ",") {
+			k, v, ok := strings.Cut(pair, "=")
+			if !ok && last != "" {
+				// No "=" means the comma was part of the previous value
+				// (e.g. a currency format like "₦1,234.56").
+				m[last] = strings.TrimSpace(m[last] + "," + pair)
+				continue
+			}
+			k, v = strings.TrimSpace(k), strings.TrimSpace(v)
+			if !ok || k == "" {
+				return fmt.Errorf("%s=%q: expected key=value pairs separated by commas", envVar, val)
+			}
+			m[k] = v
+			last = k
+		}
+		fv.Set(reflect.ValueOf(m))
 	}
 	return nil
 }
 
+// setFloatMapFromEnv assigns "KEY=number" pairs separated by commas to a
+// map of floats, such as currency rates.
+func setFloatMapFromEnv(fv reflect.Value, envVar, val string) error {
+	m := make(map[string]float64)
+	for pair := range strings.SplitSeq(val, ",") {
+		k, v, ok := strings.Cut(pair, "=")
+		k = strings.TrimSpace(k)
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if !ok || k == "" || err != nil {
+			return fmt.Errorf("%s=%q: expected key=number pairs separated by commas", envVar, val)
+		}
+		m[k] = f
+	}
+	fv.Set(reflect.ValueOf(m))
+	return nil
+}
+
 func setFieldFromEnvPtr(fv reflect.Value, envVar, val string) error {
 	//exhaustive:ignore // only config-relevant kinds
 	switch fv.Type().Elem().Kind() {
@@ -615,6 +1264,17 @@ func formatValue(v reflect.Value) (string, error) {
 			lines = append(lines, s)
 		}
 		return strings.Join(lines, "\n"), nil
+	case reflect.Map:
+		var lines []string
+		for _, k := range v.MapKeys() {
+			s, err := formatValue(v.MapIndex(k))
+			if err != nil {
+				return "", err
+			}
+			lines = append(lines, k.String()+"="+s)
+		}
+		slices.Sort(lines)
+		return strings.Join(lines, "\n"), nil
 	default:
 		return fmt.Sprintf("%v", iface), nil
 	}
@@ -781,10 +1441,21 @@ model = "` + DefaultModel + `"
 
 # Custom context appended to chat system prompts.
 # extra_context = "My house is a 1920s craftsman in Portland, OR."
+# Language chat answers are written in. Default: English.
+# answer_language = "French"
+# Cap the schema in the SQL prompt (estimated tokens); only tables relevant
+# to the question are kept. Default: 0 (derived from context_tokens).
+# max_schema_tokens = 1500
+# The model's context window in tokens; prompts are trimmed to fit.
+# Default: 0 (ask the server, else assume a conservative size).
+# context_tokens = 8192
 
 [extraction]
 # Maximum pages for async extraction of scanned documents. 0 = no limit.
 # max_pages = 0
+# Known-good preset for structured output: reasoning off, the minimal
+# prompt, and a fixed seed. Overrides llm.effort and [extraction.prompts].
+# strict = false
 
 [extraction.llm]
 # LLM connection settings for the document extraction pipeline.
@@ -794,6 +1465,10 @@ model = "` + DefaultModel + `"
 # pdftotext still run to populate document text for search/display.
 # enable = true
 
+# Set to false to skip the LLM step when importing documents: only text
+# extraction and OCR run. Run the LLM later from the extraction overlay.
+# on_import = true
+
 # provider = "ollama"
 # base_url = "` + DefaultBaseURL + `"
 model = "` + DefaultModel + `"
@@ -801,11 +1476,32 @@ model = "` + DefaultModel + `"
 # timeout = "5m"
 # effort = "low"
 
+# Maximum characters of document text sent to the model. Longer documents
+# keep their beginning and end and drop the middle. 0 = no limit.
+# max_input_chars = 40000
+# The model's context window in tokens; document text is capped to fit.
+# Default: 0 (ask the server, else assume a conservative size).
+# context_tokens = 8192
+
+[extraction.prompts]
+# Prompt variant per extraction model: "full" (default) or "minimal". The
+# minimal prompt drops the domain hints so small models can follow it.
+# "qwen3:0.6b" = "minimal"
+
 [extraction.ocr]
 # Set to false to disable OCR on uploaded documents. When disabled, scanned
 # pages and images produce no text.
 # enable = true
 
+# Keep rasterized PDF pages on disk, keyed by document checksum, so OCRing
+# the same PDF again skips rasterization. Entries expire with
+# documents.cache_ttl.
+# cache = true
+
+# Guess each document's script (Cyrillic, Han, Arabic, ...) and OCR it with
+# the matching installed tesseract language pack instead of the default.
+# auto_detect_lang = true
+
 [extraction.ocr.tsv]
 # Spatial layout annotations (line-level bounding boxes) from tesseract OCR.
 # Improves extraction accuracy for invoices and forms with tabular data,
@@ -832,15 +1528,180 @@ model = "` + DefaultModel + `"
 # Default: system Downloads folder (~/Downloads on most systems).
 # file_picker_dir = "/home/user/Documents"
 
+# Link a document imported while an entity row is selected (e.g. an
+# appliance) to that entity instead of relying on the extraction LLM's guess.
+# Default: true.
+# auto_link = true
+
+# Flag the Documents tab's storage total once document files in the database
+# pass this size. Set to 0 to disable. Default: 500 MiB.
+# storage_warning = "500 MiB"
+
+# Where document file contents live: "database" (inside the SQLite file) or
+# "files" (under blob_dir, with only a checksum in the database). Existing
+# documents move to the selected backend on startup. Default: "database".
+# storage = "database"
+
+# Directory for document files when storage = "files".
+# Default: a "documents" directory next to the database file.
+# blob_dir = "~/.local/share/micasa/documents"
+
+[documents.title]
+# Rules that turn an imported file's name into the document's default title.
+# Extraction may still replace it. Without rules, "INV_2025-01_garcia.pdf"
+# becomes "Inv 2025 01 Garcia".
+
+# Regular expression whose matches are removed from the name.
+# strip = "(?i)^scan_|_copy$"
+
+# How year-month(-day) numbers read: keep, short ("Jan 2025"), or long
+# ("January 2025"). Default: keep.
+# dates = "keep"
+
+# Capitalize every word (title), only the first (sentence), or none (lower).
+# Default: title.
+# case = "title"
+
+[documents.title.rewrite]
+# Regular expressions and their replacements, applied in key order after
+# strip and before the name is split into words. ${1} refers to a group.
+# '^INV_(.+?)_(.+)$' = "${2} inv ${1}"
+
+[documents.title.words]
+# Whole words (any case) and what to write instead.
+# inv = "Invoice"
+# hvac = "HVAC"
+
+[backup]
+# Copy the database to a timestamped file in dir each time micasa starts,
+# giving a rollback point. The --backup-on-start flag does the same for one
+# run. Default: false.
+# on_start = true
+
+# How many startup backups, and separately how many pre-migration backups,
+# to keep. Older ones are deleted. 0 = keep all.
+# keep = 5
+
+# Where startup backups go. Default: a "backups" directory next to the
+# database file.
+# dir = "~/backups/micasa"
+
 [locale]
 # ISO 4217 currency code. Stored in the database on first run; after that the
 # database value is authoritative. Auto-detected from system locale if not set.
 # currency = "USD"
 
+# How fractional cents in extracted documents are rounded to whole cents.
+# Supported: half_up, half_even (banker's rounding), truncate.
+# rounding = "half_up"
+
+# Space between the currency symbol and the amount: auto (the locale's
+# convention, e.g. "$1.00" and "1,00 €"), space ("$ 1.00"), or none ("1,00€").
+# symbol_spacing = "auto"
+
+# Parse typed amounts with the separators from LC_NUMERIC (e.g. "1.234,56"
+# for de_DE) even when money displays in another locale's style.
+# numeric_input = false
+
+[locale.currencies]
+# Custom formats for currencies the system locale formats poorly or that
+# aren't ISO 4217. Write 1234.56 the way it should look: the text around the
+# digits is the symbol, then the grouping and decimal separators.
+# VND = "1.234,56 ₫"
+
+[currency]
+# Rates for approximate dashboard totals across currencies. Nothing stored
+# is converted. Totals are added up in base, which defaults to the house
+# currency; records still show in their own currency.
+# base = "USD"
+# Fetch the day's rates on startup (cached; the rates below are the
+# fallback). The endpoint sees your base currency and IP address.
+# fetch_rates = false
+# rates_url = "https://api.frankfurter.app/latest"
+
+[currency.rates]
+# Value of one unit of each currency in the base currency.
+# EUR = 1.08
+# GBP = 1.27
+
 [address]
 # Postal code auto-fill: when you type a postal code in the house form,
 # micasa queries api.zippopotam.us to fill in city and state. The API
 # sees the postal code and your IP address. Disabled by default.
 # autofill = false
+
+[dashboard]
+# Days a maintenance item can be past due before the dashboard flags it as
+# overdue. Until then it stays under upcoming. Default: 0.
+# maintenance_grace_days = 7
+# Days the snooze action (z on a maintenance row) hides an item from the
+# dashboard. Default: 7.
+# snooze_days = 7
+# Days ahead an expiring warranty shows on the dashboard. Default: 90.
+# warranty_lookahead_days = 60
+# Days an expired warranty stays on the dashboard. Default: 30.
+# warranty_lookback_days = 7
+
+[maintenance]
+# Unit for a bare number typed as a maintenance interval: days, weeks,
+# months, or years. Suffixed intervals like "2w" or "1y 6m" ignore it.
+# Default: months.
+# interval_unit = "weeks"
+# Set the last serviced date from the service log whenever an entry is
+# added, edited, or deleted. Turn off to manage it by hand. Default: true.
+# sync_last_serviced = false
+# Vendor preselected as "Performed by" when logging new service, by name.
+# Default: empty (self).
+# default_performer = "Handy Hal"
+
+[ui]
+# BCP 47 locale for dates and counts in tables and the dashboard, e.g.
+# "en-US" (03/07/2026), "en-GB" (07/03/2026), "de" (07.03.2026).
+# Independent of [locale], which only affects currency. Default: ISO dates.
+# locale = "en-GB"
+# Blank the screen after this many idle minutes until a key is pressed.
+# Hides contacts and costs on a shared machine; no password. Default: 0 (off).
+# idle_lock_minutes = 10
+# Wrapped lines a notes cell may take in a table row. Default: 1.
+# note_lines = 3
+# Status bar segments, in order: mode, hints, dirty, currency, db.
+# Default: "mode,dirty,hints".
+# status_bar = "mode,hints,currency"
+# Keep the status bar to one row on short terminals. Default: false.
+# compact_status = true
+# Smallest terminal size to lay out for; smaller windows show a notice to
+# enlarge it. Default: 80x24.
+# min_width = 80
+# min_height = 24
+# Click rows, tabs, and hints and scroll with the wheel. Set to false for
+# keyboard-only use and native terminal text selection. Default: true.
+# mouse = false
+# Key that closes drilldowns and overlays and jumps to the dashboard from
+# anywhere. Default: "H".
+# home_key = "ctrl+g"
+# List the changed fields, old and new, and ask before saving an edit.
+# Default: true.
+# review_changes = false
+
+[sort]
+# Default sort per tab: comma-separated columns, each optionally followed by
+# asc or desc. Keys: projects, quotes, maintenance, incidents, appliances,
+# vendors, documents. Column names match the table headers.
+# maintenance = "next asc"
+# projects = "status, end desc"
+
+[forms]
+# Per record type (projects, quotes, maintenance, incidents, appliances,
+# vendors): "add" lists the optional fields the add form shows, "required"
+# every field that must be filled in, including the ones the database needs.
+# [forms.appliances]
+# add = "brand, model_number, purchase_date"
+# required = "name, brand"
+
+[seed]
+# Project types and maintenance categories to add to the built-ins,
+# comma-separated. Names already in the database are not added again.
+# project_types = "Pool, Solar"
+# maintenance_categories = "Pool, Solar"
 `
 }
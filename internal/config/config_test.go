@@ -4,6 +4,7 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -11,6 +12,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/micasa-dev/micasa/internal/locale"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -41,12 +44,50 @@ func TestLoadFromFile(t *testing.T) {
 base_url = "http://myhost:8080"
 model = "llama3"
 extra_context = "My house is old."
+answer_language = "French"
+max_schema_tokens = 1500
 `)
 	cfg, err := LoadFromPath(path)
 	require.NoError(t, err)
 	assert.Equal(t, "http://myhost:8080", cfg.Chat.LLM.BaseURL)
 	assert.Equal(t, "llama3", cfg.Chat.LLM.Model)
 	assert.Equal(t, "My house is old.", cfg.Chat.LLM.ExtraContext)
+	assert.Equal(t, "French", cfg.Chat.LLM.AnswerLanguage)
+	assert.Equal(t, 1500, cfg.Chat.LLM.MaxSchemaTokens)
+}
+
+func TestExtractionStrict(t *testing.T) {
+	path := writeConfig(t, "[extraction.llm]\neffort = \"high\"\n")
+	cfg, err := LoadFromPath(path)
+	require.NoError(t, err)
+	assert.False(t, cfg.Extraction.Strict)
+	assert.Equal(t, "high", cfg.Extraction.Effort())
+
+	path = writeConfig(t, "[extraction]\nstrict = true\n[extraction.llm]\neffort = \"high\"\n")
+	cfg, err = LoadFromPath(path)
+	require.NoError(t, err)
+	assert.True(t, cfg.Extraction.Strict)
+	assert.Equal(t, "none", cfg.Extraction.Effort(), "strict turns reasoning off")
+}
+
+func TestContextTokens(t *testing.T) {
+	path := writeConfig(t, "[chat.llm]\ncontext_tokens = 32768\n[extraction.llm]\ncontext_tokens = 4096\n")
+	cfg, err := LoadFromPath(path)
+	require.NoError(t, err)
+	assert.Equal(t, 32768, cfg.Chat.LLM.ContextTokens)
+	assert.Equal(t, 4096, cfg.Extraction.LLM.ContextTokens)
+
+	path = writeConfig(t, "[extraction.llm]\ncontext_tokens = -1\n")
+	_, err = LoadFromPath(path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "extraction.llm.context_tokens must be non-negative")
+}
+
+func TestChatMaxSchemaTokensNegative(t *testing.T) {
+	path := writeConfig(t, "[chat.llm]\nmax_schema_tokens = -1\n")
+	_, err := LoadFromPath(path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "chat.llm.max_schema_tokens must be non-negative")
 }
 
 func TestPartialConfigUsesDefaults(t *testing.T) {
@@ -188,6 +229,7 @@ func TestExampleTOML(t *testing.T) {
 	assert.Contains(t, example, "max_pages")
 	assert.Contains(t, example, "extra_context")
 	assert.Contains(t, example, "confidence_threshold")
+	assert.Contains(t, example, "max_input_chars")
 }
 
 func TestMalformedConfigReturnsError(t *testing.T) {
@@ -483,6 +525,192 @@ func TestExtractionEnvOverrides(t *testing.T) {
 	assert.False(t, cfg.Extraction.LLM.IsEnabled())
 }
 
+func TestExtractionMaxInputChars(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		cfg, err := LoadFromPath(noConfig(t))
+		require.NoError(t, err)
+		assert.Equal(t, 40000, cfg.Extraction.LLM.MaxInputChars)
+	})
+	t.Run("zero disables", func(t *testing.T) {
+		path := writeConfig(t, "[extraction.llm]\nmax_input_chars = 0\n")
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		assert.Zero(t, cfg.Extraction.LLM.MaxInputChars)
+	})
+	t.Run("env override", func(t *testing.T) {
+		t.Setenv("MICASA_EXTRACTION_LLM_MAX_INPUT_CHARS", "12000")
+		cfg, err := LoadFromPath(noConfig(t))
+		require.NoError(t, err)
+		assert.Equal(t, 12000, cfg.Extraction.LLM.MaxInputChars)
+	})
+	t.Run("negative rejected", func(t *testing.T) {
+		path := writeConfig(t, "[extraction.llm]\nmax_input_chars = -1\n")
+		_, err := LoadFromPath(path)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must be non-negative")
+	})
+}
+
+func TestExtractionLLMOnImport(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		cfg, err := LoadFromPath(noConfig(t))
+		require.NoError(t, err)
+		assert.True(t, cfg.Extraction.LLM.RunsOnImport())
+	})
+	t.Run("disabled", func(t *testing.T) {
+		path := writeConfig(t, "[extraction.llm]\non_import = false\n")
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		assert.False(t, cfg.Extraction.LLM.RunsOnImport())
+		assert.True(t, cfg.Extraction.LLM.IsEnabled(), "the LLM stays available")
+	})
+	t.Run("env override", func(t *testing.T) {
+		t.Setenv("MICASA_EXTRACTION_LLM_ON_IMPORT", "false")
+		cfg, err := LoadFromPath(noConfig(t))
+		require.NoError(t, err)
+		assert.False(t, cfg.Extraction.LLM.RunsOnImport())
+	})
+}
+
+func TestDocumentsAutoLink(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		cfg, err := LoadFromPath(noConfig(t))
+		require.NoError(t, err)
+		assert.True(t, cfg.Documents.IsAutoLinkEnabled())
+	})
+	t.Run("disabled", func(t *testing.T) {
+		path := writeConfig(t, "[documents]\nauto_link = false\n")
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		assert.False(t, cfg.Documents.IsAutoLinkEnabled())
+	})
+	t.Run("env override", func(t *testing.T) {
+		t.Setenv("MICASA_DOCUMENTS_AUTO_LINK", "false")
+		cfg, err := LoadFromPath(noConfig(t))
+		require.NoError(t, err)
+		assert.False(t, cfg.Documents.IsAutoLinkEnabled())
+	})
+}
+
+func TestDocumentsStorageWarning(t *testing.T) {
+	cfg, err := LoadFromPath(noConfig(t))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(500<<20), cfg.Documents.StorageWarning.Bytes())
+
+	cfg, err = LoadFromPath(writeConfig(t, "[documents]\nstorage_warning = \"2 GiB\"\n"))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2<<30), cfg.Documents.StorageWarning.Bytes())
+
+	cfg, err = LoadFromPath(writeConfig(t, "[documents]\nstorage_warning = 0\n"))
+	require.NoError(t, err)
+	assert.Zero(t, cfg.Documents.StorageWarning.Bytes())
+
+	t.Setenv("MICASA_DOCUMENTS_STORAGE_WARNING", "1 GiB")
+	cfg, err = LoadFromPath(noConfig(t))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1<<30), cfg.Documents.StorageWarning.Bytes())
+}
+
+func TestDocumentsStorage(t *testing.T) {
+	cfg, err := LoadFromPath(noConfig(t))
+	require.NoError(t, err)
+	assert.Equal(t, "database", cfg.Documents.Storage)
+	assert.False(t, cfg.Documents.IsFileStorage())
+
+	cfg, err = LoadFromPath(writeConfig(t,
+		"[documents]\nstorage = \"files\"\nblob_dir = \"/srv/micasa\"\n"))
+	require.NoError(t, err)
+	assert.True(t, cfg.Documents.IsFileStorage())
+	assert.Equal(t, "/srv/micasa", cfg.Documents.BlobDir)
+
+	_, err = LoadFromPath(writeConfig(t, "[documents]\nstorage = \"s3\"\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid document storage")
+
+	t.Setenv("MICASA_DOCUMENTS_STORAGE", "files")
+	cfg, err = LoadFromPath(noConfig(t))
+	require.NoError(t, err)
+	assert.True(t, cfg.Documents.IsFileStorage())
+}
+
+func TestDocumentsTitle(t *testing.T) {
+	cfg, err := LoadFromPath(noConfig(t))
+	require.NoError(t, err)
+	assert.Equal(t, "keep", cfg.Documents.Title.Dates)
+	assert.Equal(t, "title", cfg.Documents.Title.Case)
+	assert.Equal(t, "Inv 2025 01 Garcia", cfg.Documents.Title.Rules().Title("INV_2025-01_garcia.pdf"))
+
+	cfg, err = LoadFromPath(writeConfig(t, `[documents.title]
+strip = "(?i)_scan$"
+dates = "short"
+
+[documents.title.rewrite]
+'^INV_(.+?)_(.+)$' = "${2} inv ${1}"
+
+[documents.title.words]
+INV = "Invoice"
+`))
+	require.NoError(t, err)
+	rules := cfg.Documents.Title.Rules()
+	assert.Equal(t, "Garcia Invoice Jan 2025", rules.Title("INV_2025-01_garcia_SCAN.pdf"))
+
+	_, err = LoadFromPath(writeConfig(t, "[documents.title]\nstrip = \"(\"\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "documents.title.strip: invalid regular expression")
+
+	_, err = LoadFromPath(writeConfig(t, "[documents.title.rewrite]\n\"[\" = \"x\"\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid regular expression")
+
+	_, err = LoadFromPath(writeConfig(t, "[documents.title]\ncase = \"upper\"\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid case")
+
+	_, err = LoadFromPath(writeConfig(t, "[documents.title.words]\n\"two words\" = \"x\"\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid word")
+
+	t.Setenv("MICASA_DOCUMENTS_TITLE_DATES", "long")
+	cfg, err = LoadFromPath(noConfig(t))
+	require.NoError(t, err)
+	assert.Equal(t, "Report January 2025", cfg.Documents.Title.Rules().Title("report-2025-01.pdf"))
+}
+
+func TestExtractionPrompts(t *testing.T) {
+	t.Run("default empty", func(t *testing.T) {
+		cfg, err := LoadFromPath(noConfig(t))
+		require.NoError(t, err)
+		assert.Empty(t, cfg.Extraction.Prompts)
+	})
+	t.Run("per model", func(t *testing.T) {
+		path := writeConfig(t, "[extraction.prompts]\n\"qwen3:0.6b\" = \"minimal\"\nqwen3 = \"full\"\n")
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"qwen3:0.6b": "minimal", "qwen3": "full"}, cfg.Extraction.Prompts)
+		got, err := cfg.Get("extraction.prompts")
+		require.NoError(t, err)
+		assert.Equal(t, "qwen3:0.6b=minimal\nqwen3=full", got)
+	})
+	t.Run("env override", func(t *testing.T) {
+		t.Setenv("MICASA_EXTRACTION_PROMPTS", "qwen3:0.6b=minimal, gemma3:1b=minimal")
+		cfg, err := LoadFromPath(noConfig(t))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"qwen3:0.6b": "minimal", "gemma3:1b": "minimal"}, cfg.Extraction.Prompts)
+	})
+	t.Run("env malformed", func(t *testing.T) {
+		t.Setenv("MICASA_EXTRACTION_PROMPTS", "minimal")
+		_, err := LoadFromPath(noConfig(t))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "expected key=value pairs")
+	})
+	t.Run("unknown variant rejected", func(t *testing.T) {
+		path := writeConfig(t, "[extraction.prompts]\nqwen3 = \"tiny\"\n")
+		_, err := LoadFromPath(path)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid prompt variant "tiny" -- supported: full, minimal`)
+	})
+}
+
 func TestExtractionRejectsNegativePages(t *testing.T) {
 	path := writeConfig(t, "[extraction]\nmax_pages = -1\n")
 	_, err := LoadFromPath(path)
@@ -565,6 +793,573 @@ func TestInvalidEffortLevelReturnsError(t *testing.T) {
 	})
 }
 
+func TestLocaleRounding(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		path := writeConfig(t, "")
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		assert.Equal(t, "half_up", cfg.Locale.Rounding)
+		assert.Equal(t, locale.RoundHalfUp, cfg.Locale.RoundingMode())
+	})
+	t.Run("half_even", func(t *testing.T) {
+		path := writeConfig(t, "[locale]\nrounding = \"half_even\"\n")
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		assert.Equal(t, locale.RoundHalfEven, cfg.Locale.RoundingMode())
+	})
+	t.Run("env override", func(t *testing.T) {
+		t.Setenv("MICASA_LOCALE_ROUNDING", "truncate")
+		path := writeConfig(t, "")
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		assert.Equal(t, locale.RoundTruncate, cfg.Locale.RoundingMode())
+	})
+	t.Run("invalid", func(t *testing.T) {
+		path := writeConfig(t, "[locale]\nrounding = \"ceiling\"\n")
+		_, err := LoadFromPath(path)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "locale.rounding")
+		assert.Contains(t, err.Error(), "invalid rounding mode")
+		assert.Contains(t, err.Error(), "ceiling")
+	})
+}
+
+func TestLocaleSymbolSpacing(t *testing.T) {
+	cfg, err := LoadFromPath(noConfig(t))
+	require.NoError(t, err)
+	assert.Equal(t, locale.SpacingAuto, cfg.Locale.SymbolSpacingMode())
+
+	cfg, err = LoadFromPath(writeConfig(t, "[locale]\nsymbol_spacing = \"space\"\n"))
+	require.NoError(t, err)
+	assert.Equal(t, locale.SpacingSpace, cfg.Locale.SymbolSpacingMode())
+
+	_, err = LoadFromPath(writeConfig(t, "[locale]\nsymbol_spacing = \"wide\"\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `locale.symbol_spacing: invalid symbol spacing "wide" -- supported: auto, space, none`)
+
+	t.Setenv("MICASA_LOCALE_SYMBOL_SPACING", "none")
+	cfg, err = LoadFromPath(noConfig(t))
+	require.NoError(t, err)
+	assert.Equal(t, locale.SpacingNone, cfg.Locale.SymbolSpacingMode())
+}
+
+func TestLocaleCurrencies(t *testing.T) {
+	t.Run("default empty", func(t *testing.T) {
+		cfg, err := LoadFromPath(noConfig(t))
+		require.NoError(t, err)
+		assert.Empty(t, cfg.Locale.Currencies)
+		assert.Empty(t, cfg.Locale.CurrencyFormats())
+	})
+	t.Run("custom formats", func(t *testing.T) {
+		path := writeConfig(t, "[locale.currencies]\nvnd = \"1.234,56 ₫\"\nNGN = \"₦1,234.56\"\n")
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		formats := cfg.Locale.CurrencyFormats()
+		require.Len(t, formats, 2)
+		assert.Equal(t, "1.234,56\u00a0₫", formats["VND"].Currency("VND").FormatCents(123456))
+		assert.Equal(t, "₦1,234.56", formats["NGN"].Currency("NGN").FormatCents(123456))
+	})
+	t.Run("env override keeps commas in formats", func(t *testing.T) {
+		t.Setenv("MICASA_LOCALE_CURRENCIES", "VND=1.234,56 ₫,NGN=₦1,234.56")
+		cfg, err := LoadFromPath(noConfig(t))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"VND": "1.234,56 ₫", "NGN": "₦1,234.56"}, cfg.Locale.Currencies)
+	})
+	t.Run("invalid code", func(t *testing.T) {
+		path := writeConfig(t, "[locale.currencies]\nDONG = \"1.234,56 ₫\"\n")
+		_, err := LoadFromPath(path)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid currency code "DONG"`)
+	})
+	t.Run("invalid format", func(t *testing.T) {
+		path := writeConfig(t, "[locale.currencies]\nVND = \"1.234,56\"\n")
+		_, err := LoadFromPath(path)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "locale.currencies[VND]")
+		assert.Contains(t, err.Error(), "no currency symbol")
+	})
+}
+
+func TestLocaleNumericInput(t *testing.T) {
+	t.Run("default off", func(t *testing.T) {
+		cfg, err := LoadFromPath(noConfig(t))
+		require.NoError(t, err)
+		assert.False(t, cfg.Locale.IsNumericInputEnabled())
+	})
+	t.Run("enabled", func(t *testing.T) {
+		path := writeConfig(t, "[locale]\nnumeric_input = true\n")
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		assert.True(t, cfg.Locale.IsNumericInputEnabled())
+	})
+	t.Run("env override", func(t *testing.T) {
+		t.Setenv("MICASA_LOCALE_NUMERIC_INPUT", "true")
+		cfg, err := LoadFromPath(noConfig(t))
+		require.NoError(t, err)
+		assert.True(t, cfg.Locale.IsNumericInputEnabled())
+	})
+}
+
+func TestDashboardMaintenanceGraceDays(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		path := writeConfig(t, "")
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		assert.Zero(t, cfg.Dashboard.MaintenanceGraceDays)
+	})
+	t.Run("from file", func(t *testing.T) {
+		path := writeConfig(t, "[dashboard]\nmaintenance_grace_days = 7\n")
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		assert.Equal(t, 7, cfg.Dashboard.MaintenanceGraceDays)
+	})
+	t.Run("env override", func(t *testing.T) {
+		t.Setenv("MICASA_DASHBOARD_MAINTENANCE_GRACE_DAYS", "3")
+		path := writeConfig(t, "")
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		assert.Equal(t, 3, cfg.Dashboard.MaintenanceGraceDays)
+	})
+	t.Run("negative", func(t *testing.T) {
+		path := writeConfig(t, "[dashboard]\nmaintenance_grace_days = -1\n")
+		_, err := LoadFromPath(path)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "dashboard.maintenance_grace_days must be non-negative")
+	})
+}
+
+func TestDashboardSnoozeDays(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		path := writeConfig(t, "")
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		assert.Equal(t, 7, cfg.Dashboard.SnoozeDays)
+	})
+	t.Run("from file", func(t *testing.T) {
+		path := writeConfig(t, "[dashboard]\nsnooze_days = 14\n")
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		assert.Equal(t, 14, cfg.Dashboard.SnoozeDays)
+	})
+	t.Run("zero", func(t *testing.T) {
+		path := writeConfig(t, "[dashboard]\nsnooze_days = 0\n")
+		_, err := LoadFromPath(path)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "dashboard.snooze_days must be at least 1")
+	})
+}
+
+func TestDashboardWarrantyWindow(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		path := writeConfig(t, "")
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		assert.Equal(t, data.DefaultWarrantyWindow, cfg.Dashboard.WarrantyWindow())
+	})
+	t.Run("from file", func(t *testing.T) {
+		path := writeConfig(t,
+			"[dashboard]\nwarranty_lookahead_days = 45\nwarranty_lookback_days = 0\n")
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		assert.Equal(t,
+			data.WarrantyWindow{LookBackDays: 0, LookAheadDays: 45},
+			cfg.Dashboard.WarrantyWindow())
+	})
+	t.Run("env override", func(t *testing.T) {
+		t.Setenv("MICASA_DASHBOARD_WARRANTY_LOOKAHEAD_DAYS", "120")
+		path := writeConfig(t, "")
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		assert.Equal(t, 120, cfg.Dashboard.WarrantyLookaheadDays)
+	})
+	t.Run("zero lookahead", func(t *testing.T) {
+		path := writeConfig(t, "[dashboard]\nwarranty_lookahead_days = 0\n")
+		_, err := LoadFromPath(path)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "dashboard.warranty_lookahead_days must be at least 1")
+	})
+	t.Run("negative lookback", func(t *testing.T) {
+		path := writeConfig(t, "[dashboard]\nwarranty_lookback_days = -1\n")
+		_, err := LoadFromPath(path)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "dashboard.warranty_lookback_days must be non-negative")
+	})
+}
+
+func TestMaintenanceIntervalUnit(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		path := writeConfig(t, "")
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		assert.Equal(t, "months", cfg.Maintenance.IntervalUnit)
+	})
+	t.Run("from file", func(t *testing.T) {
+		path := writeConfig(t, "[maintenance]\ninterval_unit = \"weeks\"\n")
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		assert.Equal(t, "weeks", cfg.Maintenance.IntervalUnit)
+	})
+	t.Run("env override", func(t *testing.T) {
+		t.Setenv("MICASA_MAINTENANCE_INTERVAL_UNIT", "days")
+		path := writeConfig(t, "")
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		assert.Equal(t, "days", cfg.Maintenance.IntervalUnit)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		path := writeConfig(t, "[maintenance]\ninterval_unit = \"fortnights\"\n")
+		_, err := LoadFromPath(path)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid interval unit "fortnights"`)
+	})
+}
+
+func TestMaintenanceSyncLastServiced(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		cfg, err := LoadFromPath(noConfig(t))
+		require.NoError(t, err)
+		assert.True(t, cfg.Maintenance.IsSyncLastServicedEnabled())
+	})
+	t.Run("disabled", func(t *testing.T) {
+		path := writeConfig(t, "[maintenance]\nsync_last_serviced = false\n")
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		assert.False(t, cfg.Maintenance.IsSyncLastServicedEnabled())
+	})
+	t.Run("env override", func(t *testing.T) {
+		t.Setenv("MICASA_MAINTENANCE_SYNC_LAST_SERVICED", "false")
+		cfg, err := LoadFromPath(noConfig(t))
+		require.NoError(t, err)
+		assert.False(t, cfg.Maintenance.IsSyncLastServicedEnabled())
+	})
+}
+
+func TestMaintenanceDefaultPerformer(t *testing.T) {
+	cfg, err := LoadFromPath(noConfig(t))
+	require.NoError(t, err)
+	assert.Empty(t, cfg.Maintenance.DefaultPerformer)
+
+	cfg, err = LoadFromPath(writeConfig(t, "[maintenance]\ndefault_performer = \"Handy Hal\"\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "Handy Hal", cfg.Maintenance.DefaultPerformer)
+
+	t.Setenv("MICASA_MAINTENANCE_DEFAULT_PERFORMER", "Pro Plumbing")
+	cfg, err = LoadFromPath(noConfig(t))
+	require.NoError(t, err)
+	assert.Equal(t, "Pro Plumbing", cfg.Maintenance.DefaultPerformer)
+}
+
+func TestBackup(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		path := writeConfig(t, "")
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		assert.False(t, cfg.Backup.IsOnStartEnabled())
+		assert.Equal(t, 5, cfg.Backup.Keep)
+		assert.Equal(t,
+			filepath.Join("/data", "backups"),
+			cfg.Backup.ResolvedDir(filepath.Join("/data", "micasa.db")),
+		)
+	})
+	t.Run("from file", func(t *testing.T) {
+		dir := t.TempDir()
+		path := writeConfig(t, fmt.Sprintf(
+			"[backup]\non_start = true\nkeep = 2\ndir = %q\n", dir,
+		))
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		assert.True(t, cfg.Backup.IsOnStartEnabled())
+		assert.Equal(t, 2, cfg.Backup.Keep)
+		assert.Equal(t, dir, cfg.Backup.ResolvedDir("micasa.db"))
+	})
+	t.Run("env override", func(t *testing.T) {
+		t.Setenv("MICASA_BACKUP_ON_START", "true")
+		path := writeConfig(t, "")
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		assert.True(t, cfg.Backup.IsOnStartEnabled())
+	})
+	t.Run("negative keep", func(t *testing.T) {
+		path := writeConfig(t, "[backup]\nkeep = -1\n")
+		_, err := LoadFromPath(path)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "backup.keep must be non-negative")
+	})
+}
+
+func TestUILocale(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		path := writeConfig(t, "")
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		assert.Empty(t, cfg.UI.Locale)
+	})
+	t.Run("from file", func(t *testing.T) {
+		path := writeConfig(t, "[ui]\nlocale = \"en-GB\"\n")
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		assert.Equal(t, "en-GB", cfg.UI.Locale)
+	})
+	t.Run("env override", func(t *testing.T) {
+		t.Setenv("MICASA_UI_LOCALE", "de")
+		path := writeConfig(t, "")
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		assert.Equal(t, "de", cfg.UI.Locale)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		path := writeConfig(t, "[ui]\nlocale = \"not a locale\"\n")
+		_, err := LoadFromPath(path)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ui.locale: invalid locale")
+	})
+}
+
+func TestUIMinSize(t *testing.T) {
+	cfg, err := LoadFromPath(noConfig(t))
+	require.NoError(t, err)
+	assert.Equal(t, 80, cfg.UI.MinWidth)
+	assert.Equal(t, 24, cfg.UI.MinHeight)
+
+	cfg, err = LoadFromPath(writeConfig(t, "[ui]\nmin_width = 60\nmin_height = 16\n"))
+	require.NoError(t, err)
+	assert.Equal(t, 60, cfg.UI.MinWidth)
+	assert.Equal(t, 16, cfg.UI.MinHeight)
+
+	_, err = LoadFromPath(writeConfig(t, "[ui]\nmin_height = 0\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ui.min_height must be at least 1, got 0")
+}
+
+func TestUIMouse(t *testing.T) {
+	cfg, err := LoadFromPath(noConfig(t))
+	require.NoError(t, err)
+	assert.True(t, cfg.UI.IsMouseEnabled())
+
+	cfg, err = LoadFromPath(writeConfig(t, "[ui]\nmouse = false\n"))
+	require.NoError(t, err)
+	assert.False(t, cfg.UI.IsMouseEnabled())
+
+	t.Setenv("MICASA_UI_MOUSE", "false")
+	cfg, err = LoadFromPath(noConfig(t))
+	require.NoError(t, err)
+	assert.False(t, cfg.UI.IsMouseEnabled())
+}
+
+func TestUIReviewChanges(t *testing.T) {
+	cfg, err := LoadFromPath(noConfig(t))
+	require.NoError(t, err)
+	assert.True(t, cfg.UI.IsReviewChangesEnabled())
+
+	cfg, err = LoadFromPath(writeConfig(t, "[ui]\nreview_changes = false\n"))
+	require.NoError(t, err)
+	assert.False(t, cfg.UI.IsReviewChangesEnabled())
+
+	t.Setenv("MICASA_UI_REVIEW_CHANGES", "false")
+	cfg, err = LoadFromPath(noConfig(t))
+	require.NoError(t, err)
+	assert.False(t, cfg.UI.IsReviewChangesEnabled())
+}
+
+func TestUINoteLines(t *testing.T) {
+	cfg, err := LoadFromPath(writeConfig(t, ""))
+	require.NoError(t, err)
+	assert.Equal(t, 1, cfg.UI.NoteLines)
+
+	cfg, err = LoadFromPath(writeConfig(t, "[ui]\nnote_lines = 3\n"))
+	require.NoError(t, err)
+	assert.Equal(t, 3, cfg.UI.NoteLines)
+
+	_, err = LoadFromPath(writeConfig(t, "[ui]\nnote_lines = 0\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ui.note_lines must be at least 1, got 0")
+}
+
+func TestUIStatusBar(t *testing.T) {
+	cfg, err := LoadFromPath(writeConfig(t, ""))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mode", "dirty", "hints"}, cfg.UI.StatusSegments())
+	assert.False(t, cfg.UI.CompactStatus)
+
+	cfg, err = LoadFromPath(writeConfig(t,
+		"[ui]\nstatus_bar = \" hints , currency,db\"\ncompact_status = true\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"hints", "currency", "db"}, cfg.UI.StatusSegments())
+	assert.True(t, cfg.UI.CompactStatus)
+
+	cfg, err = LoadFromPath(writeConfig(t, "[ui]\nstatus_bar = \"\"\n"))
+	require.NoError(t, err)
+	assert.Empty(t, cfg.UI.StatusSegments(), "an empty list hides every segment")
+
+	_, err = LoadFromPath(writeConfig(t, "[ui]\nstatus_bar = \"mode,clock\"\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `ui.status_bar: unknown segment "clock"`)
+}
+
+func TestUIHomeKey(t *testing.T) {
+	cfg, err := LoadFromPath(writeConfig(t, ""))
+	require.NoError(t, err)
+	assert.Equal(t, "H", cfg.UI.HomeKey)
+
+	cfg, err = LoadFromPath(writeConfig(t, "[ui]\nhome_key = \"ctrl+g\"\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "ctrl+g", cfg.UI.HomeKey)
+
+	_, err = LoadFromPath(writeConfig(t, "[ui]\nhome_key = \"\"\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `ui.home_key: invalid key ""`)
+}
+
+func TestCurrencyRates(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		cfg, err := LoadFromPath(writeConfig(t, ""))
+		require.NoError(t, err)
+		assert.True(t, cfg.Currency.ConversionRates().IsZero())
+		assert.Equal(t, "USD", cfg.Currency.ResolvedBase("usd"))
+	})
+
+	t.Run("base", func(t *testing.T) {
+		cfg, err := LoadFromPath(writeConfig(t, "[currency]\nbase = \"cad\"\n"))
+		require.NoError(t, err)
+		assert.Equal(t, "CAD", cfg.Currency.ResolvedBase("USD"))
+		assert.Equal(t, "CAD", cfg.Currency.ConversionRates().Base())
+	})
+
+	t.Run("file", func(t *testing.T) {
+		path := writeConfig(t, "[currency]\nbase = \"USD\"\n[currency.rates]\neur = 1.08\n")
+		cfg, err := LoadFromPath(path)
+		require.NoError(t, err)
+		got, ok := cfg.Currency.ConversionRates().Convert(10000, "EUR", "USD")
+		require.True(t, ok)
+		assert.Equal(t, int64(10800), got)
+	})
+
+	t.Run("env", func(t *testing.T) {
+		t.Setenv("MICASA_CURRENCY_RATES", "EUR=1.08, GBP=1.27")
+		cfg, err := LoadFromPath(writeConfig(t, ""))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]float64{"EUR": 1.08, "GBP": 1.27}, cfg.Currency.Rates)
+	})
+
+	t.Run("env not a number", func(t *testing.T) {
+		t.Setenv("MICASA_CURRENCY_RATES", "EUR=lots")
+		_, err := LoadFromPath(writeConfig(t, ""))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "expected key=number pairs")
+	})
+
+	t.Run("non-positive rate", func(t *testing.T) {
+		_, err := LoadFromPath(writeConfig(t, "[currency.rates]\nEUR = 0\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "currency.rates[EUR] must be positive")
+	})
+
+	t.Run("bad code", func(t *testing.T) {
+		_, err := LoadFromPath(writeConfig(t, "[currency.rates]\nEURO = 1.1\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid currency code")
+	})
+
+	t.Run("fetch", func(t *testing.T) {
+		cfg, err := LoadFromPath(writeConfig(t, ""))
+		require.NoError(t, err)
+		assert.False(t, cfg.Currency.IsFetchRatesEnabled())
+		assert.Equal(t, "https://api.frankfurter.app/latest", cfg.Currency.RatesURL)
+
+		t.Setenv("MICASA_CURRENCY_FETCH_RATES", "true")
+		cfg, err = LoadFromPath(writeConfig(t, "[currency]\nrates_url = \"http://localhost:8080/fx\"\n"))
+		require.NoError(t, err)
+		assert.True(t, cfg.Currency.IsFetchRatesEnabled())
+		assert.Equal(t, "http://localhost:8080/fx", cfg.Currency.RatesURL)
+	})
+
+	t.Run("bad rates URL", func(t *testing.T) {
+		_, err := LoadFromPath(writeConfig(t, "[currency]\nrates_url = \"not a url\"\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "currency.rates_url")
+	})
+}
+
+func TestSortByTab(t *testing.T) {
+	cfg, err := LoadFromPath(writeConfig(t,
+		"[sort]\nmaintenance = \"next asc\"\nvendors = \" \"\n"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"maintenance": "next asc"}, cfg.Sort.ByTab())
+
+	t.Setenv("MICASA_SORT_PROJECTS", "title desc")
+	cfg, err = LoadFromPath(writeConfig(t, ""))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"projects": "title desc"}, cfg.Sort.ByTab())
+}
+
+func TestSeedNames(t *testing.T) {
+	cfg, err := LoadFromPath(writeConfig(t,
+		"[seed]\nproject_types = \"Pool, , Solar\"\n"))
+	require.NoError(t, err)
+	names := cfg.Seed.Names()
+	assert.Equal(t, []string{"Pool", "Solar"}, names.ProjectTypes)
+	assert.Nil(t, names.MaintenanceCategories)
+
+	_, err = LoadFromPath(writeConfig(t,
+		"[seed]\nmaintenance_categories = \"Pool, "+strings.Repeat("x", 65)+"\"\n"))
+	require.ErrorContains(t, err, "seed.maintenance_categories")
+	require.ErrorContains(t, err, "longer than 64 characters")
+}
+
+func TestLoadSeed(t *testing.T) {
+	seed, err := LoadSeed(writeConfig(t,
+		"[seed]\nproject_types = \"Pool\"\nmaintenance_categories = \"Solar\"\n"))
+	require.NoError(t, err)
+	assert.Equal(t, Seed{ProjectTypes: "Pool", MaintenanceCategories: "Solar"}, seed)
+
+	_, err = LoadSeed(writeConfig(t, "[seed]\nprojects = \"Pool\"\n"))
+	require.ErrorContains(t, err, `unknown key "seed.projects"`)
+
+	_, err = LoadSeed(writeConfig(t, "[seed]\nproject_types = \"Pool,\\tSolar\\u0007\"\n"))
+	require.ErrorContains(t, err, "control characters")
+}
+
+func TestFormsByType(t *testing.T) {
+	cfg, err := LoadFromPath(writeConfig(t,
+		"[forms.appliances]\nadd = \"brand, , model_number\"\n"+
+			"[forms.vendors]\nrequired = \" \"\n"))
+	require.NoError(t, err)
+	byType := cfg.Forms.ByType()
+	require.Len(t, byType, 1)
+	assert.Equal(t, []string{"brand", "model_number"}, byType["appliances"].AddFields())
+	assert.Nil(t, byType["appliances"].RequiredFields())
+
+	t.Setenv("MICASA_FORMS_PROJECTS_REQUIRED", "title,budget")
+	cfg, err = LoadFromPath(writeConfig(t, ""))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"title", "budget"},
+		cfg.Forms.ByType()["projects"].RequiredFields())
+}
+
+func TestUIIdleLock(t *testing.T) {
+	t.Run("default off", func(t *testing.T) {
+		cfg, err := LoadFromPath(writeConfig(t, ""))
+		require.NoError(t, err)
+		assert.Zero(t, cfg.UI.IdleLockDuration())
+	})
+	t.Run("from file", func(t *testing.T) {
+		cfg, err := LoadFromPath(writeConfig(t, "[ui]\nidle_lock_minutes = 10\n"))
+		require.NoError(t, err)
+		assert.Equal(t, 10*time.Minute, cfg.UI.IdleLockDuration())
+	})
+	t.Run("env override", func(t *testing.T) {
+		t.Setenv("MICASA_UI_IDLE_LOCK_MINUTES", "3")
+		cfg, err := LoadFromPath(writeConfig(t, ""))
+		require.NoError(t, err)
+		assert.Equal(t, 3, cfg.UI.IdleLockMinutes)
+	})
+	t.Run("negative", func(t *testing.T) {
+		_, err := LoadFromPath(writeConfig(t, "[ui]\nidle_lock_minutes = -1\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must be non-negative")
+	})
+}
+
 func TestInvalidTimeoutReturnsError(t *testing.T) {
 	t.Run("chat invalid", func(t *testing.T) {
 		path := writeConfig(t, "[chat.llm]\ntimeout = \"nope\"\n")
@@ -651,6 +1446,7 @@ func TestKeys(t *testing.T) {
 	assert.Contains(t, keys, "extraction.llm.model")
 	assert.Contains(t, keys, "extraction.llm.enable")
 	assert.Contains(t, keys, "extraction.max_pages")
+	assert.Contains(t, keys, "extraction.llm.max_input_chars")
 	assert.Contains(t, keys, "extraction.ocr.enable")
 	assert.Contains(t, keys, "extraction.ocr.tsv.enable")
 	assert.Contains(t, keys, "extraction.ocr.tsv.confidence_threshold")
@@ -673,34 +1469,109 @@ func TestEnvVars(t *testing.T) {
 	assert.NotEmpty(t, m)
 
 	want := map[string]string{
-		"MICASA_CHAT_ENABLE":            "chat.enable",
-		"MICASA_CHAT_LLM_PROVIDER":      "chat.llm.provider",
-		"MICASA_CHAT_LLM_BASE_URL":      "chat.llm.base_url",
-		"MICASA_CHAT_LLM_MODEL":         "chat.llm.model",
-		"MICASA_CHAT_LLM_API_KEY":       "chat.llm.api_key",
-		"MICASA_CHAT_LLM_TIMEOUT":       "chat.llm.timeout",
-		"MICASA_CHAT_LLM_EFFORT":        "chat.llm.effort",
-		"MICASA_CHAT_LLM_EXTRA_CONTEXT": "chat.llm.extra_context",
+		"MICASA_CHAT_ENABLE":                "chat.enable",
+		"MICASA_CHAT_LLM_PROVIDER":          "chat.llm.provider",
+		"MICASA_CHAT_LLM_BASE_URL":          "chat.llm.base_url",
+		"MICASA_CHAT_LLM_MODEL":             "chat.llm.model",
+		"MICASA_CHAT_LLM_API_KEY":           "chat.llm.api_key",
+		"MICASA_CHAT_LLM_TIMEOUT":           "chat.llm.timeout",
+		"MICASA_CHAT_LLM_EFFORT":            "chat.llm.effort",
+		"MICASA_CHAT_LLM_EXTRA_CONTEXT":     "chat.llm.extra_context",
+		"MICASA_CHAT_LLM_ANSWER_LANGUAGE":   "chat.llm.answer_language",
+		"MICASA_CHAT_LLM_MAX_SCHEMA_TOKENS": "chat.llm.max_schema_tokens",
+		"MICASA_CHAT_LLM_CONTEXT_TOKENS":    "chat.llm.context_tokens",
 
 		"MICASA_EXTRACTION_MAX_PAGES":                    "extraction.max_pages",
+		"MICASA_EXTRACTION_STRICT":                       "extraction.strict",
 		"MICASA_EXTRACTION_LLM_ENABLE":                   "extraction.llm.enable",
+		"MICASA_EXTRACTION_LLM_ON_IMPORT":                "extraction.llm.on_import",
 		"MICASA_EXTRACTION_LLM_PROVIDER":                 "extraction.llm.provider",
 		"MICASA_EXTRACTION_LLM_BASE_URL":                 "extraction.llm.base_url",
 		"MICASA_EXTRACTION_LLM_MODEL":                    "extraction.llm.model",
 		"MICASA_EXTRACTION_LLM_API_KEY":                  "extraction.llm.api_key",
 		"MICASA_EXTRACTION_LLM_TIMEOUT":                  "extraction.llm.timeout",
 		"MICASA_EXTRACTION_LLM_EFFORT":                   "extraction.llm.effort",
+		"MICASA_EXTRACTION_LLM_MAX_INPUT_CHARS":          "extraction.llm.max_input_chars",
+		"MICASA_EXTRACTION_LLM_CONTEXT_TOKENS":           "extraction.llm.context_tokens",
+		"MICASA_EXTRACTION_PROMPTS":                      "extraction.prompts",
 		"MICASA_EXTRACTION_OCR_ENABLE":                   "extraction.ocr.enable",
+		"MICASA_EXTRACTION_OCR_CACHE":                    "extraction.ocr.cache",
+		"MICASA_EXTRACTION_OCR_AUTO_DETECT_LANG":         "extraction.ocr.auto_detect_lang",
 		"MICASA_EXTRACTION_OCR_TSV_ENABLE":               "extraction.ocr.tsv.enable",
 		"MICASA_EXTRACTION_OCR_TSV_CONFIDENCE_THRESHOLD": "extraction.ocr.tsv.confidence_threshold",
 
 		"MICASA_DOCUMENTS_MAX_FILE_SIZE":   "documents.max_file_size",
+		"MICASA_DOCUMENTS_STORAGE_WARNING": "documents.storage_warning",
 		"MICASA_DOCUMENTS_CACHE_TTL":       "documents.cache_ttl",
 		"MICASA_DOCUMENTS_FILE_PICKER_DIR": "documents.file_picker_dir",
-
-		"MICASA_LOCALE_CURRENCY": "locale.currency",
+		"MICASA_DOCUMENTS_AUTO_LINK":       "documents.auto_link",
+		"MICASA_DOCUMENTS_STORAGE":         "documents.storage",
+		"MICASA_DOCUMENTS_BLOB_DIR":        "documents.blob_dir",
+		"MICASA_DOCUMENTS_TITLE_STRIP":     "documents.title.strip",
+		"MICASA_DOCUMENTS_TITLE_REWRITE":   "documents.title.rewrite",
+		"MICASA_DOCUMENTS_TITLE_DATES":     "documents.title.dates",
+		"MICASA_DOCUMENTS_TITLE_CASE":      "documents.title.case",
+		"MICASA_DOCUMENTS_TITLE_WORDS":     "documents.title.words",
+
+		"MICASA_BACKUP_ON_START": "backup.on_start",
+		"MICASA_BACKUP_KEEP":     "backup.keep",
+		"MICASA_BACKUP_DIR":      "backup.dir",
+
+		"MICASA_LOCALE_CURRENCY":       "locale.currency",
+		"MICASA_LOCALE_ROUNDING":       "locale.rounding",
+		"MICASA_LOCALE_SYMBOL_SPACING": "locale.symbol_spacing",
+		"MICASA_LOCALE_CURRENCIES":     "locale.currencies",
+		"MICASA_LOCALE_NUMERIC_INPUT":  "locale.numeric_input",
+
+		"MICASA_CURRENCY_BASE":        "currency.base",
+		"MICASA_CURRENCY_RATES":       "currency.rates",
+		"MICASA_CURRENCY_FETCH_RATES": "currency.fetch_rates",
+		"MICASA_CURRENCY_RATES_URL":   "currency.rates_url",
 
 		"MICASA_ADDRESS_AUTOFILL": "address.autofill",
+
+		"MICASA_DASHBOARD_MAINTENANCE_GRACE_DAYS":  "dashboard.maintenance_grace_days",
+		"MICASA_DASHBOARD_SNOOZE_DAYS":             "dashboard.snooze_days",
+		"MICASA_DASHBOARD_WARRANTY_LOOKAHEAD_DAYS": "dashboard.warranty_lookahead_days",
+		"MICASA_DASHBOARD_WARRANTY_LOOKBACK_DAYS":  "dashboard.warranty_lookback_days",
+		"MICASA_MAINTENANCE_INTERVAL_UNIT":         "maintenance.interval_unit",
+		"MICASA_MAINTENANCE_SYNC_LAST_SERVICED":    "maintenance.sync_last_serviced",
+		"MICASA_MAINTENANCE_DEFAULT_PERFORMER":     "maintenance.default_performer",
+
+		"MICASA_UI_LOCALE":            "ui.locale",
+		"MICASA_UI_IDLE_LOCK_MINUTES": "ui.idle_lock_minutes",
+		"MICASA_UI_NOTE_LINES":        "ui.note_lines",
+		"MICASA_UI_MIN_WIDTH":         "ui.min_width",
+		"MICASA_UI_MIN_HEIGHT":        "ui.min_height",
+		"MICASA_UI_MOUSE":             "ui.mouse",
+		"MICASA_UI_STATUS_BAR":        "ui.status_bar",
+		"MICASA_UI_COMPACT_STATUS":    "ui.compact_status",
+		"MICASA_UI_HOME_KEY":          "ui.home_key",
+		"MICASA_UI_REVIEW_CHANGES":    "ui.review_changes",
+
+		"MICASA_SORT_PROJECTS":    "sort.projects",
+		"MICASA_SORT_QUOTES":      "sort.quotes",
+		"MICASA_SORT_MAINTENANCE": "sort.maintenance",
+		"MICASA_SORT_INCIDENTS":   "sort.incidents",
+		"MICASA_SORT_APPLIANCES":  "sort.appliances",
+		"MICASA_SORT_VENDORS":     "sort.vendors",
+		"MICASA_SORT_DOCUMENTS":   "sort.documents",
+
+		"MICASA_FORMS_PROJECTS_ADD":         "forms.projects.add",
+		"MICASA_FORMS_PROJECTS_REQUIRED":    "forms.projects.required",
+		"MICASA_FORMS_QUOTES_ADD":           "forms.quotes.add",
+		"MICASA_FORMS_QUOTES_REQUIRED":      "forms.quotes.required",
+		"MICASA_FORMS_MAINTENANCE_ADD":      "forms.maintenance.add",
+		"MICASA_FORMS_MAINTENANCE_REQUIRED": "forms.maintenance.required",
+		"MICASA_FORMS_INCIDENTS_ADD":        "forms.incidents.add",
+		"MICASA_FORMS_INCIDENTS_REQUIRED":   "forms.incidents.required",
+		"MICASA_FORMS_APPLIANCES_ADD":       "forms.appliances.add",
+		"MICASA_FORMS_APPLIANCES_REQUIRED":  "forms.appliances.required",
+		"MICASA_FORMS_VENDORS_ADD":          "forms.vendors.add",
+		"MICASA_FORMS_VENDORS_REQUIRED":     "forms.vendors.required",
+
+		"MICASA_SEED_PROJECT_TYPES":          "seed.project_types",
+		"MICASA_SEED_MAINTENANCE_CATEGORIES": "seed.maintenance_categories",
 	}
 	assert.Equal(t, want, m)
 }
@@ -769,6 +1640,36 @@ func TestOCREnvOverrides(t *testing.T) {
 	assert.Equal(t, 80, cfg.Extraction.OCR.TSV.Threshold())
 }
 
+func TestOCRCache(t *testing.T) {
+	cfg, err := LoadFromPath(noConfig(t))
+	require.NoError(t, err)
+	assert.True(t, cfg.Extraction.OCR.IsCacheEnabled())
+
+	cfg, err = LoadFromPath(writeConfig(t, "[extraction.ocr]\ncache = false\n"))
+	require.NoError(t, err)
+	assert.False(t, cfg.Extraction.OCR.IsCacheEnabled())
+
+	t.Setenv("MICASA_EXTRACTION_OCR_CACHE", "false")
+	cfg, err = LoadFromPath(noConfig(t))
+	require.NoError(t, err)
+	assert.False(t, cfg.Extraction.OCR.IsCacheEnabled())
+}
+
+func TestOCRAutoDetectLang(t *testing.T) {
+	cfg, err := LoadFromPath(noConfig(t))
+	require.NoError(t, err)
+	assert.False(t, cfg.Extraction.OCR.IsAutoDetectLangEnabled())
+
+	cfg, err = LoadFromPath(writeConfig(t, "[extraction.ocr]\nauto_detect_lang = true\n"))
+	require.NoError(t, err)
+	assert.True(t, cfg.Extraction.OCR.IsAutoDetectLangEnabled())
+
+	t.Setenv("MICASA_EXTRACTION_OCR_AUTO_DETECT_LANG", "true")
+	cfg, err = LoadFromPath(noConfig(t))
+	require.NoError(t, err)
+	assert.True(t, cfg.Extraction.OCR.IsAutoDetectLangEnabled())
+}
+
 func TestOCRConfidenceThresholdValidation(t *testing.T) {
 	t.Run("rejects negative", func(t *testing.T) {
 		path := writeConfig(t, "[extraction.ocr.tsv]\nconfidence_threshold = -1\n")
@@ -70,11 +70,7 @@ func (c *Config) forDisplay() Config {
 // detectCurrencyCode returns the effective currency code by resolving
 // the same env/locale chain as the runtime currency detection.
 func detectCurrencyCode() string {
-	c, err := locale.ResolveDefault("")
-	if err != nil {
-		return "USD"
-	}
-	return c.Code()
+	return locale.DefaultCode("")
 }
 
 // sectionBlock groups the lines belonging to a single TOML table.
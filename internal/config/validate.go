@@ -7,11 +7,16 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"regexp"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/BurntSushi/toml"
 	"github.com/go-playground/validator/v10"
+
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/micasa-dev/micasa/internal/locale"
 )
 
 // configValidator is the package-level validator instance, configured once
@@ -47,6 +52,44 @@ func newConfigValidator() *validator.Validate {
 		return validProvider(fl.Field().String())
 	})
 
+	mustRegister(v, "bcp47", func(fl validator.FieldLevel) bool {
+		_, err := locale.ParseDisplay(fl.Field().String())
+		return err == nil
+	})
+
+	mustRegister(v, "currency_code", func(fl validator.FieldLevel) bool {
+		return validCurrencyCode(fl.Field().String())
+	})
+
+	mustRegister(v, "currency_format", func(fl validator.FieldLevel) bool {
+		_, err := locale.ParseCurrencyFormat(fl.Field().String())
+		return err == nil
+	})
+
+	mustRegister(v, "regexp", func(fl validator.FieldLevel) bool {
+		_, err := regexp.Compile(fl.Field().String())
+		return err == nil
+	})
+
+	mustRegister(v, "word", func(fl validator.FieldLevel) bool {
+		s := fl.Field().String()
+		return s != "" && !strings.ContainsAny(s, " \t\n")
+	})
+
+	mustRegister(v, "status_segments", func(fl validator.FieldLevel) bool {
+		return unknownStatusSegment(fl.Field().String()) == ""
+	})
+
+	mustRegister(v, "seed_names", func(fl validator.FieldLevel) bool {
+		_, err := invalidSeedName(fl.Field().String())
+		return err == nil
+	})
+
+	mustRegister(v, "keyname", func(fl validator.FieldLevel) bool {
+		s := fl.Field().String()
+		return s != "" && !strings.ContainsAny(s, " \t\n")
+	})
+
 	mustRegister(v, "positive_duration", func(fl validator.FieldLevel) bool {
 		s := fl.Field().String()
 		d, err := time.ParseDuration(s)
@@ -67,6 +110,42 @@ func newConfigValidator() *validator.Validate {
 	return v
 }
 
+// validCurrencyCode reports whether s looks like an ISO 4217 code: three
+// ASCII letters. Custom formats may name codes ISO doesn't assign.
+func validCurrencyCode(s string) bool {
+	if len(s) != 3 {
+		return false
+	}
+	for _, r := range s {
+		if (r < 'A' || r > 'Z') && (r < 'a' || r > 'z') {
+			return false
+		}
+	}
+	return true
+}
+
+// unknownStatusSegment returns the first entry of a comma-separated
+// status bar list that is not a known segment, or "" when all are.
+func unknownStatusSegment(list string) string {
+	for _, seg := range (UI{StatusBar: list}).StatusSegments() {
+		if !slices.Contains(StatusSegmentNames, seg) {
+			return seg
+		}
+	}
+	return ""
+}
+
+// invalidSeedName returns the first name in a comma-separated [seed] list
+// that can't be seeded, and why.
+func invalidSeedName(list string) (string, error) {
+	for _, name := range splitFieldList(list) {
+		if err := data.ValidateSeedName(name); err != nil {
+			return name, err
+		}
+	}
+	return "", nil
+}
+
 func mustRegister(
 	v *validator.Validate,
 	tag string,
@@ -122,11 +201,99 @@ func formatFieldError(fe validator.FieldError) error {
 		)
 
 	case "oneof":
+		if strings.Contains(ns, ".prompts[") {
+			return fmt.Errorf(
+				"%s: invalid prompt variant %q -- supported: %s",
+				ns, fe.Value(), strings.ReplaceAll(fe.Param(), " ", ", "),
+			)
+		}
+		if strings.HasSuffix(ns, ".storage") {
+			return fmt.Errorf(
+				"%s: invalid document storage %q -- supported: %s",
+				ns, fe.Value(), strings.ReplaceAll(fe.Param(), " ", ", "),
+			)
+		}
+		if strings.HasSuffix(ns, ".symbol_spacing") {
+			return fmt.Errorf(
+				"%s: invalid symbol spacing %q -- supported: %s",
+				ns, fe.Value(), strings.ReplaceAll(fe.Param(), " ", ", "),
+			)
+		}
+		if strings.HasSuffix(ns, ".interval_unit") {
+			return fmt.Errorf(
+				"%s: invalid interval unit %q -- supported: %s",
+				ns, fe.Value(), strings.ReplaceAll(fe.Param(), " ", ", "),
+			)
+		}
+		if strings.HasSuffix(ns, ".title.dates") {
+			return fmt.Errorf(
+				"%s: invalid date style %q -- supported: %s",
+				ns, fe.Value(), strings.ReplaceAll(fe.Param(), " ", ", "),
+			)
+		}
+		if strings.HasSuffix(ns, ".title.case") {
+			return fmt.Errorf(
+				"%s: invalid case %q -- supported: %s",
+				ns, fe.Value(), strings.ReplaceAll(fe.Param(), " ", ", "),
+			)
+		}
+		if strings.HasSuffix(ns, ".rounding") {
+			return fmt.Errorf(
+				"%s: invalid rounding mode %q -- supported: %s",
+				ns, fe.Value(), strings.ReplaceAll(fe.Param(), " ", ", "),
+			)
+		}
 		return fmt.Errorf(
 			"%s: invalid level %q -- supported: %s",
 			ns, fe.Value(), strings.ReplaceAll(fe.Param(), " ", ", "),
 		)
 
+	case "bcp47":
+		return fmt.Errorf(
+			"%s: invalid locale %q -- use a BCP 47 tag like \"en-GB\" or \"de\"",
+			ns, fe.Value(),
+		)
+
+	case "currency_code":
+		return fmt.Errorf(
+			"%s: invalid currency code %q -- use three letters like \"VND\"",
+			ns, fe.Value(),
+		)
+
+	case "currency_format":
+		s, _ := fe.Value().(string)
+		_, err := locale.ParseCurrencyFormat(s)
+		return fmt.Errorf("%s: %w", ns, err)
+
+	case "status_segments":
+		s, _ := fe.Value().(string)
+		return fmt.Errorf(
+			"%s: unknown segment %q -- supported: %s",
+			ns, unknownStatusSegment(s), strings.Join(StatusSegmentNames, ", "),
+		)
+
+	case "seed_names":
+		s, _ := fe.Value().(string)
+		name, err := invalidSeedName(s)
+		return fmt.Errorf("%s: %q: %w", ns, name, err)
+
+	case "keyname":
+		return fmt.Errorf(
+			"%s: invalid key %q -- use a key name like \"H\" or \"ctrl+g\"",
+			ns, fe.Value(),
+		)
+
+	case "regexp":
+		s, _ := fe.Value().(string)
+		_, err := regexp.Compile(s)
+		return fmt.Errorf("%s: invalid regular expression: %w", ns, err)
+
+	case "word":
+		return fmt.Errorf(
+			"%s: invalid word %q -- use a single word without spaces",
+			ns, fe.Value(),
+		)
+
 	case "positive_duration":
 		s, _ := fe.Value().(string)
 		if _, err := time.ParseDuration(s); err != nil {
@@ -140,10 +307,16 @@ func formatFieldError(fe validator.FieldError) error {
 	case "required":
 		return fmt.Errorf("%s must be positive", ns)
 
+	case "gt":
+		return fmt.Errorf("%s must be positive, got %v", ns, fe.Value())
+
 	case "min", "max":
 		if strings.HasSuffix(ns, ".confidence_threshold") {
 			return fmt.Errorf("%s must be 0-100, got %v", ns, fe.Value())
 		}
+		if fe.Tag() == "min" && fe.Param() != "0" {
+			return fmt.Errorf("%s must be at least %s, got %v", ns, fe.Param(), fe.Value())
+		}
 		return fmt.Errorf("%s must be non-negative, got %v", ns, fe.Value())
 
 	case "nonneg_duration":
@@ -4,10 +4,16 @@
 package data
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"modernc.org/sqlite"
 )
@@ -77,6 +83,122 @@ func (s *Store) Backup(ctx context.Context, destPath string) error {
 	return nil
 }
 
+// backupTimeFormat names rotating backups. It sorts lexically in time
+// order, which rotation relies on.
+const backupTimeFormat = "20060102-150405"
+
+// BackupTo writes a timestamped backup of the database at dbPath into dir,
+// creating the directory if needed, then deletes that database's oldest
+// rotating backups so at most keepN remain. keepN <= 0 keeps them all.
+// Backups are named after the database file (micasa.db backs up to
+// micasa-<stamp>.db), so several databases, like profiles, can share dir
+// without rotating each other's backups away. Anything else in dir is
+// left alone. Returns the path of the new backup. A backup already taken
+// within the same second is reused rather than overwritten.
+func (s *Store) BackupTo(ctx context.Context, dbPath, dir string, keepN int) (string, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("create backup directory: %w", err)
+	}
+	prefix := backupPrefix(dbPath)
+	dest := filepath.Join(dir, prefix+time.Now().Format(backupTimeFormat)+".db")
+	if _, err := os.Stat(dest); errors.Is(err, os.ErrNotExist) {
+		if err := s.Backup(ctx, dest); err != nil {
+			return "", err
+		}
+	}
+	if err := rotateBackups(dir, prefix, keepN); err != nil {
+		return dest, err
+	}
+	return dest, nil
+}
+
+// backupPrefix returns the name prefix of dbPath's rotating backups: the
+// database's file name without its extension, and a dash.
+func backupPrefix(dbPath string) string {
+	base := filepath.Base(dbPath)
+	return strings.TrimSuffix(base, filepath.Ext(base)) + "-"
+}
+
+// rotateBackups deletes all but the newest keepN rotating backups in dir
+// whose names start with prefix.
+func rotateBackups(dir, prefix string, keepN int) error {
+	return pruneBackups(dir, keepN, func(name string) (string, bool) {
+		stamp, ok := strings.CutPrefix(name, prefix)
+		if !ok {
+			return "", false
+		}
+		return strings.CutSuffix(stamp, ".db")
+	})
+}
+
+// MigrationBackupPath returns where the backup taken before migrating the
+// database at dbPath from schema version from is written: next to the
+// database, stamped with now.
+func MigrationBackupPath(dbPath string, from int, now time.Time) string {
+	return fmt.Sprintf("%s.schema-v%d-%s.backup", dbPath, from, now.Format(backupTimeFormat))
+}
+
+// RotateMigrationBackups deletes all but the newest keepN pre-migration
+// backups of the database at dbPath, whatever schema version each was
+// taken from. keepN <= 0 keeps them all.
+func RotateMigrationBackups(dbPath string, keepN int) error {
+	prefix := filepath.Base(dbPath) + ".schema-v"
+	return pruneBackups(filepath.Dir(dbPath), keepN, func(name string) (string, bool) {
+		rest, ok := strings.CutPrefix(name, prefix)
+		if !ok {
+			return "", false
+		}
+		version, stamp, ok := strings.Cut(rest, "-")
+		if !ok {
+			return "", false
+		}
+		if _, err := strconv.Atoi(version); err != nil {
+			return "", false
+		}
+		return strings.CutSuffix(stamp, ".backup")
+	})
+}
+
+// pruneBackups deletes all but the newest keepN regular files in dir whose
+// names stampOf recognizes, ordered by the backupTimeFormat stamp it
+// extracts. Files it doesn't recognize are left alone.
+func pruneBackups(dir string, keepN int, stampOf func(name string) (string, bool)) error {
+	if keepN <= 0 {
+		return nil
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("list backups: %w", err)
+	}
+	type backup struct{ name, stamp string }
+	var backups []backup
+	for _, e := range entries {
+		if !e.Type().IsRegular() {
+			continue
+		}
+		stamp, ok := stampOf(e.Name())
+		if !ok {
+			continue
+		}
+		if _, err := time.Parse(backupTimeFormat, stamp); err != nil {
+			continue
+		}
+		backups = append(backups, backup{name: e.Name(), stamp: stamp})
+	}
+	if len(backups) <= keepN {
+		return nil
+	}
+	slices.SortFunc(backups, func(a, b backup) int {
+		return cmp.Or(strings.Compare(a.stamp, b.stamp), strings.Compare(a.name, b.name))
+	})
+	for _, b := range backups[:len(backups)-keepN] {
+		if err := os.Remove(filepath.Join(dir, b.name)); err != nil {
+			return fmt.Errorf("remove old backup: %w", err)
+		}
+	}
+	return nil
+}
+
 // verifyBackup opens the backup and runs PRAGMA integrity_check to confirm
 // the database is internally consistent.
 func verifyBackup(ctx context.Context, path string) error {
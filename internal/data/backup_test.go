@@ -6,6 +6,7 @@ package data
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/micasa-dev/micasa/internal/fake"
@@ -114,3 +115,123 @@ func TestIsMicasaDB_False(t *testing.T) {
 	require.NoError(t, err)
 	assert.False(t, ok)
 }
+
+func TestBackupToRotates(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	dir := filepath.Join(t.TempDir(), "backups")
+
+	// Older backups from earlier launches, plus a file the user put there.
+	for _, name := range []string{
+		"micasa-20240101-080000.db",
+		"micasa-20240102-080000.db",
+		"micasa-20240103-080000.db",
+	} {
+		require.NoError(t, os.MkdirAll(dir, 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), nil, 0o600))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "micasa-keepme.db"), nil, 0o600))
+
+	dest, err := store.BackupTo(t.Context(), "micasa.db", dir, 2)
+	require.NoError(t, err)
+	assert.Equal(t, dir, filepath.Dir(dest))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	assert.ElementsMatch(t, []string{
+		"micasa-20240103-080000.db",
+		filepath.Base(dest),
+		"micasa-keepme.db",
+	}, names)
+
+	backup, err := Open(dest)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = backup.Close() })
+	ok, err := backup.IsMicasaDB()
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestBackupToKeepAll(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	dir := t.TempDir()
+	old := filepath.Join(dir, "micasa-20240101-080000.db")
+	require.NoError(t, os.WriteFile(old, nil, 0o600))
+
+	_, err := store.BackupTo(t.Context(), "micasa.db", dir, 0)
+	require.NoError(t, err)
+	assert.FileExists(t, old)
+}
+
+func TestBackupToRotatesPerDatabase(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	profiles := filepath.Join(t.TempDir(), "profiles")
+	dir := filepath.Join(profiles, "backups")
+	require.NoError(t, os.MkdirAll(dir, 0o700))
+
+	// Both profiles' backups land in the same profiles/backups directory.
+	for _, name := range []string{
+		"work-20240101-080000.db",
+		"work-20240102-080000.db",
+		"home-20240101-080000.db",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), nil, 0o600))
+	}
+
+	dest, err := store.BackupTo(t.Context(), filepath.Join(profiles, "home.db"), dir, 1)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(filepath.Base(dest), "home-"), dest)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	assert.ElementsMatch(t, []string{
+		"work-20240101-080000.db",
+		"work-20240102-080000.db",
+		filepath.Base(dest),
+	}, names, "another profile's backups are untouched")
+}
+
+func TestRotateMigrationBackups(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	dbPath := filepath.Join(dir, "micasa.db")
+	for _, name := range []string{
+		"micasa.db",
+		"micasa.db.schema-v3-20240101-080000.backup",
+		"micasa.db.schema-v2-20240301-080000.backup",
+		"micasa.db.schema-v4-20240201-080000.backup",
+		"micasa.db.schema-vX-20230101-080000.backup",
+		"other.db.schema-v1-20230101-080000.backup",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), nil, 0o600))
+	}
+
+	require.NoError(t, RotateMigrationBackups(dbPath, 2))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	assert.ElementsMatch(t, []string{
+		"micasa.db",
+		"micasa.db.schema-v2-20240301-080000.backup",
+		"micasa.db.schema-v4-20240201-080000.backup",
+		"micasa.db.schema-vX-20230101-080000.backup",
+		"other.db.schema-v1-20230101-080000.backup",
+	}, names, "the oldest by timestamp goes; unrelated files stay")
+
+	require.NoError(t, RotateMigrationBackups(dbPath, 0))
+	assert.FileExists(t, filepath.Join(dir, "micasa.db.schema-v2-20240301-080000.backup"))
+}
@@ -70,7 +70,7 @@ func BenchmarkListExpiringWarranties(b *testing.B) {
 	now := time.Now()
 	b.ResetTimer()
 	for b.Loop() {
-		_, _ = store.ListExpiringWarranties(now, 30*24*time.Hour, 90*24*time.Hour)
+		_, _ = store.ListExpiringWarranties(now, DefaultWarrantyWindow)
 	}
 }
 
@@ -0,0 +1,233 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package data
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Document storage backends accepted by SetBlobStorage's callers.
+const (
+	BlobStorageDatabase = "database"
+	BlobStorageFiles    = "files"
+)
+
+// DefaultBlobDir returns the directory for document files stored outside
+// the database: a "documents" directory next to the database file.
+func DefaultBlobDir(dbPath string) string {
+	return filepath.Join(filepath.Dir(dbPath), "documents")
+}
+
+// SetBlobStorage configures where document contents live. Files are kept
+// under dir, named by their SHA-256 checksum, so the database only needs
+// the checksum it already stores to find them. When files is true, new
+// and replaced document contents are written to dir and the data column
+// is left NULL; either way, a document whose column is empty is read from
+// dir if its file is there.
+func (s *Store) SetBlobStorage(dir string, files bool) {
+	s.blobDir = dir
+	s.blobFiles = files && dir != ""
+}
+
+// GetDocumentBlob returns the file contents of a document, from whichever
+// backend holds them. It returns nil without error when the content has
+// not been fetched yet (see PendingBlobDocuments).
+func (s *Store) GetDocumentBlob(id string) ([]byte, error) {
+	var doc Document
+	err := s.db.Select(ColData, ColChecksumSHA256).
+		First(&doc, ColID+" = ?", id).Error
+	if err != nil {
+		return nil, fmt.Errorf("load document content: %w", err)
+	}
+	return s.documentBlob(doc)
+}
+
+// documentBlob resolves doc's content from its data column or, failing
+// that, from the blob directory.
+func (s *Store) documentBlob(doc Document) ([]byte, error) {
+	if len(doc.Data) > 0 || s.blobDir == "" || doc.ChecksumSHA256 == "" {
+		return doc.Data, nil
+	}
+	data, err := os.ReadFile(s.blobPath(doc.ChecksumSHA256))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read document file: %w", err)
+	}
+	if sum := blobChecksum(data); sum != doc.ChecksumSHA256 {
+		return nil, fmt.Errorf(
+			"document file %s is corrupt -- checksum is %s",
+			s.blobPath(doc.ChecksumSHA256), sum,
+		)
+	}
+	return data, nil
+}
+
+// hasBlobFile reports whether the blob directory holds the file for sum.
+func (s *Store) hasBlobFile(sum string) bool {
+	if s.blobDir == "" || sum == "" {
+		return false
+	}
+	_, err := os.Stat(s.blobPath(sum))
+	return err == nil
+}
+
+// blobPath shards files by the first two hex digits of the checksum so no
+// single directory grows too large.
+func (s *Store) blobPath(sum string) string {
+	if len(sum) < 2 {
+		return filepath.Join(s.blobDir, sum)
+	}
+	return filepath.Join(s.blobDir, sum[:2], sum)
+}
+
+// writeBlob stores data in the blob directory and returns its checksum.
+// Identical contents share one file, so an existing file is left alone.
+func (s *Store) writeBlob(data []byte) (string, error) {
+	sum := blobChecksum(data)
+	path := s.blobPath(sum)
+	if info, err := os.Stat(path); err == nil && info.Size() == int64(len(data)) {
+		return sum, nil
+	}
+	dir := filepath.Dir(path)
+	// 0o700/0o600: owner-only access, matching the document cache.
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("create document file dir: %w", err)
+	}
+
+	// Write to a temp file and rename into place so a crash never leaves a
+	// truncated file under a valid checksum.
+	tmp, err := os.CreateTemp(dir, ".micasa-blob-*")
+	if err != nil {
+		return "", fmt.Errorf("create temp document file: %w", err)
+	}
+	tmpPath := tmp.Name()
+	ok := false
+	defer func() {
+		if !ok {
+			_ = os.Remove(tmpPath)
+		}
+	}()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return "", fmt.Errorf("write document file: %w", err)
+	}
+	if err := tmp.Chmod(0o600); err != nil {
+		_ = tmp.Close()
+		return "", fmt.Errorf("chmod document file: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return "", fmt.Errorf("sync document file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("close document file: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return "", fmt.Errorf("rename document file: %w", err)
+	}
+	ok = true
+	return sum, nil
+}
+
+// removeUnusedBlob deletes the blob file for sum unless a document,
+// deleted or not, still refers to it. A missing file is not an error.
+func (s *Store) removeUnusedBlob(sum string) error {
+	if s.blobDir == "" || sum == "" {
+		return nil
+	}
+	var refs int64
+	if err := s.db.Unscoped().Model(&Document{}).
+		Where(ColChecksumSHA256+" = ?", sum).
+		Count(&refs).Error; err != nil {
+		return fmt.Errorf("check document file references: %w", err)
+	}
+	if refs > 0 {
+		return nil
+	}
+	if err := os.Remove(s.blobPath(sum)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("remove old document file: %w", err)
+	}
+	return nil
+}
+
+// MoveDocumentBlobsToFiles writes every document content still held in the
+// database to the blob directory and clears its data column, returning the
+// number moved. Each file is written before its column is cleared, so an
+// interrupted run never loses content and can simply be run again.
+// Documents whose stored checksum doesn't match their content stay in the
+// database.
+func (s *Store) MoveDocumentBlobsToFiles() (int, error) {
+	if s.blobDir == "" {
+		return 0, errors.New("no document file directory configured")
+	}
+	var ids []string
+	if err := s.db.Unscoped().Model(&Document{}).
+		Where(ColData+" IS NOT NULL").
+		Pluck(ColID, &ids).Error; err != nil {
+		return 0, fmt.Errorf("list documents to move: %w", err)
+	}
+	moved := 0
+	for _, id := range ids {
+		var doc Document
+		if err := s.db.Unscoped().Select(ColID, ColData, ColChecksumSHA256).
+			First(&doc, ColID+" = ?", id).Error; err != nil {
+			return moved, fmt.Errorf("load document %s: %w", id, err)
+		}
+		if len(doc.Data) == 0 || blobChecksum(doc.Data) != doc.ChecksumSHA256 {
+			continue
+		}
+		if _, err := s.writeBlob(doc.Data); err != nil {
+			return moved, fmt.Errorf("move document %s: %w", id, err)
+		}
+		if err := s.db.Unscoped().Model(&Document{}).
+			Where(ColID+" = ?", id).
+			UpdateColumn(ColData, nil).Error; err != nil {
+			return moved, fmt.Errorf("clear document %s: %w", id, err)
+		}
+		moved++
+	}
+	return moved, nil
+}
+
+// MoveDocumentBlobsToDatabase is the reverse of MoveDocumentBlobsToFiles:
+// it copies file-backed document contents back into the data column and
+// returns the number copied. The files themselves are left in place.
+func (s *Store) MoveDocumentBlobsToDatabase() (int, error) {
+	if s.blobDir == "" {
+		return 0, nil
+	}
+	var docs []Document
+	if err := s.db.Unscoped().Select(ColID, ColChecksumSHA256).
+		Where(ColChecksumSHA256 + " != '' AND " + ColData + " IS NULL").
+		Find(&docs).Error; err != nil {
+		return 0, fmt.Errorf("list documents to move: %w", err)
+	}
+	moved := 0
+	for _, doc := range docs {
+		data, err := s.documentBlob(doc)
+		if err != nil {
+			return moved, fmt.Errorf("move document %s: %w", doc.ID, err)
+		}
+		if data == nil {
+			continue
+		}
+		if err := s.db.Unscoped().Model(&Document{}).
+			Where(ColID+" = ?", doc.ID).
+			UpdateColumn(ColData, data).Error; err != nil {
+			return moved, fmt.Errorf("store document %s: %w", doc.ID, err)
+		}
+		moved++
+	}
+	return moved, nil
+}
+
+func blobChecksum(data []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(data))
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package data
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// ChecklistMonths is how far ahead MaintenanceChecklist projects the
+// schedule, starting with the current month.
+const ChecklistMonths = 12
+
+// ChecklistEntry is one line on the printable maintenance checklist.
+type ChecklistEntry struct {
+	Item MaintenanceItem
+	// Due is nil for items that belong in a month without a concrete date:
+	// recurring items that have never been serviced, and month- or
+	// season-tagged items with no schedule.
+	Due *time.Time
+}
+
+// ChecklistGroup collects the entries that fall in one calendar month, or
+// everything already past due when Overdue is set.
+type ChecklistGroup struct {
+	Month   time.Time // first of the month, UTC; zero for the overdue group
+	Season  string
+	Overdue bool
+	Entries []ChecklistEntry
+}
+
+// Title returns the heading for the group, e.g. "October 2026".
+func (g ChecklistGroup) Title() string {
+	if g.Overdue {
+		return "Overdue"
+	}
+	return g.Month.Format("January 2006")
+}
+
+// MaintenanceChecklist projects the maintenance schedule over the next
+// ChecklistMonths months and groups the work by month. Recurring items
+// appear once per occurrence, computed from their last service date and
+// interval; past-due items are collected in a leading overdue group.
+// Items without a schedule land in their tagged month, or failing that the
+// first month of their tagged season. Empty months are omitted.
+func (s *Store) MaintenanceChecklist(now time.Time) ([]ChecklistGroup, error) {
+	items, err := s.ListMaintenance(false)
+	if err != nil {
+		return nil, err
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := AddMonths(start, ChecklistMonths)
+	monthIndex := func(t time.Time) int {
+		return (t.Year()-start.Year())*12 + int(t.Month()-start.Month())
+	}
+
+	overdue := ChecklistGroup{Overdue: true}
+	months := make([]ChecklistGroup, ChecklistMonths)
+	for i := range months {
+		month := AddMonths(start, i)
+		months[i] = ChecklistGroup{Month: month, Season: SeasonForMonth(month.Month())}
+	}
+
+	for _, item := range items {
+		interval := item.Interval()
+		next := ComputeNextDue(item.LastServicedAt, interval, item.DueDate)
+		if next == nil {
+			switch {
+			case !interval.IsZero():
+				months[0].Entries = append(months[0].Entries, ChecklistEntry{Item: item})
+			case item.Month >= 1 && item.Month <= 12:
+				for i := range months {
+					if int(months[i].Month.Month()) == item.Month {
+						months[i].Entries = append(months[i].Entries, ChecklistEntry{Item: item})
+						break
+					}
+				}
+			case item.Season != "":
+				for i := range months {
+					if months[i].Season == item.Season {
+						months[i].Entries = append(months[i].Entries, ChecklistEntry{Item: item})
+						break
+					}
+				}
+			}
+			continue
+		}
+
+		due := *next
+		if due.Before(today) {
+			overdue.Entries = append(overdue.Entries, ChecklistEntry{Item: item, Due: next})
+			if interval.IsZero() {
+				continue
+			}
+			for due.Before(today) {
+				due = interval.After(due)
+			}
+		}
+		for due.Before(end) {
+			occurrence := due
+			idx := monthIndex(occurrence)
+			months[idx].Entries = append(months[idx].Entries, ChecklistEntry{
+				Item: item,
+				Due:  &occurrence,
+			})
+			if interval.IsZero() {
+				break
+			}
+			due = interval.After(due)
+		}
+	}
+
+	groups := make([]ChecklistGroup, 0, len(months)+1)
+	for _, g := range append([]ChecklistGroup{overdue}, months...) {
+		if len(g.Entries) == 0 {
+			continue
+		}
+		sortChecklistEntries(g.Entries)
+		groups = append(groups, g)
+	}
+	return groups, nil
+}
+
+// sortChecklistEntries orders entries by due date, undated entries last,
+// then by name.
+func sortChecklistEntries(entries []ChecklistEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		switch {
+		case a.Due != nil && b.Due != nil && !a.Due.Equal(*b.Due):
+			return a.Due.Before(*b.Due)
+		case (a.Due == nil) != (b.Due == nil):
+			return a.Due != nil
+		}
+		return strings.ToLower(a.Item.Name) < strings.ToLower(b.Item.Name)
+	})
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package data
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaintenanceChecklist(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	cat := MaintenanceCategory{Name: "ChecklistCat"}
+	require.NoError(t, store.db.Create(&cat).Error)
+
+	ptrTime := func(y, m, d int) *time.Time {
+		t := time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+	now := time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC)
+
+	// Every 3 months, last done in July: due Oct 1, already past.
+	require.NoError(t, store.db.Create(&MaintenanceItem{
+		Name: "HVAC filter", CategoryID: cat.ID,
+		IntervalMonths: 3, LastServicedAt: ptrTime(2026, 7, 1),
+	}).Error)
+	// Every 6 months, next due in November.
+	require.NoError(t, store.db.Create(&MaintenanceItem{
+		Name: "Gutters", CategoryID: cat.ID,
+		IntervalMonths: 6, LastServicedAt: ptrTime(2026, 5, 20),
+	}).Error)
+	// One-off due date beyond the horizon.
+	require.NoError(t, store.db.Create(&MaintenanceItem{
+		Name: "Repaint", CategoryID: cat.ID, DueDate: ptrTime(2028, 1, 1),
+	}).Error)
+	// Season-tagged, no schedule.
+	require.NoError(t, store.db.Create(&MaintenanceItem{
+		Name: "Drain hoses", CategoryID: cat.ID, Season: SeasonSpring,
+	}).Error)
+	// Recurring but never serviced.
+	require.NoError(t, store.db.Create(&MaintenanceItem{
+		Name: "Test sump pump", CategoryID: cat.ID, IntervalMonths: 12,
+	}).Error)
+	// Month-tagged, no schedule; wins over its season.
+	require.NoError(t, store.db.Create(&MaintenanceItem{
+		Name: "Open pool", CategoryID: cat.ID, Season: SeasonSpring, Month: int(time.May),
+	}).Error)
+	// Nothing to schedule.
+	require.NoError(t, store.db.Create(&MaintenanceItem{
+		Name: "Someday", CategoryID: cat.ID,
+	}).Error)
+
+	groups, err := store.MaintenanceChecklist(now)
+	require.NoError(t, err)
+
+	byTitle := make(map[string][]string)
+	titles := make([]string, 0, len(groups))
+	for _, g := range groups {
+		titles = append(titles, g.Title())
+		for _, e := range g.Entries {
+			byTitle[g.Title()] = append(byTitle[g.Title()], e.Item.Name)
+		}
+	}
+
+	assert.Equal(t, []string{
+		"Overdue",
+		"October 2026",
+		"November 2026",
+		"January 2027",
+		"March 2027",
+		"April 2027",
+		"May 2027",
+		"July 2027",
+	}, titles)
+	assert.Equal(t, []string{"HVAC filter"}, byTitle["Overdue"])
+	assert.Equal(t, []string{"Test sump pump"}, byTitle["October 2026"],
+		"never-serviced recurring work is due now")
+	assert.Equal(t, []string{"Gutters"}, byTitle["November 2026"])
+	assert.Equal(t, []string{"HVAC filter"}, byTitle["January 2027"])
+	assert.Equal(t, []string{"Drain hoses"}, byTitle["March 2027"])
+	assert.Equal(t, []string{"HVAC filter"}, byTitle["April 2027"])
+	assert.Equal(t, []string{"Gutters", "Open pool"}, byTitle["May 2027"])
+	assert.Equal(t, []string{"HVAC filter"}, byTitle["July 2027"])
+
+	assert.Equal(t, SeasonFall, groups[1].Season)
+	require.NotNil(t, groups[0].Entries[0].Due)
+	assert.Equal(t, "2026-10-01", FormatDate(groups[0].Entries[0].Due))
+}
+
+func TestMaintenanceChecklistEmpty(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	groups, err := store.MaintenanceChecklist(time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, groups)
+}
@@ -4,18 +4,34 @@
 package data
 
 import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
 )
 
+// notArchivedAppliance keeps maintenance items that have no appliance or
+// whose appliance isn't archived.
+func notArchivedAppliance(db *gorm.DB) *gorm.DB {
+	return db.Where(
+		ColApplianceID + " IS NULL OR " + ColApplianceID + " NOT IN (SELECT " + ColID +
+			" FROM " + TableAppliances + " WHERE " + ColArchivedAt + " IS NOT NULL)",
+	)
+}
+
 // ListMaintenanceWithSchedule returns all non-deleted maintenance items that
 // have a positive interval or an explicit due date, preloading Category and
 // Appliance. These are the items eligible for overdue/upcoming computation.
+// Items for archived appliances are left out.
 func (s *Store) ListMaintenanceWithSchedule() ([]MaintenanceItem, error) {
 	var items []MaintenanceItem
 	err := s.db.
-		Where(ColIntervalMonths+" > 0 OR "+ColDueDate+" IS NOT NULL").
+		Where(ColIntervalMonths+" > 0 OR "+ColIntervalDays+" > 0 OR "+ColDueDate+" IS NOT NULL").
+		Scopes(notArchivedAppliance).
 		Preload("Category").
 		Preload("Appliance", func(q *gorm.DB) *gorm.DB {
 			return q.Unscoped()
@@ -42,12 +58,36 @@ func SeasonForMonth(m time.Month) string {
 	}
 }
 
+// SeasonMonths returns the calendar months that make up a season, in
+// order. Unknown seasons have no months.
+func SeasonMonths(season string) []time.Month {
+	switch season {
+	case SeasonSpring:
+		return []time.Month{time.March, time.April, time.May}
+	case SeasonSummer:
+		return []time.Month{time.June, time.July, time.August}
+	case SeasonFall:
+		return []time.Month{time.September, time.October, time.November}
+	case SeasonWinter:
+		return []time.Month{time.December, time.January, time.February}
+	default:
+		return nil
+	}
+}
+
 // ListMaintenanceBySeason returns non-deleted maintenance items tagged with
-// the given season, preloading Category and Appliance.
+// the given season, or with a month that falls in it, preloading Category
+// and Appliance. Items for archived appliances are left out.
 func (s *Store) ListMaintenanceBySeason(season string) ([]MaintenanceItem, error) {
+	months := SeasonMonths(season)
+	monthNums := make([]int, len(months))
+	for i, m := range months {
+		monthNums[i] = int(m)
+	}
 	var items []MaintenanceItem
 	err := s.db.
-		Where(ColSeason+" = ?", season).
+		Where(ColSeason+" = ? OR "+ColMonth+" IN ?", season, monthNums).
+		Scopes(notArchivedAppliance).
 		Preload("Category").
 		Preload("Appliance", func(q *gorm.DB) *gorm.DB {
 			return q.Unscoped()
@@ -91,17 +131,28 @@ func (s *Store) ListOpenIncidents() ([]Incident, error) {
 	return incidents, err
 }
 
-// ListExpiringWarranties returns non-deleted appliances whose warranty expires
-// between (now - lookBack) and (now + horizon).
-func (s *Store) ListExpiringWarranties(
-	now time.Time,
-	lookBack, horizon time.Duration,
-) ([]Appliance, error) {
+// WarrantyWindow is which warranty expirations need attention: those that
+// expired within the last LookBackDays and those expiring within the next
+// LookAheadDays. Both count whole calendar days from today, so the time of
+// day a query runs never moves a warranty in or out.
+type WarrantyWindow struct {
+	LookBackDays  int
+	LookAheadDays int
+}
+
+// DefaultWarrantyWindow matches the dashboard.warranty_* config defaults.
+var DefaultWarrantyWindow = WarrantyWindow{LookBackDays: 30, LookAheadDays: 90}
+
+// ListExpiringWarranties returns non-deleted, unarchived appliances whose
+// warranty expires within w of now's calendar day, soonest first.
+func (s *Store) ListExpiringWarranties(now time.Time, w WarrantyWindow) ([]Appliance, error) {
 	var appliances []Appliance
-	from := now.Add(-lookBack)
-	to := now.Add(horizon)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	from := today.AddDate(0, 0, -w.LookBackDays)
+	to := today.AddDate(0, 0, w.LookAheadDays+1)
 	err := s.db.
-		Where(ColWarrantyExpiry+" IS NOT NULL AND "+ColWarrantyExpiry+" BETWEEN ? AND ?", from, to).
+		Where(ColWarrantyExpiry+" >= ? AND "+ColWarrantyExpiry+" < ?", from, to).
+		Where(ColArchivedAt + " IS NULL").
 		Order(ColWarrantyExpiry + " asc, " + ColID + " desc").
 		Find(&appliances).Error
 	return appliances, err
@@ -159,3 +210,214 @@ func (s *Store) TotalProjectSpendCents() (int64, error) {
 	}
 	return *total, nil
 }
+
+// CurrencyTotal sums the money recorded in one currency. Currency is the
+// code as stored, so "" is the house currency.
+type CurrencyTotal struct {
+	Currency       string
+	BudgetCents    int64
+	ActualCents    int64
+	ApplianceCents int64
+}
+
+// TotalsByCurrency sums project budgets, project actual costs, and
+// appliance costs across non-deleted records, grouped by the currency each
+// amount is recorded in. Amounts in different currencies are never added
+// together. Sorted by currency code, so the house currency comes first.
+func (s *Store) TotalsByCurrency() ([]CurrencyTotal, error) {
+	var projects []struct {
+		Currency string
+		Budget   int64
+		Actual   int64
+	}
+	err := s.db.Model(&Project{}).
+		Select("UPPER(TRIM(" + ColBudgetCurrency + ")) AS currency, " +
+			"COALESCE(SUM(" + ColBudgetCents + "), 0) AS budget, " +
+			"COALESCE(SUM(" + ColActualCents + "), 0) AS actual").
+		Group("UPPER(TRIM(" + ColBudgetCurrency + "))").
+		Scan(&projects).Error
+	if err != nil {
+		return nil, fmt.Errorf("sum project costs: %w", err)
+	}
+	var appliances []struct {
+		Currency string
+		Cost     int64
+	}
+	err = s.db.Model(&Appliance{}).
+		Select("UPPER(TRIM(" + ColCostCurrency + ")) AS currency, " +
+			"COALESCE(SUM(" + ColCostCents + "), 0) AS cost").
+		Group("UPPER(TRIM(" + ColCostCurrency + "))").
+		Scan(&appliances).Error
+	if err != nil {
+		return nil, fmt.Errorf("sum appliance costs: %w", err)
+	}
+
+	byCode := make(map[string]*CurrencyTotal)
+	total := func(code string) *CurrencyTotal {
+		t, ok := byCode[code]
+		if !ok {
+			t = &CurrencyTotal{Currency: code}
+			byCode[code] = t
+		}
+		return t
+	}
+	for _, p := range projects {
+		t := total(p.Currency)
+		t.BudgetCents += p.Budget
+		t.ActualCents += p.Actual
+	}
+	for _, a := range appliances {
+		total(a.Currency).ApplianceCents += a.Cost
+	}
+	totals := make([]CurrencyTotal, 0, len(byCode))
+	for _, t := range byCode {
+		if t.BudgetCents == 0 && t.ActualCents == 0 && t.ApplianceCents == 0 {
+			continue
+		}
+		totals = append(totals, *t)
+	}
+	slices.SortFunc(totals, func(a, b CurrencyTotal) int {
+		return strings.Compare(a.Currency, b.Currency)
+	})
+	return totals, nil
+}
+
+// DueSoonDays is how far ahead DueSummary looks for maintenance, matching
+// the dashboard's Upcoming section.
+const DueSoonDays = 30
+
+// DueSummary counts what needs attention: maintenance past due by more than
+// the grace period, maintenance due within DueSoonDays, and warranties that
+// expire within the warranty look-ahead. Snoozed maintenance is left out, as
+// on the dashboard.
+type DueSummary struct {
+	Overdue            int
+	DueSoon            int
+	ExpiringWarranties int
+}
+
+// IsZero reports whether nothing needs attention.
+func (d DueSummary) IsZero() bool {
+	return d == DueSummary{}
+}
+
+// String formats the non-zero counts on one line, e.g. "2 overdue, 1 due
+// soon, 1 warranty expiring". Returns "" when nothing needs attention.
+func (d DueSummary) String() string {
+	var parts []string
+	if d.Overdue > 0 {
+		parts = append(parts, fmt.Sprintf("%d overdue", d.Overdue))
+	}
+	if d.DueSoon > 0 {
+		parts = append(parts, fmt.Sprintf("%d due soon", d.DueSoon))
+	}
+	switch n := d.ExpiringWarranties; {
+	case n == 1:
+		parts = append(parts, "1 warranty expiring")
+	case n > 1:
+		parts = append(parts, fmt.Sprintf("%d warranties expiring", n))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// DueItem is a maintenance item in a DueReport with its next due date and
+// the days from now until then (negative when overdue).
+type DueItem struct {
+	Item    MaintenanceItem
+	NextDue time.Time
+	Days    int
+}
+
+// DueWarranty is an appliance in a DueReport with the days until its
+// warranty expires.
+type DueWarranty struct {
+	Appliance Appliance
+	Days      int
+}
+
+// DueReport lists what needs attention, each list soonest first.
+type DueReport struct {
+	Overdue    []DueItem
+	Upcoming   []DueItem
+	Warranties []DueWarranty
+}
+
+// Summary counts the report's entries.
+func (r DueReport) Summary() DueSummary {
+	return DueSummary{
+		Overdue:            len(r.Overdue),
+		DueSoon:            len(r.Upcoming),
+		ExpiringWarranties: len(r.Warranties),
+	}
+}
+
+// DueReport lists maintenance overdue by more than graceDays, maintenance
+// due on or before dueBy, and warranties expiring between today and
+// warrantyBy. Snoozed maintenance and archived appliances are left out, as
+// on the dashboard.
+func (s *Store) DueReport(
+	now time.Time,
+	graceDays int,
+	dueBy, warrantyBy time.Time,
+) (DueReport, error) {
+	var r DueReport
+	items, err := s.ListMaintenanceWithSchedule()
+	if err != nil {
+		return r, fmt.Errorf("load maintenance: %w", err)
+	}
+	horizon := calendarDaysUntil(now, dueBy)
+	for _, item := range items {
+		if item.SnoozedUntil != nil && calendarDaysUntil(now, *item.SnoozedUntil) > 0 {
+			continue
+		}
+		next := ComputeNextDue(item.LastServicedAt, item.Interval(), item.DueDate)
+		if next == nil {
+			continue
+		}
+		entry := DueItem{Item: item, NextDue: *next, Days: calendarDaysUntil(now, *next)}
+		switch {
+		case entry.Days < -graceDays:
+			r.Overdue = append(r.Overdue, entry)
+		case entry.Days <= horizon:
+			r.Upcoming = append(r.Upcoming, entry)
+		}
+	}
+	byDays := func(a, b DueItem) int { return cmp.Compare(a.Days, b.Days) }
+	slices.SortStableFunc(r.Overdue, byDays)
+	slices.SortStableFunc(r.Upcoming, byDays)
+
+	appliances, err := s.ListExpiringWarranties(now, WarrantyWindow{
+		LookAheadDays: calendarDaysUntil(now, warrantyBy),
+	})
+	if err != nil {
+		return r, fmt.Errorf("load warranties: %w", err)
+	}
+	for _, a := range appliances {
+		r.Warranties = append(r.Warranties, DueWarranty{
+			Appliance: a,
+			Days:      calendarDaysUntil(now, *a.WarrantyExpiry),
+		})
+	}
+	return r, nil
+}
+
+// DueSummary counts the dashboard's Overdue, Upcoming, and Expiring
+// entries as of now, looking warrantyDays ahead for warranties.
+func (s *Store) DueSummary(now time.Time, graceDays, warrantyDays int) (DueSummary, error) {
+	r, err := s.DueReport(
+		now, graceDays,
+		now.AddDate(0, 0, DueSoonDays), now.AddDate(0, 0, warrantyDays),
+	)
+	if err != nil {
+		return DueSummary{}, err
+	}
+	return r.Summary(), nil
+}
+
+// calendarDaysUntil returns the whole days from now's date to target's date,
+// ignoring the time of day.
+func calendarDaysUntil(now, target time.Time) int {
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	to := time.Date(target.Year(), target.Month(), target.Day(), 0, 0, 0, 0, time.UTC)
+	return int(math.Round(to.Sub(from).Hours() / 24))
+}
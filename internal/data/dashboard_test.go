@@ -26,6 +26,11 @@ func TestListMaintenanceWithSchedule(t *testing.T) {
 		Name: "With Interval", CategoryID: cat.ID,
 		IntervalMonths: 3, LastServicedAt: ptrTime(2025, 6, 1),
 	}).Error)
+	// Item with a day interval should appear too.
+	require.NoError(t, store.db.Create(&MaintenanceItem{
+		Name: "Every Two Weeks", CategoryID: cat.ID,
+		IntervalDays: 14, LastServicedAt: ptrTime(2025, 6, 1),
+	}).Error)
 	// Item with interval = 0 should NOT appear.
 	require.NoError(t, store.db.Create(&MaintenanceItem{
 		Name: "No Interval", CategoryID: cat.ID, IntervalMonths: 0,
@@ -33,8 +38,9 @@ func TestListMaintenanceWithSchedule(t *testing.T) {
 
 	items, err := store.ListMaintenanceWithSchedule()
 	require.NoError(t, err)
-	require.Len(t, items, 1)
-	assert.Equal(t, "With Interval", items[0].Name)
+	require.Len(t, items, 2)
+	names := []string{items[0].Name, items[1].Name}
+	assert.ElementsMatch(t, []string{"With Interval", "Every Two Weeks"}, names)
 }
 
 func TestListMaintenanceWithScheduleDueDate(t *testing.T) {
@@ -85,6 +91,40 @@ func TestListMaintenanceBySeason(t *testing.T) {
 	assert.Equal(t, "Spring Item", items[0].Name)
 }
 
+func TestListMaintenanceBySeasonIncludesMonthTags(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	cat := MaintenanceCategory{Name: "MonthCat"}
+	require.NoError(t, store.db.Create(&cat).Error)
+
+	require.NoError(t, store.db.Create(&MaintenanceItem{
+		Name: "Open pool", CategoryID: cat.ID, Month: int(time.May),
+	}).Error)
+	require.NoError(t, store.db.Create(&MaintenanceItem{
+		Name: "Close pool", CategoryID: cat.ID, Month: int(time.September),
+	}).Error)
+	deleted := MaintenanceItem{Name: "Old task", CategoryID: cat.ID, Month: int(time.April)}
+	require.NoError(t, store.db.Create(&deleted).Error)
+	require.NoError(t, store.DeleteMaintenance(deleted.ID))
+
+	items, err := store.ListMaintenanceBySeason(SeasonSpring)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "Open pool", items[0].Name)
+}
+
+func TestSeasonMonths(t *testing.T) {
+	t.Parallel()
+	for _, season := range []string{SeasonSpring, SeasonSummer, SeasonFall, SeasonWinter} {
+		months := SeasonMonths(season)
+		require.Len(t, months, 3, season)
+		for _, m := range months {
+			assert.Equal(t, season, SeasonForMonth(m))
+		}
+	}
+	assert.Empty(t, SeasonMonths("monsoon"))
+}
+
 func TestListMaintenanceBySeasonExcludesDeleted(t *testing.T) {
 	t.Parallel()
 	store := newTestStore(t)
@@ -231,11 +271,87 @@ func TestListExpiringWarranties(t *testing.T) {
 	// No warranty -- should NOT appear.
 	require.NoError(t, store.db.Create(&Appliance{Name: "None"}).Error)
 
-	apps, err := store.ListExpiringWarranties(now, 30*24*time.Hour, 90*24*time.Hour)
+	apps, err := store.ListExpiringWarranties(now, DefaultWarrantyWindow)
 	require.NoError(t, err)
 	require.Len(t, apps, 2)
 }
 
+func TestArchivedAppliancesLeaveDashboard(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	now := time.Date(2026, 2, 8, 0, 0, 0, 0, time.UTC)
+	soon := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	cat := MaintenanceCategory{Name: "ArchiveCat"}
+	require.NoError(t, store.db.Create(&cat).Error)
+
+	kept := Appliance{Name: "Kept", WarrantyExpiry: &soon}
+	gone := Appliance{Name: "Gone", WarrantyExpiry: &soon}
+	require.NoError(t, store.CreateAppliance(&kept))
+	require.NoError(t, store.CreateAppliance(&gone))
+	for _, a := range []Appliance{kept, gone} {
+		require.NoError(t, store.db.Create(&MaintenanceItem{
+			Name: a.Name + " Filter", CategoryID: cat.ID, ApplianceID: &a.ID,
+			IntervalMonths: 3, Season: SeasonSpring,
+		}).Error)
+	}
+	require.NoError(t, store.db.Create(&MaintenanceItem{
+		Name: "Gutters", CategoryID: cat.ID, IntervalMonths: 6, Season: SeasonSpring,
+	}).Error)
+	require.NoError(t, store.ArchiveAppliance(gone.ID, &now))
+
+	warranties, err := store.ListExpiringWarranties(now, DefaultWarrantyWindow)
+	require.NoError(t, err)
+	require.Len(t, warranties, 1)
+	assert.Equal(t, "Kept", warranties[0].Name)
+
+	names := func(items []MaintenanceItem) []string {
+		out := make([]string, len(items))
+		for i, item := range items {
+			out[i] = item.Name
+		}
+		return out
+	}
+	scheduled, err := store.ListMaintenanceWithSchedule()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"Kept Filter", "Gutters"}, names(scheduled))
+	seasonal, err := store.ListMaintenanceBySeason(SeasonSpring)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"Kept Filter", "Gutters"}, names(seasonal))
+
+	all, err := store.ListMaintenance(false)
+	require.NoError(t, err)
+	assert.Len(t, all, 3, "archived appliances keep their maintenance")
+}
+
+func TestListExpiringWarrantiesRoundsToDays(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	// Late in the day: the window edges still count whole days.
+	now := time.Date(2026, 2, 8, 22, 30, 0, 0, time.UTC)
+	day := func(offset int) *time.Time {
+		d := time.Date(2026, 2, 8+offset, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+	for name, offset := range map[string]int{
+		"Back edge": -10, "Too old": -11, "Today": 0, "Front edge": 20, "Too far": 21,
+	} {
+		require.NoError(t, store.db.Create(&Appliance{Name: name, WarrantyExpiry: day(offset)}).Error)
+	}
+
+	apps, err := store.ListExpiringWarranties(now, WarrantyWindow{LookBackDays: 10, LookAheadDays: 20})
+	require.NoError(t, err)
+	var names []string
+	for _, a := range apps {
+		names = append(names, a.Name)
+	}
+	assert.Equal(t, []string{"Back edge", "Today", "Front edge"}, names)
+
+	apps, err = store.ListExpiringWarranties(now, WarrantyWindow{})
+	require.NoError(t, err)
+	require.Len(t, apps, 1, "a zero window is just today")
+	assert.Equal(t, "Today", apps[0].Name)
+}
+
 func TestListRecentServiceLogs(t *testing.T) {
 	t.Parallel()
 	store := newTestStore(t)
@@ -347,3 +463,133 @@ func TestTotalProjectSpendUnaffectedByEdits(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, spend1, spend2, "editing a project must not change the spending total")
 }
+
+func TestTotalsByCurrency(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	ptr := func(v int64) *int64 { return &v }
+
+	var pt ProjectType
+	require.NoError(t, store.db.First(&pt).Error)
+	for _, p := range []Project{
+		{Title: "Deck", BudgetCents: ptr(100000), ActualCents: ptr(90000)},
+		{Title: "Roof", BudgetCents: ptr(50000)},
+		{Title: "Villa", BudgetCents: ptr(20000), BudgetCurrency: "eur"},
+		{Title: "Gone", BudgetCents: ptr(99999), BudgetCurrency: "GBP"},
+	} {
+		p.ProjectTypeID = pt.ID
+		require.NoError(t, store.db.Create(&p).Error)
+	}
+	require.NoError(t, store.db.Where(ColTitle+" = ?", "Gone").Delete(&Project{}).Error)
+	for _, a := range []Appliance{
+		{Name: "Fridge", CostCents: ptr(150000)},
+		{Name: "Kettle", CostCents: ptr(4000), CostCurrency: "EUR"},
+		{Name: "Toaster", CostCents: ptr(3000), CostCurrency: "JPY"},
+		{Name: "Fan"},
+	} {
+		require.NoError(t, store.db.Create(&a).Error)
+	}
+
+	totals, err := store.TotalsByCurrency()
+	require.NoError(t, err)
+	assert.Equal(t, []CurrencyTotal{
+		{Currency: "", BudgetCents: 150000, ActualCents: 90000, ApplianceCents: 150000},
+		{Currency: "EUR", BudgetCents: 20000, ApplianceCents: 4000},
+		{Currency: "JPY", ApplianceCents: 3000},
+	}, totals, "deleted projects and codes with nothing recorded are left out")
+}
+
+func TestDueSummary(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	cat := MaintenanceCategory{Name: "DueCat"}
+	require.NoError(t, store.db.Create(&cat).Error)
+	now := time.Date(2026, 6, 15, 10, 0, 0, 0, time.UTC)
+	day := func(offset int) *time.Time {
+		d := now.AddDate(0, 0, offset)
+		return &d
+	}
+
+	for _, item := range []MaintenanceItem{
+		{Name: "Late", DueDate: day(-10)},
+		{Name: "Barely late", DueDate: day(-2)},
+		{Name: "Soon", DueDate: day(5)},
+		{Name: "Interval soon", LastServicedAt: day(-340), IntervalMonths: 12},
+		{Name: "Later", DueDate: day(60)},
+		{Name: "Snoozed", DueDate: day(-30), SnoozedUntil: day(3)},
+	} {
+		item.CategoryID = cat.ID
+		require.NoError(t, store.db.Create(&item).Error)
+	}
+	for _, a := range []Appliance{
+		{Name: "Expiring", WarrantyExpiry: day(20)},
+		{Name: "Today", WarrantyExpiry: day(0)},
+		{Name: "Expired", WarrantyExpiry: day(-5)},
+		{Name: "Far", WarrantyExpiry: day(200)},
+	} {
+		require.NoError(t, store.db.Create(&a).Error)
+	}
+
+	sum, err := store.DueSummary(now, 0, 90)
+	require.NoError(t, err)
+	assert.Equal(t, DueSummary{Overdue: 2, DueSoon: 2, ExpiringWarranties: 2}, sum)
+	assert.Equal(t, "2 overdue, 2 due soon, 2 warranties expiring", sum.String())
+
+	sum, err = store.DueSummary(now, 3, 90)
+	require.NoError(t, err)
+	assert.Equal(t, 1, sum.Overdue, "the grace period keeps barely-late items upcoming")
+	assert.Equal(t, 3, sum.DueSoon)
+}
+
+func TestDueReport(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	cat := MaintenanceCategory{Name: "ReportCat"}
+	require.NoError(t, store.db.Create(&cat).Error)
+	now := time.Date(2026, 6, 15, 10, 0, 0, 0, time.UTC)
+	day := func(offset int) *time.Time {
+		d := now.AddDate(0, 0, offset)
+		return &d
+	}
+	for _, item := range []MaintenanceItem{
+		{Name: "Later", DueDate: day(20)},
+		{Name: "Sooner", DueDate: day(3)},
+		{Name: "Very late", DueDate: day(-40)},
+		{Name: "Late", DueDate: day(-4)},
+	} {
+		item.CategoryID = cat.ID
+		require.NoError(t, store.db.Create(&item).Error)
+	}
+	require.NoError(t, store.db.Create(&Appliance{Name: "Fridge", WarrantyExpiry: day(10)}).Error)
+
+	r, err := store.DueReport(now, 0, now.AddDate(0, 0, 7), now.AddDate(0, 0, 7))
+	require.NoError(t, err)
+	names := func(ds []DueItem) []string {
+		var out []string
+		for _, d := range ds {
+			out = append(out, d.Item.Name)
+		}
+		return out
+	}
+	assert.Equal(t, []string{"Very late", "Late"}, names(r.Overdue), "most overdue first")
+	assert.Equal(t, -40, r.Overdue[0].Days)
+	assert.Equal(t, []string{"Sooner"}, names(r.Upcoming))
+	assert.Equal(t, 3, r.Upcoming[0].Days)
+	assert.Empty(t, r.Warranties, "outside the window")
+
+	r, err = store.DueReport(now, 0, now.AddDate(0, 0, 30), now.AddDate(0, 0, 30))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Sooner", "Later"}, names(r.Upcoming))
+	require.Len(t, r.Warranties, 1)
+	assert.Equal(t, "Fridge", r.Warranties[0].Appliance.Name)
+	assert.Equal(t, 10, r.Warranties[0].Days)
+	assert.Equal(t, DueSummary{Overdue: 2, DueSoon: 2, ExpiringWarranties: 1}, r.Summary())
+}
+
+func TestDueSummaryString(t *testing.T) {
+	t.Parallel()
+	assert.Empty(t, DueSummary{}.String())
+	assert.True(t, DueSummary{}.IsZero())
+	assert.Equal(t, "1 due soon, 1 warranty expiring",
+		DueSummary{DueSoon: 1, ExpiringWarranties: 1}.String())
+}
@@ -11,7 +11,7 @@ import (
 	"time"
 )
 
-// ExtractDocument writes the document's BLOB content to the XDG cache
+// ExtractDocument writes the document's content to the XDG cache
 // directory and returns the resulting filesystem path. If the cached file
 // already exists and has the expected size, the extraction is skipped.
 func (s *Store) ExtractDocument(id string) (string, error) {
@@ -21,7 +21,11 @@ func (s *Store) ExtractDocument(id string) (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("load document content: %w", err)
 	}
-	if len(doc.Data) == 0 {
+	content, err := s.documentBlob(doc)
+	if err != nil {
+		return "", err
+	}
+	if len(content) == 0 {
 		return "", errors.New("document has no content")
 	}
 
@@ -61,7 +65,7 @@ func (s *Store) ExtractDocument(id string) (string, error) {
 		}
 	}()
 
-	if _, err := tmp.Write(doc.Data); err != nil {
+	if _, err := tmp.Write(content); err != nil {
 		_ = tmp.Close()
 		return "", fmt.Errorf("write temp cache file: %w", err)
 	}
@@ -5,10 +5,96 @@ package data
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
 
+	"github.com/micasa-dev/micasa/internal/data/sqlite"
 	"github.com/micasa-dev/micasa/internal/locale"
+	"gorm.io/gorm"
 )
 
+// Sentinel errors for failures callers are expected to handle. Store
+// methods return errors that match these via errors.Is while keeping the
+// underlying gorm or driver error in the chain.
+var (
+	// ErrNotFound indicates the requested record does not exist.
+	ErrNotFound = errors.New("record not found")
+	// ErrDuplicate indicates a unique or primary key constraint was violated.
+	ErrDuplicate = errors.New("duplicate record")
+	// ErrFKConstraint indicates a foreign key constraint was violated.
+	ErrFKConstraint = errors.New("foreign key constraint violated")
+	// ErrDBLocked indicates another process held the database past the busy
+	// timeout.
+	ErrDBLocked = errors.New("database is locked")
+)
+
+// classifiedError tags a driver or gorm error with one of the sentinels
+// above. The message and chain of the original error are unchanged.
+type classifiedError struct {
+	kind error
+	err  error
+}
+
+func (e *classifiedError) Error() string        { return e.err.Error() }
+func (e *classifiedError) Unwrap() error        { return e.err }
+func (e *classifiedError) Is(target error) bool { return target == e.kind }
+
+// errRecordNotFound is gorm.ErrRecordNotFound classified as ErrNotFound, for
+// code paths that report a missing row without running a failing query.
+var errRecordNotFound error = &classifiedError{kind: ErrNotFound, err: gorm.ErrRecordNotFound}
+
+// classifyError tags err with the sentinel it corresponds to, if any.
+// Errors that are already classified, and errors that match no sentinel,
+// are returned unchanged.
+func classifyError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var ce *classifiedError
+	if errors.As(err, &ce) {
+		return err
+	}
+	var kind error
+	switch translated := (sqlite.Dialector{}).Translate(err); {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		kind = ErrNotFound
+	case errors.Is(translated, gorm.ErrDuplicatedKey), errors.Is(err, gorm.ErrDuplicatedKey):
+		kind = ErrDuplicate
+	case errors.Is(translated, gorm.ErrForeignKeyViolated),
+		errors.Is(err, gorm.ErrForeignKeyViolated):
+		kind = ErrFKConstraint
+	case sqlite.IsBusy(err):
+		kind = ErrDBLocked
+	default:
+		return err
+	}
+	return &classifiedError{kind: kind, err: err}
+}
+
+// registerErrorClassifier installs a gorm callback that runs classifyError
+// on the result of every statement, so all Store methods report the
+// sentinels without classifying at each call site.
+func registerErrorClassifier(db *gorm.DB) error {
+	classify := func(tx *gorm.DB) {
+		tx.Error = classifyError(tx.Error)
+	}
+	cb := db.Callback()
+	const name = "micasa:classify_error"
+	for _, err := range []error{
+		cb.Create().After("*").Register(name, classify),
+		cb.Query().After("*").Register(name, classify),
+		cb.Update().After("*").Register(name, classify),
+		cb.Delete().After("*").Register(name, classify),
+		cb.Row().After("*").Register(name, classify),
+		cb.Raw().After("*").Register(name, classify),
+	} {
+		if err != nil {
+			return fmt.Errorf("register error classifier: %w", err)
+		}
+	}
+	return nil
+}
+
 // hintError wraps an error with a user-facing hint message.
 // The hint becomes the error's string representation so that
 // setStatusError(err.Error()) displays actionable text.
@@ -43,6 +129,7 @@ func Hint(err error) string {
 // FieldError wraps a validation sentinel error with a field-specific,
 // user-friendly message. The sentinel is preserved in the error chain.
 func FieldError(label string, err error) error {
+	var stepErr *StepError
 	switch {
 	case errors.Is(err, locale.ErrNegativeMoney):
 		return WithHint(err, label+" must be a positive amount")
@@ -54,8 +141,14 @@ func FieldError(label string, err error) error {
 		return WithHint(err, label+" should be a whole number")
 	case errors.Is(err, ErrInvalidFloat):
 		return WithHint(err, label+" should be a number like 2.5")
+	case errors.As(err, &stepErr):
+		step := strconv.FormatFloat(stepErr.Step, 'f', -1, 64)
+		example := strconv.FormatFloat(2+stepErr.Step, 'f', -1, 64)
+		return WithHint(err, label+" should be in steps of "+step+", like 2 or "+example)
 	case errors.Is(err, ErrInvalidInterval):
 		return WithHint(err, label+" should be months (6), or a duration like 6m, 1y, 2y 6m")
+	case errors.Is(err, ErrInvalidScheduleInterval):
+		return WithHint(err, label+" should be a number, or a duration like 10d, 2w, 6m, 1y 6m")
 	case errors.Is(err, ErrIntervalAndDueDate):
 		return WithHint(err, err.Error())
 	default:
@@ -11,6 +11,7 @@ import (
 	"github.com/micasa-dev/micasa/internal/locale"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 )
 
 func TestWithHintNil(t *testing.T) {
@@ -98,3 +99,49 @@ func TestFieldErrorUnknownSentinel(t *testing.T) {
 	assert.Equal(t, "Field: something unusual", result.Error())
 	assert.ErrorIs(t, result, custom)
 }
+
+func TestClassifyErrorGormSentinels(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		err  error
+		want error
+	}{
+		{gorm.ErrRecordNotFound, ErrNotFound},
+		{fmt.Errorf("get: %w", gorm.ErrRecordNotFound), ErrNotFound},
+		{gorm.ErrDuplicatedKey, ErrDuplicate},
+		{gorm.ErrForeignKeyViolated, ErrFKConstraint},
+	}
+	for _, tt := range tests {
+		got := classifyError(tt.err)
+		require.ErrorIs(t, got, tt.want, tt.err.Error())
+		require.ErrorIs(t, got, tt.err, "original error stays in the chain")
+		assert.Equal(t, tt.err.Error(), got.Error(), "message is unchanged")
+	}
+}
+
+func TestClassifyErrorPassThrough(t *testing.T) {
+	t.Parallel()
+	assert.NoError(t, classifyError(nil))
+	plain := errors.New("something else")
+	assert.Same(t, plain, classifyError(plain))
+	assert.Same(t, errRecordNotFound, classifyError(errRecordNotFound))
+}
+
+func TestStoreErrorsAreClassified(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+
+	_, err := store.GetVendor("missing")
+	require.ErrorIs(t, err, ErrNotFound)
+	require.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+	require.ErrorIs(t, store.DeleteVendor("missing"), ErrNotFound)
+
+	v := Vendor{Name: "Acme"}
+	require.NoError(t, store.CreateVendor(&v))
+	err = store.db.Create(&Vendor{ID: v.ID, Name: "Other"}).Error
+	require.ErrorIs(t, err, ErrDuplicate)
+
+	err = store.db.Create(&ServiceLogEntry{MaintenanceItemID: "missing"}).Error
+	require.ErrorIs(t, err, ErrFKConstraint)
+}
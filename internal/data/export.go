@@ -0,0 +1,210 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package data
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Export table names, also the top-level JSON keys and CSV file names.
+const (
+	ExportHouse                 = "house"
+	ExportProjectTypes          = "project_types"
+	ExportMaintenanceCategories = "maintenance_categories"
+	ExportProjects              = "projects"
+	ExportQuotes                = "quotes"
+	ExportMaintenance           = "maintenance"
+	ExportAppliances            = "appliances"
+	ExportVendors               = "vendors"
+	ExportServiceLog            = "service_log"
+)
+
+// colDeletedAt is the extra column exported with soft-deleted rows.
+const colDeletedAt = "deleted_at"
+
+// ExportTable is one entity's rows for `micasa export`. Columns are the
+// model's JSON field names; values keep their Go types, so money stays in
+// integer cents and nullable fields are nil.
+type ExportTable struct {
+	Name    string
+	Columns []string
+	Rows    [][]any
+}
+
+// Objects returns each row as a map from column to value, for JSON.
+func (t ExportTable) Objects() []map[string]any {
+	out := make([]map[string]any, len(t.Rows))
+	for i, row := range t.Rows {
+		obj := make(map[string]any, len(t.Columns))
+		for j, col := range t.Columns {
+			obj[col] = row[j]
+		}
+		out[i] = obj
+	}
+	return out
+}
+
+// Records returns the header and the rows formatted as CSV fields. Nil
+// values are empty and times are RFC 3339, so nothing is lost.
+func (t ExportTable) Records() [][]string {
+	out := make([][]string, 0, len(t.Rows)+1)
+	out = append(out, t.Columns)
+	for _, row := range t.Rows {
+		rec := make([]string, len(row))
+		for j, v := range row {
+			rec[j] = exportField(v)
+		}
+		out = append(out, rec)
+	}
+	return out
+}
+
+// Export reads the house profile, project types, maintenance categories,
+// projects, quotes, maintenance items, appliances, vendors, and service
+// log, in that order. The lookup tables carry the names that ImportBundle
+// matches their IDs by. With includeDeleted, soft-deleted rows are
+// included and every table with soft deletes gets a deleted_at column.
+func (s *Store) Export(includeDeleted bool) ([]ExportTable, error) {
+	var houses []HouseProfile
+	h, err := s.HouseProfile()
+	switch {
+	case err == nil:
+		houses = append(houses, h)
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, fmt.Errorf("load house profile: %w", err)
+	}
+	tables := []ExportTable{exportRows(ExportHouse, houses, false, nil)}
+
+	types, err := s.ProjectTypes()
+	if err != nil {
+		return nil, fmt.Errorf("list project types: %w", err)
+	}
+	tables = append(tables, exportRows(ExportProjectTypes, types, false, nil))
+
+	categories, err := s.MaintenanceCategories()
+	if err != nil {
+		return nil, fmt.Errorf("list maintenance categories: %w", err)
+	}
+	tables = append(tables, exportRows(ExportMaintenanceCategories, categories, false, nil))
+
+	projects, err := s.ListProjects(includeDeleted)
+	if err != nil {
+		return nil, fmt.Errorf("list projects: %w", err)
+	}
+	tables = append(tables, exportRows(ExportProjects, projects, includeDeleted,
+		func(p Project) gorm.DeletedAt { return p.DeletedAt }))
+
+	quotes, err := s.ListQuotes(includeDeleted)
+	if err != nil {
+		return nil, fmt.Errorf("list quotes: %w", err)
+	}
+	tables = append(tables, exportRows(ExportQuotes, quotes, includeDeleted,
+		func(q Quote) gorm.DeletedAt { return q.DeletedAt }))
+
+	maintenance, err := s.ListMaintenance(includeDeleted)
+	if err != nil {
+		return nil, fmt.Errorf("list maintenance: %w", err)
+	}
+	tables = append(tables, exportRows(ExportMaintenance, maintenance, includeDeleted,
+		func(m MaintenanceItem) gorm.DeletedAt { return m.DeletedAt }))
+
+	appliances, err := s.ListAppliances(includeDeleted)
+	if err != nil {
+		return nil, fmt.Errorf("list appliances: %w", err)
+	}
+	tables = append(tables, exportRows(ExportAppliances, appliances, includeDeleted,
+		func(a Appliance) gorm.DeletedAt { return a.DeletedAt }))
+
+	vendors, err := s.ListVendors(includeDeleted)
+	if err != nil {
+		return nil, fmt.Errorf("list vendors: %w", err)
+	}
+	tables = append(tables, exportRows(ExportVendors, vendors, includeDeleted,
+		func(v Vendor) gorm.DeletedAt { return v.DeletedAt }))
+
+	serviceLog, err := s.ListAllServiceLogEntries(includeDeleted)
+	if err != nil {
+		return nil, fmt.Errorf("list service log: %w", err)
+	}
+	tables = append(tables, exportRows(ExportServiceLog, serviceLog, includeDeleted,
+		func(e ServiceLogEntry) gorm.DeletedAt { return e.DeletedAt }))
+
+	return tables, nil
+}
+
+// exportRows builds a table from the JSON-tagged fields of T, skipping
+// fields tagged "-" (relations, documents, and the soft-delete marker).
+// With withDeleted, a deleted_at column is added from deletedAt.
+func exportRows[T any](
+	name string,
+	items []T,
+	withDeleted bool,
+	deletedAt func(T) gorm.DeletedAt,
+) ExportTable {
+	rt := reflect.TypeFor[T]()
+	var fields []int
+	t := ExportTable{Name: name}
+	for i := range rt.NumField() {
+		tag, _, _ := strings.Cut(rt.Field(i).Tag.Get("json"), ",")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		fields = append(fields, i)
+		t.Columns = append(t.Columns, tag)
+	}
+	if withDeleted {
+		t.Columns = append(t.Columns, colDeletedAt)
+	}
+	t.Rows = make([][]any, len(items))
+	for r, item := range items {
+		rv := reflect.ValueOf(item)
+		row := make([]any, 0, len(t.Columns))
+		for _, i := range fields {
+			f := rv.Field(i)
+			if f.Kind() == reflect.Pointer {
+				if f.IsNil() {
+					row = append(row, nil)
+					continue
+				}
+				f = f.Elem()
+			}
+			row = append(row, f.Interface())
+		}
+		if withDeleted {
+			var v any
+			if da := deletedAt(item); da.Valid {
+				v = da.Time
+			}
+			row = append(row, v)
+		}
+		t.Rows[r] = row
+	}
+	return t
+}
+
+// exportField formats one value as a CSV field.
+func exportField(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case int:
+		return strconv.Itoa(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package data
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	types, err := store.ProjectTypes()
+	require.NoError(t, err)
+	budget := int64(1234567)
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	keep := Project{
+		Title: "Deck", ProjectTypeID: types[0].ID, Status: ProjectStatusPlanned,
+		BudgetCents: &budget, StartDate: &start,
+	}
+	require.NoError(t, store.CreateProject(&keep))
+	gone := Project{Title: "Shed", ProjectTypeID: types[0].ID, Status: ProjectStatusPlanned}
+	require.NoError(t, store.CreateProject(&gone))
+	require.NoError(t, store.DeleteProject(gone.ID))
+
+	tables, err := store.Export(false)
+	require.NoError(t, err)
+	names := make([]string, len(tables))
+	for i, tbl := range tables {
+		names[i] = tbl.Name
+	}
+	assert.Equal(t, []string{
+		ExportHouse, ExportProjectTypes, ExportMaintenanceCategories,
+		ExportProjects, ExportQuotes, ExportMaintenance,
+		ExportAppliances, ExportVendors, ExportServiceLog,
+	}, names)
+	assert.Empty(t, tables[0].Rows, "no house profile yet")
+	assert.Len(t, tables[1].Rows, len(types))
+
+	projects := tables[3]
+	assert.NotContains(t, projects.Columns, "deleted_at")
+	require.Len(t, projects.Rows, 1, "deleted rows are left out")
+	obj := projects.Objects()[0]
+	assert.Equal(t, "Deck", obj["title"])
+	assert.Equal(t, budget, obj["budget_cents"], "money stays in cents")
+	assert.Nil(t, obj["actual_cents"])
+
+	records := projects.Records()
+	col := slices.Index(records[0], "budget_cents")
+	require.GreaterOrEqual(t, col, 0)
+	assert.Equal(t, "1234567", records[1][col])
+	col = slices.Index(records[0], "start_date")
+	assert.Equal(t, "2026-03-01T00:00:00Z", records[1][col])
+	col = slices.Index(records[0], "actual_cents")
+	assert.Empty(t, records[1][col])
+
+	tables, err = store.Export(true)
+	require.NoError(t, err)
+	projects = tables[3]
+	assert.Contains(t, projects.Columns, "deleted_at")
+	require.Len(t, projects.Rows, 2)
+	deleted := map[string]bool{}
+	for _, o := range projects.Objects() {
+		deleted[o["title"].(string)] = o["deleted_at"] != nil
+	}
+	assert.Equal(t, map[string]bool{"Deck": false, "Shed": true}, deleted)
+}
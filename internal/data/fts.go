@@ -29,9 +29,15 @@ type DocumentSearchResult struct {
 }
 
 // setupFTS creates the FTS5 virtual table and sync triggers if they do not
-// already exist, then rebuilds the index to catch any documents that were
-// created before FTS was added.
-func (s *Store) setupFTS() error {
+// already exist. The triggers keep the index current one row at a time, so
+// the full index is only rebuilt when the table is new (to catch documents
+// created before FTS was added) or when rebuild is set, e.g. after a schema
+// migration that may have changed what the triggers index.
+func (s *Store) setupFTS(rebuild bool) error {
+	if !s.hasFTSTable() {
+		rebuild = true
+	}
+
 	// Create the external-content FTS5 virtual table. Porter stemmer
 	// enables "plumbing" matching "plumber"; unicode61 handles case
 	// folding and diacritics.
@@ -98,14 +104,12 @@ func (s *Store) setupFTS() error {
 		}
 	}
 
-	// Rebuild to index any documents created before FTS was set up.
-	rebuild := fmt.Sprintf(
-		`INSERT INTO %s(%s) VALUES('rebuild')`, tableFTS, tableFTS,
-	)
-	if err := s.db.Exec(rebuild).Error; err != nil {
+	if !rebuild {
+		return nil
+	}
+	if err := s.RebuildFTSIndex(); err != nil {
 		return fmt.Errorf("rebuild FTS index: %w", err)
 	}
-
 	return nil
 }
 
@@ -113,6 +117,13 @@ func (s *Store) setupFTS() error {
 // and extracted text. Returns results ranked by BM25 relevance with text
 // snippets showing matched context. Only non-deleted documents are returned.
 func (s *Store) SearchDocuments(query string) ([]DocumentSearchResult, error) {
+	return s.SearchDocumentsOfKind(query, DocumentEntityNone)
+}
+
+// SearchDocumentsOfKind is SearchDocuments restricted to documents linked
+// to entities of the given kind (e.g. DocumentEntityAppliance). An empty
+// kind searches all documents.
+func (s *Store) SearchDocumentsOfKind(query, entityKind string) ([]DocumentSearchResult, error) {
 	query = strings.TrimSpace(query)
 	if query == "" {
 		return nil, nil
@@ -140,9 +151,10 @@ func (s *Store) SearchDocuments(query string) ([]DocumentSearchResult, error) {
 		JOIN %s d ON d.rowid = %s.rowid
 		WHERE %s MATCH ?
 			AND d.deleted_at IS NULL
+			AND (? = '' OR d.entity_kind = ?)
 		ORDER BY rank
 		LIMIT 50
-	`, tableFTS, tableFTS, TableDocuments, tableFTS, tableFTS), safeQuery).
+	`, tableFTS, tableFTS, TableDocuments, tableFTS, tableFTS), safeQuery, entityKind, entityKind).
 		Scan(&results).Error
 	if err != nil {
 		// FTS syntax errors should not crash the app. Return empty
@@ -4,6 +4,7 @@
 package data
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -200,6 +201,35 @@ func TestSearchDocumentsEntityFields(t *testing.T) {
 	assert.Equal(t, "01JTEST00000000000000042", results[0].EntityID)
 }
 
+func TestSearchDocumentsOfKind(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+
+	require.NoError(t, store.CreateDocument(&Document{
+		Title:         "Fridge Manual",
+		FileName:      "fridge.pdf",
+		EntityKind:    DocumentEntityAppliance,
+		EntityID:      "01JTEST00000000000000001",
+		ExtractedText: "kitchen refrigerator care",
+	}))
+	require.NoError(t, store.CreateDocument(&Document{
+		Title:         "Remodel Contract",
+		FileName:      "contract.pdf",
+		EntityKind:    DocumentEntityProject,
+		EntityID:      "01JTEST00000000000000002",
+		ExtractedText: "kitchen remodel scope",
+	}))
+
+	results, err := store.SearchDocumentsOfKind("kitchen", DocumentEntityAppliance)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "Fridge Manual", results[0].Title)
+
+	results, err = store.SearchDocumentsOfKind("kitchen", DocumentEntityNone)
+	require.NoError(t, err)
+	assert.Len(t, results, 2, "empty kind searches everything")
+}
+
 func TestSearchDocumentsSnippetFromBestColumn(t *testing.T) {
 	t.Parallel()
 	store := newTestStore(t)
@@ -277,3 +307,38 @@ func TestHasFTSTable(t *testing.T) {
 	store := newTestStore(t)
 	assert.True(t, store.hasFTSTable())
 }
+
+func TestAutoMigrateRebuildsFTSOnlyWhenNeeded(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	require.NoError(t, store.CreateDocument(&Document{
+		Title:         "Boiler Service",
+		FileName:      "boiler.pdf",
+		ExtractedText: "annual flue inspection",
+	}))
+
+	// Empty the index behind the triggers' back so a rebuild is observable.
+	clearIndex := func() {
+		require.NoError(t, store.db.Exec(fmt.Sprintf(
+			`INSERT INTO %s(%s) VALUES('delete-all')`, tableFTS, tableFTS,
+		)).Error)
+	}
+	search := func() []DocumentSearchResult {
+		results, err := store.SearchDocuments("flue")
+		require.NoError(t, err)
+		return results
+	}
+
+	clearIndex()
+	require.NoError(t, store.AutoMigrate())
+	assert.Empty(t, search(), "a routine open leaves the index to the triggers")
+
+	require.NoError(t, store.setSchemaVersion(0))
+	require.NoError(t, store.AutoMigrate())
+	assert.Len(t, search(), 1, "a schema migration rebuilds the index")
+
+	clearIndex()
+	require.NoError(t, store.db.Exec("DROP TABLE "+tableFTS).Error)
+	require.NoError(t, store.AutoMigrate())
+	assert.Len(t, search(), 1, "a recreated index is rebuilt")
+}
@@ -0,0 +1,357 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package data
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Bundle is the JSON written by `micasa export`.
+type Bundle struct {
+	House                 *HouseProfile                    `json:"house"`
+	ProjectTypes          []BundleRow[ProjectType]         `json:"project_types"`
+	MaintenanceCategories []BundleRow[MaintenanceCategory] `json:"maintenance_categories"`
+	Projects              []BundleRow[Project]             `json:"projects"`
+	Quotes                []BundleRow[Quote]               `json:"quotes"`
+	Maintenance           []BundleRow[MaintenanceItem]     `json:"maintenance"`
+	Appliances            []BundleRow[Appliance]           `json:"appliances"`
+	Vendors               []BundleRow[Vendor]              `json:"vendors"`
+	ServiceLog            []BundleRow[ServiceLogEntry]     `json:"service_log"`
+}
+
+// BundleRow is one exported record and, for exports made with
+// --include-deleted, when it was deleted. The models leave deleted_at out
+// of their JSON, so it's read separately.
+type BundleRow[T any] struct {
+	Record    T
+	DeletedAt *time.Time
+}
+
+func (r *BundleRow[T]) UnmarshalJSON(b []byte) error {
+	if err := json.Unmarshal(b, &r.Record); err != nil {
+		return err
+	}
+	var extra struct {
+		DeletedAt *time.Time `json:"deleted_at"`
+	}
+	if err := json.Unmarshal(b, &extra); err != nil {
+		return err
+	}
+	r.DeletedAt = extra.DeletedAt
+	return nil
+}
+
+// ImportCount is what ImportBundle did with one table's rows.
+type ImportCount struct {
+	Table    string
+	Rows     int // rows in the bundle
+	New      int // inserted
+	Matched  int // linked by name to an existing record instead
+	Replaced int // overwrote the record with the same ID
+	Skipped  int // left the record with the same ID alone
+}
+
+// ImportBundle inserts an exported bundle in one transaction. Rows whose
+// ID already exists are skipped, or overwritten with replace. Project
+// types, maintenance categories, vendors, appliances, and projects with a
+// new ID but the name (or title) of an existing record are linked to that
+// record rather than duplicated. Foreign keys follow: a quote whose vendor
+// was matched points at the existing vendor. A foreign key that's neither
+// in the bundle nor the database is an error, and nothing is imported.
+func (s *Store) ImportBundle(b Bundle, replace bool) ([]ImportCount, error) {
+	var counts []ImportCount
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		imp := &bundleImport{tx: tx, replace: replace, ids: make(map[string]map[string]string)}
+		steps := []func() (ImportCount, error){
+			func() (ImportCount, error) {
+				return importRows(imp, TableProjectTypes, b.ProjectTypes,
+					func(t *ProjectType) *string { return &t.ID },
+					matchBy(imp, TableProjectTypes, ColName, func(t ProjectType) string { return t.Name }),
+					nil)
+			},
+			func() (ImportCount, error) {
+				return importRows(imp, TableMaintenanceCategories, b.MaintenanceCategories,
+					func(c *MaintenanceCategory) *string { return &c.ID },
+					matchBy(imp, TableMaintenanceCategories, ColName,
+						func(c MaintenanceCategory) string { return c.Name }),
+					nil)
+			},
+			func() (ImportCount, error) {
+				return importRows(imp, TableVendors, b.Vendors,
+					func(v *Vendor) *string { return &v.ID },
+					matchBy(imp, TableVendors, ColName, func(v Vendor) string { return v.Name }),
+					nil)
+			},
+			func() (ImportCount, error) {
+				return importRows(imp, TableAppliances, b.Appliances,
+					func(a *Appliance) *string { return &a.ID },
+					matchActiveBy(imp, TableAppliances, ColName, func(a Appliance) string { return a.Name }),
+					nil)
+			},
+			func() (ImportCount, error) {
+				return importRows(imp, TableProjects, b.Projects,
+					func(p *Project) *string { return &p.ID },
+					matchActiveBy(imp, TableProjects, ColTitle, func(p Project) string { return p.Title }),
+					func(p *Project) error {
+						return imp.resolve(TableProjectTypes, "project type", &p.ProjectTypeID)
+					})
+			},
+			func() (ImportCount, error) {
+				return importRows(imp, TableQuotes, b.Quotes,
+					func(q *Quote) *string { return &q.ID }, nil,
+					func(q *Quote) error {
+						if err := imp.resolve(TableProjects, "project", &q.ProjectID); err != nil {
+							return err
+						}
+						return imp.resolve(TableVendors, "vendor", &q.VendorID)
+					})
+			},
+			func() (ImportCount, error) {
+				return importRows(imp, TableMaintenanceItems, b.Maintenance,
+					func(m *MaintenanceItem) *string { return &m.ID }, nil,
+					func(m *MaintenanceItem) error {
+						if err := imp.resolve(
+							TableMaintenanceCategories, "maintenance category", &m.CategoryID,
+						); err != nil {
+							return err
+						}
+						return imp.resolveOptional(TableAppliances, "appliance", m.ApplianceID)
+					})
+			},
+			func() (ImportCount, error) {
+				return importRows(imp, TableServiceLogEntries, b.ServiceLog,
+					func(e *ServiceLogEntry) *string { return &e.ID }, nil,
+					func(e *ServiceLogEntry) error {
+						if err := imp.resolve(
+							TableMaintenanceItems, "maintenance item", &e.MaintenanceItemID,
+						); err != nil {
+							return err
+						}
+						return imp.resolveOptional(TableVendors, "vendor", e.VendorID)
+					})
+			},
+			func() (ImportCount, error) { return imp.house(b.House) },
+		}
+		for _, step := range steps {
+			count, err := step()
+			if err != nil {
+				return err
+			}
+			counts = append(counts, count)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// bundleImport is the state of one ImportBundle run.
+type bundleImport struct {
+	tx      *gorm.DB
+	replace bool
+	// ids maps each table's bundle IDs to the IDs they were imported or
+	// matched as.
+	ids map[string]map[string]string
+}
+
+// importRows imports one table. match, when set, looks for an existing
+// record to use instead of a row with a new ID. link, when set, rewrites
+// the row's foreign keys before it's written.
+func importRows[T any](
+	imp *bundleImport,
+	table string,
+	rows []BundleRow[T],
+	id func(*T) *string,
+	match func(T) (string, error),
+	link func(*T) error,
+) (ImportCount, error) {
+	count := ImportCount{Table: table, Rows: len(rows)}
+	ids := make(map[string]string, len(rows))
+	imp.ids[table] = ids
+	for _, r := range rows {
+		row := r.Record
+		rowID := *id(&row)
+		exists, err := rowExists(imp.tx, table, rowID)
+		if err != nil {
+			return count, err
+		}
+		if exists && !imp.replace {
+			ids[rowID] = rowID
+			count.Skipped++
+			continue
+		}
+		if !exists && match != nil {
+			existing, err := match(row)
+			if err != nil {
+				return count, err
+			}
+			if existing != "" {
+				ids[rowID] = existing
+				count.Matched++
+				continue
+			}
+		}
+		if link != nil {
+			if err := link(&row); err != nil {
+				return count, fmt.Errorf("%s %s: %w", singularTable(table), rowID, err)
+			}
+		}
+		ids[rowID] = rowID
+		if exists {
+			// rowExists sees deleted rows too, so replace them unscoped.
+			unscoped := imp.tx.Unscoped().Session(&gorm.Session{})
+			if err := updateByIDWith(unscoped, table, new(T), rowID, row); err != nil {
+				return count, fmt.Errorf("replace %s %s: %w", singularTable(table), rowID, err)
+			}
+			count.Replaced++
+			continue
+		}
+		if r.DeletedAt != nil {
+			setDeletedAt(&row, *r.DeletedAt)
+		}
+		if err := imp.tx.Omit(clause.Associations).Create(&row).Error; err != nil {
+			return count, fmt.Errorf("insert %s %s: %w", singularTable(table), rowID, err)
+		}
+		count.New++
+	}
+	return count, nil
+}
+
+// matchBy matches rows to the existing record whose column equals the
+// row's name. Names are unique for project types, categories, and vendors,
+// deleted vendors included, so those can only be matched, not duplicated.
+func matchBy[T any](
+	imp *bundleImport,
+	table, column string,
+	name func(T) string,
+) func(T) (string, error) {
+	return func(row T) (string, error) {
+		return firstID(imp.tx.Table(table).Where(column+" = ?", name(row)))
+	}
+}
+
+// matchActiveBy is matchBy for tables whose names aren't unique: only
+// active records are matched, so a deleted appliance isn't revived by an
+// import.
+func matchActiveBy[T any](
+	imp *bundleImport,
+	table, column string,
+	name func(T) string,
+) func(T) (string, error) {
+	return func(row T) (string, error) {
+		return firstID(imp.tx.Table(table).
+			Where(column+" = ? AND "+ColDeletedAt+" IS NULL", name(row)))
+	}
+}
+
+func firstID(q *gorm.DB) (string, error) {
+	var ids []string
+	if err := q.Order(ColID).Limit(1).Pluck(ColID, &ids).Error; err != nil || len(ids) == 0 {
+		return "", err
+	}
+	return ids[0], nil
+}
+
+// resolve points a foreign key at the record its bundle ID was imported
+// or matched as. IDs that aren't in the bundle must already exist.
+func (imp *bundleImport) resolve(table, noun string, id *string) error {
+	if mapped, ok := imp.ids[table][*id]; ok {
+		*id = mapped
+		return nil
+	}
+	exists, err := rowExists(imp.tx, table, *id)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("%s %s is in neither the bundle nor the database", noun, *id)
+	}
+	return nil
+}
+
+// resolveOptional is resolve for a nullable foreign key.
+func (imp *bundleImport) resolveOptional(table, noun string, id *string) error {
+	if id == nil || *id == "" {
+		return nil
+	}
+	return imp.resolve(table, noun, id)
+}
+
+// house imports the house profile. There's only one, so a bundle's house
+// with a new ID still counts as existing when the database has one.
+func (imp *bundleImport) house(h *HouseProfile) (ImportCount, error) {
+	count := ImportCount{Table: TableHouseProfiles}
+	if h == nil {
+		return count, nil
+	}
+	count.Rows = 1
+	var existing HouseProfile
+	err := imp.tx.First(&existing).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		row := *h
+		if err := imp.tx.Create(&row).Error; err != nil {
+			return count, fmt.Errorf("insert house profile: %w", err)
+		}
+		count.New++
+	case err != nil:
+		return count, err
+	case imp.replace:
+		if err := updateByIDWith(
+			imp.tx, TableHouseProfiles, &HouseProfile{}, existing.ID, *h,
+		); err != nil {
+			return count, fmt.Errorf("replace house profile: %w", err)
+		}
+		count.Replaced++
+	default:
+		count.Skipped++
+	}
+	return count, nil
+}
+
+func rowExists(tx *gorm.DB, table, id string) (bool, error) {
+	var n int64
+	err := tx.Table(table).Where(ColID+" = ?", id).Count(&n).Error
+	return n > 0, err
+}
+
+// setDeletedAt soft-deletes a record about to be inserted.
+func setDeletedAt(row any, at time.Time) {
+	f := reflect.ValueOf(row).Elem().FieldByName("DeletedAt")
+	if f.IsValid() {
+		f.Set(reflect.ValueOf(gorm.DeletedAt{Time: at, Valid: true}))
+	}
+}
+
+// singularTable names one row of table in errors.
+func singularTable(table string) string {
+	switch table {
+	case TableProjectTypes:
+		return "project type"
+	case TableMaintenanceCategories:
+		return "maintenance category"
+	case TableVendors:
+		return "vendor"
+	case TableAppliances:
+		return "appliance"
+	case TableProjects:
+		return "project"
+	case TableQuotes:
+		return "quote"
+	case TableMaintenanceItems:
+		return "maintenance item"
+	case TableServiceLogEntries:
+		return "service log entry"
+	}
+	return table
+}
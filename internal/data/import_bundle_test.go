@@ -0,0 +1,162 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package data
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/micasa-dev/micasa/internal/uid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func bundleRows[T any](records ...T) []BundleRow[T] {
+	rows := make([]BundleRow[T], len(records))
+	for i, r := range records {
+		rows[i] = BundleRow[T]{Record: r}
+	}
+	return rows
+}
+
+// testBundle returns a bundle with a project, a vendor, and a quote from
+// the vendor for the project, all with IDs new to any store.
+func testBundle(t *testing.T, store *Store) Bundle {
+	t.Helper()
+	types, err := store.ProjectTypes()
+	require.NoError(t, err)
+	project := Project{
+		ID: uid.New(), Title: "Deck", ProjectTypeID: types[0].ID, Status: ProjectStatusPlanned,
+	}
+	vendor := Vendor{ID: uid.New(), Name: "Acme Decks"}
+	quote := Quote{
+		ID: uid.New(), ProjectID: project.ID, VendorID: vendor.ID, TotalCents: 500000,
+	}
+	return Bundle{
+		ProjectTypes: bundleRows(types...),
+		Projects:     bundleRows(project),
+		Vendors:      bundleRows(vendor),
+		Quotes:       bundleRows(quote),
+	}
+}
+
+func importCounts(counts []ImportCount) map[string]ImportCount {
+	byTable := make(map[string]ImportCount, len(counts))
+	for _, c := range counts {
+		byTable[c.Table] = c
+	}
+	return byTable
+}
+
+func TestImportBundle(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	b := testBundle(t, store)
+
+	counts, err := store.ImportBundle(b, false)
+	require.NoError(t, err)
+	byTable := importCounts(counts)
+	assert.Equal(t, 1, byTable[TableProjects].New)
+	assert.Equal(t, 1, byTable[TableVendors].New)
+	assert.Equal(t, 1, byTable[TableQuotes].New)
+	assert.Equal(t, len(b.ProjectTypes), byTable[TableProjectTypes].Skipped,
+		"the store's own types are already there")
+
+	quotes, err := store.ListQuotes(false)
+	require.NoError(t, err)
+	require.Len(t, quotes, 1)
+	assert.Equal(t, b.Quotes[0].Record.ID, quotes[0].ID, "IDs are kept")
+	assert.Equal(t, "Deck", quotes[0].Project.Title)
+	assert.Equal(t, "Acme Decks", quotes[0].Vendor.Name)
+
+	t.Run("AgainSkips", func(t *testing.T) {
+		counts, err := store.ImportBundle(b, false)
+		require.NoError(t, err)
+		byTable := importCounts(counts)
+		assert.Equal(t, 1, byTable[TableProjects].Skipped)
+		assert.Equal(t, 1, byTable[TableQuotes].Skipped)
+		assert.Zero(t, byTable[TableQuotes].New)
+	})
+
+	t.Run("Replace", func(t *testing.T) {
+		b := b
+		b.Projects = bundleRows(b.Projects[0].Record)
+		b.Projects[0].Record.Title = "Back deck"
+		counts, err := store.ImportBundle(b, true)
+		require.NoError(t, err)
+		assert.Equal(t, 1, importCounts(counts)[TableProjects].Replaced)
+		p, err := store.GetProject(b.Projects[0].Record.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Back deck", p.Title)
+	})
+}
+
+func TestImportBundleReplacesDeletedRow(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	b := testBundle(t, store)
+	_, err := store.ImportBundle(b, false)
+	require.NoError(t, err)
+	id := b.Projects[0].Record.ID
+	require.NoError(t, store.DeleteQuote(b.Quotes[0].Record.ID))
+	require.NoError(t, store.DeleteProject(id))
+
+	b.Projects[0].Record.Title = "Back deck"
+	counts, err := store.ImportBundle(b, true)
+	require.NoError(t, err)
+	assert.Equal(t, 1, importCounts(counts)[TableProjects].Replaced)
+
+	var p Project
+	require.NoError(t, store.db.Unscoped().First(&p, "id = ?", id).Error)
+	assert.Equal(t, "Back deck", p.Title, "the deleted row is replaced")
+	assert.True(t, p.DeletedAt.Valid, "and stays deleted")
+}
+
+func TestImportBundleMatchesByName(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	existing := Vendor{Name: "Acme Decks"}
+	require.NoError(t, store.CreateVendor(&existing))
+	b := testBundle(t, store)
+
+	counts, err := store.ImportBundle(b, false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, importCounts(counts)[TableVendors].Matched)
+
+	vendors, err := store.ListVendors(false)
+	require.NoError(t, err)
+	require.Len(t, vendors, 1, "no duplicate vendor")
+	quotes, err := store.ListQuotes(false)
+	require.NoError(t, err)
+	require.Len(t, quotes, 1)
+	assert.Equal(t, existing.ID, quotes[0].VendorID, "the quote follows the match")
+}
+
+func TestImportBundleDanglingProject(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	b := testBundle(t, store)
+	missing := uid.New()
+	b.Projects = nil
+	b.Quotes[0].Record.ProjectID = missing
+
+	_, err := store.ImportBundle(b, false)
+	require.ErrorContains(t, err,
+		"quote "+b.Quotes[0].Record.ID+": project "+missing+" is in neither the bundle nor the database")
+	vendors, err := store.ListVendors(true)
+	require.NoError(t, err)
+	assert.Empty(t, vendors, "nothing is imported")
+}
+
+func TestBundleRowDeletedAt(t *testing.T) {
+	t.Parallel()
+	var b Bundle
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"vendors": [{"id": "v1", "name": "Gone", "deleted_at": "2026-01-02T03:04:05Z"}]
+	}`), &b))
+	require.Len(t, b.Vendors, 1)
+	assert.Equal(t, "Gone", b.Vendors[0].Record.Name)
+	require.NotNil(t, b.Vendors[0].DeletedAt)
+	assert.Equal(t, 2026, b.Vendors[0].DeletedAt.Year())
+}
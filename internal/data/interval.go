@@ -0,0 +1,165 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package data
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ErrInvalidScheduleInterval reports a maintenance interval that isn't a
+// number or a duration built from days, weeks, months, and years.
+var ErrInvalidScheduleInterval = errors.New("invalid interval value")
+
+// Interval is how often a maintenance item recurs. Months counts calendar
+// months (a year is stored as 12) and Days counts exact days (a week is
+// stored as 7), so each unit keeps its meaning: one month from Jan 31 is
+// Feb 28, while 30 days is always thirty days. Either or both may be set.
+type Interval struct {
+	Months int
+	Days   int
+}
+
+// IsZero reports whether the interval never recurs.
+func (i Interval) IsZero() bool {
+	return i.Months <= 0 && i.Days <= 0
+}
+
+// After returns the date one interval after t: months first, clamped like
+// AddMonths, then days.
+func (i Interval) After(t time.Time) time.Time {
+	if i.Months > 0 {
+		t = AddMonths(t, i.Months)
+	}
+	if i.Days > 0 {
+		t = t.AddDate(0, 0, i.Days)
+	}
+	return t
+}
+
+// String formats the interval compactly, e.g. "1y 6m", "2w", "10d", or
+// "1m 2w". Days show as weeks when they divide evenly. Zero is "".
+func (i Interval) String() string {
+	var parts []string
+	if i.Months > 0 {
+		if y := i.Months / 12; y > 0 {
+			parts = append(parts, fmt.Sprintf("%dy", y))
+		}
+		if m := i.Months % 12; m > 0 {
+			parts = append(parts, fmt.Sprintf("%dm", m))
+		}
+	}
+	if i.Days > 0 {
+		if i.Days%7 == 0 {
+			parts = append(parts, fmt.Sprintf("%dw", i.Days/7))
+		} else {
+			parts = append(parts, fmt.Sprintf("%dd", i.Days))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// Interval returns the item's recurrence.
+func (m MaintenanceItem) Interval() Interval {
+	return Interval{Months: m.IntervalMonths, Days: m.IntervalDays}
+}
+
+// IntervalUnit is the unit a bare number typed as a maintenance interval is
+// read in.
+type IntervalUnit string
+
+const (
+	IntervalUnitDays   IntervalUnit = "days"
+	IntervalUnitWeeks  IntervalUnit = "weeks"
+	IntervalUnitMonths IntervalUnit = "months"
+	IntervalUnitYears  IntervalUnit = "years"
+)
+
+// IntervalUnits lists the supported units in ascending size.
+var IntervalUnits = []IntervalUnit{
+	IntervalUnitDays, IntervalUnitWeeks, IntervalUnitMonths, IntervalUnitYears,
+}
+
+// intervalSuffixes maps every accepted unit spelling to its unit.
+var intervalSuffixes = map[string]IntervalUnit{
+	"d": IntervalUnitDays, "day": IntervalUnitDays, "days": IntervalUnitDays,
+	"w": IntervalUnitWeeks, "wk": IntervalUnitWeeks, "wks": IntervalUnitWeeks,
+	"week": IntervalUnitWeeks, "weeks": IntervalUnitWeeks,
+	"m": IntervalUnitMonths, "mo": IntervalUnitMonths, "mos": IntervalUnitMonths,
+	"month": IntervalUnitMonths, "months": IntervalUnitMonths,
+	"y": IntervalUnitYears, "yr": IntervalUnitYears, "yrs": IntervalUnitYears,
+	"year": IntervalUnitYears, "years": IntervalUnitYears,
+}
+
+// ParseInterval parses a maintenance interval. A bare number ("6") is read
+// in unit, or months when unit is empty; otherwise the input is one or more
+// amounts with a unit suffix, e.g. "2w", "10d", "1y 6m", "1m2w", or
+// "3 months". Case-insensitive, whitespace-flexible. Returns the zero
+// Interval for blank input (non-recurring).
+func ParseInterval(input string, unit IntervalUnit) (Interval, error) {
+	s := strings.ToLower(strings.TrimSpace(input))
+	if s == "" {
+		return Interval{}, nil
+	}
+	if n, err := strconv.Atoi(s); err == nil {
+		if n < 0 {
+			return Interval{}, ErrInvalidScheduleInterval
+		}
+		if unit == "" {
+			unit = IntervalUnitMonths
+		}
+		var out Interval
+		if !out.add(n, unit) {
+			return Interval{}, ErrInvalidScheduleInterval
+		}
+		return out, nil
+	}
+
+	var out Interval
+	for s != "" {
+		digits := strings.IndexFunc(s, func(r rune) bool { return !unicode.IsDigit(r) })
+		if digits <= 0 {
+			return Interval{}, ErrInvalidScheduleInterval
+		}
+		n, err := strconv.Atoi(s[:digits])
+		if err != nil {
+			return Interval{}, ErrInvalidScheduleInterval
+		}
+		s = strings.TrimLeft(s[digits:], " ")
+		letters := strings.IndexFunc(s, func(r rune) bool { return !unicode.IsLetter(r) })
+		if letters < 0 {
+			letters = len(s)
+		}
+		u, ok := intervalSuffixes[s[:letters]]
+		if !ok || !out.add(n, u) {
+			return Interval{}, ErrInvalidScheduleInterval
+		}
+		s = strings.TrimLeft(s[letters:], " ")
+	}
+	return out, nil
+}
+
+// add accumulates n of unit, reporting false on overflow.
+func (i *Interval) add(n int, unit IntervalUnit) bool {
+	field, per := &i.Months, 1
+	switch unit {
+	case IntervalUnitDays:
+		field = &i.Days
+	case IntervalUnitWeeks:
+		field, per = &i.Days, 7
+	case IntervalUnitMonths:
+	case IntervalUnitYears:
+		per = 12
+	}
+	if n > (math.MaxInt-*field)/per {
+		return false
+	}
+	*field += n * per
+	return true
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package data
+
+import (
+	"math"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInterval(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		input string
+		unit  IntervalUnit
+		want  Interval
+	}{
+		// bare numbers use the unit, months when unset
+		{"6", "", Interval{Months: 6}},
+		{"6", IntervalUnitMonths, Interval{Months: 6}},
+		{"2", IntervalUnitWeeks, Interval{Days: 14}},
+		{"10", IntervalUnitDays, Interval{Days: 10}},
+		{"1", IntervalUnitYears, Interval{Months: 12}},
+		{"0", IntervalUnitWeeks, Interval{}},
+		// suffixes ignore the unit
+		{"6m", IntervalUnitWeeks, Interval{Months: 6}},
+		{"2w", "", Interval{Days: 14}},
+		{"10d", IntervalUnitYears, Interval{Days: 10}},
+		{"1Y", "", Interval{Months: 12}},
+		{"3 months", "", Interval{Months: 3}},
+		{"2 weeks", "", Interval{Days: 14}},
+		{"1 day", "", Interval{Days: 1}},
+		{"1yr", "", Interval{Months: 12}},
+		// combined
+		{"1y 6m", "", Interval{Months: 18}},
+		{"1y6m", "", Interval{Months: 18}},
+		{"1m2w", "", Interval{Months: 1, Days: 14}},
+		{"  1w  3d  ", "", Interval{Days: 10}},
+		// empty
+		{"", IntervalUnitDays, Interval{}},
+		{"   ", "", Interval{}},
+	}
+	for _, tt := range tests {
+		got, err := ParseInterval(tt.input, tt.unit)
+		require.NoError(t, err, "input=%q unit=%q", tt.input, tt.unit)
+		assert.Equal(t, tt.want, got, "input=%q unit=%q", tt.input, tt.unit)
+	}
+}
+
+func TestParseIntervalInvalid(t *testing.T) {
+	t.Parallel()
+	huge := strconv.Itoa(math.MaxInt/7 + 1)
+	for _, input := range []string{
+		"abc", "-1", "1.5m", "1x", "m", "w", "2 fortnights", "1y-6m",
+		huge + "w", "1d " + strconv.Itoa(math.MaxInt) + "d",
+	} {
+		_, err := ParseInterval(input, "")
+		require.ErrorIs(t, err, ErrInvalidScheduleInterval, "input=%q", input)
+	}
+	_, err := ParseInterval(huge, IntervalUnitWeeks)
+	require.ErrorIs(t, err, ErrInvalidScheduleInterval, "bare weeks overflow")
+}
+
+func TestIntervalString(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		in   Interval
+		want string
+	}{
+		{Interval{}, ""},
+		{Interval{Months: 3}, "3m"},
+		{Interval{Months: 12}, "1y"},
+		{Interval{Months: 30}, "2y 6m"},
+		{Interval{Days: 14}, "2w"},
+		{Interval{Days: 10}, "10d"},
+		{Interval{Months: 1, Days: 14}, "1m 2w"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.in.String())
+		if tt.want == "" {
+			continue
+		}
+		// Formatted intervals round-trip whatever the configured unit.
+		back, err := ParseInterval(tt.want, IntervalUnitDays)
+		require.NoError(t, err)
+		assert.Equal(t, tt.in, back)
+	}
+}
+
+func TestComputeNextDueDays(t *testing.T) {
+	t.Parallel()
+	last := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	next := ComputeNextDue(&last, Interval{Days: 14}, nil)
+	require.NotNil(t, next)
+	assert.Equal(t, "2025-02-14", next.Format(DateLayout))
+
+	next = ComputeNextDue(&last, Interval{Months: 1, Days: 7}, nil)
+	require.NotNil(t, next)
+	assert.Equal(t, "2025-03-07", next.Format(DateLayout), "months clamp before days are added")
+}
@@ -27,22 +27,26 @@ const (
 
 // Column name constants derived from GORM model structs.
 const (
+	ColAcceptedDate      = "accepted_date"
 	ColActualCents       = "actual_cents"
 	ColAddressLine1      = "address_line1"
 	ColAddressLine2      = "address_line2"
 	ColApplianceID       = "appliance_id"
 	ColAppliedAt         = "applied_at"
+	ColArchivedAt        = "archived_at"
 	ColBasementType      = "basement_type"
 	ColBathrooms         = "bathrooms"
 	ColBedrooms          = "bedrooms"
 	ColBrand             = "brand"
 	ColBudgetCents       = "budget_cents"
+	ColBudgetCurrency    = "budget_currency"
 	ColCategoryID        = "category_id"
 	ColChecksumSHA256    = "sha256"
 	ColCity              = "city"
 	ColContactName       = "contact_name"
 	ColCoolingType       = "cooling_type"
 	ColCostCents         = "cost_cents"
+	ColCostCurrency      = "cost_currency"
 	ColCreatedAt         = "created_at"
 	ColData              = "data"
 	ColDateNoticed       = "date_noticed"
@@ -72,6 +76,7 @@ const (
 	ColInsuranceCarrier  = "insurance_carrier"
 	ColInsurancePolicy   = "insurance_policy"
 	ColInsuranceRenewal  = "insurance_renewal"
+	ColIntervalDays      = "interval_days"
 	ColIntervalMonths    = "interval_months"
 	ColKey               = "key"
 	ColLaborCents        = "labor_cents"
@@ -86,6 +91,7 @@ const (
 	ColManualURL         = "manual_url"
 	ColMaterialsCents    = "materials_cents"
 	ColModelNumber       = "model_number"
+	ColMonth             = "month"
 	ColName              = "name"
 	ColNickname          = "nickname"
 	ColNotes             = "notes"
@@ -101,6 +107,7 @@ const (
 	ColPropertyTaxCents  = "property_tax_cents"
 	ColPurchaseDate      = "purchase_date"
 	ColReceivedDate      = "received_date"
+	ColRecurrenceMonths  = "recurrence_months"
 	ColRelayURL          = "relay_url"
 	ColRestoredAt        = "restored_at"
 	ColRoofType          = "roof_type"
@@ -111,6 +118,7 @@ const (
 	ColSeverity          = "severity"
 	ColSewerType         = "sewer_type"
 	ColSizeBytes         = "size_bytes"
+	ColSnoozedUntil      = "snoozed_until"
 	ColSquareFeet        = "square_feet"
 	ColStartDate         = "start_date"
 	ColState             = "state"
@@ -118,6 +126,7 @@ const (
 	ColSyncedAt          = "synced_at"
 	ColTableName         = "table_name"
 	ColTargetID          = "target_id"
+	ColTaxCents          = "tax_cents"
 	ColTitle             = "title"
 	ColTotalCents        = "total_cents"
 	ColUpdatedAt         = "updated_at"
@@ -257,6 +266,7 @@ var TableExtractColumns = map[string][]metaColumn{
 		{Name: "total_cents", JSONType: "integer"},
 		{Name: "labor_cents", JSONType: "integer"},
 		{Name: "materials_cents", JSONType: "integer"},
+		{Name: "tax_cents", JSONType: "integer"},
 		{Name: "notes", JSONType: "string"},
 	},
 	TableServiceLogEntries: {
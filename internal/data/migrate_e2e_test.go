@@ -215,7 +215,7 @@ func TestMigrateE2E_RealisticV22Database(t *testing.T) {
 	})
 
 	t.Run("seed_defaults_after_migration", func(t *testing.T) {
-		// SeedDefaults uses FirstOrCreate — the v2.3 seed names differ
+		// SeedDefaults only adds missing names — the v2.3 seed names differ
 		// from the v2.2 test data, so both sets coexist. What matters
 		// is that SeedDefaults doesn't error on a migrated database.
 		require.NoError(t, store.SeedDefaults())
@@ -157,20 +157,22 @@ type Vendor struct {
 }
 
 type Project struct {
-	ID            string         `gorm:"primaryKey;size:26"                                                     json:"id"`
-	Title         string         `                                                                              json:"title"`
-	ProjectTypeID string         `                                                                              json:"project_type_id"`
-	ProjectType   ProjectType    `gorm:"constraint:OnDelete:RESTRICT;"                                          json:"-"`
-	Status        string         `                                                                              json:"status"          default:"planned"`
-	Description   string         `                                                                              json:"description"`
-	StartDate     *time.Time     `                                                                              json:"start_date"                        extract:"-"`
-	EndDate       *time.Time     `                                                                              json:"end_date"                          extract:"-"`
-	BudgetCents   *int64         `                                                                              json:"budget_cents"`
-	ActualCents   *int64         `                                                                              json:"actual_cents"                      extract:"-"`
-	Documents     []Document     `gorm:"polymorphic:Entity;polymorphicType:EntityKind;polymorphicValue:project" json:"-"`
-	CreatedAt     time.Time      `                                                                              json:"created_at"`
-	UpdatedAt     time.Time      `                                                                              json:"updated_at"`
-	DeletedAt     gorm.DeletedAt `gorm:"index"                                                                  json:"-"`
+	ID               string         `gorm:"primaryKey;size:26"                                                     json:"id"`
+	Title            string         `                                                                              json:"title"`
+	ProjectTypeID    string         `                                                                              json:"project_type_id"`
+	ProjectType      ProjectType    `gorm:"constraint:OnDelete:RESTRICT;"                                          json:"-"`
+	Status           string         `                                                                              json:"status"          default:"planned"`
+	Description      string         `                                                                              json:"description"`
+	StartDate        *time.Time     `                                                                              json:"start_date"                        extract:"-"`
+	EndDate          *time.Time     `                                                                              json:"end_date"                          extract:"-"`
+	BudgetCents      *int64         `                                                                              json:"budget_cents"`
+	ActualCents      *int64         `                                                                              json:"actual_cents"                      extract:"-"`
+	BudgetCurrency   string         `                                                                              json:"budget_currency"                   extract:"-"`
+	RecurrenceMonths int            `                                                                              json:"recurrence_months"                 extract:"-"`
+	Documents        []Document     `gorm:"polymorphic:Entity;polymorphicType:EntityKind;polymorphicValue:project" json:"-"`
+	CreatedAt        time.Time      `                                                                              json:"created_at"`
+	UpdatedAt        time.Time      `                                                                              json:"updated_at"`
+	DeletedAt        gorm.DeletedAt `gorm:"index"                                                                  json:"-"`
 }
 
 type Quote struct {
@@ -183,7 +185,9 @@ type Quote struct {
 	LaborCents     *int64         `                                                                            json:"labor_cents"`
 	MaterialsCents *int64         `                                                                            json:"materials_cents"`
 	OtherCents     *int64         `                                                                            json:"other_cents"     extract:"-"`
+	TaxCents       *int64         `                                                                            json:"tax_cents"`
 	ReceivedDate   *time.Time     `                                                                            json:"received_date"   extract:"-"`
+	AcceptedDate   *time.Time     `                                                                            json:"accepted_date"   extract:"-"`
 	Notes          string         `                                                                            json:"notes"`
 	Documents      []Document     `gorm:"polymorphic:Entity;polymorphicType:EntityKind;polymorphicValue:quote" json:"-"`
 	CreatedAt      time.Time      `                                                                            json:"created_at"`
@@ -208,7 +212,9 @@ type Appliance struct {
 	WarrantyExpiry *time.Time     `gorm:"index"                                                                    json:"warranty_expiry" extract:"-"`
 	Location       string         `                                                                                json:"location"`
 	CostCents      *int64         `                                                                                json:"cost_cents"`
+	CostCurrency   string         `                                                                                json:"cost_currency"   extract:"-"`
 	Notes          string         `                                                                                json:"notes"`
+	ArchivedAt     *time.Time     `gorm:"index"                                                                    json:"archived_at"     extract:"-"`
 	Documents      []Document     `gorm:"polymorphic:Entity;polymorphicType:EntityKind;polymorphicValue:appliance" json:"-"`
 	CreatedAt      time.Time      `                                                                                json:"created_at"`
 	UpdatedAt      time.Time      `                                                                                json:"updated_at"`
@@ -223,9 +229,12 @@ type MaintenanceItem struct {
 	ApplianceID    *string             `gorm:"index"                                                                      json:"appliance_id"`
 	Appliance      Appliance           `gorm:"constraint:OnDelete:SET NULL;"                                              json:"-"`
 	Season         string              `                                                                                  json:"season"`
+	Month          int                 `                                                                                  json:"month"            extract:"-"`
 	LastServicedAt *time.Time          `                                                                                  json:"last_serviced_at" extract:"-"`
 	IntervalMonths int                 `                                                                                  json:"interval_months"`
+	IntervalDays   int                 `                                                                                  json:"interval_days"    extract:"-"`
 	DueDate        *time.Time          `                                                                                  json:"due_date"         extract:"-"`
+	SnoozedUntil   *time.Time          `                                                                                  json:"snoozed_until"    extract:"-"`
 	ManualURL      string              `                                                                                  json:"manual_url"       extract:"-"`
 	ManualText     string              `                                                                                  json:"manual_text"      extract:"-"`
 	Notes          string              `                                                                                  json:"notes"`
@@ -0,0 +1,22 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package data
+
+import "path/filepath"
+
+// NetworkFilesystem reports whether the database at path lives on a network
+// filesystem, where SQLite's file locking is unreliable, and names the
+// filesystem type. The probe is best-effort: it checks the directory the
+// database is in (the file itself may not exist yet) and reports false
+// whenever the platform can't tell.
+func NetworkFilesystem(path string) (string, bool) {
+	if path == ":memory:" {
+		return "", false
+	}
+	dir, err := filepath.Abs(filepath.Dir(path))
+	if err != nil {
+		return "", false
+	}
+	return networkFSType(dir)
+}
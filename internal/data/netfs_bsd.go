@@ -0,0 +1,27 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+//go:build darwin || freebsd
+
+package data
+
+import "golang.org/x/sys/unix"
+
+// networkFSNames are the statfs f_fstypename values of network filesystems.
+var networkFSNames = map[string]bool{
+	"nfs":    true,
+	"smbfs":  true,
+	"afpfs":  true,
+	"webdav": true,
+	"cifs":   true,
+	"afs":    true,
+}
+
+func networkFSType(dir string) (string, bool) {
+	var st unix.Statfs_t
+	if err := unix.Statfs(dir, &st); err != nil {
+		return "", false
+	}
+	name := unix.ByteSliceToString(st.Fstypename[:])
+	return name, networkFSNames[name]
+}
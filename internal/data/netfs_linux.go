@@ -0,0 +1,32 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package data
+
+import "golang.org/x/sys/unix"
+
+// networkFSMagic maps statfs f_type magic numbers of network filesystems to
+// their names.
+var networkFSMagic = map[uint32]string{
+	0x6969:     "nfs",
+	0x517B:     "smb",
+	0xFF534D42: "cifs",
+	0xFE534D42: "smb2",
+	0x73757245: "coda",
+	0x5346414F: "afs",
+	0x6B414653: "afs",
+	0x01021997: "9p",
+	0x00C36400: "ceph",
+	0x0BD00BD0: "lustre",
+	0x01161970: "gfs2",
+	0x7461636F: "ocfs2",
+}
+
+func networkFSType(dir string) (string, bool) {
+	var st unix.Statfs_t
+	if err := unix.Statfs(dir, &st); err != nil {
+		return "", false
+	}
+	name, ok := networkFSMagic[uint32(st.Type)] //nolint:gosec // magic numbers are 32-bit
+	return name, ok
+}
@@ -0,0 +1,11 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+//go:build !linux && !darwin && !freebsd && !windows
+
+package data
+
+// networkFSType can't tell on this platform, so it never warns.
+func networkFSType(string) (string, bool) {
+	return "", false
+}
@@ -0,0 +1,21 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package data
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkFilesystemLocal(t *testing.T) {
+	t.Parallel()
+	_, ok := NetworkFilesystem(":memory:")
+	assert.False(t, ok)
+	_, ok = NetworkFilesystem(filepath.Join(t.TempDir(), "micasa.db"))
+	assert.False(t, ok, "a temp dir is local")
+	_, ok = NetworkFilesystem(filepath.Join(t.TempDir(), "missing", "micasa.db"))
+	assert.False(t, ok, "an unreadable directory is never reported as remote")
+}
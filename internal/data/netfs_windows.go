@@ -0,0 +1,25 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+//go:build windows
+
+package data
+
+import "golang.org/x/sys/windows"
+
+// networkFSType reports mapped network drives and UNC paths, which Windows
+// classifies as remote drives.
+func networkFSType(dir string) (string, bool) {
+	p, err := windows.UTF16PtrFromString(dir)
+	if err != nil {
+		return "", false
+	}
+	root := make([]uint16, windows.MAX_PATH+1)
+	if err := windows.GetVolumePathName(p, &root[0], uint32(len(root))); err != nil {
+		return "", false
+	}
+	if windows.GetDriveType(&root[0]) != windows.DRIVE_REMOTE {
+		return "", false
+	}
+	return "network drive", true
+}
@@ -4,9 +4,12 @@
 package data
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 
 	"github.com/adrg/xdg"
 )
@@ -17,6 +20,9 @@ func DefaultDBPath() (string, error) {
 	if override := os.Getenv("MICASA_DB_PATH"); override != "" {
 		return override, nil
 	}
+	if profile := os.Getenv("MICASA_PROFILE"); profile != "" {
+		return ProfileDBPath(profile)
+	}
 	// xdg.DataFile creates the parent directory and returns the full path.
 	// On Linux/WSL: $XDG_DATA_HOME/micasa/micasa.db (default ~/.local/share)
 	// On macOS:     ~/Library/Application Support/micasa/micasa.db
@@ -28,6 +34,73 @@ func DefaultDBPath() (string, error) {
 	return p, nil
 }
 
+// maxProfileNameLen bounds profile names, which become file names.
+const maxProfileNameLen = 64
+
+// profilesDir is where named database profiles live.
+// On Linux: $XDG_DATA_HOME/micasa/profiles (default ~/.local/share/micasa/profiles).
+func profilesDir() string {
+	return filepath.Join(xdg.DataHome, AppName, "profiles")
+}
+
+// ValidateProfileName checks that name can be used as a profile: letters,
+// digits, dashes, and underscores, starting with a letter or digit.
+func ValidateProfileName(name string) error {
+	if name == "" {
+		return errors.New("profile name is empty")
+	}
+	if len(name) > maxProfileNameLen {
+		return fmt.Errorf("profile name is longer than %d characters", maxProfileNameLen)
+	}
+	for i, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case (r == '-' || r == '_') && i > 0:
+		default:
+			return fmt.Errorf(
+				"profile name %q -- use letters, digits, dashes, and underscores",
+				name,
+			)
+		}
+	}
+	return nil
+}
+
+// ProfileDBPath returns the database path for the named profile, creating
+// the profiles directory if needed.
+func ProfileDBPath(name string) (string, error) {
+	if err := ValidateProfileName(name); err != nil {
+		return "", err
+	}
+	p, err := xdg.DataFile(filepath.Join(AppName, "profiles", name+".db"))
+	if err != nil {
+		return "", fmt.Errorf("resolving profile path: %w", err)
+	}
+	return p, nil
+}
+
+// ListProfiles returns the names of the profiles that have a database,
+// sorted.
+func ListProfiles() ([]string, error) {
+	entries, err := os.ReadDir(profilesDir())
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("list profiles: %w", err)
+	}
+	var names []string
+	for _, e := range entries {
+		name, ok := strings.CutSuffix(e.Name(), ".db")
+		if !ok || e.IsDir() || ValidateProfileName(name) != nil {
+			continue
+		}
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
 // DocumentCacheDir returns the directory used for extracted document BLOBs.
 // On Linux: $XDG_CACHE_HOME/micasa/documents (default ~/.cache/micasa/documents).
 func DocumentCacheDir() (string, error) {
@@ -39,3 +112,26 @@ func DocumentCacheDir() (string, error) {
 	}
 	return dir, nil
 }
+
+// FXRatesCachePath returns the file that caches the last fetched exchange
+// rates.
+// On Linux: $XDG_CACHE_HOME/micasa/fx-rates.json.
+func FXRatesCachePath() (string, error) {
+	dir := filepath.Join(xdg.CacheHome, AppName)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("creating cache dir: %w", err)
+	}
+	return filepath.Join(dir, "fx-rates.json"), nil
+}
+
+// PageCacheDir returns the directory used for rasterized PDF pages kept
+// between OCR runs.
+// On Linux: $XDG_CACHE_HOME/micasa/pages (default ~/.cache/micasa/pages).
+func PageCacheDir() (string, error) {
+	dir := filepath.Join(xdg.CacheHome, AppName, "pages")
+	// 0o700: owner-only access, like DocumentCacheDir.
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("creating page cache dir: %w", err)
+	}
+	return dir, nil
+}
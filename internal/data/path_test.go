@@ -0,0 +1,78 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package data
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/adrg/xdg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// useDataHome points the XDG data home at a temp directory for one test.
+func useDataHome(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv("XDG_DATA_HOME", dir)
+	xdg.Reload()
+	t.Cleanup(xdg.Reload)
+	return dir
+}
+
+func TestValidateProfileName(t *testing.T) {
+	t.Parallel()
+	for _, name := range []string{"house", "rental-2", "Lake_Cabin", "7"} {
+		assert.NoError(t, ValidateProfileName(name), name)
+	}
+	for _, name := range []string{"", "-x", "_x", "a b", "../evil", "a/b", "house.db"} {
+		assert.Error(t, ValidateProfileName(name), name)
+	}
+}
+
+func TestProfileDBPath(t *testing.T) {
+	home := useDataHome(t)
+	p, err := ProfileDBPath("rental")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(home, AppName, "profiles", "rental.db"), p)
+	assert.DirExists(t, filepath.Dir(p))
+
+	_, err = ProfileDBPath("../rental")
+	require.Error(t, err)
+}
+
+func TestListProfiles(t *testing.T) {
+	useDataHome(t)
+	names, err := ListProfiles()
+	require.NoError(t, err)
+	assert.Empty(t, names)
+
+	for _, name := range []string{"rental", "house"} {
+		p, err := ProfileDBPath(name)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(p, nil, 0o600))
+	}
+	dir := filepath.Join(xdg.DataHome, AppName, "profiles")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), nil, 0o600))
+
+	names, err = ListProfiles()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"house", "rental"}, names)
+}
+
+func TestDefaultDBPathHonorsProfile(t *testing.T) {
+	useDataHome(t)
+	t.Setenv("MICASA_DB_PATH", "")
+	t.Setenv("MICASA_PROFILE", "house")
+	got, err := DefaultDBPath()
+	require.NoError(t, err)
+	assert.Equal(t, "house.db", filepath.Base(got))
+
+	t.Setenv("MICASA_DB_PATH", "/explicit.db")
+	got, err = DefaultDBPath()
+	require.NoError(t, err)
+	assert.Equal(t, "/explicit.db", got, "MICASA_DB_PATH wins over MICASA_PROFILE")
+}
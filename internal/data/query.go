@@ -82,9 +82,49 @@ var disallowedKeywords = []string{
 	"ATTACH", "DETACH", "PRAGMA", "REINDEX", "VACUUM",
 }
 
-// ReadOnlyQuery executes a validated SELECT query and returns the results as
-// string slices. Only SELECT/WITH statements are allowed; result rows are
-// capped at maxQueryRows.
+// ColumnMeta describes a column in a ReadOnlyQuery result.
+type ColumnMeta struct {
+	Name string
+	// DeclType is the column's declared SQLite type ("INTEGER", "TEXT",
+	// "DATETIME", ...), or "" for computed expressions.
+	DeclType string
+}
+
+// ColumnNames returns the names of cols.
+func ColumnNames(cols []ColumnMeta) []string {
+	names := make([]string, len(cols))
+	for i, c := range cols {
+		names[i] = c.Name
+	}
+	return names
+}
+
+// FormatQueryValue renders a ReadOnlyQuery value as plain text: "" for
+// NULL, otherwise Go's default formatting.
+func FormatQueryValue(v any) string {
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// QueryRowStrings renders ReadOnlyQuery rows with FormatQueryValue, for
+// callers that only want text.
+func QueryRowStrings(rows [][]any) [][]string {
+	out := make([][]string, len(rows))
+	for i, row := range rows {
+		out[i] = make([]string, len(row))
+		for j, v := range row {
+			out[i][j] = FormatQueryValue(v)
+		}
+	}
+	return out
+}
+
+// ReadOnlyQuery executes a validated SELECT query and returns the results
+// with their SQLite types intact: int64, float64, string, []byte,
+// time.Time for date and time columns, or nil for NULL. Only SELECT/WITH
+// statements are allowed; result rows are capped at maxQueryRows.
 //
 // Validation is layered for defense-in-depth:
 //  1. Fast prefix check: query must start with SELECT or WITH (after stripping
@@ -102,7 +142,7 @@ var disallowedKeywords = []string{
 func (s *Store) ReadOnlyQuery(
 	ctx context.Context,
 	query string,
-) (columns []string, rows [][]string, err error) {
+) (columns []ColumnMeta, rows [][]any, err error) {
 	trimmed := strings.TrimSpace(query)
 	if trimmed == "" {
 		return nil, nil, errors.New("empty query")
@@ -162,10 +202,14 @@ func (s *Store) ReadOnlyQuery(
 		}
 		defer func() { _ = sqlRows.Close() }()
 
-		columns, qErr = sqlRows.Columns()
+		types, qErr := sqlRows.ColumnTypes()
 		if qErr != nil {
 			return fmt.Errorf("get columns: %w", qErr)
 		}
+		columns = make([]ColumnMeta, len(types))
+		for i, ct := range types {
+			columns[i] = ColumnMeta{Name: ct.Name(), DeclType: ct.DatabaseTypeName()}
+		}
 
 		for sqlRows.Next() {
 			if len(rows) >= maxQueryRows {
@@ -179,15 +223,7 @@ func (s *Store) ReadOnlyQuery(
 			if qErr = sqlRows.Scan(ptrs...); qErr != nil {
 				return fmt.Errorf("scan row: %w", qErr)
 			}
-			row := make([]string, len(columns))
-			for i, v := range values {
-				if v == nil {
-					row[i] = ""
-				} else {
-					row[i] = fmt.Sprintf("%v", v)
-				}
-			}
-			rows = append(rows, row)
+			rows = append(rows, values)
 		}
 		return sqlRows.Err()
 	})
@@ -290,8 +326,10 @@ func firstWord(s string) string {
 // The output is optimized for small LLMs: null/empty values are omitted,
 // money columns (ending in "_ct") are formatted as dollars, and internal
 // columns (id, created_at, updated_at, deleted_at) are excluded to reduce
-// noise.
-func (s *Store) DataDump() string {
+// noise. Soft-deleted rows are left out unless includeDeleted is set, in
+// which case they are kept and their deleted_at is shown so the model can
+// tell them apart.
+func (s *Store) DataDump(includeDeleted bool) string {
 	names, err := s.TableNames()
 	if err != nil {
 		return ""
@@ -303,7 +341,7 @@ func (s *Store) DataDump() string {
 		if name == TableSyncOplogEntries || name == TableSyncDevices {
 			continue
 		}
-		rows, cols, err := dumpTable(s, name)
+		rows, cols, err := dumpTable(s, name, includeDeleted)
 		if err != nil {
 			continue
 		}
@@ -319,7 +357,7 @@ func (s *Store) DataDump() string {
 				if v == "" {
 					continue
 				}
-				if isNoiseColumn(col) {
+				if isNoiseColumn(col) && !(includeDeleted && col == ColDeletedAt) {
 					continue
 				}
 				parts = append(parts, formatColumnValue(col, v))
@@ -462,7 +500,7 @@ func isIdentChar(b byte) bool {
 // dumpTable queries all non-deleted rows from a single table, returning
 // them as string slices along with column names. The sql.Rows lifecycle
 // is scoped to this function so defer closes correctly.
-func dumpTable(s *Store, name string) ([][]string, []string, error) {
+func dumpTable(s *Store, name string, includeDeleted bool) ([][]string, []string, error) {
 	sqlRows, err := s.db.Raw("SELECT * FROM " + name).Rows()
 	if err != nil {
 		return nil, nil, err
@@ -472,16 +510,17 @@ func dumpTable(s *Store, name string) ([][]string, []string, error) {
 	if err != nil {
 		return nil, nil, err
 	}
-	rows, err := scanTableRows(sqlRows, cols)
+	rows, err := scanTableRows(sqlRows, cols, includeDeleted)
 	if err != nil {
 		return nil, nil, err
 	}
 	return rows, cols, nil
 }
 
-// scanTableRows reads all non-deleted rows from an open sql.Rows into
-// string slices. The caller must close sqlRows after this returns.
-func scanTableRows(sqlRows *sql.Rows, cols []string) ([][]string, error) {
+// scanTableRows reads all rows from an open sql.Rows into string slices,
+// skipping soft-deleted ones unless includeDeleted is set. The caller must
+// close sqlRows after this returns.
+func scanTableRows(sqlRows *sql.Rows, cols []string, includeDeleted bool) ([][]string, error) {
 	deletedAtIdx := -1
 	for i, c := range cols {
 		if strings.ToLower(c) == ColDeletedAt {
@@ -500,7 +539,7 @@ func scanTableRows(sqlRows *sql.Rows, cols []string) ([][]string, error) {
 		if err := sqlRows.Scan(ptrs...); err != nil {
 			continue
 		}
-		if deletedAtIdx >= 0 && values[deletedAtIdx] != nil {
+		if !includeDeleted && deletedAtIdx >= 0 && values[deletedAtIdx] != nil {
 			continue
 		}
 		row := make([]string, len(cols))
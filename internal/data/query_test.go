@@ -4,7 +4,9 @@
 package data
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -68,10 +70,40 @@ func TestReadOnlyQuerySelect(t *testing.T) {
 		"SELECT name FROM project_types ORDER BY name LIMIT 3",
 	)
 	require.NoError(t, err)
-	assert.Equal(t, []string{"name"}, cols)
+	assert.Equal(t, []ColumnMeta{{Name: "name", DeclType: "TEXT"}}, cols)
 	assert.Len(t, rows, 3)
 }
 
+func TestReadOnlyQueryKeepsTypes(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	bought := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	cost := int64(129900)
+	require.NoError(t, store.db.Create(&Appliance{
+		Name: "Fridge", CostCents: &cost, PurchaseDate: &bought,
+	}).Error)
+
+	cols, rows, err := store.ReadOnlyQuery(t.Context(),
+		"SELECT name, cost_cents, purchase_date, cost_cents / 100.0 AS dollars, model_number "+
+			"FROM appliances WHERE name = 'Fridge'",
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"name", "cost_cents", "purchase_date", "dollars", "model_number"},
+		ColumnNames(cols))
+	assert.Empty(t, cols[3].DeclType, "computed columns have no declared type")
+	require.Len(t, rows, 1)
+	assert.Equal(t, "Fridge", rows[0][0])
+	assert.Equal(t, int64(129900), rows[0][1])
+	require.IsType(t, time.Time{}, rows[0][2])
+	assert.True(t, bought.Equal(rows[0][2].(time.Time)))
+	assert.InDelta(t, 1299.0, rows[0][3], 0.001)
+	assert.Equal(t, "", FormatQueryValue(rows[0][4]), "empty or NULL text")
+
+	strs := QueryRowStrings(rows)
+	assert.Equal(t, "129900", strs[0][1])
+	assert.Equal(t, "1299", strs[0][3])
+}
+
 func TestReadOnlyQueryRejectsInsert(t *testing.T) {
 	t.Parallel()
 	store := newTestStore(t)
@@ -139,7 +171,7 @@ func TestReadOnlyQueryAllowsDeletedAtColumn(t *testing.T) {
 		"SELECT id FROM projects WHERE deleted_at IS NULL LIMIT 1",
 	)
 	require.NoError(t, err)
-	assert.Equal(t, []string{"id"}, cols)
+	assert.Equal(t, []string{"id"}, ColumnNames(cols))
 }
 
 func TestReadOnlyQueryAllowsWithCTE(t *testing.T) {
@@ -149,7 +181,7 @@ func TestReadOnlyQueryAllowsWithCTE(t *testing.T) {
 		"WITH cte AS (SELECT name FROM project_types) SELECT name FROM cte LIMIT 1",
 	)
 	require.NoError(t, err)
-	assert.Equal(t, []string{"name"}, cols)
+	assert.Equal(t, []string{"name"}, ColumnNames(cols))
 }
 
 func TestReadOnlyQueryRejectsCommentHiddenInsert(t *testing.T) {
@@ -271,7 +303,7 @@ func TestDataDump(t *testing.T) {
 	t.Parallel()
 	store := newTestStoreWithDemoData(t, testSeed)
 
-	dump := store.DataDump()
+	dump := store.DataDump(false)
 	assert.NotEmpty(t, dump)
 	// Should contain table headers with row counts.
 	assert.Contains(t, dump, "rows)")
@@ -291,13 +323,37 @@ func TestDataDumpExcludesSoftDeletedRecords(t *testing.T) {
 	// Create a non-deleted vendor to verify the dump still works.
 	require.NoError(t, store.db.Create(&Vendor{Name: "ActiveVendorABC"}).Error)
 
-	dump := store.DataDump()
+	dump := store.DataDump(false)
 	assert.NotContains(t, dump, "DeletedVendorXYZ",
 		"soft-deleted vendor should not appear in DataDump")
 	assert.Contains(t, dump, "ActiveVendorABC",
 		"active vendor should appear in DataDump")
 }
 
+func TestDataDumpIncludeDeleted(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+
+	v := Vendor{Name: "DeletedVendorXYZ"}
+	require.NoError(t, store.db.Create(&v).Error)
+	require.NoError(t, store.db.Delete(&v).Error)
+	require.NoError(t, store.db.Create(&Vendor{Name: "ActiveVendorABC"}).Error)
+
+	dump := store.DataDump(true)
+	assert.Contains(t, dump, "vendors (2 rows)")
+	var deletedLine, activeLine string
+	for line := range strings.SplitSeq(dump, "\n") {
+		switch {
+		case strings.Contains(line, "DeletedVendorXYZ"):
+			deletedLine = line
+		case strings.Contains(line, "ActiveVendorABC"):
+			activeLine = line
+		}
+	}
+	assert.Contains(t, deletedLine, ColDeletedAt+": ", "deleted rows say when")
+	assert.NotContains(t, activeLine, ColDeletedAt)
+}
+
 func TestColumnHints(t *testing.T) {
 	t.Parallel()
 	store := newTestStoreWithDemoData(t, testSeed)
@@ -0,0 +1,97 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package data
+
+import (
+	"fmt"
+)
+
+// SchemaVersion is the database schema version this binary reads and
+// writes. It is stored in SQLite's user_version pragma. Bump it and append
+// a line to schemaChanges whenever a release changes the shape of Models(),
+// the migration steps in AutoMigrate, or the FTS triggers (a bump is what
+// makes AutoMigrate rebuild the search index).
+const SchemaVersion = 6
+
+// schemaChanges[i] describes what schema version i+1 changed, in words a
+// user deciding whether to upgrade can follow.
+var schemaChanges = []string{
+	"record the schema version so upgrades and downgrades are detected",
+	"add a snoozed-until date to maintenance items so reminders can be snoozed",
+	"add a recurrence interval to projects so repeating projects can be rescheduled",
+	"add a day count to maintenance intervals so items can repeat every few days or weeks",
+	"add a currency to project budgets and appliance costs",
+	"add an archived date to appliances so ones you no longer own can be put away",
+}
+
+// SchemaTooNewError reports a database written by a newer micasa than the
+// running one. Migrating it would drop or misread columns this binary does
+// not know about.
+type SchemaTooNewError struct {
+	Stored    int
+	Supported int
+}
+
+func (e *SchemaTooNewError) Error() string {
+	return fmt.Sprintf(
+		"database schema version %d is newer than this micasa supports (%d) -- "+
+			"upgrade micasa to open it; the database was left unchanged",
+		e.Stored, e.Supported,
+	)
+}
+
+// MigrationPlan describes the schema migration AutoMigrate would run.
+type MigrationPlan struct {
+	From    int      // version stored in the database
+	To      int      // SchemaVersion
+	Changes []string // one entry per version between From and To
+}
+
+// Needed reports whether the database is behind this binary.
+func (p MigrationPlan) Needed() bool {
+	return p.From < p.To
+}
+
+// StoredSchemaVersion returns the schema version recorded in the database.
+// Databases created before versioning, and empty databases, report 0.
+func (s *Store) StoredSchemaVersion() (int, error) {
+	var v int
+	if err := s.db.Raw("PRAGMA user_version").Scan(&v).Error; err != nil {
+		return 0, fmt.Errorf("read schema version: %w", err)
+	}
+	return v, nil
+}
+
+func (s *Store) setSchemaVersion(v int) error {
+	// PRAGMA does not accept bound parameters.
+	if err := s.db.Exec(fmt.Sprintf("PRAGMA user_version = %d", v)).Error; err != nil {
+		return fmt.Errorf("record schema version: %w", err)
+	}
+	return nil
+}
+
+// PendingMigration compares the stored schema version with SchemaVersion.
+// A database without micasa tables has nothing to migrate: AutoMigrate
+// creates it at the current version. Returns *SchemaTooNewError when the
+// database comes from a newer binary.
+func (s *Store) PendingMigration() (MigrationPlan, error) {
+	plan := MigrationPlan{From: SchemaVersion, To: SchemaVersion}
+	stored, err := s.StoredSchemaVersion()
+	if err != nil {
+		return plan, err
+	}
+	if stored > SchemaVersion {
+		return plan, &SchemaTooNewError{Stored: stored, Supported: SchemaVersion}
+	}
+	ok, err := s.IsMicasaDB()
+	if err != nil {
+		return plan, fmt.Errorf("check database schema: %w", err)
+	}
+	if !ok {
+		return plan, nil
+	}
+	plan.From = stored
+	plan.Changes = schemaChanges[stored:SchemaVersion]
+	return plan, nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package data
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaChangesCoverEveryVersion(t *testing.T) {
+	t.Parallel()
+	assert.Len(t, schemaChanges, SchemaVersion)
+}
+
+func TestAutoMigrateRecordsSchemaVersion(t *testing.T) {
+	t.Parallel()
+	store, err := Open(filepath.Join(t.TempDir(), "fresh.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+
+	plan, err := store.PendingMigration()
+	require.NoError(t, err)
+	assert.False(t, plan.Needed(), "a fresh database is created at the current version")
+
+	require.NoError(t, store.AutoMigrate())
+	v, err := store.StoredSchemaVersion()
+	require.NoError(t, err)
+	assert.Equal(t, SchemaVersion, v)
+}
+
+func TestPendingMigrationUnversionedDB(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	require.NoError(t, store.setSchemaVersion(0))
+
+	plan, err := store.PendingMigration()
+	require.NoError(t, err)
+	assert.True(t, plan.Needed())
+	assert.Equal(t, 0, plan.From)
+	assert.Equal(t, SchemaVersion, plan.To)
+	assert.Equal(t, schemaChanges, plan.Changes)
+
+	require.NoError(t, store.AutoMigrate())
+	plan, err = store.PendingMigration()
+	require.NoError(t, err)
+	assert.False(t, plan.Needed())
+}
+
+func TestSchemaTooNewRefused(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	require.NoError(t, store.setSchemaVersion(SchemaVersion+1))
+
+	_, err := store.PendingMigration()
+	var tooNew *SchemaTooNewError
+	require.ErrorAs(t, err, &tooNew)
+	assert.Equal(t, SchemaVersion+1, tooNew.Stored)
+	assert.Contains(t, err.Error(), "upgrade micasa")
+
+	err = store.AutoMigrate()
+	require.True(t, errors.As(err, &tooNew), "AutoMigrate must refuse too")
+	v, err := store.StoredSchemaVersion()
+	require.NoError(t, err)
+	assert.Equal(t, SchemaVersion+1, v, "version is left alone")
+	assert.Contains(t, tooNew.Error(), fmt.Sprintf("(%d)", SchemaVersion))
+}
@@ -4,6 +4,7 @@
 package data
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -19,6 +20,8 @@ const (
 	settingUnitSystem        = "ui.unit_system"
 	settingTesseractHintSeen = "hint.tesseract_shown"
 	settingCurrency          = "locale.currency"
+	settingSavedViewsPrefix  = "ui.views."
+	settingColumnWidthPrefix = "ui.widths."
 
 	// chatHistoryMax is the maximum number of chat inputs retained.
 	chatHistoryMax = 200
@@ -118,6 +121,81 @@ func (s *Store) PutCurrency(code string) error {
 	return s.PutSetting(settingCurrency, code)
 }
 
+// SavedView is a named filter and sort combination for one tab. Columns are
+// stored by title so views survive column reordering.
+type SavedView struct {
+	Name           string      `json:"name"`
+	Sorts          []SavedSort `json:"sorts,omitempty"`
+	Pins           []SavedPin  `json:"pins,omitempty"`
+	FilterActive   bool        `json:"filter_active,omitempty"`
+	FilterInverted bool        `json:"filter_inverted,omitempty"`
+	MagMode        bool        `json:"mag_mode,omitempty"` // pins hold magnitude values
+}
+
+// SavedSort is one sort key of a SavedView.
+type SavedSort struct {
+	Column string `json:"column"`
+	Desc   bool   `json:"desc,omitempty"`
+}
+
+// SavedPin is the set of pinned values for one column of a SavedView.
+type SavedPin struct {
+	Column string   `json:"column"`
+	Values []string `json:"values"`
+}
+
+// GetSavedViews returns the saved views for a tab, in the order they were
+// saved. Returns nil when the tab has none.
+func (s *Store) GetSavedViews(tab string) ([]SavedView, error) {
+	val, err := s.GetSetting(settingSavedViewsPrefix + tab)
+	if err != nil || val == "" {
+		return nil, err
+	}
+	var views []SavedView
+	if err := json.Unmarshal([]byte(val), &views); err != nil {
+		return nil, fmt.Errorf("decode saved views for %s: %w", tab, err)
+	}
+	return views, nil
+}
+
+// PutSavedViews replaces the saved views for a tab.
+func (s *Store) PutSavedViews(tab string, views []SavedView) error {
+	if views == nil {
+		views = []SavedView{}
+	}
+	val, err := json.Marshal(views)
+	if err != nil {
+		return fmt.Errorf("encode saved views for %s: %w", tab, err)
+	}
+	return s.PutSetting(settingSavedViewsPrefix+tab, string(val))
+}
+
+// GetColumnWidths returns the user's column width overrides for a tab,
+// keyed by column title. Returns nil when the tab has none.
+func (s *Store) GetColumnWidths(tab string) (map[string]int, error) {
+	val, err := s.GetSetting(settingColumnWidthPrefix + tab)
+	if err != nil || val == "" {
+		return nil, err
+	}
+	var widths map[string]int
+	if err := json.Unmarshal([]byte(val), &widths); err != nil {
+		return nil, fmt.Errorf("decode column widths for %s: %w", tab, err)
+	}
+	return widths, nil
+}
+
+// PutColumnWidths replaces the column width overrides for a tab.
+func (s *Store) PutColumnWidths(tab string, widths map[string]int) error {
+	if widths == nil {
+		widths = map[string]int{}
+	}
+	val, err := json.Marshal(widths)
+	if err != nil {
+		return fmt.Errorf("encode column widths for %s: %w", tab, err)
+	}
+	return s.PutSetting(settingColumnWidthPrefix+tab, string(val))
+}
+
 // AppendChatInput adds a prompt to the persistent history, deduplicating
 // consecutive repeats. Trims old entries beyond chatHistoryMax.
 func (s *Store) AppendChatInput(input string) error {
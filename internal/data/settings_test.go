@@ -153,3 +153,77 @@ func TestShowDashboardRoundTrip(t *testing.T) {
 	require.NoError(t, err)
 	assert.True(t, show)
 }
+
+func TestSavedViewsRoundTrip(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+
+	views, err := store.GetSavedViews("projects")
+	require.NoError(t, err)
+	assert.Empty(t, views)
+
+	want := []SavedView{{
+		Name:         "active big",
+		Sorts:        []SavedSort{{Column: "Budget", Desc: true}},
+		Pins:         []SavedPin{{Column: "Status", Values: []string{"underway"}}},
+		FilterActive: true,
+	}}
+	require.NoError(t, store.PutSavedViews("projects", want))
+
+	views, err = store.GetSavedViews("projects")
+	require.NoError(t, err)
+	assert.Equal(t, want, views)
+
+	// Views are per tab.
+	views, err = store.GetSavedViews("vendors")
+	require.NoError(t, err)
+	assert.Empty(t, views)
+
+	require.NoError(t, store.PutSavedViews("projects", nil))
+	views, err = store.GetSavedViews("projects")
+	require.NoError(t, err)
+	assert.Empty(t, views)
+}
+
+func TestGetSavedViewsCorrupt(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	require.NoError(t, store.PutSetting(settingSavedViewsPrefix+"projects", "{"))
+	_, err := store.GetSavedViews("projects")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "decode saved views")
+}
+
+func TestColumnWidthsRoundTrip(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+
+	widths, err := store.GetColumnWidths("appliances")
+	require.NoError(t, err)
+	assert.Empty(t, widths)
+
+	want := map[string]int{"Name": 30, "Serial": 6}
+	require.NoError(t, store.PutColumnWidths("appliances", want))
+	widths, err = store.GetColumnWidths("appliances")
+	require.NoError(t, err)
+	assert.Equal(t, want, widths)
+
+	// Widths are per tab.
+	widths, err = store.GetColumnWidths("projects")
+	require.NoError(t, err)
+	assert.Empty(t, widths)
+
+	require.NoError(t, store.PutColumnWidths("appliances", nil))
+	widths, err = store.GetColumnWidths("appliances")
+	require.NoError(t, err)
+	assert.Empty(t, widths)
+}
+
+func TestGetColumnWidthsCorrupt(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	require.NoError(t, store.PutSetting(settingColumnWidthPrefix+"projects", "["))
+	_, err := store.GetColumnWidths("projects")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "decode column widths")
+}
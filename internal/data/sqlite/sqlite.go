@@ -331,6 +331,21 @@ func (dialector Dialector) Translate(err error) error {
 	return err
 }
 
+// IsBusy reports whether err is SQLITE_BUSY or SQLITE_LOCKED (including
+// their extended codes): another connection held the database longer than
+// the busy timeout.
+func IsBusy(err error) bool {
+	var terr *sqlite.Error
+	if !errors.As(err, &terr) {
+		return false
+	}
+	switch terr.Code() & 0xff {
+	case sqlite3.SQLITE_BUSY, sqlite3.SQLITE_LOCKED:
+		return true
+	}
+	return false
+}
+
 func compareVersion(version1, version2 string) int {
 	n, m := len(version1), len(version2)
 	i, j := 0, 0
@@ -330,3 +330,29 @@ func TestCompareVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestIsBusy(t *testing.T) {
+	t.Parallel()
+	path := t.TempDir() + "/busy.db"
+	holder, err := sql.Open(DriverName, path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = holder.Close() })
+	_, err = holder.Exec("CREATE TABLE t (x integer)")
+	require.NoError(t, err)
+
+	tx, err := holder.Begin()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = tx.Rollback() })
+	_, err = tx.Exec("INSERT INTO t VALUES (1)")
+	require.NoError(t, err)
+
+	other, err := sql.Open(DriverName, path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = other.Close() })
+	_, err = other.Exec("INSERT INTO t VALUES (2)")
+	require.Error(t, err)
+	assert.True(t, IsBusy(err), "got %v", err)
+
+	assert.False(t, IsBusy(nil))
+	assert.False(t, IsBusy(gorm.ErrDuplicatedKey))
+}
@@ -4,6 +4,7 @@
 package data
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
 	"os"
@@ -24,7 +25,16 @@ type Store struct {
 	db              *gorm.DB
 	maxDocumentSize uint64
 	currency        locale.Currency
+	currencyFormats map[string]locale.CurrencyFormat
 	deviceCell      *deviceIDCell
+	blobDir         string
+	blobFiles       bool
+	// manualLastServiced stops service log changes from updating their
+	// maintenance item's LastServicedAt.
+	manualLastServiced bool
+	// networkFS names the network filesystem the database is on, or is
+	// empty for a local one.
+	networkFS string
 }
 
 func unscopedPreload(q *gorm.DB) *gorm.DB { return q.Unscoped() }
@@ -111,14 +121,22 @@ func (s *Store) checkDependencies(id string, checks []dependencyCheck) error {
 	return nil
 }
 
+// Open opens (creating if needed) the SQLite database at path. On a network
+// filesystem it uses a rollback journal instead of WAL, whose shared-memory
+// index only works between processes on the same host.
 func Open(path string) (*Store, error) {
 	if err := ValidateDBPath(path); err != nil {
 		return nil, err
 	}
+	netFS, onNetwork := NetworkFilesystem(path)
+	journal := "PRAGMA journal_mode = WAL"
+	if onNetwork {
+		journal = "PRAGMA journal_mode = DELETE"
+	}
 	db, err := gorm.Open(
 		sqlite.Open(path,
 			"PRAGMA foreign_keys = ON",
-			"PRAGMA journal_mode = WAL",
+			journal,
 			"PRAGMA synchronous = NORMAL",
 			"PRAGMA busy_timeout = 5000",
 		),
@@ -129,9 +147,18 @@ func Open(path string) (*Store, error) {
 	if err != nil {
 		return nil, fmt.Errorf("open db: %w", err)
 	}
+	if err := registerErrorClassifier(db); err != nil {
+		return nil, err
+	}
 	cell := &deviceIDCell{}
 	db = db.WithContext(withDeviceIDCell(db.Statement.Context, cell))
-	return &Store{db: db, deviceCell: cell}, nil
+	return &Store{db: db, deviceCell: cell, networkFS: netFS}, nil
+}
+
+// NetworkFilesystem returns the name of the network filesystem the database
+// is on and true, or false when it looks local. See NetworkFilesystem.
+func (s *Store) NetworkFilesystem() (string, bool) {
+	return s.networkFS, s.networkFS != ""
 }
 
 // GormDB returns the underlying *gorm.DB for use by sync.ApplyOps,
@@ -155,6 +182,19 @@ func (s *Store) SetMaxDocumentSize(n uint64) error {
 	return nil
 }
 
+// SetLastServicedSync controls whether creating, editing, deleting, or
+// restoring a service log entry updates its maintenance item's
+// LastServicedAt (and so its next due date). It is on by default.
+func (s *Store) SetLastServicedSync(enabled bool) {
+	s.manualLastServiced = !enabled
+}
+
+// LastServicedSync reports whether service log changes update the
+// maintenance item's LastServicedAt.
+func (s *Store) LastServicedSync() bool {
+	return !s.manualLastServiced
+}
+
 // Currency returns the resolved currency for this store.
 func (s *Store) Currency() locale.Currency {
 	return s.currency
@@ -166,6 +206,13 @@ func (s *Store) SetCurrency(cur locale.Currency) {
 	s.currency = cur
 }
 
+// SetCurrencyFormats registers custom display formats keyed by currency
+// code. ResolveCurrency prefers them over CLDR, so codes outside ISO 4217
+// and codes the detected locale formats poorly still display correctly.
+func (s *Store) SetCurrencyFormats(formats map[string]locale.CurrencyFormat) {
+	s.currencyFormats = formats
+}
+
 // ResolveCurrency determines the currency to use. The database value is
 // authoritative for the currency CODE; if unset, resolves from
 // configured/env/locale and persists the code for portability. The
@@ -178,14 +225,14 @@ func (s *Store) ResolveCurrency(configured string) error {
 		return fmt.Errorf("read currency from database: %w", err)
 	}
 	if code != "" {
-		cur, err := locale.Resolve(code, tag)
+		cur, err := locale.ResolveWith(code, tag, s.currencyFormats)
 		if err != nil {
 			return err
 		}
 		s.currency = cur
 		return nil
 	}
-	cur, err := locale.ResolveDefault(configured)
+	cur, err := locale.ResolveWith(locale.DefaultCode(configured), tag, s.currencyFormats)
 	if err != nil {
 		return err
 	}
@@ -293,10 +340,14 @@ func (s *Store) Close() error {
 func (s *Store) Transaction(fn func(tx *Store) error) error {
 	return s.db.Transaction(func(tx *gorm.DB) error {
 		txStore := &Store{
-			db:              tx,
-			maxDocumentSize: s.maxDocumentSize,
-			currency:        s.currency,
-			deviceCell:      s.deviceCell,
+			db:                 tx,
+			maxDocumentSize:    s.maxDocumentSize,
+			currency:           s.currency,
+			currencyFormats:    s.currencyFormats,
+			deviceCell:         s.deviceCell,
+			blobDir:            s.blobDir,
+			blobFiles:          s.blobFiles,
+			manualLastServiced: s.manualLastServiced,
 		}
 		return fn(txStore)
 	})
@@ -327,21 +378,47 @@ func (s *Store) SetQueryOnly() error {
 	return nil
 }
 
+// AutoMigrate brings the schema up to SchemaVersion and records it. It
+// refuses with *SchemaTooNewError, before touching any table, when the
+// database was written by a newer binary.
 func (s *Store) AutoMigrate() error {
+	stored, err := s.StoredSchemaVersion()
+	if err != nil {
+		return err
+	}
+	if stored > SchemaVersion {
+		return &SchemaTooNewError{Stored: stored, Supported: SchemaVersion}
+	}
 	if err := migrateIntToStringIDs(s.db); err != nil {
 		return fmt.Errorf("pre-migrate int-to-string IDs: %w", err)
 	}
 	if err := s.db.AutoMigrate(Models()...); err != nil {
 		return err
 	}
-	return s.setupFTS()
+	if err := s.setupFTS(stored < SchemaVersion); err != nil {
+		return err
+	}
+	if stored == SchemaVersion {
+		return nil
+	}
+	return s.setSchemaVersion(SchemaVersion)
 }
 
 func (s *Store) SeedDefaults() error {
-	if err := s.seedProjectTypes(); err != nil {
+	return s.SeedDefaultsWith(SeedNames{})
+}
+
+// SeedDefaultsWith seeds the built-in project types and maintenance
+// categories plus extra ones. Names already present, ignoring case, are
+// left alone, so it's safe to run on every start.
+func (s *Store) SeedDefaultsWith(extra SeedNames) error {
+	if err := extra.Validate(); err != nil {
 		return err
 	}
-	return s.seedMaintenanceCategories()
+	if err := s.seedProjectTypes(extra.ProjectTypes); err != nil {
+		return err
+	}
+	return s.seedMaintenanceCategories(extra.MaintenanceCategories)
 }
 
 // SeedDemoData populates the database with realistic demo data using a fixed
@@ -395,7 +472,13 @@ var (
 // soft-deleted, or ErrParentNotFound if it doesn't exist at all. Returns nil
 // when the parent is alive.
 func (s *Store) requireParentAlive(model any, id string) error {
-	err := s.db.First(model, "id = ?", id).Error
+	return requireParentAliveWith(s.db, model, id)
+}
+
+// requireParentAliveWith is requireParentAlive for an explicit handle, so
+// checks can run inside a transaction.
+func requireParentAliveWith(db *gorm.DB, model any, id string) error {
+	err := db.First(model, "id = ?", id).Error
 	if err == nil {
 		return nil
 	}
@@ -403,12 +486,47 @@ func (s *Store) requireParentAlive(model any, id string) error {
 		return err
 	}
 	// Distinguish soft-deleted from truly missing.
-	if err := s.db.Unscoped().First(model, "id = ?", id).Error; err != nil {
+	if err := db.Unscoped().First(model, "id = ?", id).Error; err != nil {
 		return ErrParentNotFound
 	}
 	return ErrParentDeleted
 }
 
+// reparentCheck describes an update that may move a row to a different
+// parent: fkColumn on model holds the current parent, parentID the new one
+// (nil for an optional FK being cleared).
+type reparentCheck struct {
+	model    any
+	id       string
+	fkColumn string
+	parent   any
+	parentID *string
+	label    string
+}
+
+// requireReparentAlive checks, when an update moves a row to a different
+// parent, that the new parent exists and isn't deleted. Keeping the current
+// parent always passes so unrelated edits never fail on it.
+func requireReparentAlive(tx *gorm.DB, c reparentCheck) error {
+	if c.parentID == nil {
+		return nil
+	}
+	var current sql.NullString
+	if err := tx.Unscoped().Model(c.model).
+		Select(c.fkColumn).
+		Where(ColID+" = ?", c.id).
+		Row().Scan(&current); err != nil {
+		return err
+	}
+	if current.Valid && current.String == *c.parentID {
+		return nil
+	}
+	if err := requireParentAliveWith(tx, c.parent, *c.parentID); err != nil {
+		return parentRestoreError(c.label, err)
+	}
+	return nil
+}
+
 // parentRestoreError returns a user-facing error message for a failed parent
 // alive check, distinguishing soft-deleted parents (restorable) from missing
 // parents (permanently gone).
@@ -453,7 +571,7 @@ func softDeleteWith(tx *gorm.DB, model any, entity string, id string) error {
 		return result.Error
 	}
 	if result.RowsAffected == 0 {
-		return gorm.ErrRecordNotFound
+		return errRecordNotFound
 	}
 
 	// Write oplog "delete" entry for the soft-deleted entity.
@@ -3,7 +3,11 @@
 
 package data
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 func (s *Store) ListAppliances(includeDeleted bool) ([]Appliance, error) {
 	return listQuery[Appliance](s, includeDeleted, func(db *gorm.DB) *gorm.DB {
@@ -33,10 +37,32 @@ func (s *Store) FindOrCreateAppliance(item Appliance) (Appliance, error) {
 	)
 }
 
+// UpdateAppliance persists changes to an appliance. The stored ArchivedAt
+// is kept -- forms and extraction don't carry it -- so use ArchiveAppliance
+// to change it.
 func (s *Store) UpdateAppliance(item Appliance) error {
+	var stored Appliance
+	if err := s.db.Unscoped().Select(ColArchivedAt).
+		Where(ColID+" = ?", item.ID).
+		First(&stored).Error; err != nil {
+		return err
+	}
+	item.ArchivedAt = stored.ArchivedAt
 	return s.updateByID(TableAppliances, &Appliance{}, item.ID, item)
 }
 
+// ArchiveAppliance marks an appliance as no longer owned as of the given
+// time. Archived appliances keep their history but drop off the dashboard.
+// A nil time unarchives it.
+func (s *Store) ArchiveAppliance(id string, at *time.Time) error {
+	item, err := s.GetAppliance(id)
+	if err != nil {
+		return err
+	}
+	item.ArchivedAt = at
+	return s.updateByID(TableAppliances, &Appliance{}, id, item)
+}
+
 func (s *Store) DeleteAppliance(id string) error {
 	if err := s.checkDependencies(id, []dependencyCheck{
 		{&MaintenanceItem{}, ColApplianceID, "appliance has %d active maintenance item(s) -- delete or reassign them first"},
@@ -6,12 +6,8 @@ package data
 import (
 	"errors"
 	"fmt"
-	"path/filepath"
-	"strings"
-	"unicode"
 
 	"github.com/dustin/go-humanize"
-	"github.com/iancoleman/strcase"
 
 	"gorm.io/gorm"
 )
@@ -83,8 +79,37 @@ func (s *Store) CountDocumentsByEntity(
 	return counts, nil
 }
 
+// DocumentStorage summarizes the space document files take in the
+// database. Soft-deleted documents keep their blobs until purged, so they
+// count toward Bytes and are also broken out on their own.
+type DocumentStorage struct {
+	Bytes        int64
+	DeletedBytes int64
+}
+
+// DocumentStorage totals the size of every stored document.
+func (s *Store) DocumentStorage() (DocumentStorage, error) {
+	var out DocumentStorage
+	err := s.db.Unscoped().Model(&Document{}).
+		Select(
+			"coalesce(sum(" + ColSizeBytes + "), 0) as bytes, " +
+				"coalesce(sum(case when " + ColDeletedAt + " is not null then " +
+				ColSizeBytes + " end), 0) as deleted_bytes",
+		).
+		Scan(&out).Error
+	if err != nil {
+		return DocumentStorage{}, fmt.Errorf("sum document sizes: %w", err)
+	}
+	return out, nil
+}
+
 func (s *Store) GetDocument(id string) (Document, error) {
-	return getByID[Document](s, id, identity)
+	doc, err := getByID[Document](s, id, identity)
+	if err != nil {
+		return doc, err
+	}
+	doc.Data, err = s.documentBlob(doc)
+	return doc, err
 }
 
 // GetDocumentMetadata loads a document by ID without the Data BLOB,
@@ -100,18 +125,35 @@ func (s *Store) GetDocumentMetadata(id string) (Document, error) {
 // (meaning they had file data at some point) but currently have no Data
 // (blob not yet fetched from the relay). These are candidates for blob
 // download during sync pull. Soft-deleted documents are automatically
-// excluded by GORM's DeletedAt scoping (Document uses gorm.DeletedAt).
+// excluded by GORM's DeletedAt scoping (Document uses gorm.DeletedAt), and
+// documents whose content is already in the blob directory are skipped.
 func (s *Store) PendingBlobDocuments() ([]Document, error) {
 	var docs []Document
 	err := s.db.Select(listDocumentColumns).
 		Where("sha256 != '' AND data IS NULL").
 		Order("updated_at DESC, id DESC").
 		Find(&docs).Error
-	return docs, err
+	if err != nil || s.blobDir == "" {
+		return docs, err
+	}
+	pending := docs[:0]
+	for _, doc := range docs {
+		if !s.hasBlobFile(doc.ChecksumSHA256) {
+			pending = append(pending, doc)
+		}
+	}
+	return pending, nil
 }
 
-// UpdateDocumentData sets the Data blob on an existing document by ID.
+// UpdateDocumentData sets the Data blob on an existing document by ID. With
+// file storage enabled the content goes to the blob directory instead.
 func (s *Store) UpdateDocumentData(id string, data []byte) error {
+	if s.blobFiles && len(data) > 0 {
+		if _, err := s.writeBlob(data); err != nil {
+			return err
+		}
+		data = nil
+	}
 	return s.db.Model(&Document{}).Where("id = ?", id).Update("data", data).Error
 }
 
@@ -126,20 +168,48 @@ func (s *Store) CreateDocument(doc *Document) error {
 			humanize.IBytes(s.maxDocumentSize),
 		)
 	}
-	return s.db.Create(doc).Error
+	if !s.blobFiles || len(doc.Data) == 0 {
+		return s.db.Create(doc).Error
+	}
+	sum, err := s.writeBlob(doc.Data)
+	if err != nil {
+		return err
+	}
+	content := doc.Data
+	doc.ChecksumSHA256 = sum
+	doc.Data = nil
+	err = s.db.Create(doc).Error
+	doc.Data = content
+	return err
 }
 
 // UpdateDocument persists changes to a document. Entity linkage (EntityID,
 // EntityKind) is always preserved -- callers must use a dedicated method to
 // re-link a document. When Data is empty the existing BLOB and file metadata
 // columns are also preserved, so metadata-only edits don't erase the file.
+// Replacing the content of a file-backed document removes the old file once
+// no document refers to it.
 func (s *Store) UpdateDocument(doc Document) error {
 	omit := []string{ColID, ColCreatedAt, ColDeletedAt}
+	var oldSum string
 	if len(doc.Data) == 0 {
 		omit = append(omit,
 			ColFileName, ColMIMEType, ColSizeBytes,
 			ColChecksumSHA256, ColData,
 		)
+	} else if s.blobFiles {
+		var old Document
+		if err := s.db.Unscoped().Select(ColChecksumSHA256).
+			First(&old, ColID+" = ?", doc.ID).Error; err != nil {
+			return err
+		}
+		oldSum = old.ChecksumSHA256
+		sum, err := s.writeBlob(doc.Data)
+		if err != nil {
+			return err
+		}
+		doc.ChecksumSHA256 = sum
+		doc.Data = nil
 	}
 	if err := s.db.Model(&Document{}).Where(ColID+" = ?", doc.ID). //nolint:unqueryvet // GORM Select("*") updates all non-omitted columns
 									Select("*").
@@ -154,13 +224,18 @@ func (s *Store) UpdateDocument(doc Document) error {
 		if err := s.db.First(&full, ColID+" = ?", doc.ID).Error; err != nil {
 			return err
 		}
-		return writeOplogEntry(
+		if err := writeOplogEntry(
 			s.db,
 			TableDocuments,
 			doc.ID,
 			OpUpdate,
 			newDocumentOplogPayload(full),
-		)
+		); err != nil {
+			return err
+		}
+	}
+	if oldSum != "" && oldSum != doc.ChecksumSHA256 {
+		return s.removeUnusedBlob(oldSum)
 	}
 	return nil
 }
@@ -273,43 +348,5 @@ func (s *Store) validateDocumentParent(doc Document) error {
 // stripping extensions (including compound ones like .tar.gz), splitting on
 // word boundaries via strcase, and title-casing each word.
 func TitleFromFilename(name string) string {
-	name = strings.TrimSpace(name)
-
-	// Always strip the outermost extension (every file has one).
-	if ext := filepath.Ext(name); ext != "" && ext != name {
-		name = strings.TrimSuffix(name, ext)
-	}
-
-	// Continue stripping known compound-extension intermediaries
-	// (e.g. .tar in .tar.gz, .tar.bz2, .tar.xz).
-	for {
-		ext := filepath.Ext(name)
-		if ext == "" || ext == name {
-			break
-		}
-		lower := strings.ToLower(ext)
-		if lower != ".tar" {
-			break
-		}
-		name = strings.TrimSuffix(name, ext)
-	}
-
-	// Split on word boundaries (camelCase, snake_case, kebab, dots).
-	name = strcase.ToDelimited(name, ' ')
-	name = strings.Join(strings.Fields(name), " ")
-
-	// Title-case each word.
-	runes := []rune(name)
-	wordStart := true
-	for i, r := range runes {
-		if unicode.IsSpace(r) {
-			wordStart = true
-			continue
-		}
-		if wordStart {
-			runes[i] = unicode.ToUpper(r)
-		}
-		wordStart = false
-	}
-	return string(runes)
+	return TitleRules{}.Title(name)
 }
@@ -14,7 +14,7 @@ func (s *Store) HouseProfile() (HouseProfile, error) {
 	var profile HouseProfile
 	err := s.db.First(&profile).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
-		return HouseProfile{}, gorm.ErrRecordNotFound
+		return HouseProfile{}, errRecordNotFound
 	}
 	return profile, err
 }
@@ -53,7 +53,7 @@ func (s *Store) DeleteIncident(id string) error {
 			return result.Error
 		}
 		if result.RowsAffected == 0 {
-			return gorm.ErrRecordNotFound
+			return errRecordNotFound
 		}
 		if !isSyncApplying(tx) {
 			if err := writeOplogEntryRaw(tx, TableIncidents, id, OpDelete); err != nil {
@@ -137,7 +137,7 @@ func (s *Store) HardDeleteIncident(id string) error {
 			return result.Error
 		}
 		if result.RowsAffected == 0 {
-			return gorm.ErrRecordNotFound
+			return errRecordNotFound
 		}
 		return nil
 	})
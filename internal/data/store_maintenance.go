@@ -3,7 +3,11 @@
 
 package data
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 func (s *Store) MaintenanceCategories() ([]MaintenanceCategory, error) {
 	var categories []MaintenanceCategory
@@ -56,8 +60,38 @@ func (s *Store) FindOrCreateMaintenance(item MaintenanceItem) (MaintenanceItem,
 	)
 }
 
+// UpdateMaintenance persists changes to a maintenance item, including
+// moving it to another appliance; the target appliance must exist and not
+// be deleted. The stored SnoozedUntil is kept -- forms and extraction don't
+// carry it -- so use SnoozeMaintenance to change it.
 func (s *Store) UpdateMaintenance(item MaintenanceItem) error {
-	return s.updateByID(TableMaintenanceItems, &MaintenanceItem{}, item.ID, item)
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		var stored MaintenanceItem
+		if err := tx.Unscoped().Select(ColSnoozedUntil).
+			Where(ColID+" = ?", item.ID).
+			First(&stored).Error; err != nil {
+			return err
+		}
+		if err := requireReparentAlive(tx, reparentCheck{
+			model: &MaintenanceItem{}, id: item.ID, fkColumn: ColApplianceID,
+			parent: &Appliance{}, parentID: item.ApplianceID, label: "appliance",
+		}); err != nil {
+			return err
+		}
+		item.SnoozedUntil = stored.SnoozedUntil
+		return updateByIDWith(tx, TableMaintenanceItems, &MaintenanceItem{}, item.ID, item)
+	})
+}
+
+// SnoozeMaintenance hides a maintenance item from the dashboard until the
+// given date. A nil date clears the snooze.
+func (s *Store) SnoozeMaintenance(id string, until *time.Time) error {
+	item, err := s.GetMaintenance(id)
+	if err != nil {
+		return err
+	}
+	item.SnoozedUntil = until
+	return s.updateByID(TableMaintenanceItems, &MaintenanceItem{}, id, item)
 }
 
 func (s *Store) DeleteMaintenance(id string) error {
@@ -117,7 +151,7 @@ func (s *Store) HardDeleteMaintenance(id string) error {
 			return result.Error
 		}
 		if result.RowsAffected == 0 {
-			return gorm.ErrRecordNotFound
+			return errRecordNotFound
 		}
 		return nil
 	})
@@ -0,0 +1,207 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package data
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// RecordMerge records what a merge changed so UndoMerge can put it back.
+type RecordMerge struct {
+	Entity    string // DeletionEntityVendor or DeletionEntityAppliance
+	KeepID    string
+	MergedIDs []string
+	// Moved maps each table to its moved rows, by row ID, and the record
+	// each of them pointed at before the merge.
+	Moved map[string]map[string]string
+}
+
+// mergeRef is a column that points at a mergeable record.
+type mergeRef struct {
+	table  string
+	column string
+	kind   string // documents only: the entity kind the link is for
+}
+
+// mergeEntity describes a record type that can be merged.
+type mergeEntity struct {
+	label    string
+	newModel func() any
+	refs     []mergeRef
+}
+
+var mergeEntities = map[string]mergeEntity{
+	DeletionEntityVendor: {
+		label:    "vendor",
+		newModel: func() any { return &Vendor{} },
+		refs: []mergeRef{
+			{table: TableQuotes, column: ColVendorID},
+			{table: TableServiceLogEntries, column: ColVendorID},
+			{table: TableIncidents, column: ColVendorID},
+			{table: TableDocuments, column: ColEntityID, kind: DocumentEntityVendor},
+		},
+	},
+	DeletionEntityAppliance: {
+		label:    "appliance",
+		newModel: func() any { return &Appliance{} },
+		refs: []mergeRef{
+			{table: TableMaintenanceItems, column: ColApplianceID},
+			{table: TableIncidents, column: ColApplianceID},
+			{table: TableDocuments, column: ColEntityID, kind: DocumentEntityAppliance},
+		},
+	},
+}
+
+// MergeVendors moves every quote, service log entry, incident, and
+// document of the merged vendors to the kept one, then soft-deletes the
+// merged vendors, all in one transaction.
+func (s *Store) MergeVendors(keepID string, mergeIDs []string) (RecordMerge, error) {
+	return s.mergeRecords(DeletionEntityVendor, keepID, mergeIDs)
+}
+
+// MergeAppliances moves every maintenance item, incident, and document of
+// the merged appliances to the kept one, then soft-deletes the merged
+// appliances, all in one transaction.
+func (s *Store) MergeAppliances(keepID string, mergeIDs []string) (RecordMerge, error) {
+	return s.mergeRecords(DeletionEntityAppliance, keepID, mergeIDs)
+}
+
+// mergeRecords repoints the references to mergeIDs at keepID and
+// soft-deletes mergeIDs. Deleted rows move too, so they can still be
+// restored later.
+func (s *Store) mergeRecords(entity, keepID string, mergeIDs []string) (RecordMerge, error) {
+	me := mergeEntities[entity]
+	merge := RecordMerge{
+		Entity:    entity,
+		KeepID:    keepID,
+		MergedIDs: mergeIDs,
+		Moved:     make(map[string]map[string]string, len(me.refs)),
+	}
+	if len(mergeIDs) == 0 {
+		return merge, fmt.Errorf("no %ss to merge", me.label)
+	}
+	if slices.Contains(mergeIDs, keepID) {
+		return merge, fmt.Errorf("cannot merge a %s into itself", me.label)
+	}
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := requireParentAliveWith(tx, me.newModel(), keepID); err != nil {
+			return parentRestoreError(me.label, err)
+		}
+		for _, ref := range me.refs {
+			moved, err := moveMergeRefs(tx, ref, mergeIDs, keepID)
+			if err != nil {
+				return err
+			}
+			if len(moved) > 0 {
+				merge.Moved[ref.table] = moved
+			}
+		}
+		for _, id := range mergeIDs {
+			if err := softDeleteWith(tx, me.newModel(), entity, id); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return merge, err
+}
+
+// UndoMerge restores the merged records and moves their rows back, in one
+// transaction.
+func (s *Store) UndoMerge(merge RecordMerge) error {
+	me, ok := mergeEntities[merge.Entity]
+	if !ok {
+		return fmt.Errorf("cannot undo a merge of %q", merge.Entity)
+	}
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		for _, id := range merge.MergedIDs {
+			if err := restoreSoftDeleted(tx, me.newModel(), merge.Entity, id); err != nil {
+				return err
+			}
+		}
+		for _, ref := range me.refs {
+			for id, prev := range merge.Moved[ref.table] {
+				if err := setMergeRef(tx, ref, id, prev); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// moveMergeRefs points every row whose ref column holds one of fromIDs at
+// toID, deleted rows included, and returns each moved row's previous value
+// by row ID.
+func moveMergeRefs(tx *gorm.DB, ref mergeRef, fromIDs []string, toID string) (map[string]string, error) {
+	var rows []struct {
+		ID  string
+		Ref string
+	}
+	q := tx.Table(ref.table).
+		Select(ColID+" AS id, "+ref.column+" AS ref").
+		Where(ref.column+" IN ?", fromIDs)
+	if ref.kind != "" {
+		q = q.Where(ColEntityKind+" = ?", ref.kind)
+	}
+	if err := q.Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	moved := make(map[string]string, len(rows))
+	for _, r := range rows {
+		if err := setMergeRef(tx, ref, r.ID, toID); err != nil {
+			return nil, err
+		}
+		moved[r.ID] = r.Ref
+	}
+	return moved, nil
+}
+
+// setMergeRef sets one row's reference and logs the updated row.
+func setMergeRef(tx *gorm.DB, ref mergeRef, id, value string) error {
+	if err := tx.Table(ref.table).
+		Where(ColID+" = ?", id).
+		Updates(map[string]any{ref.column: value, ColUpdatedAt: time.Now()}).Error; err != nil {
+		return err
+	}
+	if isSyncApplying(tx) {
+		return nil
+	}
+	payload, err := mergeOplogPayload(tx, ref.table, id)
+	if err != nil {
+		return err
+	}
+	return writeOplogEntry(tx, ref.table, id, OpUpdate, payload)
+}
+
+// mergeOplogPayload re-reads a moved row for its oplog entry. Documents
+// leave out the file contents.
+func mergeOplogPayload(tx *gorm.DB, table, id string) (any, error) {
+	switch table {
+	case TableQuotes:
+		return unscopedRow[Quote](tx, id)
+	case TableServiceLogEntries:
+		return unscopedRow[ServiceLogEntry](tx, id)
+	case TableIncidents:
+		return unscopedRow[Incident](tx, id)
+	case TableMaintenanceItems:
+		return unscopedRow[MaintenanceItem](tx, id)
+	case TableDocuments:
+		var doc Document
+		err := tx.Unscoped().First(&doc, ColID+" = ?", id).Error
+		return newDocumentOplogPayload(doc), err
+	}
+	return nil, fmt.Errorf("no oplog payload for %s", table)
+}
+
+// unscopedRow reads one row by ID, deleted or not.
+func unscopedRow[T any](tx *gorm.DB, id string) (any, error) {
+	var row T
+	err := tx.Unscoped().First(&row, ColID+" = ?", id).Error
+	return row, err
+}
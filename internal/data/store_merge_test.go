@@ -0,0 +1,118 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeVendorsAndUndo(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	types, err := store.ProjectTypes()
+	require.NoError(t, err)
+	project := Project{Title: "Bath", ProjectTypeID: types[0].ID, Status: ProjectStatusPlanned}
+	require.NoError(t, store.CreateProject(&project))
+
+	canonical := Vendor{Name: "Acme Plumbing"}
+	require.NoError(t, store.CreateVendor(&canonical))
+	quote := Quote{ProjectID: project.ID, TotalCents: 1000}
+	require.NoError(t, store.CreateQuote(&quote, Vendor{Name: "Acme"}))
+	dupID := quote.VendorID
+	deletedQuote := Quote{ProjectID: project.ID, TotalCents: 2000}
+	require.NoError(t, store.CreateQuote(&deletedQuote, Vendor{Name: "Acme"}))
+	require.NoError(t, store.DeleteQuote(deletedQuote.ID))
+	doc := Document{
+		Title: "W-9", EntityKind: DocumentEntityVendor, EntityID: dupID, Data: []byte("w9"),
+	}
+	require.NoError(t, store.CreateDocument(&doc))
+
+	merge, err := store.MergeVendors(canonical.ID, []string{dupID})
+	require.NoError(t, err)
+	assert.Len(t, merge.Moved[TableQuotes], 2, "deleted quotes move too")
+	assert.Len(t, merge.Moved[TableDocuments], 1)
+
+	quotes, err := store.ListQuotesByVendor(canonical.ID, true)
+	require.NoError(t, err)
+	assert.Len(t, quotes, 2)
+	_, err = store.GetVendor(dupID)
+	require.Error(t, err, "the duplicate is soft-deleted")
+	got, err := store.GetDocument(doc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, canonical.ID, got.EntityID)
+	assert.Equal(t, OpUpdate, lastOplogEntry(t, store, TableQuotes, quote.ID).OpType)
+
+	require.NoError(t, store.UndoMerge(merge))
+	_, err = store.GetVendor(dupID)
+	require.NoError(t, err, "undo restores the duplicate")
+	quotes, err = store.ListQuotesByVendor(dupID, true)
+	require.NoError(t, err)
+	assert.Len(t, quotes, 2)
+	got, err = store.GetDocument(doc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, dupID, got.EntityID)
+}
+
+func TestMergeVendorsErrors(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	a := Vendor{Name: "Acme"}
+	b := Vendor{Name: "Acme Plumbing"}
+	require.NoError(t, store.CreateVendor(&a))
+	require.NoError(t, store.CreateVendor(&b))
+
+	_, err := store.MergeVendors(a.ID, nil)
+	require.ErrorContains(t, err, "no vendors to merge")
+	_, err = store.MergeVendors(a.ID, []string{a.ID, b.ID})
+	require.ErrorContains(t, err, "into itself")
+
+	require.NoError(t, store.DeleteVendor(a.ID))
+	_, err = store.MergeVendors(a.ID, []string{b.ID})
+	require.ErrorContains(t, err, "vendor is deleted")
+	_, err = store.GetVendor(b.ID)
+	require.NoError(t, err, "a failed merge changes nothing")
+}
+
+func TestMergeAppliancesAndUndo(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	keep := Appliance{Name: "Dishwasher"}
+	dup := Appliance{Name: "Bosch dishwasher"}
+	require.NoError(t, store.CreateAppliance(&keep))
+	require.NoError(t, store.CreateAppliance(&dup))
+	cats, err := store.MaintenanceCategories()
+	require.NoError(t, err)
+	item := MaintenanceItem{Name: "Clean filter", CategoryID: cats[0].ID, ApplianceID: &dup.ID}
+	require.NoError(t, store.CreateMaintenance(&item))
+	doc := Document{
+		Title: "Manual", EntityKind: DocumentEntityAppliance, EntityID: dup.ID, Data: []byte("pdf"),
+	}
+	require.NoError(t, store.CreateDocument(&doc))
+
+	merge, err := store.MergeAppliances(keep.ID, []string{dup.ID})
+	require.NoError(t, err)
+	got, err := store.GetMaintenance(item.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got.ApplianceID)
+	assert.Equal(t, keep.ID, *got.ApplianceID)
+	gotDoc, err := store.GetDocument(doc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, keep.ID, gotDoc.EntityID)
+	_, err = store.GetAppliance(dup.ID)
+	require.Error(t, err, "the duplicate is soft-deleted")
+	require.Error(t, store.DeleteAppliance(keep.ID), "the kept appliance now has the maintenance item")
+
+	require.NoError(t, store.UndoMerge(merge))
+	got, err = store.GetMaintenance(item.ID)
+	require.NoError(t, err)
+	assert.Equal(t, dup.ID, *got.ApplianceID)
+	gotDoc, err = store.GetDocument(doc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, dup.ID, gotDoc.EntityID)
+	_, err = store.GetAppliance(dup.ID)
+	require.NoError(t, err)
+}
@@ -3,7 +3,12 @@
 
 package data
 
-import "gorm.io/gorm"
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
 
 func (s *Store) ProjectTypes() ([]ProjectType, error) {
 	var types []ProjectType
@@ -33,9 +38,108 @@ func (s *Store) UpdateProject(project Project) error {
 	return s.updateByID(TableProjects, &Project{}, project.ID, project)
 }
 
+// ProjectCompletion records what CompleteProjects changed so
+// UndoProjectCompletion can put it back.
+type ProjectCompletion struct {
+	Before []Project // each changed project as it was
+	After  []Project // the same projects, completed
+}
+
+// CompleteProjects marks the given projects completed in one transaction,
+// skipping ones that already are. A non-nil endDate is set on projects
+// without an end date.
+func (s *Store) CompleteProjects(ids []string, endDate *time.Time) (ProjectCompletion, error) {
+	var c ProjectCompletion
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		for _, id := range ids {
+			var project Project
+			if err := tx.Preload("ProjectType").First(&project, "id = ?", id).Error; err != nil {
+				return fmt.Errorf("load project: %w", err)
+			}
+			if project.Status == ProjectStatusCompleted {
+				continue
+			}
+			before := project
+			project.Status = ProjectStatusCompleted
+			if endDate != nil && project.EndDate == nil {
+				project.EndDate = endDate
+			}
+			if err := updateByIDWith(tx, TableProjects, &Project{}, project.ID, project); err != nil {
+				return fmt.Errorf("complete %q: %w", project.Title, err)
+			}
+			c.Before = append(c.Before, before)
+			c.After = append(c.After, project)
+		}
+		return nil
+	})
+	if err != nil {
+		return ProjectCompletion{}, err
+	}
+	return c, nil
+}
+
+// UndoProjectCompletion puts back the status and end date of every
+// project a CompleteProjects call changed, in one transaction. Other edits
+// made since are kept.
+func (s *Store) UndoProjectCompletion(c ProjectCompletion) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		for _, before := range c.Before {
+			var project Project
+			if err := tx.Preload("ProjectType").First(&project, "id = ?", before.ID).Error; err != nil {
+				return fmt.Errorf("load project: %w", err)
+			}
+			project.Status = before.Status
+			project.EndDate = before.EndDate
+			if err := updateByIDWith(tx, TableProjects, &Project{}, project.ID, project); err != nil {
+				return fmt.Errorf("restore %q: %w", project.Title, err)
+			}
+		}
+		return nil
+	})
+}
+
+// ScheduleNextProject creates the next instance of a recurring project: a
+// planned copy with its dates advanced by the recurrence interval and no
+// actual cost. A project without dates starts one interval from now.
+func (s *Store) ScheduleNextProject(id string, now time.Time) (Project, error) {
+	project, err := s.GetProject(id)
+	if err != nil {
+		return Project{}, err
+	}
+	if project.RecurrenceMonths <= 0 {
+		return Project{}, fmt.Errorf("project %q does not recur", project.Title)
+	}
+	advance := func(t *time.Time) *time.Time {
+		if t == nil {
+			return nil
+		}
+		next := AddMonths(*t, project.RecurrenceMonths)
+		return &next
+	}
+	next := Project{
+		Title:            project.Title,
+		ProjectTypeID:    project.ProjectTypeID,
+		Status:           ProjectStatusPlanned,
+		Description:      project.Description,
+		StartDate:        advance(project.StartDate),
+		EndDate:          advance(project.EndDate),
+		BudgetCents:      project.BudgetCents,
+		BudgetCurrency:   project.BudgetCurrency,
+		RecurrenceMonths: project.RecurrenceMonths,
+	}
+	if next.StartDate == nil && next.EndDate == nil {
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		next.StartDate = advance(&today)
+	}
+	if err := s.CreateProject(&next); err != nil {
+		return Project{}, err
+	}
+	return next, nil
+}
+
 func (s *Store) DeleteProject(id string) error {
 	if err := s.checkDependencies(id, []dependencyCheck{
-		{&Quote{}, ColProjectID, "project has %d active quote(s) -- delete them first"},
+		{&Quote{}, ColProjectID, "project has %d active quote(s) -- delete or move them first"},
 	}); err != nil {
 		return err
 	}
@@ -50,3 +154,43 @@ func (s *Store) RestoreProject(id string) error {
 func (s *Store) CountQuotesByProject(projectIDs []string) (map[string]int, error) {
 	return s.countByFK(&Quote{}, ColProjectID, projectIDs)
 }
+
+// ProjectSpentCents returns what has actually been committed to a project:
+// the sum of its accepted quotes' totals. Quotes without an accepted date
+// are still bids and don't count. Service log entries attach to
+// maintenance items rather than projects, so they have no project to roll
+// up into.
+func (s *Store) ProjectSpentCents(projectID string) (int64, error) {
+	spent, err := s.SpentCentsByProject([]string{projectID})
+	if err != nil {
+		return 0, err
+	}
+	return spent[projectID], nil
+}
+
+// SpentCentsByProject is the batch form of ProjectSpentCents. Projects with
+// no accepted quotes are absent from the map.
+func (s *Store) SpentCentsByProject(projectIDs []string) (map[string]int64, error) {
+	if len(projectIDs) == 0 {
+		return map[string]int64{}, nil
+	}
+	type row struct {
+		FK    string `gorm:"column:fk"`
+		Total int64  `gorm:"column:total"`
+	}
+	var results []row
+	err := s.db.Model(&Quote{}).
+		Select(ColProjectID+" as fk, sum("+ColTotalCents+") as total").
+		Where(ColProjectID+" IN ?", projectIDs).
+		Where(ColAcceptedDate + " IS NOT NULL").
+		Group(ColProjectID).
+		Find(&results).Error
+	if err != nil {
+		return nil, err
+	}
+	spent := make(map[string]int64, len(results))
+	for _, r := range results {
+		spent[r.FK] = r.Total
+	}
+	return spent, nil
+}
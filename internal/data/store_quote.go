@@ -43,8 +43,16 @@ func (s *Store) CreateQuote(quote *Quote, vendor Vendor) error {
 	})
 }
 
+// UpdateQuote persists changes to a quote, including moving it to another
+// project; the target project must exist and not be deleted.
 func (s *Store) UpdateQuote(quote Quote, vendor Vendor) error {
 	return s.db.Transaction(func(tx *gorm.DB) error {
+		if err := requireReparentAlive(tx, reparentCheck{
+			model: &Quote{}, id: quote.ID, fkColumn: ColProjectID,
+			parent: &Project{}, parentID: &quote.ProjectID, label: "project",
+		}); err != nil {
+			return err
+		}
 		foundVendor, err := findOrCreateVendor(tx, vendor)
 		if err != nil {
 			return err
@@ -77,3 +85,31 @@ func (s *Store) ListQuotesByProject(projectID string, includeDeleted bool) ([]Qu
 		).Order(ColReceivedDate + " desc, " + ColID + " desc")
 	})
 }
+
+// BreakdownCents sums the quote's itemized amounts (labor, materials,
+// other, tax). lines is the number of line items that are set; zero means
+// there is nothing to reconcile against the total.
+func (q Quote) BreakdownCents() (sum int64, lines int) {
+	for _, part := range []*int64{q.LaborCents, q.MaterialsCents, q.OtherCents, q.TaxCents} {
+		if part != nil {
+			sum += *part
+			lines++
+		}
+	}
+	return sum, lines
+}
+
+// BreakdownMismatch reports whether the itemized amounts disagree with the
+// total by more than a cent per line item, which absorbs per-line rounding
+// on real invoices. Quotes with no line items never mismatch.
+func (q Quote) BreakdownMismatch() bool {
+	sum, lines := q.BreakdownCents()
+	if lines == 0 {
+		return false
+	}
+	diff := sum - q.TotalCents
+	if diff < 0 {
+		diff = -diff
+	}
+	return diff > int64(lines)
+}
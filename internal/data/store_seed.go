@@ -5,9 +5,14 @@ package data
 
 import (
 	"crypto/sha256"
+	"errors"
 	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/micasa-dev/micasa/internal/fake"
+	"gorm.io/gorm"
 )
 
 // SeedDemoDataFrom populates the database with demo data generated by the
@@ -305,45 +310,101 @@ func (s *Store) SeedDemoDataFrom(h *fake.HomeFaker) error {
 	return nil
 }
 
-func (s *Store) seedProjectTypes() error {
-	types := []ProjectType{
-		{Name: "Appliance"},
-		{Name: "Electrical"},
-		{Name: "Exterior"},
-		{Name: "Flooring"},
-		{Name: "HVAC"},
-		{Name: "Landscaping"},
-		{Name: "Painting"},
-		{Name: "Plumbing"},
-		{Name: "Remodel"},
-		{Name: "Roof"},
-		{Name: "Structural"},
-		{Name: "Windows"},
+func (s *Store) seedProjectTypes(extra []string) error {
+	names := []string{
+		"Appliance",
+		"Electrical",
+		"Exterior",
+		"Flooring",
+		"HVAC",
+		"Landscaping",
+		"Painting",
+		"Plumbing",
+		"Remodel",
+		"Roof",
+		"Structural",
+		"Windows",
 	}
-	for _, projectType := range types {
-		if err := s.db.FirstOrCreate(&projectType, ColName+" = ?", projectType.Name).
-			Error; err != nil {
-			return err
+	return seedNamed(s.db, append(names, extra...), func(name string) *ProjectType {
+		return &ProjectType{Name: name}
+	})
+}
+
+func (s *Store) seedMaintenanceCategories(extra []string) error {
+	names := []string{
+		"Appliance",
+		"Electrical",
+		"Exterior",
+		"HVAC",
+		"Interior",
+		"Landscaping",
+		"Plumbing",
+		"Safety",
+		"Structural",
+	}
+	return seedNamed(s.db, append(names, extra...), func(name string) *MaintenanceCategory {
+		return &MaintenanceCategory{Name: name}
+	})
+}
+
+// maxSeedNameLen bounds configured project type and category names.
+const maxSeedNameLen = 64
+
+// SeedNames are project types and maintenance categories seeded on top of
+// the built-ins, such as "Pool" or "Solar".
+type SeedNames struct {
+	ProjectTypes          []string
+	MaintenanceCategories []string
+}
+
+// Validate reports the first name that's blank, too long, or holds
+// control characters.
+func (n SeedNames) Validate() error {
+	for _, list := range []struct {
+		noun  string
+		names []string
+	}{
+		{"project type", n.ProjectTypes},
+		{"maintenance category", n.MaintenanceCategories},
+	} {
+		for _, name := range list.names {
+			if err := ValidateSeedName(name); err != nil {
+				return fmt.Errorf("%s %q: %w", list.noun, name, err)
+			}
 		}
 	}
 	return nil
 }
 
-func (s *Store) seedMaintenanceCategories() error {
-	categories := []MaintenanceCategory{
-		{Name: "Appliance"},
-		{Name: "Electrical"},
-		{Name: "Exterior"},
-		{Name: "HVAC"},
-		{Name: "Interior"},
-		{Name: "Landscaping"},
-		{Name: "Plumbing"},
-		{Name: "Safety"},
-		{Name: "Structural"},
+// ValidateSeedName checks one project type or maintenance category name.
+func ValidateSeedName(name string) error {
+	switch {
+	case strings.TrimSpace(name) == "":
+		return errors.New("name is blank")
+	case utf8.RuneCountInString(name) > maxSeedNameLen:
+		return fmt.Errorf("name is longer than %d characters", maxSeedNameLen)
+	case strings.ContainsFunc(name, unicode.IsControl):
+		return errors.New("name contains control characters")
 	}
-	for _, category := range categories {
-		if err := s.db.FirstOrCreate(&category, ColName+" = ?", category.Name).
-			Error; err != nil {
+	return nil
+}
+
+// seedNamed creates a row for each name that isn't in T's table yet,
+// comparing names without regard to case so "pool" doesn't duplicate
+// "Pool".
+func seedNamed[T any](db *gorm.DB, names []string, row func(name string) *T) error {
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		var n int64
+		if err := db.Model(new(T)).
+			Where("LOWER("+ColName+") = LOWER(?)", name).
+			Count(&n).Error; err != nil {
+			return err
+		}
+		if n > 0 {
+			continue
+		}
+		if err := db.Create(row(name)).Error; err != nil {
 			return err
 		}
 	}
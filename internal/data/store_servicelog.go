@@ -12,8 +12,12 @@ import (
 
 // syncLastServiced sets a maintenance item's LastServicedAt to the most recent
 // ServicedAt from its non-deleted service log entries. If no entries exist the
-// field is left unchanged, preserving any manually-set value.
-func syncLastServiced(tx *gorm.DB, maintenanceItemID string) error {
+// field is left unchanged, preserving any manually-set value. It does nothing
+// when the sync is turned off (see SetLastServicedSync).
+func (s *Store) syncLastServiced(tx *gorm.DB, maintenanceItemID string) error {
+	if s.manualLastServiced {
+		return nil
+	}
 	var latest ServiceLogEntry
 	err := tx.Where(ColMaintenanceItemID+" = ?", maintenanceItemID).
 		Order(ColServicedAt + " desc, " + ColID + " desc").
@@ -68,7 +72,7 @@ func (s *Store) CreateServiceLog(entry *ServiceLogEntry, vendor Vendor) error {
 		if err := tx.Create(entry).Error; err != nil {
 			return err
 		}
-		return syncLastServiced(tx, entry.MaintenanceItemID)
+		return s.syncLastServiced(tx, entry.MaintenanceItemID)
 	})
 }
 
@@ -93,11 +97,11 @@ func (s *Store) UpdateServiceLog(entry ServiceLogEntry, vendor Vendor) error {
 		}
 		// If the entry moved to a different parent, sync both.
 		if old.MaintenanceItemID != entry.MaintenanceItemID {
-			if err := syncLastServiced(tx, old.MaintenanceItemID); err != nil {
+			if err := s.syncLastServiced(tx, old.MaintenanceItemID); err != nil {
 				return err
 			}
 		}
-		return syncLastServiced(tx, entry.MaintenanceItemID)
+		return s.syncLastServiced(tx, entry.MaintenanceItemID)
 	})
 }
 
@@ -110,7 +114,7 @@ func (s *Store) DeleteServiceLog(id string) error {
 		if err := softDeleteWith(tx, &ServiceLogEntry{}, DeletionEntityServiceLog, id); err != nil {
 			return err
 		}
-		return syncLastServiced(tx, entry.MaintenanceItemID)
+		return s.syncLastServiced(tx, entry.MaintenanceItemID)
 	})
 }
 
@@ -129,7 +133,7 @@ func (s *Store) RestoreServiceLog(id string) error {
 		if err := restoreSoftDeleted(tx, &ServiceLogEntry{}, DeletionEntityServiceLog, id); err != nil {
 			return err
 		}
-		return syncLastServiced(tx, entry.MaintenanceItemID)
+		return s.syncLastServiced(tx, entry.MaintenanceItemID)
 	})
 }
 
@@ -138,3 +142,45 @@ func (s *Store) RestoreServiceLog(id string) error {
 func (s *Store) CountServiceLogs(itemIDs []string) (map[string]int, error) {
 	return s.countByFK(&ServiceLogEntry{}, ColMaintenanceItemID, itemIDs)
 }
+
+// AverageServiceCost estimates the cost of the next service for a
+// maintenance item as the rounded average of its past service log costs.
+// Returns nil when no non-deleted entry has a cost.
+func (s *Store) AverageServiceCost(itemID string) (*int64, error) {
+	avg, err := s.AverageServiceCostByItem([]string{itemID})
+	if err != nil {
+		return nil, err
+	}
+	v, ok := avg[itemID]
+	if !ok {
+		return nil, nil
+	}
+	return &v, nil
+}
+
+// AverageServiceCostByItem is the batch form of AverageServiceCost. Items
+// with no costed service history are absent from the map.
+func (s *Store) AverageServiceCostByItem(itemIDs []string) (map[string]int64, error) {
+	if len(itemIDs) == 0 {
+		return map[string]int64{}, nil
+	}
+	type row struct {
+		FK      string `gorm:"column:fk"`
+		Average int64  `gorm:"column:average"`
+	}
+	var results []row
+	err := s.db.Model(&ServiceLogEntry{}).
+		Select(ColMaintenanceItemID+" as fk, cast(round(avg("+ColCostCents+")) as integer) as average").
+		Where(ColMaintenanceItemID+" IN ?", itemIDs).
+		Where(ColCostCents + " IS NOT NULL").
+		Group(ColMaintenanceItemID).
+		Find(&results).Error
+	if err != nil {
+		return nil, err
+	}
+	avg := make(map[string]int64, len(results))
+	for _, r := range results {
+		avg[r.FK] = r.Average
+	}
+	return avg, nil
+}
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -45,6 +47,37 @@ func TestSeedDefaults(t *testing.T) {
 	require.NotEmpty(t, categories)
 }
 
+func TestSeedDefaultsWith(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	before, err := store.ProjectTypes()
+	require.NoError(t, err)
+	extra := SeedNames{
+		ProjectTypes:          []string{"Pool", " Solar ", "hvac", "pool"},
+		MaintenanceCategories: []string{"Pool"},
+	}
+	require.NoError(t, store.SeedDefaultsWith(extra))
+	require.NoError(t, store.SeedDefaultsWith(extra), "seeding again adds nothing")
+
+	types, err := store.ProjectTypes()
+	require.NoError(t, err)
+	var names []string
+	for _, pt := range types {
+		names = append(names, pt.Name)
+	}
+	assert.Len(t, types, len(before)+2, "hvac and pool match existing names")
+	assert.Contains(t, names, "Pool")
+	assert.Contains(t, names, "Solar")
+	categories, err := store.MaintenanceCategories()
+	require.NoError(t, err)
+	assert.True(t, slices.ContainsFunc(categories, func(c MaintenanceCategory) bool {
+		return c.Name == "Pool"
+	}))
+
+	err = store.SeedDefaultsWith(SeedNames{ProjectTypes: []string{strings.Repeat("x", 65)}})
+	require.ErrorContains(t, err, "longer than 64 characters")
+}
+
 func TestHouseProfileSingle(t *testing.T) {
 	t.Parallel()
 	store := newTestStore(t)
@@ -151,6 +184,80 @@ func TestUpdateProject(t *testing.T) {
 	assert.Equal(t, ProjectStatusInProgress, fetched.Status)
 }
 
+func TestScheduleNextProject(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	types, err := store.ProjectTypes()
+	require.NoError(t, err)
+	start := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 2, 2, 0, 0, 0, 0, time.UTC)
+	budget, actual := int64(25000), int64(27500)
+	done := Project{
+		Title:            "Chimney inspection",
+		ProjectTypeID:    types[0].ID,
+		Status:           ProjectStatusCompleted,
+		Description:      "Sweep and camera check",
+		StartDate:        &start,
+		EndDate:          &end,
+		BudgetCents:      &budget,
+		ActualCents:      &actual,
+		BudgetCurrency:   "EUR",
+		RecurrenceMonths: 12,
+	}
+	require.NoError(t, store.CreateProject(&done))
+
+	next, err := store.ScheduleNextProject(done.ID, time.Now())
+	require.NoError(t, err)
+	assert.NotEqual(t, done.ID, next.ID)
+
+	fetched, err := store.GetProject(next.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Chimney inspection", fetched.Title)
+	assert.Equal(t, "Sweep and camera check", fetched.Description)
+	assert.Equal(t, ProjectStatusPlanned, fetched.Status)
+	assert.Equal(t, 12, fetched.RecurrenceMonths)
+	require.NotNil(t, fetched.StartDate)
+	assert.Equal(t, "2027-01-31", FormatDate(fetched.StartDate))
+	assert.Equal(t, "2027-02-02", FormatDate(fetched.EndDate))
+	require.NotNil(t, fetched.BudgetCents)
+	assert.Equal(t, budget, *fetched.BudgetCents)
+	assert.Equal(t, "EUR", fetched.BudgetCurrency)
+	assert.Nil(t, fetched.ActualCents, "the next run hasn't cost anything yet")
+
+	orig, err := store.GetProject(done.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ProjectStatusCompleted, orig.Status, "the completed project is left alone")
+}
+
+func TestScheduleNextProjectWithoutDates(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	types, err := store.ProjectTypes()
+	require.NoError(t, err)
+	done := Project{
+		Title: "Clean dryer vent", ProjectTypeID: types[0].ID,
+		Status: ProjectStatusCompleted, RecurrenceMonths: 6,
+	}
+	require.NoError(t, store.CreateProject(&done))
+
+	next, err := store.ScheduleNextProject(done.ID, time.Date(2026, 5, 10, 15, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, "2026-11-10", FormatDate(next.StartDate))
+	assert.Nil(t, next.EndDate)
+}
+
+func TestScheduleNextProjectRequiresRecurrence(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	types, err := store.ProjectTypes()
+	require.NoError(t, err)
+	once := Project{Title: "Deck", ProjectTypeID: types[0].ID, Status: ProjectStatusCompleted}
+	require.NoError(t, store.CreateProject(&once))
+
+	_, err = store.ScheduleNextProject(once.ID, time.Now())
+	require.ErrorContains(t, err, "does not recur")
+}
+
 func TestUpdateQuote(t *testing.T) {
 	t.Parallel()
 	store := newTestStore(t)
@@ -210,6 +317,180 @@ func TestUpdateMaintenance(t *testing.T) {
 	assert.Equal(t, 3, fetched.IntervalMonths)
 }
 
+func TestUpdateQuoteMovesToAnotherProject(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	types, err := store.ProjectTypes()
+	require.NoError(t, err)
+	from := &Project{Title: "Deck", ProjectTypeID: types[0].ID, Status: ProjectStatusPlanned}
+	to := &Project{Title: "Fence", ProjectTypeID: types[0].ID, Status: ProjectStatusPlanned}
+	gone := &Project{Title: "Shed", ProjectTypeID: types[0].ID, Status: ProjectStatusPlanned}
+	for _, p := range []*Project{from, to, gone} {
+		require.NoError(t, store.CreateProject(p))
+	}
+	require.NoError(t, store.DeleteProject(gone.ID))
+
+	quote := &Quote{ProjectID: from.ID, TotalCents: 5000}
+	require.NoError(t, store.CreateQuote(quote, Vendor{Name: "Acme"}))
+
+	moved := *quote
+	moved.ProjectID = gone.ID
+	require.ErrorContains(t, store.UpdateQuote(moved, Vendor{Name: "Acme"}),
+		"project is deleted")
+	moved.ProjectID = "01NOSUCHPROJECT00000000000"
+	require.ErrorContains(t, store.UpdateQuote(moved, Vendor{Name: "Acme"}),
+		"project no longer exists")
+	fetched, err := store.GetQuote(quote.ID)
+	require.NoError(t, err)
+	assert.Equal(t, from.ID, fetched.ProjectID, "failed moves change nothing")
+
+	moved.ProjectID = to.ID
+	require.NoError(t, store.UpdateQuote(moved, Vendor{Name: "Acme"}))
+	fetched, err = store.GetQuote(quote.ID)
+	require.NoError(t, err)
+	assert.Equal(t, to.ID, fetched.ProjectID)
+	assert.Equal(t, "Fence", fetched.Project.Title)
+}
+
+func TestUpdateMaintenanceMovesToAnotherAppliance(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	categories, err := store.MaintenanceCategories()
+	require.NoError(t, err)
+	furnace := &Appliance{Name: "Furnace"}
+	boiler := &Appliance{Name: "Boiler"}
+	gone := &Appliance{Name: "Old Boiler"}
+	for _, a := range []*Appliance{furnace, boiler, gone} {
+		require.NoError(t, store.CreateAppliance(a))
+	}
+	require.NoError(t, store.DeleteAppliance(gone.ID))
+
+	item := &MaintenanceItem{
+		Name: "Filter", CategoryID: categories[0].ID, ApplianceID: &furnace.ID,
+	}
+	require.NoError(t, store.CreateMaintenance(item))
+
+	moved := *item
+	moved.ApplianceID = &gone.ID
+	require.ErrorContains(t, store.UpdateMaintenance(moved), "appliance is deleted")
+
+	moved.ApplianceID = &boiler.ID
+	require.NoError(t, store.UpdateMaintenance(moved))
+	fetched, err := store.GetMaintenance(item.ID)
+	require.NoError(t, err)
+	require.NotNil(t, fetched.ApplianceID)
+	assert.Equal(t, boiler.ID, *fetched.ApplianceID)
+
+	moved.ApplianceID = nil
+	require.NoError(t, store.UpdateMaintenance(moved), "detaching needs no target")
+	fetched, err = store.GetMaintenance(item.ID)
+	require.NoError(t, err)
+	assert.Nil(t, fetched.ApplianceID)
+}
+
+func TestSnoozeMaintenance(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	categories, err := store.MaintenanceCategories()
+	require.NoError(t, err)
+	item := MaintenanceItem{Name: "Gutter Clean", CategoryID: categories[0].ID}
+	require.NoError(t, store.CreateMaintenance(&item))
+
+	until := time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, store.SnoozeMaintenance(item.ID, &until))
+	fetched, err := store.GetMaintenance(item.ID)
+	require.NoError(t, err)
+	require.NotNil(t, fetched.SnoozedUntil)
+	assert.True(t, until.Equal(*fetched.SnoozedUntil))
+
+	// Edits from forms and extraction don't carry the snooze.
+	require.NoError(t, store.UpdateMaintenance(MaintenanceItem{
+		ID: item.ID, Name: "Gutter Cleaning", CategoryID: categories[0].ID,
+	}))
+	fetched, err = store.GetMaintenance(item.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Gutter Cleaning", fetched.Name)
+	require.NotNil(t, fetched.SnoozedUntil, "update keeps the snooze")
+	assert.True(t, until.Equal(*fetched.SnoozedUntil))
+
+	require.NoError(t, store.SnoozeMaintenance(item.ID, nil))
+	fetched, err = store.GetMaintenance(item.ID)
+	require.NoError(t, err)
+	assert.Nil(t, fetched.SnoozedUntil)
+}
+
+func TestArchiveAppliance(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	item := Appliance{Name: "Old Fridge"}
+	require.NoError(t, store.CreateAppliance(&item))
+
+	at := time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, store.ArchiveAppliance(item.ID, &at))
+	fetched, err := store.GetAppliance(item.ID)
+	require.NoError(t, err)
+	require.NotNil(t, fetched.ArchivedAt)
+	assert.True(t, at.Equal(*fetched.ArchivedAt))
+
+	// Edits from forms and extraction don't carry the archive date.
+	require.NoError(t, store.UpdateAppliance(Appliance{ID: item.ID, Name: "Garage Fridge"}))
+	fetched, err = store.GetAppliance(item.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Garage Fridge", fetched.Name)
+	require.NotNil(t, fetched.ArchivedAt, "update keeps the archive date")
+
+	all, err := store.ListAppliances(false)
+	require.NoError(t, err)
+	assert.Len(t, all, 1, "archived appliances are still listed")
+
+	require.NoError(t, store.ArchiveAppliance(item.ID, nil))
+	fetched, err = store.GetAppliance(item.ID)
+	require.NoError(t, err)
+	assert.Nil(t, fetched.ArchivedAt)
+}
+
+func TestAverageServiceCost(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	categories, err := store.MaintenanceCategories()
+	require.NoError(t, err)
+	item := MaintenanceItem{Name: "Furnace tune-up", CategoryID: categories[0].ID}
+	require.NoError(t, store.CreateMaintenance(&item))
+
+	avg, err := store.AverageServiceCost(item.ID)
+	require.NoError(t, err)
+	assert.Nil(t, avg, "no history means no estimate")
+
+	cost := func(v int64) *int64 { return &v }
+	for _, c := range []*int64{cost(15000), cost(20001), nil} {
+		require.NoError(t, store.CreateServiceLog(&ServiceLogEntry{
+			MaintenanceItemID: item.ID,
+			ServicedAt:        time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC),
+			CostCents:         c,
+		}, Vendor{}))
+	}
+	deleted := ServiceLogEntry{
+		MaintenanceItemID: item.ID,
+		ServicedAt:        time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC),
+		CostCents:         cost(90000),
+	}
+	require.NoError(t, store.CreateServiceLog(&deleted, Vendor{}))
+	require.NoError(t, store.DeleteServiceLog(deleted.ID))
+
+	avg, err = store.AverageServiceCost(item.ID)
+	require.NoError(t, err)
+	require.NotNil(t, avg)
+	assert.Equal(t, int64(17501), *avg, "uncosted and deleted entries are ignored")
+
+	byItem, err := store.AverageServiceCostByItem([]string{item.ID, "missing"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{item.ID: 17501}, byItem)
+
+	empty, err := store.AverageServiceCostByItem(nil)
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+}
+
 func TestServiceLogCRUD(t *testing.T) {
 	t.Parallel()
 	store := newTestStore(t)
@@ -351,6 +632,40 @@ func TestServiceLogSyncsLastServiced(t *testing.T) {
 		"should keep the last synced value")
 }
 
+func TestServiceLogSyncDisabledKeepsLastServiced(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	store.SetLastServicedSync(false)
+	assert.False(t, store.LastServicedSync())
+	categories, err := store.MaintenanceCategories()
+	require.NoError(t, err)
+
+	jan15 := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+	item := &MaintenanceItem{
+		Name: "Manual", CategoryID: categories[0].ID, LastServicedAt: &jan15,
+	}
+	require.NoError(t, store.CreateMaintenance(item))
+
+	entry := &ServiceLogEntry{
+		MaintenanceItemID: item.ID,
+		ServicedAt:        time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, store.CreateServiceLog(entry, Vendor{}))
+	require.NoError(t, store.DeleteServiceLog(entry.ID))
+	require.NoError(t, store.RestoreServiceLog(entry.ID))
+
+	got, err := store.GetMaintenance(item.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got.LastServicedAt)
+	assert.True(t, got.LastServicedAt.Equal(jan15), "a manual date is left alone")
+
+	// Transactional stores inherit the setting.
+	require.NoError(t, store.Transaction(func(tx *Store) error {
+		assert.False(t, tx.LastServicedSync())
+		return nil
+	}))
+}
+
 func TestServiceLogMoveBetweenParentsSyncsBoth(t *testing.T) {
 	t.Parallel()
 	store := newTestStore(t)
@@ -1836,6 +2151,65 @@ func TestCountQuotesByProject(t *testing.T) {
 	assert.Empty(t, empty)
 }
 
+func TestProjectSpentCentsSumsAcceptedQuotes(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	types, _ := store.ProjectTypes()
+
+	require.NoError(t, store.CreateProject(&Project{
+		Title: "P1", ProjectTypeID: types[0].ID, Status: ProjectStatusInProgress,
+	}))
+	projects, _ := store.ListProjects(false)
+	projectID := projects[0].ID
+
+	accepted := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, store.CreateQuote(
+		&Quote{ProjectID: projectID, TotalCents: 500000, AcceptedDate: &accepted},
+		Vendor{Name: "V1"},
+	))
+	require.NoError(t, store.CreateQuote(
+		&Quote{ProjectID: projectID, TotalCents: 25000, AcceptedDate: &accepted},
+		Vendor{Name: "V2"},
+	))
+	require.NoError(t, store.CreateQuote(
+		&Quote{ProjectID: projectID, TotalCents: 450000},
+		Vendor{Name: "V3"},
+	))
+
+	spent, err := store.ProjectSpentCents(projectID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(525000), spent)
+
+	byProject, err := store.SpentCentsByProject([]string{projectID, "missing"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{projectID: 525000}, byProject)
+
+	empty, err := store.SpentCentsByProject(nil)
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+}
+
+func TestProjectSpentCentsIgnoresDeletedQuotes(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	types, _ := store.ProjectTypes()
+
+	require.NoError(t, store.CreateProject(&Project{
+		Title: "P1", ProjectTypeID: types[0].ID, Status: ProjectStatusInProgress,
+	}))
+	projects, _ := store.ListProjects(false)
+	projectID := projects[0].ID
+
+	accepted := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	quote := Quote{ProjectID: projectID, TotalCents: 500000, AcceptedDate: &accepted}
+	require.NoError(t, store.CreateQuote(&quote, Vendor{Name: "V1"}))
+	require.NoError(t, store.DeleteQuote(quote.ID))
+
+	spent, err := store.ProjectSpentCents(projectID)
+	require.NoError(t, err)
+	assert.Zero(t, spent)
+}
+
 func TestListQuotesByVendor(t *testing.T) {
 	t.Parallel()
 	store := newTestStore(t)
@@ -2187,6 +2561,190 @@ func TestCreateDocumentRejectsOversized(t *testing.T) {
 	}))
 }
 
+func TestDocumentStorage(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+
+	empty, err := store.DocumentStorage()
+	require.NoError(t, err)
+	assert.Equal(t, DocumentStorage{}, empty)
+
+	keep := &Document{Title: "Keep", SizeBytes: 300, Data: make([]byte, 300)}
+	gone := &Document{Title: "Gone", SizeBytes: 200, Data: make([]byte, 200)}
+	require.NoError(t, store.CreateDocument(keep))
+	require.NoError(t, store.CreateDocument(gone))
+	require.NoError(t, store.DeleteDocument(gone.ID))
+
+	got, err := store.DocumentStorage()
+	require.NoError(t, err)
+	assert.Equal(t, DocumentStorage{Bytes: 500, DeletedBytes: 200}, got)
+}
+
+func TestDocumentFileStorage(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	dir := t.TempDir()
+	store.SetBlobStorage(dir, true)
+
+	content := []byte("warranty scan")
+	sum := fmt.Sprintf("%x", sha256.Sum256(content))
+	doc := &Document{
+		Title: "Warranty", FileName: "warranty.pdf",
+		SizeBytes: int64(len(content)), Data: content,
+	}
+	require.NoError(t, store.CreateDocument(doc))
+	assert.Equal(t, content, doc.Data, "caller's data is left intact")
+	assert.Equal(t, sum, doc.ChecksumSHA256)
+
+	var inDB int
+	require.NoError(t, store.db.Raw(
+		"SELECT count(*) FROM documents WHERE data IS NOT NULL").Scan(&inDB).Error)
+	assert.Zero(t, inDB, "content should not be stored in the database")
+	assert.FileExists(t, filepath.Join(dir, sum[:2], sum))
+
+	got, err := store.GetDocumentBlob(doc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, content, got)
+	full, err := store.GetDocument(doc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, content, full.Data)
+	path, err := store.ExtractDocument(doc.ID)
+	require.NoError(t, err)
+	cached, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, content, cached)
+
+	pending, err := store.PendingBlobDocuments()
+	require.NoError(t, err)
+	assert.Empty(t, pending, "file-backed documents are not pending")
+
+	replaced := []byte("warranty scan, signed")
+	doc.Data = replaced
+	doc.SizeBytes = int64(len(replaced))
+	require.NoError(t, store.UpdateDocument(*doc))
+	got, err = store.GetDocumentBlob(doc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, replaced, got)
+}
+
+func TestUpdateDocumentRemovesReplacedFile(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	dir := t.TempDir()
+	store.SetBlobStorage(dir, true)
+
+	// Two documents share one file until both are replaced.
+	first := &Document{Title: "Manual", Data: []byte("manual v1")}
+	second := &Document{Title: "Manual copy", Data: []byte("manual v1")}
+	require.NoError(t, store.CreateDocument(first))
+	require.NoError(t, store.CreateDocument(second))
+	oldPath := filepath.Join(dir, first.ChecksumSHA256[:2], first.ChecksumSHA256)
+
+	first.Data = []byte("manual v2")
+	require.NoError(t, store.UpdateDocument(*first))
+	assert.FileExists(t, oldPath, "still referenced by the copy")
+
+	second.Data = []byte("manual v2")
+	require.NoError(t, store.UpdateDocument(*second))
+	assert.NoFileExists(t, oldPath)
+	got, err := store.GetDocumentBlob(second.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("manual v2"), got)
+
+	// Metadata-only edits keep the file.
+	second.Data = nil
+	second.Title = "Manual (signed)"
+	require.NoError(t, store.UpdateDocument(*second))
+	got, err = store.GetDocumentBlob(second.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("manual v2"), got)
+}
+
+func TestDocumentFileStorageCorruptFile(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	dir := t.TempDir()
+	store.SetBlobStorage(dir, true)
+
+	doc := &Document{Title: "Manual", Data: []byte("manual")}
+	require.NoError(t, store.CreateDocument(doc))
+	sum := doc.ChecksumSHA256
+	require.NoError(t, os.WriteFile(filepath.Join(dir, sum[:2], sum), []byte("oops"), 0o600))
+
+	_, err := store.GetDocumentBlob(doc.ID)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "corrupt")
+}
+
+func TestMoveDocumentBlobs(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+
+	content := []byte("receipt")
+	doc := &Document{
+		Title: "Receipt", ChecksumSHA256: fmt.Sprintf("%x", sha256.Sum256(content)),
+		SizeBytes: int64(len(content)), Data: content,
+	}
+	require.NoError(t, store.CreateDocument(doc))
+	deleted := &Document{
+		Title: "Old", ChecksumSHA256: fmt.Sprintf("%x", sha256.Sum256([]byte("old"))),
+		Data: []byte("old"),
+	}
+	require.NoError(t, store.CreateDocument(deleted))
+	require.NoError(t, store.DeleteDocument(deleted.ID))
+	mismatched := &Document{Title: "Bad", ChecksumSHA256: "abc", Data: []byte("bad")}
+	require.NoError(t, store.CreateDocument(mismatched))
+
+	store.SetBlobStorage(t.TempDir(), true)
+	moved, err := store.MoveDocumentBlobsToFiles()
+	require.NoError(t, err)
+	assert.Equal(t, 2, moved, "checksum mismatches stay in the database")
+
+	var inDB []string
+	require.NoError(t, store.db.Raw(
+		"SELECT id FROM documents WHERE data IS NOT NULL").Scan(&inDB).Error)
+	assert.Equal(t, []string{mismatched.ID}, inDB)
+	got, err := store.GetDocumentBlob(doc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, content, got)
+
+	moved, err = store.MoveDocumentBlobsToFiles()
+	require.NoError(t, err)
+	assert.Zero(t, moved, "second run has nothing to move")
+
+	moved, err = store.MoveDocumentBlobsToDatabase()
+	require.NoError(t, err)
+	assert.Equal(t, 2, moved)
+	store.SetBlobStorage("", false)
+	got, err = store.GetDocumentBlob(doc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, content, got, "content is back in the database")
+}
+
+func TestPendingBlobDocumentsWithFileStorage(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	store.SetBlobStorage(t.TempDir(), true)
+
+	content := []byte("synced elsewhere")
+	doc := &Document{
+		Title: "Remote", ChecksumSHA256: fmt.Sprintf("%x", sha256.Sum256(content)),
+	}
+	require.NoError(t, store.CreateDocument(doc))
+
+	pending, err := store.PendingBlobDocuments()
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+
+	require.NoError(t, store.UpdateDocumentData(doc.ID, content))
+	pending, err = store.PendingBlobDocuments()
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+	got, err := store.GetDocumentBlob(doc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, content, got)
+}
+
 func TestDeleteVendorAllowedWithDocuments(t *testing.T) {
 	t.Parallel()
 	store := newTestStore(t)
@@ -3412,3 +3970,62 @@ func TestHardDeleteSoftDeletedIncident(t *testing.T) {
 	err := store.db.Unscoped().First(&inc, "id = ?", incID).Error
 	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
 }
+
+func TestQuoteBreakdownMismatchToleratesPerLineRounding(t *testing.T) {
+	t.Parallel()
+	ptr := func(n int64) *int64 { return &n }
+	assert.False(t, Quote{TotalCents: 1000}.BreakdownMismatch(), "no line items")
+	assert.False(t, Quote{
+		TotalCents: 1002, LaborCents: ptr(500), TaxCents: ptr(500),
+	}.BreakdownMismatch(), "within a cent per line")
+	assert.True(t, Quote{
+		TotalCents: 1003, LaborCents: ptr(500), TaxCents: ptr(500),
+	}.BreakdownMismatch())
+}
+
+func TestCompleteProjectsAndUndo(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	types, err := store.ProjectTypes()
+	require.NoError(t, err)
+	end := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	deck := Project{Title: "Deck", ProjectTypeID: types[0].ID, Status: ProjectStatusInProgress}
+	fence := Project{
+		Title: "Fence", ProjectTypeID: types[0].ID, Status: ProjectStatusDelayed, EndDate: &end,
+	}
+	shed := Project{Title: "Shed", ProjectTypeID: types[0].ID, Status: ProjectStatusCompleted}
+	for _, p := range []*Project{&deck, &fence, &shed} {
+		require.NoError(t, store.CreateProject(p))
+	}
+
+	today := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+	c, err := store.CompleteProjects([]string{deck.ID, fence.ID, shed.ID}, &today)
+	require.NoError(t, err)
+	require.Len(t, c.After, 2, "already completed projects are skipped")
+
+	got, err := store.GetProject(deck.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ProjectStatusCompleted, got.Status)
+	require.NotNil(t, got.EndDate)
+	assert.True(t, got.EndDate.Equal(today))
+	got, err = store.GetProject(fence.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got.EndDate)
+	assert.True(t, got.EndDate.Equal(end), "an existing end date is kept")
+
+	got.Description = "Cedar"
+	require.NoError(t, store.UpdateProject(got))
+
+	require.NoError(t, store.UndoProjectCompletion(c))
+	got, err = store.GetProject(deck.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ProjectStatusInProgress, got.Status)
+	assert.Nil(t, got.EndDate)
+	got, err = store.GetProject(fence.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ProjectStatusDelayed, got.Status)
+	assert.Equal(t, "Cedar", got.Description, "undo keeps later edits")
+	got, err = store.GetProject(shed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ProjectStatusCompleted, got.Status)
+}
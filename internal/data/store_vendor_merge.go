@@ -0,0 +1,106 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package data
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// vendorNameNoise are words that don't tell vendors apart: legal suffixes
+// and filler that extraction and hand entry add or drop at random.
+var vendorNameNoise = map[string]bool{
+	"and": true, "co": true, "company": true, "corp": true,
+	"corporation": true, "inc": true, "incorporated": true, "llc": true,
+	"llp": true, "ltd": true, "limited": true, "pllc": true, "the": true,
+}
+
+// vendorNameTokens normalizes a vendor name to comparable words:
+// lowercased, punctuation dropped, noise words removed. "ACME Plumbing,
+// L.L.C." becomes [acme plumbing].
+func vendorNameTokens(name string) []string {
+	cleaned := strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsLetter(r), unicode.IsDigit(r):
+			return unicode.ToLower(r)
+		case r == '.' || r == '\'' || r == '’':
+			return -1 // L.L.C. -> llc, Joe's -> joes
+		default:
+			return ' '
+		}
+	}, name)
+	var tokens []string
+	for _, f := range strings.Fields(cleaned) {
+		if !vendorNameNoise[f] {
+			tokens = append(tokens, f)
+		}
+	}
+	return tokens
+}
+
+// similarVendorNames reports whether one normalized name is the other or
+// starts with it, word for word: "Acme" matches "Acme Plumbing LLC" but
+// not "Acmeco".
+func similarVendorNames(a, b []string) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return false
+	}
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	return slices.Equal(a, b[:len(a)])
+}
+
+// FindSimilarVendors groups active vendors whose normalized names match
+// (see similarVendorNames). Similarity chains, so "Acme" pulls "Acme
+// Plumbing" and "Acme Roofing" into one group. Each group has at least two
+// vendors, ordered by name; groups are ordered by their first name.
+func (s *Store) FindSimilarVendors() ([][]Vendor, error) {
+	vendors, err := s.ListVendors(false)
+	if err != nil {
+		return nil, err
+	}
+	tokens := make([][]string, len(vendors))
+	for i, v := range vendors {
+		tokens[i] = vendorNameTokens(v.Name)
+	}
+
+	// Union-find over the pairwise matches.
+	parent := make([]int, len(vendors))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	for i := range vendors {
+		for j := i + 1; j < len(vendors); j++ {
+			if similarVendorNames(tokens[i], tokens[j]) {
+				parent[find(j)] = find(i)
+			}
+		}
+	}
+
+	byRoot := make(map[int][]Vendor)
+	var roots []int
+	for i, v := range vendors {
+		root := find(i)
+		if _, seen := byRoot[root]; !seen {
+			roots = append(roots, root)
+		}
+		byRoot[root] = append(byRoot[root], v)
+	}
+	var groups [][]Vendor
+	for _, root := range roots {
+		if len(byRoot[root]) > 1 {
+			groups = append(groups, byRoot[root])
+		}
+	}
+	return groups, nil
+}
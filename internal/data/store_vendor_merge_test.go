@@ -0,0 +1,55 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVendorNameTokens(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		want []string
+	}{
+		{"Acme", []string{"acme"}},
+		{"ACME PLUMBING LLC", []string{"acme", "plumbing"}},
+		{"Acme Plumbing, L.L.C.", []string{"acme", "plumbing"}},
+		{"The Joe's Roofing & Co.", []string{"joes", "roofing"}},
+		{"Inc.", nil},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, vendorNameTokens(tt.name), tt.name)
+	}
+}
+
+func TestFindSimilarVendors(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	for _, name := range []string{
+		"Acme", "ACME PLUMBING LLC", "Acme Plumbing", "Acmeco",
+		"Bright Electric", "Bright Electric, Inc.", "Cedar Roofing", "Deleted Acme",
+	} {
+		require.NoError(t, store.CreateVendor(&Vendor{Name: name}))
+	}
+	dup := Vendor{Name: "acme plumbing inc"}
+	require.NoError(t, store.CreateVendor(&dup))
+	require.NoError(t, store.DeleteVendor(dup.ID))
+
+	groups, err := store.FindSimilarVendors()
+	require.NoError(t, err)
+	names := make([][]string, len(groups))
+	for i, g := range groups {
+		for _, v := range g {
+			names[i] = append(names[i], v.Name)
+		}
+	}
+	assert.Equal(t, [][]string{
+		{"ACME PLUMBING LLC", "Acme", "Acme Plumbing"},
+		{"Bright Electric", "Bright Electric, Inc."},
+	}, names, "deleted vendors and partial words don't match")
+}
@@ -0,0 +1,170 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package data
+
+import (
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/iancoleman/strcase"
+)
+
+// Title casing styles for TitleRules.Case.
+const (
+	TitleCaseTitle    = "title"
+	TitleCaseSentence = "sentence"
+	TitleCaseLower    = "lower"
+)
+
+// Date styles for TitleRules.Dates.
+const (
+	TitleDatesKeep  = "keep"
+	TitleDatesShort = "short"
+	TitleDatesLong  = "long"
+)
+
+// TitleRewrite replaces every match of Pattern in a file name, before it is
+// split into words. Replace may refer to groups as ${1}.
+type TitleRewrite struct {
+	Pattern *regexp.Regexp
+	Replace string
+}
+
+// TitleRules customizes how a file name becomes a document title. The zero
+// value title-cases the words of the name and changes nothing else.
+type TitleRules struct {
+	// Strip removes every match from the name, after its extension.
+	Strip *regexp.Regexp
+
+	// Rewrites run in order after Strip.
+	Rewrites []TitleRewrite
+
+	// Dates spells out year-month and year-month-day runs of words:
+	// TitleDatesShort gives "Jan 2025", TitleDatesLong "January 2025".
+	// Empty or TitleDatesKeep leaves the numbers alone.
+	Dates string
+
+	// Case is one of the TitleCase constants. Empty means TitleCaseTitle.
+	Case string
+
+	// Words replaces whole words, matched case-insensitively on the lower
+	// case key, after casing. Values are used as written (e.g. "inv" →
+	// "Invoice", "hvac" → "HVAC").
+	Words map[string]string
+}
+
+// Title derives a title from a file name: it strips extensions (including
+// compound ones like .tar.gz), applies Strip and Rewrites, splits on word
+// boundaries via strcase, then applies Dates, Case, and Words. When the
+// rules leave nothing, the name is titled without them.
+func (r TitleRules) Title(name string) string {
+	stem := filenameStem(name)
+	custom := stem
+	if r.Strip != nil {
+		custom = r.Strip.ReplaceAllString(custom, "")
+	}
+	for _, rw := range r.Rewrites {
+		custom = rw.Pattern.ReplaceAllString(custom, rw.Replace)
+	}
+	words := strings.Fields(strcase.ToDelimited(custom, ' '))
+	if len(words) == 0 {
+		words = strings.Fields(strcase.ToDelimited(stem, ' '))
+		r = TitleRules{}
+	}
+
+	words = spellDates(words, r.Dates)
+	for i, w := range words {
+		switch {
+		case r.Case == TitleCaseLower:
+			words[i] = strings.ToLower(w)
+		case r.Case == TitleCaseSentence && i > 0:
+			// strcase already lower-cased the words.
+		default:
+			words[i] = upperFirst(w)
+		}
+		if repl, ok := r.Words[strings.ToLower(w)]; ok {
+			words[i] = repl
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// filenameStem strips the outermost extension (every file has one) and any
+// known compound-extension intermediaries (e.g. .tar in .tar.gz).
+func filenameStem(name string) string {
+	name = strings.TrimSpace(name)
+	if ext := filepath.Ext(name); ext != "" && ext != name {
+		name = strings.TrimSuffix(name, ext)
+	}
+	for {
+		ext := filepath.Ext(name)
+		if ext == "" || ext == name {
+			break
+		}
+		if strings.ToLower(ext) != ".tar" {
+			break
+		}
+		name = strings.TrimSuffix(name, ext)
+	}
+	return name
+}
+
+// spellDates rewrites a four-digit year followed by a two-digit month, and
+// optionally a two-digit day, as a month name in the given style.
+func spellDates(words []string, style string) []string {
+	if style != TitleDatesShort && style != TitleDatesLong {
+		return words
+	}
+	out := make([]string, 0, len(words))
+	for i := 0; i < len(words); i++ {
+		year, ok := dateWord(words, i, 4, 1000, 9999)
+		if !ok {
+			out = append(out, words[i])
+			continue
+		}
+		month, ok := dateWord(words, i+1, 2, 1, 12)
+		if !ok {
+			out = append(out, words[i])
+			continue
+		}
+		name := time.Month(month).String()
+		if style == TitleDatesShort {
+			name = name[:3]
+		}
+		if day, ok := dateWord(words, i+2, 2, 1, 31); ok {
+			out = append(out, name, strconv.Itoa(day), strconv.Itoa(year))
+			i += 2
+		} else {
+			out = append(out, name, strconv.Itoa(year))
+			i++
+		}
+	}
+	return out
+}
+
+// dateWord parses words[i] as a number of exactly digits digits within
+// [lo, hi].
+func dateWord(words []string, i, digits, lo, hi int) (int, bool) {
+	if i >= len(words) || len(words[i]) != digits {
+		return 0, false
+	}
+	n, err := strconv.Atoi(words[i])
+	if err != nil || n < lo || n > hi {
+		return 0, false
+	}
+	return n, true
+}
+
+// upperFirst upper-cases the first rune of w.
+func upperFirst(w string) string {
+	runes := []rune(w)
+	if len(runes) > 0 {
+		runes[0] = unicode.ToUpper(runes[0])
+	}
+	return string(runes)
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package data
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTitleFromFilename(t *testing.T) {
+	t.Parallel()
+	tests := map[string]string{
+		"INV_2025-01_garcia.pdf": "Inv 2025 01 Garcia",
+		"waterHeaterManual.pdf":  "Water Heater Manual",
+		"backup.tar.gz":          "Backup",
+		"  furnace-receipt.PDF ": "Furnace Receipt",
+		".bashrc":                "Bashrc",
+	}
+	for name, want := range tests {
+		assert.Equal(t, want, TitleFromFilename(name), name)
+	}
+}
+
+func TestTitleRules(t *testing.T) {
+	t.Parallel()
+	rules := TitleRules{
+		Strip: regexp.MustCompile(`(?i)_scan\d*$`),
+		Rewrites: []TitleRewrite{{
+			Pattern: regexp.MustCompile(`^INV_(.+?)_(.+)$`),
+			Replace: "${2} inv ${1}",
+		}},
+		Dates: TitleDatesShort,
+		Words: map[string]string{"inv": "Invoice", "hvac": "HVAC"},
+	}
+	assert.Equal(t, "Garcia Invoice Jan 2025", rules.Title("INV_2025-01_garcia_SCAN2.pdf"))
+	assert.Equal(t, "HVAC Service Mar 4 2024", rules.Title("hvac-service-2024-03-04.pdf"))
+	assert.Equal(t, "Unit 2025 13", rules.Title("unit_2025_13.pdf"),
+		"13 is not a month")
+
+	rules.Dates = TitleDatesLong
+	rules.Case = TitleCaseSentence
+	assert.Equal(t, "Report January 2025", rules.Title("REPORT-2025-01.pdf"))
+
+	rules.Case = TitleCaseLower
+	assert.Equal(t, "HVAC filter", rules.Title("HVAC_Filter.txt"))
+}
+
+func TestTitleRulesFallBackWhenEmpty(t *testing.T) {
+	t.Parallel()
+	rules := TitleRules{Strip: regexp.MustCompile(`.*`), Case: TitleCaseLower}
+	assert.Equal(t, "Scan 001", rules.Title("scan_001.jpg"),
+		"stripping everything should fall back to the default title")
+}
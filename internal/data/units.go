@@ -5,6 +5,7 @@ package data
 
 import (
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/dustin/go-humanize"
@@ -106,6 +107,28 @@ func FormatLotArea(sqft int, u UnitSystem) string {
 	panic(fmt.Sprintf("unhandled UnitSystem: %d", u))
 }
 
+// AreaUnit returns the short area unit label ("ft²" or "m²").
+func AreaUnit(u UnitSystem) string {
+	switch u {
+	case UnitsMetric:
+		return "m" + symSuperTwo
+	case UnitsImperial:
+		return "ft" + symSuperTwo
+	}
+	panic(fmt.Sprintf("unhandled UnitSystem: %d", u))
+}
+
+// CentsPerArea divides a cost by the building area in the user's display
+// unit, giving the familiar cost-per-square-foot (or per m²) renovation
+// figure. Returns false when the area is unknown.
+func CentsPerArea(cents int64, sqft int, u UnitSystem) (int64, bool) {
+	area := SqFtToDisplayInt(sqft, u)
+	if area <= 0 {
+		return 0, false
+	}
+	return int64(math.Round(float64(cents) / float64(area))), true
+}
+
 // AreaFormTitle returns the form field title for the building area.
 func AreaFormTitle(u UnitSystem) string {
 	switch u {
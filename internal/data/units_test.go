@@ -142,3 +142,21 @@ func TestUnitSystemForLocale(t *testing.T) {
 		})
 	}
 }
+
+func TestCentsPerArea(t *testing.T) {
+	t.Parallel()
+	per, ok := CentsPerArea(4500000, 2000, UnitsImperial)
+	require.True(t, ok)
+	assert.Equal(t, int64(2250), per)
+
+	// 2000 ft² displays as 186 m².
+	per, ok = CentsPerArea(4500000, 2000, UnitsMetric)
+	require.True(t, ok)
+	assert.Equal(t, int64(24194), per)
+
+	_, ok = CentsPerArea(4500000, 0, UnitsImperial)
+	assert.False(t, ok, "unknown area has no per-area cost")
+
+	assert.Equal(t, "ft²", AreaUnit(UnitsImperial))
+	assert.Equal(t, "m²", AreaUnit(UnitsMetric))
+}
@@ -24,6 +24,19 @@ var (
 	ErrIntervalAndDueDate = errors.New("set interval or due date, not both")
 )
 
+// BathroomStep is the granularity of HouseProfile.Bathrooms: a half bath
+// is a real thing, a third of one isn't.
+const BathroomStep = 0.5
+
+// StepError reports a decimal value that isn't a whole multiple of Step.
+type StepError struct {
+	Step float64
+}
+
+func (e *StepError) Error() string {
+	return "decimal value is not a multiple of " + strconv.FormatFloat(e.Step, 'f', -1, 64)
+}
+
 func ParseRequiredDate(input string) (time.Time, error) {
 	return ParseRequiredDateAt(input, time.Now())
 }
@@ -159,20 +172,35 @@ func ParseOptionalFloat(input string) (float64, error) {
 		return 0, nil
 	}
 	value, err := strconv.ParseFloat(trimmed, 64)
-	if err != nil || value < 0 {
+	if err != nil || value < 0 || math.IsInf(value, 0) || math.IsNaN(value) {
 		return 0, ErrInvalidFloat
 	}
 	return value, nil
 }
 
-func ComputeNextDue(last *time.Time, intervalMonths int, dueDate *time.Time) *time.Time {
+// ParseOptionalFloatStep is ParseOptionalFloat constrained to whole
+// multiples of step (e.g. 0.5 for bathrooms). A step of zero accepts any
+// precision.
+func ParseOptionalFloatStep(input string, step float64) (float64, error) {
+	value, err := ParseOptionalFloat(input)
+	if err != nil || step <= 0 {
+		return value, err
+	}
+	steps := value / step
+	if math.Abs(steps-math.Round(steps)) > 1e-9 {
+		return 0, &StepError{Step: step}
+	}
+	return value, nil
+}
+
+func ComputeNextDue(last *time.Time, interval Interval, dueDate *time.Time) *time.Time {
 	if dueDate != nil {
 		return dueDate
 	}
-	if last == nil || intervalMonths <= 0 {
+	if last == nil || interval.IsZero() {
 		return nil
 	}
-	next := AddMonths(*last, intervalMonths)
+	next := interval.After(*last)
 	return &next
 }
 
@@ -63,6 +63,28 @@ func TestParseOptionalFloat(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestParseOptionalFloatStep(t *testing.T) {
+	t.Parallel()
+	for _, input := range []string{"", "0", "2", "2.5", "3.0"} {
+		_, err := ParseOptionalFloatStep(input, BathroomStep)
+		assert.NoErrorf(t, err, "ParseOptionalFloatStep(%q)", input)
+	}
+	for _, input := range []string{"2.33", "1.25", "0.1"} {
+		_, err := ParseOptionalFloatStep(input, BathroomStep)
+		var stepErr *StepError
+		require.ErrorAsf(t, err, &stepErr, "ParseOptionalFloatStep(%q)", input)
+		assert.InDelta(t, BathroomStep, stepErr.Step, 0)
+	}
+	for _, input := range []string{"abc", "-0.5", "NaN", "Inf"} {
+		_, err := ParseOptionalFloatStep(input, BathroomStep)
+		assert.ErrorIsf(t, err, ErrInvalidFloat, "ParseOptionalFloatStep(%q)", input)
+	}
+
+	value, err := ParseOptionalFloatStep("2.33", 0)
+	require.NoError(t, err, "zero step accepts any precision")
+	assert.InDelta(t, 2.33, value, 0.001)
+}
+
 func TestParseRequiredDate(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -237,26 +259,26 @@ func TestParseOptionalDateEmpty(t *testing.T) {
 func TestComputeNextDue(t *testing.T) {
 	t.Parallel()
 	last := time.Date(2024, 10, 10, 0, 0, 0, 0, time.UTC)
-	next := ComputeNextDue(&last, 6, nil)
+	next := ComputeNextDue(&last, Interval{Months: 6}, nil)
 	require.NotNil(t, next)
 	assert.Equal(t, "2025-04-10", next.Format(DateLayout))
 }
 
 func TestComputeNextDueNilDate(t *testing.T) {
 	t.Parallel()
-	assert.Nil(t, ComputeNextDue(nil, 6, nil))
+	assert.Nil(t, ComputeNextDue(nil, Interval{Months: 6}, nil))
 }
 
 func TestComputeNextDueZeroInterval(t *testing.T) {
 	t.Parallel()
 	d := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
-	assert.Nil(t, ComputeNextDue(&d, 0, nil))
+	assert.Nil(t, ComputeNextDue(&d, Interval{}, nil))
 }
 
 func TestComputeNextDueExplicitDueDate(t *testing.T) {
 	t.Parallel()
 	due := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
-	next := ComputeNextDue(nil, 0, &due)
+	next := ComputeNextDue(nil, Interval{}, &due)
 	require.NotNil(t, next)
 	assert.Equal(t, "2025-11-01", next.Format(DateLayout))
 }
@@ -265,14 +287,14 @@ func TestComputeNextDueDateOverridesInterval(t *testing.T) {
 	t.Parallel()
 	last := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
 	due := time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)
-	next := ComputeNextDue(&last, 6, &due)
+	next := ComputeNextDue(&last, Interval{Months: 6}, &due)
 	require.NotNil(t, next)
 	assert.Equal(t, "2025-03-15", next.Format(DateLayout))
 }
 
 func TestComputeNextDueNeitherSet(t *testing.T) {
 	t.Parallel()
-	assert.Nil(t, ComputeNextDue(nil, 0, nil))
+	assert.Nil(t, ComputeNextDue(nil, Interval{}, nil))
 }
 
 func TestAddMonths(t *testing.T) {
@@ -372,7 +394,7 @@ func TestParseIntervalMonthsInvalid(t *testing.T) {
 func TestComputeNextDueMonthEndClamping(t *testing.T) {
 	t.Parallel()
 	last := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
-	next := ComputeNextDue(&last, 1, nil)
+	next := ComputeNextDue(&last, Interval{Months: 1}, nil)
 	require.NotNil(t, next)
 	assert.Equal(t, "2025-02-28", next.Format(DateLayout))
 }
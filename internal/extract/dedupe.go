@@ -0,0 +1,108 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package extract
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// Thresholds for collapsing text that two sources both carry.
+const (
+	// dupContainment is the fraction of a paragraph's word pairs that must
+	// also appear in the preferred source for the paragraph to count as a
+	// duplicate. Below 1 so OCR misreads of a few words still match.
+	dupContainment = 0.8
+
+	// dupMinPairs: paragraphs with fewer word pairs than this are kept.
+	// Short lines ("Total", a date) match by accident and cost little.
+	dupMinPairs = 4
+)
+
+// spatialPrefixRe matches the [left,top,width] or [left,top,width;conf]
+// prefix of a spatial OCR line.
+var spatialPrefixRe = regexp.MustCompile(`(?m)^\[[0-9,;]+\] ?`)
+
+// dedupeSources drops paragraphs from the later sources that the first
+// source already carries, so overlapping pdftotext and OCR output reaches
+// the model once. The first source is the one rankSources prefers, so each
+// duplicated passage keeps its higher-quality reading. Each trimmed source
+// ends with a note saying what was dropped. Returns the number of
+// paragraphs dropped.
+//
+// Neither tool marks page breaks in its output, so paragraphs (blank-line
+// separated blocks) are the unit of comparison. Matching uses word pairs
+// against the whole preferred text, which tolerates the two tools
+// splitting paragraphs differently.
+func dedupeSources(contents []promptSourceContent) int {
+	if len(contents) < 2 {
+		return 0
+	}
+	preferred := wordPairs(contents[0].text)
+	if len(preferred) == 0 {
+		return 0
+	}
+	dropped := 0
+	for i := 1; i < len(contents); i++ {
+		paras := strings.Split(contents[i].text, "\n\n")
+		kept := paras[:0]
+		n := 0
+		for _, p := range paras {
+			if isDuplicateParagraph(p, preferred) {
+				n++
+				continue
+			}
+			kept = append(kept, p)
+		}
+		if n == 0 {
+			continue
+		}
+		dropped += n
+		kept = append(kept, fmt.Sprintf(
+			"[%d %s omitted: same text as the %s source]",
+			n, pluralParagraphs(n), contents[0].src.Tool,
+		))
+		contents[i].text = strings.Join(kept, "\n\n")
+	}
+	return dropped
+}
+
+// isDuplicateParagraph reports whether most of p's word pairs appear in
+// the preferred source.
+func isDuplicateParagraph(p string, preferred map[[2]string]bool) bool {
+	pairs := wordPairs(p)
+	if len(pairs) < dupMinPairs {
+		return false
+	}
+	hits := 0
+	for pair := range pairs {
+		if preferred[pair] {
+			hits++
+		}
+	}
+	return float64(hits) >= dupContainment*float64(len(pairs))
+}
+
+// wordPairs returns the set of adjacent lowercase word pairs in s, with
+// spatial line prefixes and punctuation removed.
+func wordPairs(s string) map[[2]string]bool {
+	s = spatialPrefixRe.ReplaceAllString(s, "")
+	words := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	pairs := make(map[[2]string]bool, len(words))
+	for i := 1; i < len(words); i++ {
+		pairs[[2]string{words[i-1], words[i]}] = true
+	}
+	return pairs
+}
+
+func pluralParagraphs(n int) string {
+	if n == 1 {
+		return "paragraph"
+	}
+	return "paragraphs"
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package extract
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	dupInvoice  = "Garcia Plumbing invoice 1042 for replacing the water heater in the garage"
+	dupTerms    = "Payment is due within thirty days of the invoice date shown above"
+	dupWarranty = "Labor is warranted for one year from the date of installation"
+)
+
+func contentsOf(sources ...TextSource) []promptSourceContent {
+	out := make([]promptSourceContent, len(sources))
+	for i, s := range sources {
+		out[i] = promptSourceContent{src: s, text: s.Text}
+	}
+	return out
+}
+
+func TestDedupeSources_DropsOverlap(t *testing.T) {
+	t.Parallel()
+	contents := contentsOf(
+		TextSource{Tool: "pdftotext", Text: dupInvoice + "\n\n" + dupTerms},
+		// OCR read the same two paragraphs (one with misreads) plus a
+		// scanned page the digital text lacks.
+		TextSource{Tool: "tesseract", Text: dupInvoice + "\n\n" +
+			strings.Replace(dupTerms, "thirty", "th1rty", 1) + "\n\n" + dupWarranty},
+	)
+
+	dropped := dedupeSources(contents)
+
+	assert.Equal(t, 2, dropped)
+	assert.Equal(t, dupInvoice+"\n\n"+dupTerms, contents[0].text, "preferred source is untouched")
+	assert.Contains(t, contents[1].text, dupWarranty, "unique OCR text is kept")
+	assert.NotContains(t, contents[1].text, "Garcia Plumbing")
+	assert.Contains(t, contents[1].text, "[2 paragraphs omitted: same text as the pdftotext source]")
+}
+
+func TestDedupeSources_KeepsDistinctText(t *testing.T) {
+	t.Parallel()
+	contents := contentsOf(
+		TextSource{Tool: "pdftotext", Text: dupInvoice},
+		TextSource{Tool: "tesseract", Text: dupTerms + "\n\n" + dupWarranty},
+	)
+
+	assert.Zero(t, dedupeSources(contents))
+	assert.Equal(t, dupTerms+"\n\n"+dupWarranty, contents[1].text)
+}
+
+func TestDedupeSources_KeepsShortParagraphs(t *testing.T) {
+	t.Parallel()
+	contents := contentsOf(
+		TextSource{Tool: "pdftotext", Text: "Total due $150.00\n\n" + dupInvoice},
+		TextSource{Tool: "tesseract", Text: "Total due $150.00"},
+	)
+
+	assert.Zero(t, dedupeSources(contents), "short lines match by accident and are kept")
+}
+
+func TestDedupeSources_IgnoresSpatialPrefixes(t *testing.T) {
+	t.Parallel()
+	spatial := "[10,20,300] Garcia Plumbing invoice 1042 for replacing\n" +
+		"[10,40,310;55] the water heater in the garage"
+	contents := contentsOf(
+		TextSource{Tool: "pdftotext", Text: dupInvoice},
+		TextSource{Tool: "tesseract", Text: spatial},
+	)
+
+	assert.Equal(t, 1, dedupeSources(contents))
+}
+
+func TestBuildExtractionPrompt_DedupesOverlappingSources(t *testing.T) {
+	t.Parallel()
+	user := userMessage(t,
+		TextSource{Tool: "pdftotext", Text: dupInvoice + "\n\n" + dupTerms},
+		TextSource{Tool: "tesseract", Text: dupInvoice + "\n\n" + dupWarranty},
+	)
+
+	assert.Equal(t, 1, strings.Count(user, "Garcia Plumbing"), "overlap reaches the model once")
+	assert.Contains(t, user, dupWarranty)
+}
@@ -54,6 +54,33 @@ func DefaultExtractors(
 	return ext
 }
 
+// WithLangDetection turns on OCR language detection for every OCR
+// extractor in extractors and returns the slice for chaining. Each
+// document's script is guessed with tesseract's orientation and script
+// detection and OCRed with the matching installed language pack.
+func WithLangDetection(extractors []Extractor) []Extractor {
+	for _, ext := range extractors {
+		switch e := ext.(type) {
+		case *PDFOCRExtractor:
+			e.DetectLang = true
+		case *ImageOCRExtractor:
+			e.DetectLang = true
+		}
+	}
+	return extractors
+}
+
+// WithPageCache sets the rasterized page cache directory on every PDF OCR
+// extractor in extractors and returns the slice for chaining.
+func WithPageCache(extractors []Extractor, dir string) []Extractor {
+	for _, ext := range extractors {
+		if e, ok := ext.(*PDFOCRExtractor); ok {
+			e.PageCacheDir = dir
+		}
+	}
+	return extractors
+}
+
 // HasMatchingExtractor reports whether any extractor in the list with
 // the given tool name matches the MIME type and is available.
 func HasMatchingExtractor(extractors []Extractor, tool string, mime string) bool {
@@ -172,6 +199,12 @@ func (e *PlainTextExtractor) Extract(_ context.Context, data []byte) (TextSource
 type PDFOCRExtractor struct {
 	Tools    *OCRTools
 	MaxPages int
+	// PageCacheDir, when set, keeps rasterized pages there keyed by the
+	// document's checksum so re-extracting the same PDF skips pdftocairo.
+	PageCacheDir string
+	// DetectLang guesses each document's language from its first page
+	// and OCRs in it, falling back to tesseract's default language.
+	DetectLang bool
 }
 
 func (e *PDFOCRExtractor) Tool() string             { return "tesseract" }
@@ -190,7 +223,7 @@ func (e *PDFOCRExtractor) Extract(ctx context.Context, data []byte) (TextSource,
 	if len(data) == 0 {
 		return TextSource{}, nil
 	}
-	text, tsv, err := ocrPDF(ctx, e.tools(), data, e.MaxPages)
+	text, tsv, err := ocrPDF(ctx, e.tools(), data, e.MaxPages, e.PageCacheDir, e.DetectLang)
 	if err != nil {
 		return TextSource{}, err
 	}
@@ -208,6 +241,9 @@ func (e *PDFOCRExtractor) Extract(ctx context.Context, data []byte) (TextSource,
 // failure paths without mutating PATH.
 type ImageOCRExtractor struct {
 	Tools *OCRTools
+	// DetectLang guesses the image's language and OCRs in it, falling
+	// back to tesseract's default language.
+	DetectLang bool
 }
 
 func (e *ImageOCRExtractor) Tool() string             { return "tesseract" }
@@ -226,7 +262,7 @@ func (e *ImageOCRExtractor) Extract(ctx context.Context, data []byte) (TextSourc
 	if len(data) == 0 {
 		return TextSource{}, nil
 	}
-	text, tsv, err := ocrImage(ctx, e.tools().Tesseract, data)
+	text, tsv, err := ocrImage(ctx, e.tools().Tesseract, data, e.DetectLang)
 	if err != nil {
 		return TextSource{}, err
 	}
@@ -6,11 +6,20 @@ package extract
 import (
 	"fmt"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/micasa-dev/micasa/internal/data"
 	"github.com/micasa-dev/micasa/internal/llm"
 )
 
+// Extraction prompt variants. The full prompt carries the domain hints and
+// detailed rules; the minimal one keeps only what a small model needs to
+// produce valid operations.
+const (
+	PromptFull    = "full"
+	PromptMinimal = "minimal"
+)
+
 // ExtractionPromptInput holds the inputs for building an extraction prompt.
 type ExtractionPromptInput struct {
 	DocID         string
@@ -19,8 +28,10 @@ type ExtractionPromptInput struct {
 	SizeBytes     int64
 	Schema        SchemaContext
 	Sources       []TextSource
-	SendTSV       bool // send spatial layout annotations from tesseract OCR
-	ConfThreshold int  // confidence threshold for spatial annotations
+	SendTSV       bool   // send spatial layout annotations from tesseract OCR
+	ConfThreshold int    // confidence threshold for spatial annotations
+	MaxChars      int    // budget for source text across all sources; 0 = no limit
+	Variant       string // PromptFull or PromptMinimal; empty = PromptFull
 }
 
 // BuildExtractionPrompt creates the system and user messages for document
@@ -28,16 +39,21 @@ type ExtractionPromptInput struct {
 // rows; the LLM outputs a JSON array of operations.
 func BuildExtractionPrompt(in ExtractionPromptInput) []llm.Message {
 	return []llm.Message{
-		{Role: "system", Content: operationExtractionSystemPrompt(in.Schema, in.SendTSV)},
+		{Role: "system", Content: operationExtractionSystemPrompt(in.Schema, in.SendTSV, in.Variant)},
 		{Role: "user", Content: operationExtractionUserMessage(in)},
 	}
 }
 
-func operationExtractionSystemPrompt(ctx SchemaContext, sendTSV bool) string {
+func operationExtractionSystemPrompt(ctx SchemaContext, sendTSV bool, variant string) string {
+	minimal := variant == PromptMinimal
 	var b strings.Builder
-	b.WriteString(operationExtractionPreamble)
-	if sendTSV {
-		b.WriteString(operationExtractionTSVPreamble)
+	if minimal {
+		b.WriteString(minimalExtractionPreamble)
+	} else {
+		b.WriteString(operationExtractionPreamble)
+		if sendTSV {
+			b.WriteString(operationExtractionTSVPreamble)
+		}
 	}
 
 	b.WriteString("\n\n## Database schema\n\n")
@@ -57,7 +73,11 @@ func operationExtractionSystemPrompt(ctx SchemaContext, sendTSV bool) string {
 	}
 
 	b.WriteString("\n")
-	b.WriteString(operationExtractionRules)
+	if minimal {
+		b.WriteString(minimalExtractionRules)
+	} else {
+		b.WriteString(operationExtractionRules)
+	}
 	return b.String()
 }
 
@@ -70,6 +90,40 @@ func operationExtractionUserMessage(in ExtractionPromptInput) string {
 	fmt.Fprintf(&b, "MIME: %s\n", in.MIME)
 	fmt.Fprintf(&b, "Size: %d bytes\n", in.SizeBytes)
 
+	contents, guidance := preparedSources(in)
+	if guidance != "" {
+		b.WriteString(guidance + "\n")
+	}
+	if omitted := fitSourceBudget(contents, in.MaxChars); omitted > 0 {
+		fmt.Fprintf(&b, "Note: %d characters were omitted from the middle of "+
+			"long sources to fit the context window; omissions are marked inline.\n", omitted)
+	}
+
+	for _, c := range contents {
+		fmt.Fprintf(&b, "\n---\n\n## Source: %s\n", c.src.Tool)
+		if c.src.Desc != "" {
+			b.WriteString(c.src.Desc + "\n\n")
+		}
+		if c.spatial {
+			b.WriteString(spatialFormatHint)
+		}
+		b.WriteString(c.text)
+	}
+
+	return b.String()
+}
+
+// promptSourceContent is a source's text as it will appear in the prompt.
+type promptSourceContent struct {
+	src     TextSource
+	text    string
+	spatial bool // text is the compact spatial format, not plain text
+}
+
+// promptSourceContents resolves the prompt text for each source, skipping
+// sources with no text.
+func promptSourceContents(in ExtractionPromptInput) []promptSourceContent {
+	var out []promptSourceContent
 	for _, src := range in.Sources {
 		// When SendTSV is enabled and the source has TSV data, prefer
 		// a compact spatial format (line-level bounding boxes). If TSV
@@ -87,17 +141,85 @@ func operationExtractionUserMessage(in ExtractionPromptInput) string {
 		if content == "" {
 			continue
 		}
-		fmt.Fprintf(&b, "\n---\n\n## Source: %s\n", src.Tool)
-		if src.Desc != "" {
-			b.WriteString(src.Desc + "\n\n")
-		}
-		if hasSpatial {
-			b.WriteString(spatialFormatHint)
-		}
-		b.WriteString(content)
+		out = append(out, promptSourceContent{src: src, text: content, spatial: hasSpatial})
 	}
+	return out
+}
 
-	return b.String()
+// preparedSources resolves the prompt text for each source, puts the most
+// trustworthy source first, and drops text the later sources duplicate.
+// Returns the sources and guidance on which to trust.
+func preparedSources(in ExtractionPromptInput) ([]promptSourceContent, string) {
+	contents := promptSourceContents(in)
+	guidance := rankSources(contents)
+	if guidance != "" {
+		dedupeSources(contents)
+	}
+	return contents, guidance
+}
+
+// PromptTruncation reports how many characters of source text the prompt
+// built from in will carry and how many it drops to stay within MaxChars.
+func PromptTruncation(in ExtractionPromptInput) (total, omitted int) {
+	contents, _ := preparedSources(in)
+	for _, c := range contents {
+		total += utf8.RuneCountInString(c.text)
+	}
+	return total, fitSourceBudget(contents, in.MaxChars)
+}
+
+// fitSourceBudget shrinks the source texts in place so that together they
+// fit in maxChars, giving each source a share proportional to its length.
+// Returns the number of characters omitted.
+func fitSourceBudget(contents []promptSourceContent, maxChars int) int {
+	if maxChars <= 0 {
+		return 0
+	}
+	total := 0
+	for _, c := range contents {
+		total += utf8.RuneCountInString(c.text)
+	}
+	if total <= maxChars {
+		return 0
+	}
+	omitted := 0
+	for i := range contents {
+		n := utf8.RuneCountInString(contents[i].text)
+		limit := int(int64(maxChars) * int64(n) / int64(total))
+		var dropped int
+		contents[i].text, dropped = truncateMiddle(contents[i].text, limit)
+		omitted += dropped
+	}
+	return omitted
+}
+
+// truncateMiddle keeps the head and tail of s, which together hold about
+// limit characters, and marks the omission between them. Documents put
+// identifying details up front and totals or warranty terms at the end,
+// so the middle is the cheapest part to lose. Cuts fall on line
+// boundaries when there is one nearby. Returns the number of characters
+// dropped.
+func truncateMiddle(s string, limit int) (string, int) {
+	r := []rune(s)
+	if len(r) <= limit {
+		return s, 0
+	}
+	headN := limit * 2 / 3
+	tailN := limit - headN
+	head := string(r[:headN])
+	tail := string(r[len(r)-tailN:])
+	// Prefer whole lines, but only give up the partial line if it is
+	// short relative to the kept text.
+	if i := strings.LastIndexByte(head, '\n'); i >= len(head)/2 {
+		head = head[:i]
+	}
+	if i := strings.IndexByte(tail, '\n'); i >= 0 && i < len(tail)/2 {
+		tail = tail[i+1:]
+	}
+	head = strings.TrimRight(head, "\n")
+	tail = strings.TrimLeft(tail, "\n")
+	dropped := len(r) - utf8.RuneCountInString(head) - utf8.RuneCountInString(tail)
+	return fmt.Sprintf("%s\n\n[... %d characters omitted ...]\n\n%s", head, dropped, tail), dropped
 }
 
 const operationExtractionPreamble = `You are a document extraction assistant for a home management application. Given a document's metadata and extracted text, output operations to record what the document describes. The output is constrained by a JSON schema -- focus on choosing the right rows and field values, not on the JSON shape.
@@ -130,11 +252,24 @@ const operationExtractionRules = `## Rules
 
 ## Document type hints
 
-- Contractor proposal, bid, or invoice for project work: create the vendor (if new) and project (if new), then a quote with labor_cents, materials_cents, and total_cents. Use this for both estimates and invoices on one-off project work (remodels, repairs, installations) -- quotes hold both proposed and final amounts.
+- Contractor proposal, bid, or invoice for project work: create the vendor (if new) and project (if new), then a quote with labor_cents, materials_cents, tax_cents, and total_cents. total_cents is the grand total including tax; tax_cents holds sales tax or VAT when the document itemizes it. Use this for both estimates and invoices on one-off project work (remodels, repairs, installations) -- quotes hold both proposed and final amounts.
 - Service receipt or invoice for completed maintenance work tied to a recurring task: create a service_log_entries row with the maintenance_item_id (creating the maintenance_items row first if needed), serviced_at, and cost_cents; set vendor_name when a contractor performed the work. The disambiguator from quotes is whether the work corresponds to a recurring maintenance_item: routine HVAC service, gutter cleaning, and the like belong here; one-off project work belongs in quotes. Do not create both for the same document.
 - Appliance manual: create the appliance with brand and model_number, then one maintenance_items row per scheduled task with interval_months.
 - Inspection report: create one incidents row per finding with severity and date_noticed; if the inspector is identifiable, create them as a vendor.`
 
+// minimalExtractionPreamble and minimalExtractionRules make up the minimal
+// prompt variant. Spatial OCR sources still carry their per-source format
+// hint in the user message.
+const minimalExtractionPreamble = `Record what a home document describes as database operations. "quotes" holds costs for one-off project work (estimates, bids, invoices); create a quote only when the document states such a cost.`
+
+const minimalExtractionRules = `## Rules
+
+1. Only set fields the document states. Do not guess.
+2. Money is integer cents: $1,500.00 -> 150000.
+3. Dates are YYYY-MM-DD.
+4. Use IDs from the existing rows for foreign keys. A row you create gets max(existing IDs) + 1.
+5. When a Document ID is provided, update that document; otherwise create one.`
+
 // StripCodeFences removes markdown code fences that LLMs sometimes wrap
 // around JSON output. Handles fences anywhere in the text (not just at
 // the start), since LLMs may produce commentary before the fenced block.
@@ -4,7 +4,10 @@
 package extract
 
 import (
+	"fmt"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/micasa-dev/micasa/internal/data"
 	"github.com/stretchr/testify/assert"
@@ -238,6 +241,40 @@ func TestBuildExtractionPrompt_ContainsDomainHints(t *testing.T) {
 	)
 }
 
+func TestBuildExtractionPrompt_MinimalVariant(t *testing.T) {
+	t.Parallel()
+	in := ExtractionPromptInput{
+		DocID:    "1",
+		Filename: "scan.png",
+		MIME:     "image/png",
+		Schema: SchemaContext{
+			DDL: map[string]string{
+				data.TableVendors: "CREATE TABLE `vendors` (`id` integer)",
+			},
+			Vendors: []EntityRow{{ID: "7", Name: "Acme Plumbing"}},
+		},
+		Sources: []TextSource{
+			{Tool: "tesseract", Text: "Invoice #1042", Data: []byte(sampleTSV)},
+		},
+		SendTSV:       true,
+		ConfThreshold: DefaultOCRConfThreshold,
+	}
+	full := BuildExtractionPrompt(in)[0].Content
+	in.Variant = PromptMinimal
+	msgs := BuildExtractionPrompt(in)
+	sys := msgs[0].Content
+
+	assert.Less(t, len(sys), len(full))
+	assert.NotContains(t, sys, "Document type hints")
+	assert.NotContains(t, sys, "spatial layout annotations",
+		"the TSV preamble is dropped; the user message keeps its format hint")
+	assert.Contains(t, msgs[1].Content, "[left,top,width]")
+	assert.Contains(t, sys, "CREATE TABLE `vendors`", "the schema is still sent")
+	assert.Contains(t, sys, "Acme Plumbing", "existing rows are still sent")
+	assert.Contains(t, sys, "integer cents")
+	assert.Contains(t, sys, "YYYY-MM-DD")
+}
+
 // TestBuildExtractionPrompt_OmitsSchemaRedundantSections asserts that the
 // prompt no longer duplicates content the JSON schema already enforces:
 // JSON shape examples, "output ONLY JSON" instructions, the allowed-ops
@@ -497,3 +534,90 @@ func TestFormatEntityRows_Empty(t *testing.T) {
 	result := FormatEntityRows(data.TableVendors, nil)
 	assert.Empty(t, result)
 }
+
+// numberedLines returns n lines of the form "line 0001".
+func numberedLines(n int) string {
+	lines := make([]string, n)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("line %04d", i)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestBuildExtractionPrompt_TruncatesLongSources(t *testing.T) {
+	t.Parallel()
+	text := numberedLines(1000) // ~10,000 chars
+	msgs := BuildExtractionPrompt(ExtractionPromptInput{
+		Filename: "manual.pdf",
+		MIME:     "application/pdf",
+		Sources:  []TextSource{{Tool: "pdftotext", Text: text}},
+		MaxChars: 1000,
+	})
+
+	user := msgs[1].Content
+	assert.Contains(t, user, "line 0000", "head is kept")
+	assert.Contains(t, user, "line 0999", "tail is kept")
+	assert.NotContains(t, user, "line 0500", "middle is dropped")
+	assert.Contains(t, user, "characters omitted ...]")
+	assert.Contains(t, user, "were omitted from the middle of long sources")
+	assert.Less(t, len(user), 1500)
+}
+
+func TestBuildExtractionPrompt_NoTruncationWithinBudget(t *testing.T) {
+	t.Parallel()
+	text := numberedLines(50)
+	for _, maxChars := range []int{0, 10000} {
+		msgs := BuildExtractionPrompt(ExtractionPromptInput{
+			Filename: "receipt.pdf",
+			MIME:     "application/pdf",
+			Sources:  []TextSource{{Tool: "pdftotext", Text: text}},
+			MaxChars: maxChars,
+		})
+		user := msgs[1].Content
+		assert.Contains(t, user, text)
+		assert.NotContains(t, user, "omitted")
+	}
+}
+
+func TestPromptTruncation_SplitsBudgetAcrossSources(t *testing.T) {
+	t.Parallel()
+	long := strings.Repeat("a", 3000)
+	short := strings.Repeat("b", 1000)
+	in := ExtractionPromptInput{
+		Sources: []TextSource{
+			{Tool: "pdftotext", Text: long},
+			{Tool: "tesseract", Text: short},
+			{Tool: "empty", Text: "  "},
+		},
+		MaxChars: 2000,
+	}
+
+	total, omitted := PromptTruncation(in)
+	assert.Equal(t, 4000, total)
+	assert.Equal(t, 2000, omitted)
+
+	user := BuildExtractionPrompt(in)[1].Content
+	assert.Contains(t, user, "[... 1500 characters omitted ...]")
+	assert.Contains(t, user, "[... 500 characters omitted ...]")
+}
+
+func TestTruncateMiddle_CutsOnLineBoundaries(t *testing.T) {
+	t.Parallel()
+	out, dropped := truncateMiddle(numberedLines(100), 300)
+	assert.Positive(t, dropped)
+	head, tail, ok := strings.Cut(out, "\n\n[... ")
+	require.True(t, ok)
+	assert.True(t, strings.HasSuffix(head, "line 0019") || strings.HasSuffix(head, "line 0018"),
+		"head should end on a whole line, got %q", head[len(head)-12:])
+	_, tail, ok = strings.Cut(tail, "...]\n\n")
+	require.True(t, ok)
+	assert.True(t, strings.HasPrefix(tail, "line "), "tail should start on a whole line")
+	assert.True(t, strings.HasSuffix(tail, "line 0099"))
+}
+
+func TestTruncateMiddle_MultibyteSafe(t *testing.T) {
+	t.Parallel()
+	out, dropped := truncateMiddle(strings.Repeat("é", 100), 30)
+	assert.Equal(t, 70, dropped)
+	assert.True(t, utf8.ValidString(out))
+}
@@ -31,12 +31,16 @@ type ocrPageResult struct {
 // ocrPDF extracts text from a PDF using parallel per-page rasterization
 // with pdftocairo fused with tesseract OCR. Each page is rasterized and
 // OCR'd in a single goroutine, eliminating the sequential bottleneck.
-// tools must have PDFInfo, PDFToCairo, and Tesseract populated.
+// tools must have PDFInfo, PDFToCairo, and Tesseract populated. A
+// non-empty cacheDir keeps the rasterized pages there for reuse. With
+// detectLang, the language is guessed from the first page first.
 func ocrPDF(
 	ctx context.Context,
 	tools *OCRTools,
 	data []byte,
 	maxPages int,
+	cacheDir string,
+	detectLang bool,
 ) (string, []byte, error) {
 	tmpDir, err := os.MkdirTemp("", "micasa-ocr-*")
 	if err != nil {
@@ -60,7 +64,12 @@ func ocrPDF(
 		return "", nil, nil
 	}
 
-	results := ocrPDFPages(ctx, tools, pdfPath, pageCount, nil, nil)
+	var lang string
+	if detectLang {
+		lang = detectPDFLang(ctx, tools, pdfPath, tmpDir)
+	}
+	cache := newPageCache(cacheDir, data)
+	results := ocrPDFPages(ctx, tools, pdfPath, pageCount, lang, cache, nil, nil)
 	text, tsv := collectOCRResults(results)
 	return text, tsv, nil
 }
@@ -97,28 +106,26 @@ func pdfPageCount(ctx context.Context, pdfInfoPath, pdfPath string) (int, error)
 // directly into tesseract for OCR, with no intermediate file on disk.
 // If onRasterDone is non-nil, it is called after pdftocairo finishes
 // (before tesseract completes) to enable per-stage progress reporting.
-// tools must have PDFToCairo and Tesseract populated.
+// A non-nil cache routes the page through ocrCachedPage instead. lang
+// is passed to tesseract's -l when set. tools must have PDFToCairo and
+// Tesseract populated.
 func ocrPage(
 	ctx context.Context,
 	tools *OCRTools,
 	pdfPath string,
 	page int,
+	lang string,
+	cache *pageCache,
 	onRasterDone func(),
 ) ocrPageResult {
-	// pdftocairo streams the PNG to stdout; tesseract reads from stdin.
-	cairoArgs := []string{
-		"-png",
-		"-r", "300",
-		"-singlefile",
-		"-f", strconv.Itoa(page),
-		"-l", strconv.Itoa(page),
-		pdfPath,
-		"-", // stdout
+	if cache != nil {
+		return ocrCachedPage(ctx, tools, pdfPath, page, lang, cache, onRasterDone)
 	}
+	// pdftocairo streams the PNG to stdout; tesseract reads from stdin.
 	cairoCmd := exec.CommandContext( //nolint:gosec // tools.PDFToCairo is resolved at startup, args constructed internally
 		ctx,
 		tools.PDFToCairo,
-		cairoArgs...,
+		rasterArgs(pdfPath, page)...,
 	)
 	var cairoErr bytes.Buffer
 	cairoCmd.Stderr = &cairoErr
@@ -126,9 +133,7 @@ func ocrPage(
 	tessCmd := exec.CommandContext( //nolint:gosec // tools.Tesseract is resolved at startup
 		ctx,
 		tools.Tesseract,
-		"stdin",
-		"stdout",
-		"tsv",
+		tesseractArgs("stdin", lang)...,
 	)
 	tessCmd.Env = append(os.Environ(), "OMP_THREAD_LIMIT=1")
 	var tsvBuf bytes.Buffer
@@ -193,17 +198,44 @@ func ocrPage(
 	return ocrPageResult{text: text, tsv: tsvData}
 }
 
+// tesseractArgs returns the tesseract arguments that OCR input (a path or
+// "stdin") to TSV on stdout, in lang when set and tesseract's default
+// language otherwise.
+func tesseractArgs(input, lang string) []string {
+	args := []string{input, "stdout"}
+	if lang != "" {
+		args = append(args, "-l", lang)
+	}
+	return append(args, "tsv")
+}
+
+// rasterArgs returns the pdftocairo arguments that render one page of
+// pdfPath as a 300 DPI PNG on stdout.
+func rasterArgs(pdfPath string, page int) []string {
+	return []string{
+		"-png",
+		"-r", "300",
+		"-singlefile",
+		"-f", strconv.Itoa(page),
+		"-l", strconv.Itoa(page),
+		pdfPath,
+		"-", // stdout
+	}
+}
+
 // ocrPDFPages runs fused pdftocairo|tesseract on each page in parallel,
 // capping concurrency at runtime.NumCPU(). Results are returned in page
 // order. If rasterDone is non-nil, a value is sent after each page's
 // pdftocairo finishes. If pageDone is non-nil, a value is sent after each
-// page's tesseract finishes. tools must have PDFToCairo and Tesseract
-// populated.
+// page's tesseract finishes. cache may be nil; lang may be empty. tools
+// must have PDFToCairo and Tesseract populated.
 func ocrPDFPages(
 	ctx context.Context,
 	tools *OCRTools,
 	pdfPath string,
 	pageCount int,
+	lang string,
+	cache *pageCache,
 	rasterDone chan<- struct{},
 	pageDone chan<- struct{},
 ) []ocrPageResult {
@@ -237,7 +269,7 @@ func ocrPDFPages(
 				}
 			}
 
-			results[idx] = ocrPage(ctx, tools, pdfPath, idx+1, onRasterDone)
+			results[idx] = ocrPage(ctx, tools, pdfPath, idx+1, lang, cache, onRasterDone)
 
 			if pageDone != nil {
 				select {
@@ -284,8 +316,14 @@ func collectOCRResults(results []ocrPageResult) (string, []byte) {
 }
 
 // ocrImage runs tesseract on raw image bytes. tesseractPath is the
-// absolute path to the tesseract binary.
-func ocrImage(ctx context.Context, tesseractPath string, data []byte) (string, []byte, error) {
+// absolute path to the tesseract binary. With detectLang, the image's
+// language is guessed first.
+func ocrImage(
+	ctx context.Context,
+	tesseractPath string,
+	data []byte,
+	detectLang bool,
+) (string, []byte, error) {
 	tmpDir, err := os.MkdirTemp("", "micasa-ocr-*")
 	if err != nil {
 		return "", nil, fmt.Errorf("create temp dir: %w", err)
@@ -297,13 +335,17 @@ func ocrImage(ctx context.Context, tesseractPath string, data []byte) (string, [
 		return "", nil, fmt.Errorf("write temp image: %w", err)
 	}
 
-	return ocrImageFile(ctx, tesseractPath, imgPath)
+	var lang string
+	if detectLang {
+		lang = detectImageLang(ctx, tesseractPath, imgPath)
+	}
+	return ocrImageFile(ctx, tesseractPath, lang, imgPath)
 }
 
 // ocrImageFile runs tesseract on an image file, returning extracted text
 // and raw TSV output. tesseractPath is the absolute path to the tesseract
-// binary.
-func ocrImageFile(ctx context.Context, tesseractPath, imgPath string) (string, []byte, error) {
+// binary. lang is passed to tesseract's -l when set.
+func ocrImageFile(ctx context.Context, tesseractPath, lang, imgPath string) (string, []byte, error) {
 	// Run tesseract with TSV output to capture confidence/coordinates.
 	// OMP_THREAD_LIMIT=1 forces single-threaded mode per process so our
 	// worker pool controls parallelism without OpenMP oversubscription.
@@ -312,9 +354,7 @@ func ocrImageFile(ctx context.Context, tesseractPath, imgPath string) (string, [
 	tsvCmd := exec.CommandContext( //nolint:gosec // tesseractPath is resolved at startup, imgPath is a temp file we created
 		ctx,
 		tesseractPath,
-		imgPath,
-		"stdout",
-		"tsv",
+		tesseractArgs(imgPath, lang)...,
 	)
 	tsvCmd.Env = append(os.Environ(), "OMP_THREAD_LIMIT=1")
 	tsvCmd.Stdout = &tsvBuf
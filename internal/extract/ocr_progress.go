@@ -57,12 +57,12 @@ func ExtractWithProgress(
 		defer close(ch)
 		if IsImageMIME(mime) {
 			if img := findImageOCRExtractor(extractors, mime); img != nil {
-				ocrImageWithProgress(ctx, img.tools().Tesseract, data, ch)
+				ocrImageWithProgress(ctx, img.tools().Tesseract, data, img.DetectLang, ch)
 				return
 			}
 		}
-		tools, maxPages := pdfOCRToolsAndMaxPages(extractors)
-		ocrPDFWithProgress(ctx, tools, data, maxPages, ch)
+		tools, maxPages, cacheDir, detectLang := pdfOCRSettings(extractors)
+		ocrPDFWithProgress(ctx, tools, data, maxPages, cacheDir, detectLang, ch)
 	}()
 	return ch
 }
@@ -80,30 +80,32 @@ func findImageOCRExtractor(extractors []Extractor, mime string) *ImageOCRExtract
 	return nil
 }
 
-// pdfOCRToolsAndMaxPages returns the *OCRTools and MaxPages cap from the
-// first available *PDFOCRExtractor in extractors. Unavailable extractors
+// pdfOCRSettings returns the *OCRTools, MaxPages cap, PageCacheDir, and
+// DetectLang setting from the first available *PDFOCRExtractor in extractors. Unavailable extractors
 // (e.g. ones carrying stub paths that fail the tools().PDFOCRAvailable()
 // check) are skipped so a later runnable extractor in the slice wins, the
 // same selection rule findImageOCRExtractor uses. If no available
 // PDFOCRExtractor is found it falls back to DefaultOCRTools() with an
-// unlimited page cap so the progress pipeline still runs for callers
-// that construct extractor slices without an explicit PDF OCR stage.
-func pdfOCRToolsAndMaxPages(extractors []Extractor) (*OCRTools, int) {
+// unlimited page cap, no page cache, and no language detection so the
+// progress pipeline still runs for callers that construct extractor slices
+// without an explicit PDF OCR stage.
+func pdfOCRSettings(extractors []Extractor) (*OCRTools, int, string, bool) {
 	for _, ext := range extractors {
 		if e, ok := ext.(*PDFOCRExtractor); ok && e.Available() {
-			return e.tools(), e.MaxPages
+			return e.tools(), e.MaxPages, e.PageCacheDir, e.DetectLang
 		}
 	}
-	return DefaultOCRTools(), 0
+	return DefaultOCRTools(), 0, "", false
 }
 
 // ocrImageWithProgress runs tesseract directly on an image file.
 // tesseractPath is the absolute path to the tesseract binary, resolved
-// once at startup.
+// once at startup. With detectLang, the image's language is guessed first.
 func ocrImageWithProgress(
 	ctx context.Context,
 	tesseractPath string,
 	data []byte,
+	detectLang bool,
 	ch chan<- ExtractProgress,
 ) {
 	if len(data) == 0 {
@@ -131,7 +133,11 @@ func ocrImageWithProgress(
 		return
 	}
 
-	text, tsv, err := ocrImageFile(ctx, tesseractPath, imgPath)
+	var lang string
+	if detectLang {
+		lang = detectImageLang(ctx, tesseractPath, imgPath)
+	}
+	text, tsv, err := ocrImageFile(ctx, tesseractPath, lang, imgPath)
 	if err != nil {
 		ch <- ExtractProgress{Err: fmt.Errorf("tesseract: %w", err), Done: true}
 		return
@@ -148,12 +154,15 @@ func ocrImageWithProgress(
 
 // ocrPDFWithProgress runs the fused pdftocairo|tesseract pipeline with
 // per-page progress events. tools must have PDFInfo, PDFToCairo, and
-// Tesseract populated.
+// Tesseract populated. A non-empty cacheDir keeps rasterized pages there.
+// With detectLang, the language is guessed from the first page first.
 func ocrPDFWithProgress(
 	ctx context.Context,
 	tools *OCRTools,
 	data []byte,
 	maxPages int,
+	cacheDir string,
+	detectLang bool,
 	ch chan<- ExtractProgress,
 ) {
 	if len(data) == 0 {
@@ -210,14 +219,20 @@ func ocrPDFWithProgress(
 		return
 	}
 
+	var lang string
+	if detectLang {
+		lang = detectPDFLang(ctx, tools, pdfPath, tmpDir)
+	}
+
 	// Run fused pdftocairo|tesseract pipeline with per-stage progress.
 	total := pageCount
 	rasterDone := make(chan struct{}, total)
 	pageDone := make(chan struct{}, total)
 	var ocrResults []ocrPageResult
 	done := make(chan struct{})
+	cache := newPageCache(cacheDir, data)
 	go func() {
-		ocrResults = ocrPDFPages(ctx, tools, pdfPath, total, rasterDone, pageDone)
+		ocrResults = ocrPDFPages(ctx, tools, pdfPath, total, lang, cache, rasterDone, pageDone)
 		close(done)
 	}()
 
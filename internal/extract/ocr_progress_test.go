@@ -167,7 +167,7 @@ func TestExtractWithProgress_PDF_FallsBackToDefaultToolsWhenAbsent(t *testing.T)
 
 // TestExtractWithProgress_PDF_SkipsUnavailablePDFExtractor verifies that
 // when the first *PDFOCRExtractor in the slice is unavailable (Tools not
-// populated), pdfOCRToolsAndMaxPages walks past it and picks the next
+// populated), pdfOCRSettings walks past it and picks the next
 // available one. Regression guard for the Available()-check bug in the
 // PDF selector.
 func TestExtractWithProgress_PDF_SkipsUnavailablePDFExtractor(t *testing.T) {
@@ -0,0 +1,149 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package extract
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// osdMinScriptConf is the script confidence below which tesseract's
+// orientation and script detection is ignored as a guess.
+const osdMinScriptConf = 2.0
+
+// scriptLangs maps the scripts tesseract's OSD reports to the language
+// packs that read them, most common first. Latin is absent: tesseract's
+// default language already covers it.
+var scriptLangs = map[string][]string{
+	"Arabic":     {"ara", "fas"},
+	"Armenian":   {"hye"},
+	"Bengali":    {"ben"},
+	"Cyrillic":   {"rus", "ukr", "bul", "srp"},
+	"Devanagari": {"hin", "mar", "nep"},
+	"Georgian":   {"kat"},
+	"Greek":      {"ell"},
+	"Gujarati":   {"guj"},
+	"Han":        {"chi_sim", "chi_tra"},
+	"Hangul":     {"kor"},
+	"Hebrew":     {"heb"},
+	"Japanese":   {"jpn"},
+	"Kannada":    {"kan"},
+	"Katakana":   {"jpn"},
+	"Hiragana":   {"jpn"},
+	"Tamil":      {"tam"},
+	"Telugu":     {"tel"},
+	"Thai":       {"tha"},
+}
+
+// detectImageLang guesses the tesseract language for the image at imgPath
+// from its script. It returns "" -- tesseract's default language -- when
+// detection fails, the script is Latin, or no matching language pack is
+// installed.
+func detectImageLang(ctx context.Context, tesseractPath, imgPath string) string {
+	var stdout bytes.Buffer
+	cmd := exec.CommandContext( //nolint:gosec // tesseractPath is resolved at startup, imgPath is a temp file we created
+		ctx,
+		tesseractPath,
+		imgPath,
+		"stdout",
+		"--psm", "0",
+	)
+	cmd.Env = append(os.Environ(), "OMP_THREAD_LIMIT=1")
+	cmd.Stdout = &stdout
+	if err := cmd.Run(); err != nil {
+		return ""
+	}
+	script, ok := parseOSDScript(stdout.Bytes())
+	if !ok {
+		return ""
+	}
+	return langForScript(script, installedLangs(ctx, tesseractPath))
+}
+
+// detectPDFLang rasterizes the first page of the PDF at pdfPath into tmpDir
+// and guesses its language with detectImageLang.
+func detectPDFLang(ctx context.Context, tools *OCRTools, pdfPath, tmpDir string) string {
+	f, err := os.Create(filepath.Join(tmpDir, "osd.png")) //nolint:gosec // path is tmpDir + constant filename
+	if err != nil {
+		return ""
+	}
+	if err := rasterizePage(ctx, tools.PDFToCairo, pdfPath, 1, f); err != nil {
+		return ""
+	}
+	return detectImageLang(ctx, tools.Tesseract, f.Name())
+}
+
+// parseOSDScript returns the script from tesseract --psm 0 output, or
+// false when it's missing or detected with too little confidence.
+func parseOSDScript(out []byte) (string, bool) {
+	var script string
+	conf := -1.0
+	for line := range strings.SplitSeq(string(out), "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		switch strings.TrimSpace(key) {
+		case "Script":
+			script = value
+		case "Script confidence":
+			if f, err := strconv.ParseFloat(value, 64); err == nil {
+				conf = f
+			}
+		}
+	}
+	if script == "" || conf < osdMinScriptConf {
+		return "", false
+	}
+	return script, true
+}
+
+// langForScript picks the first installed language pack for script,
+// falling back to tesseract's script model, and adds English so mixed
+// documents keep their Latin text. Returns "" for Latin or when nothing
+// fits.
+func langForScript(script string, installed map[string]bool) string {
+	if script == "Latin" {
+		return ""
+	}
+	for _, lang := range slices.Concat(scriptLangs[script], []string{"script/" + script}) {
+		if !installed[lang] {
+			continue
+		}
+		if installed["eng"] {
+			return lang + "+eng"
+		}
+		return lang
+	}
+	return ""
+}
+
+// installedLangs returns the language packs tesseract --list-langs
+// reports, or nil when it can't be asked.
+func installedLangs(ctx context.Context, tesseractPath string) map[string]bool {
+	out, err := exec.CommandContext( //nolint:gosec // tesseractPath is resolved at startup
+		ctx,
+		tesseractPath,
+		"--list-langs",
+	).Output()
+	if err != nil {
+		return nil
+	}
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	if len(lines) < 2 {
+		return nil
+	}
+	langs := make(map[string]bool, len(lines)-1)
+	for _, l := range lines[1:] { // skip "List of available languages ..."
+		langs[strings.TrimSpace(l)] = true
+	}
+	return langs
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package extract
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOSDScript(t *testing.T) {
+	t.Parallel()
+	out := []byte("Page number: 0\nOrientation in degrees: 0\nRotate: 0\n" +
+		"Orientation confidence: 14.06\nScript: Cyrillic\nScript confidence: 4.55\n")
+	script, ok := parseOSDScript(out)
+	assert.True(t, ok)
+	assert.Equal(t, "Cyrillic", script)
+
+	_, ok = parseOSDScript([]byte("Script: Cyrillic\nScript confidence: 0.80\n"))
+	assert.False(t, ok, "low confidence is ignored")
+	_, ok = parseOSDScript([]byte("Too few characters. Skipping this page\n"))
+	assert.False(t, ok)
+}
+
+func TestLangForScript(t *testing.T) {
+	t.Parallel()
+	installed := map[string]bool{"eng": true, "osd": true, "ukr": true, "script/Greek": true}
+	assert.Equal(t, "ukr+eng", langForScript("Cyrillic", installed), "first installed pack wins")
+	assert.Equal(t, "script/Greek+eng", langForScript("Greek", installed), "script model as fallback")
+	assert.Empty(t, langForScript("Latin", installed))
+	assert.Empty(t, langForScript("Thai", installed), "nothing installed for it")
+	assert.Equal(t, "ukr", langForScript("Cyrillic", map[string]bool{"ukr": true}))
+}
+
+func TestTesseractArgs(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, []string{"stdin", "stdout", "tsv"}, tesseractArgs("stdin", ""))
+	assert.Equal(t,
+		[]string{"in.png", "stdout", "-l", "rus+eng", "tsv"},
+		tesseractArgs("in.png", "rus+eng"),
+	)
+}
+
+func TestDetectImageLangStubFallsBack(t *testing.T) {
+	t.Parallel()
+	assert.Empty(t, detectImageLang(t.Context(), stubBinPath(t, "tesseract"), "in.png"))
+}
+
+func TestWithLangDetection(t *testing.T) {
+	t.Parallel()
+	exts := WithLangDetection(DefaultExtractors(0, 0, true))
+	for _, ext := range exts {
+		switch e := ext.(type) {
+		case *PDFOCRExtractor:
+			assert.True(t, e.DetectLang)
+		case *ImageOCRExtractor:
+			assert.True(t, e.DetectLang)
+		}
+	}
+}
@@ -326,7 +326,7 @@ func TestOperationsSchema_QuotesCreateColumns(t *testing.T) {
 
 	expected := []string{
 		"project_id", "vendor_id", "vendor_name",
-		"total_cents", "labor_cents", "materials_cents", "notes",
+		"total_cents", "labor_cents", "materials_cents", "tax_cents", "notes",
 	}
 	assert.Len(t, dataProps, len(expected))
 	for _, col := range expected {
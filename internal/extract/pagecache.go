@@ -0,0 +1,128 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package extract
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// pageCache keeps rasterized PDF pages on disk, keyed by the document's
+// SHA-256 checksum and page number, so OCRing the same PDF again skips
+// pdftocairo. A changed document has a new checksum and never sees the old
+// pages, which age out of the cache directory with its TTL eviction.
+type pageCache struct {
+	dir string
+	sum string
+}
+
+// newPageCache returns a cache for the PDF in data, or nil when dir is
+// empty (caching disabled).
+func newPageCache(dir string, data []byte) *pageCache {
+	if dir == "" {
+		return nil
+	}
+	return &pageCache{dir: dir, sum: fmt.Sprintf("%x", sha256.Sum256(data))}
+}
+
+func (c *pageCache) path(page int) string {
+	return filepath.Join(c.dir, fmt.Sprintf("%s-p%04d.png", c.sum, page))
+}
+
+// lookup returns the cached raster for page, touching its ModTime so TTL
+// eviction treats it as recently used.
+func (c *pageCache) lookup(page int) (string, bool) {
+	path := c.path(page)
+	info, err := os.Stat(path)
+	if err != nil || info.Size() == 0 {
+		return "", false
+	}
+	now := time.Now()
+	// Best-effort: a failed touch only means earlier eviction.
+	_ = os.Chtimes(path, now, now)
+	return path, true
+}
+
+// ocrCachedPage OCRs a page from the page cache, rasterizing it into the
+// cache first on a miss. The raster goes through a file rather than the
+// fused pipe so it can be reused; pages still run in parallel across
+// workers. If the cache directory is unusable the page falls back to the
+// fused pipeline, so a bad cache never costs the OCR result.
+func ocrCachedPage(
+	ctx context.Context,
+	tools *OCRTools,
+	pdfPath string,
+	page int,
+	lang string,
+	cache *pageCache,
+	onRasterDone func(),
+) ocrPageResult {
+	path, ok := cache.lookup(page)
+	if !ok {
+		// 0o700: owner-only access, matching the document cache.
+		if err := os.MkdirAll(cache.dir, 0o700); err != nil {
+			return ocrPage(ctx, tools, pdfPath, page, lang, nil, onRasterDone)
+		}
+		tmp, err := os.CreateTemp(cache.dir, ".micasa-page-*")
+		if err != nil {
+			return ocrPage(ctx, tools, pdfPath, page, lang, nil, onRasterDone)
+		}
+		err = rasterizePage(ctx, tools.PDFToCairo, pdfPath, page, tmp)
+		if onRasterDone != nil {
+			onRasterDone()
+		}
+		if err == nil {
+			path = cache.path(page)
+			err = os.Rename(tmp.Name(), path)
+		}
+		if err != nil {
+			_ = os.Remove(tmp.Name())
+			return ocrPageResult{err: err}
+		}
+	} else if onRasterDone != nil {
+		onRasterDone()
+	}
+
+	text, tsv, err := ocrImageFile(ctx, tools.Tesseract, lang, path)
+	if err != nil {
+		return ocrPageResult{err: fmt.Errorf("page %d: %w", page, err)}
+	}
+	return ocrPageResult{text: text, tsv: tsv}
+}
+
+// rasterizePage writes page of the PDF as a PNG to out and closes it.
+func rasterizePage(
+	ctx context.Context,
+	pdfToCairo, pdfPath string,
+	page int,
+	out *os.File,
+) error {
+	cmd := exec.CommandContext( //nolint:gosec // pdfToCairo is resolved at startup, args constructed internally
+		ctx,
+		pdfToCairo,
+		rasterArgs(pdfPath, page)...,
+	)
+	var stderr bytes.Buffer
+	cmd.Stdout = out
+	cmd.Stderr = &stderr
+	runErr := cmd.Run()
+	closeErr := out.Close()
+	if runErr != nil {
+		return fmt.Errorf(
+			"pdftocairo page %d: %s: %w",
+			page, strings.TrimSpace(stderr.String()), runErr,
+		)
+	}
+	if closeErr != nil {
+		return fmt.Errorf("write page %d raster: %w", page, closeErr)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package extract
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPageCacheDisabled(t *testing.T) {
+	t.Parallel()
+	assert.Nil(t, newPageCache("", []byte("%PDF")))
+}
+
+func TestPageCacheKeyedByChecksum(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	a := newPageCache(dir, []byte("first"))
+	b := newPageCache(dir, []byte("second"))
+	assert.NotEqual(t, a.path(1), b.path(1), "changed content must miss")
+	assert.NotEqual(t, a.path(1), a.path(2))
+	assert.Equal(t, a.path(1), newPageCache(dir, []byte("first")).path(1))
+}
+
+func TestOcrPageCacheHitSkipsRasterization(t *testing.T) {
+	t.Parallel()
+	cache := newPageCache(t.TempDir(), []byte("%PDF-stub"))
+	path := cache.path(1)
+	require.NoError(t, os.WriteFile(path, []byte("png"), 0o600))
+	old := time.Now().Add(-48 * time.Hour)
+	require.NoError(t, os.Chtimes(path, old, old))
+
+	tools := &OCRTools{
+		PDFToCairo: stubBinPath(t, "pdftocairo"),
+		Tesseract:  stubBinPath(t, "tesseract"),
+	}
+	rastered := false
+	result := ocrPage(t.Context(), tools, writePDFFixture(t), 1, "", cache, func() { rastered = true })
+	require.Error(t, result.err)
+	assert.Contains(t, result.err.Error(), "tesseract", "the cached raster goes straight to tesseract")
+	assert.NotContains(t, result.err.Error(), "pdftocairo")
+	assert.True(t, rastered, "a cache hit still reports the raster stage done")
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.True(t, info.ModTime().After(old), "a hit refreshes the entry for TTL eviction")
+}
+
+func TestOcrPageCacheMissFailureLeavesNoEntry(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	cache := newPageCache(dir, []byte("%PDF-stub"))
+	tools := &OCRTools{
+		PDFToCairo: stubBinPath(t, "pdftocairo"),
+		Tesseract:  stubBinPath(t, "tesseract"),
+	}
+	result := ocrPage(t.Context(), tools, writePDFFixture(t), 1, "", cache, nil)
+	require.Error(t, result.err)
+	assert.Contains(t, result.err.Error(), "pdftocairo")
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "failed rasters must not be cached")
+}
+
+func TestOcrPageUnusableCacheFallsBack(t *testing.T) {
+	t.Parallel()
+	blocker := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(blocker, nil, 0o600))
+	cache := newPageCache(filepath.Join(blocker, "pages"), []byte("%PDF-stub"))
+	tools := &OCRTools{
+		PDFToCairo: stubBinPath(t, "pdftocairo"),
+		Tesseract:  stubBinPath(t, "tesseract"),
+	}
+	result := ocrPage(t.Context(), tools, writePDFFixture(t), 1, "", cache, nil)
+	require.Error(t, result.err)
+	assert.Contains(t, result.err.Error(), "pdftocairo", "falls back to the fused pipeline")
+}
+
+func TestOcrPDFReusesCachedPages(t *testing.T) {
+	if !OCRAvailable() {
+		t.Skip("pdftocairo, pdfinfo, or tesseract not available")
+	}
+	t.Parallel()
+	data, err := os.ReadFile(writeSamplePDFOrSkip(t))
+	require.NoError(t, err)
+	dir := t.TempDir()
+
+	uncached, _, err := ocrPDF(t.Context(), DefaultOCRTools(), data, 1, "", false)
+	require.NoError(t, err)
+	first, _, err := ocrPDF(t.Context(), DefaultOCRTools(), data, 1, dir, false)
+	require.NoError(t, err)
+	assert.Equal(t, uncached, first)
+	assert.FileExists(t, newPageCache(dir, data).path(1))
+
+	// A broken pdftocairo proves the second run never rasterizes.
+	tools := *DefaultOCRTools()
+	tools.PDFToCairo = stubBinPath(t, "pdftocairo")
+	second, _, err := ocrPDF(t.Context(), &tools, data, 1, dir, false)
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+}
+
+func TestWithPageCache(t *testing.T) {
+	t.Parallel()
+	ext := WithPageCache(DefaultExtractors(0, 0, true), "/tmp/pages")
+	found := false
+	for _, e := range ext {
+		if p, ok := e.(*PDFOCRExtractor); ok {
+			found = true
+			assert.Equal(t, "/tmp/pages", p.PageCacheDir)
+		}
+	}
+	assert.True(t, found)
+}
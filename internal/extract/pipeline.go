@@ -21,6 +21,7 @@ type Pipeline struct {
 	DocID         string                 // document ID for UPDATE operations
 	SendTSV       bool                   // send spatial layout annotations to LLM
 	ConfThreshold int                    // confidence threshold for spatial annotations
+	MaxInputChars int                    // source text budget for the prompt; 0 = no limit
 }
 
 // Result holds the output of a pipeline run.
@@ -131,6 +132,7 @@ func (p *Pipeline) extractWithLLM(
 		Sources:       sources,
 		SendTSV:       p.SendTSV,
 		ConfThreshold: p.ConfThreshold,
+		MaxChars:      p.MaxInputChars,
 	})
 
 	ch, err := p.LLMClient.ExtractStream(ctx, messages, OperationsSchema())
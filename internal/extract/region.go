@@ -0,0 +1,133 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package extract
+
+import (
+	"bytes"
+	"strings"
+)
+
+// Rect is a page region in fractions of the page width and height, with
+// the origin at the top left. Fractions let one region (say, the top right
+// quarter where invoices put their totals) apply to pages scanned at any
+// resolution.
+type Rect struct {
+	Left, Top, Right, Bottom float64
+}
+
+// Contains reports whether the point (x, y), in page fractions, lies
+// inside r. Points on the edge count as inside.
+func (r Rect) Contains(x, y float64) bool {
+	return x >= r.Left && x <= r.Right && y >= r.Top && y <= r.Bottom
+}
+
+// tsvWord is one recognized word from tesseract TSV output, with its
+// bounding box in pixels.
+type tsvWord struct {
+	text                     string
+	block, par, line         int
+	left, top, width, height int
+}
+
+// tsvPage holds the words of one OCR'd page and its size in pixels.
+type tsvPage struct {
+	width, height int
+	words         []tsvWord
+}
+
+// RegionText returns the words of tesseract TSV output whose centers fall
+// inside region on any page, joined like the plain OCR text: spaces within
+// a line, newlines between lines, and blank lines between blocks and pages.
+// Pages are sized from tesseract's page rows, or from the extent of their
+// words when those are missing.
+func RegionText(tsv []byte, region Rect) string {
+	var result strings.Builder
+	for _, page := range parseTSVPages(tsv) {
+		w, h := page.width, page.height
+		if w <= 0 || h <= 0 {
+			w, h = page.extent()
+		}
+		if w <= 0 || h <= 0 {
+			continue
+		}
+
+		var last *tsvWord
+		for i := range page.words {
+			word := &page.words[i]
+			cx := (float64(word.left) + float64(word.width)/2) / float64(w)
+			cy := (float64(word.top) + float64(word.height)/2) / float64(h)
+			if !region.Contains(cx, cy) {
+				continue
+			}
+			switch {
+			case result.Len() == 0:
+			case last == nil, word.block != last.block, word.par != last.par:
+				// First word on this page, or a new block or paragraph.
+				result.WriteString("\n\n")
+			case word.line != last.line:
+				result.WriteString("\n")
+			default:
+				result.WriteString(" ")
+			}
+			result.WriteString(word.text)
+			last = word
+		}
+	}
+	return result.String()
+}
+
+// parseTSVPages splits tesseract TSV output into pages. Per-page output
+// is concatenated with one header, so each level-1 row starts a new page.
+func parseTSVPages(tsv []byte) []tsvPage {
+	lines := bytes.Split(tsv, []byte("\n"))
+	if len(lines) < 2 {
+		return nil
+	}
+
+	var pages []tsvPage
+	for _, line := range lines[1:] { // skip header
+		fields := bytes.Split(line, []byte("\t"))
+		if len(fields) < 12 {
+			continue
+		}
+		if atoi(fields[0]) == 1 {
+			pages = append(pages, tsvPage{
+				width:  atoi(fields[8]),
+				height: atoi(fields[9]),
+			})
+			continue
+		}
+
+		text := strings.TrimSpace(string(fields[11]))
+		if text == "" {
+			continue
+		}
+		if len(pages) == 0 {
+			pages = append(pages, tsvPage{})
+		}
+		p := &pages[len(pages)-1]
+		p.words = append(p.words, tsvWord{
+			text:   text,
+			block:  atoi(fields[2]),
+			par:    atoi(fields[3]),
+			line:   atoi(fields[4]),
+			left:   atoi(fields[6]),
+			top:    atoi(fields[7]),
+			width:  atoi(fields[8]),
+			height: atoi(fields[9]),
+		})
+	}
+	return pages
+}
+
+// extent returns the right and bottom edges of the page's words, standing
+// in for the page size when the TSV has no page row.
+func (p tsvPage) extent() (int, int) {
+	var right, bottom int
+	for _, w := range p.words {
+		right = max(right, w.left+w.width)
+		bottom = max(bottom, w.top+w.height)
+	}
+	return right, bottom
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package extract
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const regionTSVHeader = "level\tpage_num\tblock_num\tpar_num\tline_num\tword_num\tleft\ttop\twidth\theight\tconf\ttext\n"
+
+// regionInvoiceTSV is a 1000x1000 invoice page: the vendor at the top
+// left, the invoice number and date at the top right, and the total at
+// the bottom right.
+const regionInvoiceTSV = regionTSVHeader +
+	"1\t1\t0\t0\t0\t0\t0\t0\t1000\t1000\t-1\t\n" +
+	"5\t1\t1\t1\t1\t1\t50\t40\t120\t30\t95\tACME\n" +
+	"5\t1\t1\t1\t1\t2\t180\t40\t140\t30\t94\tPlumbing\n" +
+	"5\t1\t2\t1\t1\t1\t650\t40\t120\t30\t93\tInvoice\n" +
+	"5\t1\t2\t1\t1\t2\t780\t40\t100\t30\t92\t#1234\n" +
+	"5\t1\t2\t1\t2\t1\t650\t80\t200\t30\t91\t2026-03-15\n" +
+	"5\t1\t3\t1\t1\t1\t50\t500\t300\t30\t90\tReplace\n" +
+	"5\t1\t3\t1\t1\t2\t360\t500\t200\t30\t90\tvalve\n" +
+	"5\t1\t4\t1\t1\t1\t650\t900\t100\t30\t96\tTotal\n" +
+	"5\t1\t4\t1\t1\t2\t760\t900\t120\t30\t96\t$450.00\n"
+
+func TestRegionText_TopRight(t *testing.T) {
+	t.Parallel()
+	got := RegionText([]byte(regionInvoiceTSV), Rect{Left: 0.5, Top: 0, Right: 1, Bottom: 0.25})
+	assert.Equal(t, "Invoice #1234\n2026-03-15", got)
+}
+
+func TestRegionText_BottomRight(t *testing.T) {
+	t.Parallel()
+	got := RegionText([]byte(regionInvoiceTSV), Rect{Left: 0.5, Top: 0.75, Right: 1, Bottom: 1})
+	assert.Equal(t, "Total $450.00", got)
+}
+
+func TestRegionText_WholePageMatchesPlainText(t *testing.T) {
+	t.Parallel()
+	tsv := []byte(regionInvoiceTSV)
+	got := RegionText(tsv, Rect{Left: 0, Top: 0, Right: 1, Bottom: 1})
+	assert.Equal(t, textFromTSV(tsv), got)
+}
+
+func TestRegionText_WordCenterDecides(t *testing.T) {
+	t.Parallel()
+	// "Plumbing" spans x=180..320; its center (250) is left of 0.3.
+	got := RegionText([]byte(regionInvoiceTSV), Rect{Left: 0, Top: 0, Right: 0.3, Bottom: 0.1})
+	assert.Equal(t, "ACME Plumbing", got)
+	got = RegionText([]byte(regionInvoiceTSV), Rect{Left: 0, Top: 0, Right: 0.2, Bottom: 0.1})
+	assert.Equal(t, "ACME", got)
+}
+
+func TestRegionText_NoPageRowUsesWordExtent(t *testing.T) {
+	t.Parallel()
+	tsv := []byte(regionTSVHeader +
+		"5\t1\t1\t1\t1\t1\t0\t0\t100\t20\t95\tleft\n" +
+		"5\t1\t1\t1\t1\t2\t300\t0\t100\t20\t95\tright\n")
+	got := RegionText(tsv, Rect{Left: 0.5, Top: 0, Right: 1, Bottom: 1})
+	assert.Equal(t, "right", got)
+}
+
+func TestRegionText_MultiPage(t *testing.T) {
+	t.Parallel()
+	tsv := []byte(regionTSVHeader +
+		"1\t1\t0\t0\t0\t0\t0\t0\t1000\t1000\t-1\t\n" +
+		"5\t1\t1\t1\t1\t1\t700\t50\t100\t30\t95\tPage1\n" +
+		"5\t1\t2\t1\t1\t1\t100\t900\t100\t30\t95\tfooter\n" +
+		"1\t1\t0\t0\t0\t0\t0\t0\t2000\t2000\t-1\t\n" +
+		"5\t1\t1\t1\t1\t1\t1400\t100\t200\t60\t95\tPage2\n")
+	got := RegionText(tsv, Rect{Left: 0.5, Top: 0, Right: 1, Bottom: 0.25})
+	assert.Equal(t, "Page1\n\nPage2", got, "the region is relative to each page's size")
+}
+
+func TestRegionText_Empty(t *testing.T) {
+	t.Parallel()
+	all := Rect{Left: 0, Top: 0, Right: 1, Bottom: 1}
+	assert.Empty(t, RegionText(nil, all))
+	assert.Empty(t, RegionText([]byte(regionTSVHeader), all))
+	assert.Empty(t, RegionText([]byte(regionInvoiceTSV), Rect{Left: 0.9, Top: 0.4, Right: 1, Bottom: 0.5}))
+}
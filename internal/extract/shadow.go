@@ -381,7 +381,7 @@ func commitAppliance(store *data.Store, row map[string]any) (string, error) {
 	stringField(row, data.ColBrand, &a.Brand)
 	stringField(row, data.ColModelNumber, &a.ModelNumber)
 	stringField(row, data.ColSerialNumber, &a.SerialNumber)
-	if v := toInt64Ptr(row[data.ColCostCents]); v != nil {
+	if v := centsPtr(store, row[data.ColCostCents]); v != nil {
 		a.CostCents = v
 	}
 	found, err := store.FindOrCreateAppliance(a)
@@ -397,7 +397,7 @@ func commitProject(store *data.Store, row map[string]any) (string, error) {
 	stringField(row, data.ColDescription, &p.Description)
 	stringField(row, data.ColStatus, &p.Status)
 	p.ProjectTypeID = ParseStringID(row[data.ColProjectTypeID])
-	if v := toInt64Ptr(row[data.ColBudgetCents]); v != nil {
+	if v := centsPtr(store, row[data.ColBudgetCents]); v != nil {
 		p.BudgetCents = v
 	}
 	if strings.TrimSpace(p.Title) == "" {
@@ -418,15 +418,18 @@ func commitQuote(store *data.Store, row map[string]any, opData map[string]any) (
 	if q.ProjectID == "" {
 		return "", errors.New("quote requires a project_id referencing an existing project")
 	}
-	q.TotalCents = ParseInt64(row[data.ColTotalCents])
+	q.TotalCents = parseCents(store, row[data.ColTotalCents])
 	stringField(row, data.ColNotes, &q.Notes)
 
-	if v := toInt64Ptr(row[data.ColLaborCents]); v != nil {
+	if v := centsPtr(store, row[data.ColLaborCents]); v != nil {
 		q.LaborCents = v
 	}
-	if v := toInt64Ptr(row[data.ColMaterialsCents]); v != nil {
+	if v := centsPtr(store, row[data.ColMaterialsCents]); v != nil {
 		q.MaterialsCents = v
 	}
+	if v := centsPtr(store, row[data.ColTaxCents]); v != nil {
+		q.TaxCents = v
+	}
 
 	// Resolve vendor. After remapping, vendor_id is either:
 	// - A real ID (batch-created vendor already committed, or existing vendor)
@@ -466,7 +469,7 @@ func commitMaintenance(store *data.Store, row map[string]any) (string, error) {
 		}
 		m.IntervalMonths = n
 	}
-	if v := toInt64Ptr(row[data.ColCostCents]); v != nil {
+	if v := centsPtr(store, row[data.ColCostCents]); v != nil {
 		m.CostCents = v
 	}
 	found, err := store.FindOrCreateMaintenance(m)
@@ -484,7 +487,7 @@ func commitIncident(store *data.Store, row map[string]any, opData map[string]any
 	stringField(row, data.ColSeverity, &inc.Severity)
 	stringField(row, data.ColLocation, &inc.Location)
 	stringField(row, data.ColNotes, &inc.Notes)
-	if v := toInt64Ptr(row[data.ColCostCents]); v != nil {
+	if v := centsPtr(store, row[data.ColCostCents]); v != nil {
 		inc.CostCents = v
 	}
 	if v := ParseStringID(row[data.ColApplianceID]); v != "" {
@@ -529,7 +532,7 @@ func commitServiceLog(
 	entry := data.ServiceLogEntry{}
 	entry.MaintenanceItemID = ParseStringID(row[data.ColMaintenanceItemID])
 	stringField(row, data.ColNotes, &entry.Notes)
-	if v := toInt64Ptr(row[data.ColCostCents]); v != nil {
+	if v := centsPtr(store, row[data.ColCostCents]); v != nil {
 		entry.CostCents = v
 	}
 	entry.ServicedAt = parseDateOrNow(opData, data.ColServicedAt)
@@ -634,7 +637,7 @@ func commitUpdateMaintenance(store *data.Store, op Operation) error {
 		}
 	}
 	if v, ok := op.Data[data.ColCostCents]; ok {
-		n := ParseInt64(v)
+		n := parseCents(store, v)
 		item.CostCents = &n
 	}
 	return store.UpdateMaintenance(item)
@@ -674,7 +677,7 @@ func commitUpdateAppliance(store *data.Store, op Operation) error {
 	stringField(op.Data, data.ColLocation, &a.Location)
 	stringField(op.Data, data.ColNotes, &a.Notes)
 	if v, ok := op.Data[data.ColCostCents]; ok {
-		n := ParseInt64(v)
+		n := parseCents(store, v)
 		a.CostCents = &n
 	}
 	return store.UpdateAppliance(a)
@@ -691,16 +694,20 @@ func commitUpdateQuote(store *data.Store, op Operation) error {
 	}
 	stringField(op.Data, data.ColNotes, &q.Notes)
 	if v, ok := op.Data[data.ColTotalCents]; ok {
-		q.TotalCents = ParseInt64(v)
+		q.TotalCents = parseCents(store, v)
 	}
 	if v, ok := op.Data[data.ColLaborCents]; ok {
-		n := ParseInt64(v)
+		n := parseCents(store, v)
 		q.LaborCents = &n
 	}
 	if v, ok := op.Data[data.ColMaterialsCents]; ok {
-		n := ParseInt64(v)
+		n := parseCents(store, v)
 		q.MaterialsCents = &n
 	}
+	if v, ok := op.Data[data.ColTaxCents]; ok {
+		n := parseCents(store, v)
+		q.TaxCents = &n
+	}
 	if v, ok := op.Data[data.ColProjectID]; ok {
 		if n := ParseStringID(v); n != "" {
 			q.ProjectID = n
@@ -882,13 +889,35 @@ func ParseInt64(v any) int64 {
 	return 0
 }
 
-// toInt64Ptr returns a pointer to the int64 value, or nil if the value is
-// nil or zero. Used for optional *int64 model fields.
-func toInt64Ptr(v any) *int64 {
+// parseCents extracts a whole-cents value from an arbitrary value. LLMs
+// occasionally emit fractional cents (1234.5); those are resolved with the
+// store currency's rounding mode rather than silently truncated.
+func parseCents(store *data.Store, v any) int64 {
+	rounding := store.Currency().Rounding()
+	switch val := v.(type) {
+	case float64:
+		return rounding.Round(val)
+	case string:
+		n, _ := rounding.RoundString(val)
+		return n
+	case []byte:
+		n, _ := rounding.RoundString(string(val))
+		return n
+	case interface{ String() string }:
+		n, _ := rounding.RoundString(val.String())
+		return n
+	default:
+		return ParseInt64(v)
+	}
+}
+
+// centsPtr returns a pointer to the rounded cents value, or nil if the
+// value is nil or zero. Used for optional *int64 money fields.
+func centsPtr(store *data.Store, v any) *int64 {
 	if v == nil {
 		return nil
 	}
-	n := ParseInt64(v)
+	n := parseCents(store, v)
 	if n == 0 {
 		return nil
 	}
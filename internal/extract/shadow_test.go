@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/micasa-dev/micasa/internal/locale"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -1473,3 +1474,96 @@ func TestShadowDB_CommitUpdateQuote(t *testing.T) {
 	assert.Equal(t, "Revised estimate after site visit", updated.Notes)
 	assert.Equal(t, projectID, updated.ProjectID)
 }
+
+func TestShadowDB_CommitRoundsFractionalCents(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		rounding locale.Rounding
+		want     int64
+	}{
+		{locale.RoundHalfUp, 12345},
+		{locale.RoundHalfEven, 12344},
+		{locale.RoundTruncate, 12344},
+	} {
+		t.Run(tc.rounding.String(), func(t *testing.T) {
+			t.Parallel()
+			store := newTestStore(t)
+			store.SetCurrency(locale.DefaultCurrency().WithRounding(tc.rounding))
+
+			sdb, err := NewShadowDB(store)
+			require.NoError(t, err)
+			ops := []Operation{
+				{Action: ActionCreate, Table: data.TableAppliances, Data: map[string]any{
+					"name":       "Dishwasher",
+					"cost_cents": jn("12344.5"),
+				}},
+			}
+			require.NoError(t, sdb.Stage(ops))
+			require.NoError(t, sdb.Commit(store, ops))
+
+			appliances, err := store.ListAppliances(false)
+			require.NoError(t, err)
+			require.Len(t, appliances, 1)
+			require.NotNil(t, appliances[0].CostCents)
+			assert.Equal(t, tc.want, *appliances[0].CostCents)
+		})
+	}
+}
+
+func TestShadowDB_CommitUpdateRoundsFractionalCents(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	store.SetCurrency(locale.DefaultCurrency().WithRounding(locale.RoundHalfEven))
+	a := data.Appliance{Name: "Furnace"}
+	require.NoError(t, store.CreateAppliance(&a))
+
+	sdb, err := NewShadowDB(store)
+	require.NoError(t, err)
+	ops := []Operation{
+		{Action: ActionUpdate, Table: data.TableAppliances, Data: map[string]any{
+			"id":         a.ID,
+			"cost_cents": jn("250.5"),
+		}},
+	}
+	require.NoError(t, sdb.Stage(ops))
+	require.NoError(t, sdb.Commit(store, ops))
+
+	got, err := store.GetAppliance(a.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got.CostCents)
+	assert.Equal(t, int64(250), *got.CostCents)
+}
+
+func TestShadowDB_CommitQuoteWithTax(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	types, err := store.ProjectTypes()
+	require.NoError(t, err)
+	project := data.Project{
+		Title:         "Roof",
+		ProjectTypeID: types[0].ID,
+		Status:        data.ProjectStatusQuoted,
+	}
+	require.NoError(t, store.CreateProject(&project))
+
+	sdb, err := NewShadowDB(store)
+	require.NoError(t, err)
+	ops := []Operation{
+		{Action: ActionCreate, Table: data.TableQuotes, Data: map[string]any{
+			"project_id":  project.ID,
+			"vendor_name": "Acme Roofing",
+			"total_cents": jn("108250"),
+			"labor_cents": jn("100000"),
+			"tax_cents":   jn("8250"),
+		}},
+	}
+	require.NoError(t, sdb.Stage(ops))
+	require.NoError(t, sdb.Commit(store, ops))
+
+	quotes, err := store.ListQuotes(false)
+	require.NoError(t, err)
+	require.Len(t, quotes, 1)
+	require.NotNil(t, quotes[0].TaxCents)
+	assert.Equal(t, int64(8250), *quotes[0].TaxCents)
+	assert.False(t, quotes[0].BreakdownMismatch())
+}
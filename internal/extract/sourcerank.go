@@ -0,0 +1,152 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package extract
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strconv"
+	"unicode"
+)
+
+// Thresholds for the source ranking heuristic.
+const (
+	// scannedRatio: digital text with less than this fraction of the OCR
+	// text's letters and digits is treated as covering only part of the
+	// document (scanned pages, image-only attachments).
+	scannedRatio = 0.5
+
+	// lowOCRConfidence: mean word confidence below this marks OCR output
+	// as noisy, so digital text wins whenever it is substantial.
+	lowOCRConfidence = 60
+)
+
+// sourceSignal summarizes how complete and reliable a text source looks.
+type sourceSignal struct {
+	chars int // letters and digits in the plain text
+	conf  int // mean OCR word confidence 0-100, or -1 when unknown
+}
+
+func signalFor(src TextSource) sourceSignal {
+	chars := 0
+	for _, r := range src.Text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			chars++
+		}
+	}
+	return sourceSignal{chars: chars, conf: meanOCRConfidence(src.Data)}
+}
+
+// isOCRSource reports whether a source came from OCR rather than from
+// text embedded in the document.
+func isOCRSource(src TextSource) bool {
+	return src.Tool == "tesseract"
+}
+
+// meanOCRConfidence returns the mean word confidence from tesseract TSV,
+// or -1 when the data has no scored words. Tesseract writes confidence as
+// an integer or a decimal depending on version; -1 marks non-word rows.
+func meanOCRConfidence(tsv []byte) int {
+	lines := bytes.Split(tsv, []byte("\n"))
+	if len(lines) < 2 {
+		return -1
+	}
+	var sum float64
+	var n int
+	for _, line := range lines[1:] { // skip header
+		fields := bytes.Split(line, []byte("\t"))
+		if len(fields) < 12 || len(bytes.TrimSpace(fields[11])) == 0 {
+			continue
+		}
+		conf, err := strconv.ParseFloat(string(fields[10]), 64)
+		if err != nil || conf < 0 {
+			continue
+		}
+		sum += conf
+		n++
+	}
+	if n == 0 {
+		return -1
+	}
+	return int(sum/float64(n) + 0.5)
+}
+
+// rankSources orders prompt sources by how far the model should trust
+// them and returns guidance naming the preferred source. Digital text is
+// preferred, as the base prompt says, unless it is much sparser than the
+// OCR text; OCR also loses when its confidence is low. With fewer than
+// two sources, or none of each kind, the order is unchanged and the
+// guidance is empty.
+func rankSources(contents []promptSourceContent) string {
+	if len(contents) < 2 {
+		return ""
+	}
+	var digital, ocr []int
+	for i, c := range contents {
+		if isOCRSource(c.src) {
+			ocr = append(ocr, i)
+		} else {
+			digital = append(digital, i)
+		}
+	}
+	if len(digital) == 0 || len(ocr) == 0 {
+		return ""
+	}
+
+	// Compare the strongest source of each kind.
+	best := func(idx []int) (int, sourceSignal) {
+		bi, bs := idx[0], signalFor(contents[idx[0]].src)
+		for _, i := range idx[1:] {
+			if s := signalFor(contents[i].src); s.chars > bs.chars {
+				bi, bs = i, s
+			}
+		}
+		return bi, bs
+	}
+	di, ds := best(digital)
+	oi, os := best(ocr)
+
+	preferOCR := float64(ds.chars) < scannedRatio*float64(os.chars)
+	if preferOCR && os.conf >= 0 && os.conf < lowOCRConfidence && ds.chars > 0 {
+		preferOCR = false
+	}
+
+	var reason string
+	if preferOCR {
+		reason = fmt.Sprintf(
+			"%s covers much more of the document (%d vs %d letters and digits), "+
+				"so the document is likely scanned",
+			contents[oi].src.Tool, os.chars, ds.chars,
+		)
+	} else {
+		reason = fmt.Sprintf(
+			"%s is text embedded in the document, which is exact where OCR may misread",
+			contents[di].src.Tool,
+		)
+		if os.conf >= 0 && os.conf < lowOCRConfidence {
+			reason = fmt.Sprintf("%s; OCR confidence is low (mean %d/100)", reason, os.conf)
+		}
+	}
+
+	preferred := contents[di].src.Tool
+	if preferOCR {
+		preferred = contents[oi].src.Tool
+	}
+	slices.SortStableFunc(contents, func(a, b promptSourceContent) int {
+		ra, rb := 1, 1
+		if isOCRSource(a.src) == preferOCR {
+			ra = 0
+		}
+		if isOCRSource(b.src) == preferOCR {
+			rb = 0
+		}
+		return ra - rb
+	})
+	return fmt.Sprintf(
+		"Source priority: trust %s where sources disagree -- %s. "+
+			"Use the other sources to fill in what it lacks.",
+		preferred, reason,
+	)
+}
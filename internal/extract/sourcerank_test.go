@@ -0,0 +1,98 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package extract
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tsvWithConf builds tesseract TSV with one word per confidence value.
+func tsvWithConf(confs ...string) []byte {
+	var b strings.Builder
+	b.WriteString("level\tpage_num\tblock_num\tpar_num\tline_num\tword_num\t" +
+		"left\ttop\twidth\theight\tconf\ttext\n")
+	b.WriteString("1\t1\t0\t0\t0\t0\t0\t0\t100\t100\t-1\t\n") // page row, no word
+	for i, c := range confs {
+		fmt.Fprintf(&b, "5\t1\t1\t1\t1\t%d\t%d\t0\t10\t10\t%s\tword\n", i+1, i*12, c)
+	}
+	return []byte(b.String())
+}
+
+func userMessage(t *testing.T, sources ...TextSource) string {
+	t.Helper()
+	msgs := BuildExtractionPrompt(ExtractionPromptInput{
+		Filename: "doc.pdf",
+		MIME:     "application/pdf",
+		Sources:  sources,
+	})
+	require.Len(t, msgs, 2)
+	return msgs[1].Content
+}
+
+func TestRankSources_ScannedPrefersOCR(t *testing.T) {
+	t.Parallel()
+	user := userMessage(t,
+		TextSource{Tool: "pdftotext", Text: "Page 1"},
+		TextSource{
+			Tool: "tesseract",
+			Text: strings.Repeat("Invoice total due 1500 ", 20),
+			Data: tsvWithConf("91", "88.5"),
+		},
+	)
+
+	assert.Contains(t, user, "trust tesseract where sources disagree")
+	assert.Contains(t, user, "likely scanned")
+	assert.Less(t, strings.Index(user, "Source: tesseract"), strings.Index(user, "Source: pdftotext"),
+		"preferred source should come first")
+}
+
+func TestRankSources_DigitalPreferredByDefault(t *testing.T) {
+	t.Parallel()
+	user := userMessage(t,
+		TextSource{Tool: "pdftotext", Text: "Invoice total due 1500 for gutter work"},
+		TextSource{Tool: "tesseract", Text: "Inv0ice tota1 due 15O0", Data: tsvWithConf("90")},
+	)
+
+	assert.Contains(t, user, "trust pdftotext where sources disagree")
+	assert.Less(t, strings.Index(user, "Source: pdftotext"), strings.Index(user, "Source: tesseract"))
+}
+
+func TestRankSources_LowConfidenceOCRLoses(t *testing.T) {
+	t.Parallel()
+	user := userMessage(t,
+		TextSource{Tool: "pdftotext", Text: "Header only"},
+		TextSource{
+			Tool: "tesseract",
+			Text: strings.Repeat("garbled scan text ", 20),
+			Data: tsvWithConf("31", "40", "22"),
+		},
+	)
+
+	assert.Contains(t, user, "trust pdftotext")
+	assert.Contains(t, user, "OCR confidence is low (mean 31/100)")
+}
+
+func TestRankSources_NoGuidanceForSingleKind(t *testing.T) {
+	t.Parallel()
+	assert.NotContains(t, userMessage(t,
+		TextSource{Tool: "tesseract", Text: "only OCR"},
+	), "Source priority")
+	assert.NotContains(t, userMessage(t,
+		TextSource{Tool: "pdftotext", Text: "digital"},
+		TextSource{Tool: "plaintext", Text: "also digital"},
+	), "Source priority")
+}
+
+func TestMeanOCRConfidence(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, 80, meanOCRConfidence(tsvWithConf("70", "90")))
+	assert.Equal(t, 86, meanOCRConfidence(tsvWithConf("85.6")))
+	assert.Equal(t, -1, meanOCRConfidence(nil))
+	assert.Equal(t, -1, meanOCRConfidence(tsvWithConf()))
+}
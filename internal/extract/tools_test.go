@@ -149,7 +149,7 @@ func TestOCRTools_StubPath_OcrPage_Cairo(t *testing.T) {
 		PDFToCairo: stubBinPath(t, "pdftocairo"),
 		Tesseract:  stubBinPath(t, "tesseract"),
 	}
-	result := ocrPage(t.Context(), tools, pdfPath, 1, nil)
+	result := ocrPage(t.Context(), tools, pdfPath, 1, "", nil, nil)
 	require.Error(t, result.err)
 	assert.Contains(t, result.err.Error(), "pdftocairo")
 }
@@ -168,7 +168,7 @@ func TestOCRTools_StubPath_OcrPage_TesseractOnly(t *testing.T) {
 		PDFToCairo: DefaultOCRTools().PDFToCairo,
 		Tesseract:  stubBinPath(t, "tesseract"),
 	}
-	result := ocrPage(t.Context(), tools, pdfPath, 1, nil)
+	result := ocrPage(t.Context(), tools, pdfPath, 1, "", nil, nil)
 	require.Error(t, result.err)
 	assert.Contains(t, result.err.Error(), "tesseract")
 }
@@ -207,7 +207,7 @@ func TestOCRTools_OcrPage_TesseractStartFailure_ReturnsPromptly(t *testing.T) {
 
 	done := make(chan ocrPageResult, 1)
 	go func() {
-		done <- ocrPage(t.Context(), tools, pdfPath, 1, nil)
+		done <- ocrPage(t.Context(), tools, pdfPath, 1, "", nil, nil)
 	}()
 
 	select {
@@ -253,7 +253,7 @@ func TestOCRTools_StubPath_OcrImageFile(t *testing.T) {
 	imgPath := filepath.Join(dir, "input.png")
 	require.NoError(t, os.WriteFile(imgPath, []byte("not a real png"), 0o600))
 
-	_, _, err := ocrImageFile(t.Context(), stubBinPath(t, "tesseract"), imgPath)
+	_, _, err := ocrImageFile(t.Context(), stubBinPath(t, "tesseract"), "", imgPath)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "tesseract")
 }
@@ -261,7 +261,7 @@ func TestOCRTools_StubPath_OcrImageFile(t *testing.T) {
 func TestOCRTools_StubPath_OcrImage(t *testing.T) {
 	t.Parallel()
 
-	_, _, err := ocrImage(t.Context(), stubBinPath(t, "tesseract"), []byte("not a real png"))
+	_, _, err := ocrImage(t.Context(), stubBinPath(t, "tesseract"), []byte("not a real png"), false)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "tesseract")
 }
@@ -274,7 +274,7 @@ func TestOCRTools_StubPath_OcrPDF(t *testing.T) {
 		PDFToCairo: stubBinPath(t, "pdftocairo"),
 		Tesseract:  stubBinPath(t, "tesseract"),
 	}
-	_, _, err := ocrPDF(t.Context(), tools, []byte("%PDF-stub"), 0)
+	_, _, err := ocrPDF(t.Context(), tools, []byte("%PDF-stub"), 0, "", false)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "pdfinfo")
 }
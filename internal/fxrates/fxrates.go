@@ -0,0 +1,167 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+// Package fxrates fetches daily foreign exchange rates and caches them on
+// disk, so approximate cross-currency totals stay current without a
+// request on every start and keep working offline.
+package fxrates
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/micasa-dev/micasa/internal/locale"
+)
+
+// maxResponseBytes caps the response body. A full rate table is ~1 KiB.
+const maxResponseBytes = 64 * 1024
+
+// Snapshot is one fetch of the rate table: how many units of each currency
+// one unit of Base buys, as the endpoint reports them.
+type Snapshot struct {
+	Base  string             `json:"base"`
+	Date  string             `json:"date"` // the rates' date, per the endpoint
+	Rates map[string]float64 `json:"rates"`
+	// Fetched is when the snapshot was downloaded; a snapshot is refetched
+	// once a day.
+	Fetched time.Time `json:"fetched"`
+}
+
+// Conversion returns the snapshot as conversion rates quoted in Base.
+// Endpoints quote units per Base; conversion rates are the value of one
+// unit in Base, so each rate is inverted.
+func (s Snapshot) Conversion() locale.Rates {
+	rates := make(map[string]float64, len(s.Rates))
+	for code, perBase := range s.Rates {
+		if perBase > 0 {
+			rates[code] = 1 / perBase
+		}
+	}
+	return locale.NewRates(s.Base, rates)
+}
+
+// fresh reports whether s holds base's rates and was fetched on now's
+// calendar day.
+func (s Snapshot) fresh(base string, now time.Time) bool {
+	if !strings.EqualFold(s.Base, base) || len(s.Rates) == 0 {
+		return false
+	}
+	y1, m1, d1 := s.Fetched.In(now.Location()).Date()
+	y2, m2, d2 := now.Date()
+	return y1 == y2 && m1 == m2 && d1 == d2
+}
+
+// Fetch downloads the latest rates for base from endpoint, which must
+// answer GET <endpoint>?base=<code> with {"base", "date", "rates"} JSON.
+func Fetch(ctx context.Context, client *http.Client, endpoint, base string) (Snapshot, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("parse rates URL: %w", err)
+	}
+	q := u.Query()
+	q.Set("base", strings.ToUpper(base))
+	u.RawQuery = q.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("User-Agent", "micasa")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("fetch rates: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return Snapshot{}, fmt.Errorf("fetch rates: unexpected status %d", resp.StatusCode)
+	}
+
+	var s Snapshot
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseBytes)).Decode(&s); err != nil {
+		return Snapshot{}, fmt.Errorf("decode rates response: %w", err)
+	}
+	if len(s.Rates) == 0 {
+		return Snapshot{}, errors.New("fetch rates: response has no rates")
+	}
+	if s.Base == "" {
+		s.Base = strings.ToUpper(base)
+	}
+	if !strings.EqualFold(s.Base, base) {
+		return Snapshot{}, fmt.Errorf("fetch rates: asked for %s, got %s", base, s.Base)
+	}
+	return s, nil
+}
+
+// LoadCache reads a snapshot saved by SaveCache.
+func LoadCache(path string) (Snapshot, error) {
+	raw, err := os.ReadFile(path) //nolint:gosec // path is the app's own cache file
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("read rates cache: %w", err)
+	}
+	var s Snapshot
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return Snapshot{}, fmt.Errorf("parse rates cache: %w", err)
+	}
+	return s, nil
+}
+
+// SaveCache writes s to path, replacing any earlier snapshot.
+func SaveCache(path string, s Snapshot) error {
+	raw, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("encode rates cache: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".fxrates-*")
+	if err != nil {
+		return fmt.Errorf("write rates cache: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err := tmp.Write(raw); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("write rates cache: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("write rates cache: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("write rates cache: %w", err)
+	}
+	return nil
+}
+
+// Current returns today's rates for base: the cached snapshot when it was
+// fetched today, otherwise a new fetch, which is cached. When the fetch
+// fails, the cached snapshot for base is returned however old, along with
+// the error; the snapshot is empty when there is none.
+func Current(
+	ctx context.Context,
+	client *http.Client,
+	endpoint, base, cachePath string,
+	now time.Time,
+) (Snapshot, error) {
+	cached, cacheErr := LoadCache(cachePath)
+	if cacheErr == nil && cached.fresh(base, now) {
+		return cached, nil
+	}
+	s, err := Fetch(ctx, client, endpoint, base)
+	if err != nil {
+		if cacheErr != nil || !strings.EqualFold(cached.Base, base) {
+			cached = Snapshot{}
+		}
+		return cached, err
+	}
+	s.Fetched = now
+	if err := SaveCache(cachePath, s); err != nil {
+		return s, err
+	}
+	return s, nil
+}
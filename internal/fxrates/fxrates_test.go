@@ -0,0 +1,124 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package fxrates
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rateServer answers like the default endpoint, counting requests. With
+// fail set it answers 503.
+func rateServer(t *testing.T, fail *atomic.Bool) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		if fail != nil && fail.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		base := r.URL.Query().Get("base")
+		_, _ = w.Write([]byte(`{"amount":1.0,"base":"` + base +
+			`","date":"2026-10-14","rates":{"EUR":0.8,"GBP":0.5}}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &hits
+}
+
+func TestFetch(t *testing.T) {
+	t.Parallel()
+	srv, _ := rateServer(t, nil)
+	s, err := Fetch(t.Context(), srv.Client(), srv.URL+"/latest", "usd")
+	require.NoError(t, err)
+	assert.Equal(t, "USD", s.Base)
+	assert.Equal(t, "2026-10-14", s.Date)
+
+	got, ok := s.Conversion().Convert(10000, "EUR", "USD")
+	require.True(t, ok)
+	assert.Equal(t, int64(12500), got, "0.8 EUR per USD makes 1 EUR worth 1.25 USD")
+}
+
+func TestFetchErrors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		body   string
+		status int
+		errMsg string
+	}{
+		{"status", "", http.StatusInternalServerError, "unexpected status 500"},
+		{"not json", "<html>", http.StatusOK, "decode rates response"},
+		{"no rates", `{"base":"USD","rates":{}}`, http.StatusOK, "no rates"},
+		{"wrong base", `{"base":"EUR","rates":{"USD":1.1}}`, http.StatusOK, "asked for USD, got EUR"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			t.Cleanup(srv.Close)
+			_, err := Fetch(t.Context(), srv.Client(), srv.URL, "USD")
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestCurrentCachesForTheDay(t *testing.T) {
+	t.Parallel()
+	var fail atomic.Bool
+	srv, hits := rateServer(t, &fail)
+	path := filepath.Join(t.TempDir(), "fx-rates.json")
+	morning := time.Date(2026, 10, 15, 8, 0, 0, 0, time.Local)
+
+	s, err := Current(t.Context(), srv.Client(), srv.URL, "USD", path, morning)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), hits.Load())
+	assert.Equal(t, morning, s.Fetched)
+
+	_, err = Current(t.Context(), srv.Client(), srv.URL, "USD", path, morning.Add(10*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), hits.Load(), "the same day reads the cache")
+
+	_, err = Current(t.Context(), srv.Client(), srv.URL, "EUR", path, morning.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), hits.Load(), "another base refetches")
+
+	_, err = Current(t.Context(), srv.Client(), srv.URL, "EUR", path, morning.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), hits.Load(), "a new day refetches")
+
+	fail.Store(true)
+	s, err = Current(t.Context(), srv.Client(), srv.URL, "EUR", path, morning.AddDate(0, 0, 2))
+	require.Error(t, err)
+	assert.Equal(t, "EUR", s.Base, "a failed fetch falls back to the stale cache")
+	assert.NotEmpty(t, s.Rates)
+
+	s, err = Current(t.Context(), srv.Client(), srv.URL, "USD", path, morning.AddDate(0, 0, 2))
+	require.Error(t, err)
+	assert.Empty(t, s.Rates, "a cache for another base is no fallback")
+}
+
+func TestCurrentWithoutCache(t *testing.T) {
+	t.Parallel()
+	var fail atomic.Bool
+	fail.Store(true)
+	srv, _ := rateServer(t, &fail)
+	path := filepath.Join(t.TempDir(), "fx-rates.json")
+
+	s, err := Current(t.Context(), srv.Client(), srv.URL, "USD", path, time.Now())
+	require.Error(t, err)
+	assert.Empty(t, s.Rates)
+	assert.True(t, s.Conversion().IsZero())
+}
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	anyllm "github.com/mozilla-ai/any-llm-go"
@@ -37,6 +38,13 @@ type Client struct {
 	baseURL      string
 	model        string
 	effort       string // reasoning effort: none|low|medium|high|auto
+	seed         *int   // sampling seed; nil = server default
+
+	// mu guards model against ContextTokens, which runs off the UI
+	// goroutine, and the context window state below.
+	mu            sync.Mutex
+	contextTokens int            // configured context window; 0 = probe
+	probed        map[string]int // context window per model, as probed
 }
 
 // Message represents a single turn in the conversation.
@@ -151,11 +159,15 @@ func (c *Client) IsLocalServer() bool {
 
 // Model returns the configured model name.
 func (c *Client) Model() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	return c.model
 }
 
 // SetModel switches the active model.
 func (c *Client) SetModel(model string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.model = model
 }
 
@@ -164,6 +176,12 @@ func (c *Client) SetEffort(level string) {
 	c.effort = level
 }
 
+// SetSeed fixes the sampling seed, so identical requests get identical
+// answers from servers that honor it.
+func (c *Client) SetSeed(seed int) {
+	c.seed = &seed
+}
+
 // BaseURL returns the configured base URL.
 func (c *Client) BaseURL() string {
 	return c.baseURL
@@ -201,6 +219,7 @@ func (c *Client) completionParams(messages []Message) anyllm.CompletionParams {
 	if c.effort != "" {
 		params.ReasoningEffort = anyllm.ReasoningEffort(c.effort)
 	}
+	params.Seed = c.seed
 	return params
 }
 
@@ -105,6 +105,7 @@ func TestExtractStreamPassesSchema(t *testing.T) {
 			return
 		}
 		assert.Equal(t, "json_schema", rf["type"])
+		assert.InDelta(t, 42, body["seed"], 0, "SetSeed should send the seed")
 		w.Header().Set("Content-Type", "text/event-stream")
 		flusher, _ := w.(http.Flusher)
 		for _, line := range []string{
@@ -126,6 +127,7 @@ func TestExtractStreamPassesSchema(t *testing.T) {
 		"properties": map[string]any{"ok": map[string]any{"type": "boolean"}},
 	}
 	client := newTestClient(t, srv.URL+"/v1", "test-model")
+	client.SetSeed(42)
 	ch, err := client.ExtractStream(t.Context(), []Message{
 		{Role: "user", Content: "extract"},
 	}, schema)
@@ -0,0 +1,167 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultContextTokens is the context window assumed for a local server
+	// that can't report one. Small on purpose: overflowing a local model's
+	// window silently drops the start of the prompt.
+	DefaultContextTokens = 8192
+
+	// DefaultHostedContextTokens is the context window assumed for hosted
+	// providers, which don't report one. Every supported provider's chat
+	// models take at least this much.
+	DefaultHostedContextTokens = 32768
+)
+
+// contextProbeTimeout bounds the request asking a local server for the
+// model's context window.
+const contextProbeTimeout = 5 * time.Second
+
+// SetContextTokens sets the model's context window in tokens, overriding
+// what the server reports. 0 restores probing.
+func (c *Client) SetContextTokens(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.contextTokens = n
+}
+
+// ContextTokens returns the model's context window in tokens: the value set
+// with SetContextTokens, else what the server reports, else a conservative
+// default. The server is asked once per model, so the first call may make a
+// network request; don't call it on the UI goroutine.
+func (c *Client) ContextTokens(ctx context.Context) int {
+	c.mu.Lock()
+	if c.contextTokens > 0 {
+		defer c.mu.Unlock()
+		return c.contextTokens
+	}
+	model, baseURL, provider := c.model, c.baseURL, c.providerName
+	if n, ok := c.probed[model]; ok {
+		c.mu.Unlock()
+		return n
+	}
+	c.mu.Unlock()
+
+	n := probeContextTokens(ctx, provider, baseURL, model)
+	if n <= 0 {
+		n = DefaultHostedContextTokens
+		if localProviders[provider] {
+			n = DefaultContextTokens
+		}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.probed == nil {
+		c.probed = make(map[string]int)
+	}
+	c.probed[model] = n
+	return n
+}
+
+// probeContextTokens asks a local server for the model's context window,
+// returning 0 when the provider can't say or the request fails. It takes
+// the client's settings as arguments so the request runs without the lock.
+func probeContextTokens(ctx context.Context, provider, baseURL, model string) int {
+	ctx, cancel := context.WithTimeout(ctx, contextProbeTimeout)
+	defer cancel()
+	base := strings.TrimSuffix(strings.TrimRight(baseURL, "/"), "/v1")
+	switch provider {
+	case providerOllama:
+		return probeOllamaContext(ctx, base, model)
+	case "llamacpp", "llamafile":
+		return probeLlamaCppContext(ctx, base)
+	}
+	return 0
+}
+
+// probeOllamaContext reads the context window from Ollama's /api/show. An
+// explicit num_ctx parameter wins over the model's trained context length,
+// since that's what the server actually runs with.
+func probeOllamaContext(ctx context.Context, baseURL, model string) int {
+	body, err := json.Marshal(map[string]string{"model": model})
+	if err != nil {
+		return 0
+	}
+	var resp struct {
+		Parameters string         `json:"parameters"`
+		ModelInfo  map[string]any `json:"model_info"`
+	}
+	if err := fetchJSON(ctx, http.MethodPost, baseURL+"/api/show", body, &resp); err != nil {
+		return 0
+	}
+	for line := range strings.SplitSeq(resp.Parameters, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[0] == "num_ctx" {
+			if n, err := strconv.Atoi(fields[1]); err == nil {
+				return n
+			}
+		}
+	}
+	for k, v := range resp.ModelInfo {
+		if n, ok := v.(float64); ok && strings.HasSuffix(k, ".context_length") {
+			return int(n)
+		}
+	}
+	return 0
+}
+
+// probeLlamaCppContext reads the server's n_ctx from llama.cpp's /props,
+// which llamafile serves too.
+func probeLlamaCppContext(ctx context.Context, baseURL string) int {
+	var resp struct {
+		Settings struct {
+			NCtx int `json:"n_ctx"`
+		} `json:"default_generation_settings"`
+	}
+	if err := fetchJSON(ctx, http.MethodGet, baseURL+"/props", nil, &resp); err != nil {
+		return 0
+	}
+	return resp.Settings.NCtx
+}
+
+func fetchJSON(ctx context.Context, method, url string, body []byte, out any) error {
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request %s: %w", url, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("request %s: status %d", url, resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode %s: %w", url, err)
+	}
+	return nil
+}
+
+// PromptBudget is the share of a context window a system prompt may fill:
+// half, leaving the rest for the conversation and the reply.
+func PromptBudget(contextTokens int) int {
+	return contextTokens / 2
+}
+
+// CharsForTokens converts a token budget to characters at the rate
+// EstimateTokens assumes.
+func CharsForTokens(tokens int) int {
+	return tokens * 4
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package llm
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContextTokensOllamaNumCtx(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&body)) {
+			return
+		}
+		assert.Equal(t, "/api/show", r.URL.Path)
+		assert.Equal(t, "qwen3", body["model"])
+		jsonResponse(w, `{"parameters":"stop \"<|im_end|>\"\nnum_ctx 16384",`+
+			`"model_info":{"qwen3.context_length":40960}}`)
+	}))
+	defer srv.Close()
+
+	client, err := NewClient("ollama", srv.URL, "qwen3", "", testTimeout)
+	require.NoError(t, err)
+	assert.Equal(t, 16384, client.ContextTokens(t.Context()), "num_ctx wins")
+}
+
+func TestContextTokensOllamaModelInfo(t *testing.T) {
+	t.Parallel()
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		jsonResponse(w, `{"model_info":{"general.architecture":"qwen3","qwen3.context_length":40960}}`)
+	}))
+	defer srv.Close()
+
+	client, err := NewClient("ollama", srv.URL, "qwen3", "", testTimeout)
+	require.NoError(t, err)
+	assert.Equal(t, 40960, client.ContextTokens(t.Context()))
+	assert.Equal(t, 40960, client.ContextTokens(t.Context()))
+	assert.Equal(t, int32(1), calls.Load(), "probed once per model")
+
+	client.SetModel("llama3")
+	client.ContextTokens(t.Context())
+	assert.Equal(t, int32(2), calls.Load(), "a new model is probed again")
+}
+
+func TestContextTokensLlamaCpp(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/props", r.URL.Path)
+		jsonResponse(w, `{"default_generation_settings":{"n_ctx":4096}}`)
+	}))
+	defer srv.Close()
+
+	client := newTestClient(t, srv.URL+"/v1", "qwen3")
+	assert.Equal(t, 4096, client.ContextTokens(t.Context()))
+}
+
+func TestContextTokensDefaults(t *testing.T) {
+	t.Parallel()
+	local := newTestClient(t, "http://127.0.0.1:1/v1", "qwen3")
+	assert.Equal(t, DefaultContextTokens, local.ContextTokens(t.Context()),
+		"unreachable local server")
+
+	hosted, err := NewClient("anthropic", "", "claude-sonnet-4-5", "test-key", testTimeout)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultHostedContextTokens, hosted.ContextTokens(t.Context()))
+}
+
+func TestSetContextTokensOverridesProbe(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("configured context window should not probe")
+	}))
+	defer srv.Close()
+
+	client := newTestClient(t, srv.URL+"/v1", "qwen3")
+	client.SetContextTokens(2048)
+	assert.Equal(t, 2048, client.ContextTokens(t.Context()))
+}
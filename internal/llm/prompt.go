@@ -5,6 +5,8 @@ package llm
 
 import (
 	"fmt"
+	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -25,18 +27,39 @@ type ColumnInfo struct {
 	PK      bool
 }
 
+// deletedQuestion matches questions about soft-deleted rows: deleted or
+// trashed items, and resolved incidents, which are soft-deleted too.
+var deletedQuestion = regexp.MustCompile(
+	`(?i)\b(delet(e|ed|es|ing)|trash(ed)?|removed|resolved)\b`,
+)
+
+// AsksAboutDeleted reports whether question is about deleted items, so the
+// prompts should include soft-deleted rows instead of filtering them out.
+func AsksAboutDeleted(question string) bool {
+	return deletedQuestion.MatchString(question)
+}
+
 // BuildSQLPrompt creates a system prompt that instructs the LLM to translate
 // a natural-language question into a single SELECT statement. The prompt
 // includes the current date, the full schema as DDL, and few-shot examples.
-// If extraContext is non-empty, it's appended at the end.
+// If extraContext is non-empty, it's appended at the end. includeDeleted
+// swaps the rule that excludes soft-deleted rows for one that includes them
+// (see AsksAboutDeleted). When maxTokens is positive, few-shot examples are
+// dropped, general ones from the last, until the prompt fits.
 func BuildSQLPrompt(
 	tables []TableInfo,
 	now time.Time,
 	columnHints string,
 	extraContext string,
+	includeDeleted bool,
+	maxTokens int,
 ) string {
 	var b strings.Builder
-	b.WriteString(sqlSystemPreamble)
+	if includeDeleted {
+		b.WriteString(strings.Replace(sqlSystemPreamble, sqlExcludeDeletedRule, sqlIncludeDeletedRule, 1))
+	} else {
+		b.WriteString(sqlSystemPreamble)
+	}
 	b.WriteString(dateContext(now))
 	b.WriteString("\n\n## Schema\n\n```sql\n")
 	for _, t := range tables {
@@ -50,22 +73,78 @@ func BuildSQLPrompt(
 		b.WriteString("\n\n## Known values in the database\n\n")
 		b.WriteString(columnHints)
 	}
-	b.WriteString("\n\n")
-	b.WriteString(sqlFewShot)
+	var tail string
 	if extraContext != "" {
-		b.WriteString("\n\n## Additional context\n\n")
-		b.WriteString(extraContext)
+		tail = "\n\n## Additional context\n\n" + extraContext
+	}
+	sections := []string{sqlFewShot}
+	if includeDeleted {
+		sections = append(sections, sqlDeletedFewShot)
 	}
+	shotBudget := 0
+	if maxTokens > 0 {
+		shotBudget = max(maxTokens-EstimateTokens(b.String()+tail), 1)
+	}
+	b.WriteString("\n\n")
+	b.WriteString(fitFewShot(sections, shotBudget))
+	b.WriteString(tail)
 	return b.String()
 }
 
+// fitFewShot joins few-shot sections, dropping "User:" examples until the
+// result fits in maxTokens: the first section's from its last example,
+// then the next section's. A section left without examples loses its
+// heading; its instructions stay. maxTokens of 0 keeps everything.
+func fitFewShot(sections []string, maxTokens int) string {
+	paras := make([][]string, len(sections))
+	for i, s := range sections {
+		paras[i] = strings.Split(s, "\n\n")
+	}
+	join := func() string {
+		var out []string
+		for _, ps := range paras {
+			if slices.ContainsFunc(ps, isExample) {
+				out = append(out, ps...)
+				continue
+			}
+			for _, p := range ps {
+				if !strings.HasPrefix(p, "## ") {
+					out = append(out, p)
+				}
+			}
+		}
+		return strings.Join(out, "\n\n")
+	}
+	for i := range paras {
+		for maxTokens > 0 && EstimateTokens(join()) > maxTokens {
+			last := -1
+			for j, p := range paras[i] {
+				if isExample(p) {
+					last = j
+				}
+			}
+			if last < 0 {
+				break
+			}
+			paras[i] = slices.Delete(paras[i], last, last+1)
+		}
+	}
+	return join()
+}
+
+func isExample(para string) bool {
+	return strings.HasPrefix(para, "User:")
+}
+
 // BuildSummaryPrompt creates a system prompt for the second stage: turning
 // SQL results into a concise natural-language answer.
-// If extraContext is non-empty, it's appended at the end.
+// If extraContext is non-empty, it's appended at the end. answerLanguage
+// asks for the answer in that language; empty means English.
 func BuildSummaryPrompt(
 	question, sql, resultsTable string,
 	now time.Time,
 	extraContext string,
+	answerLanguage string,
 ) string {
 	var b strings.Builder
 	b.WriteString(summarySystemPreamble)
@@ -78,6 +157,7 @@ func BuildSummaryPrompt(
 	b.WriteString(resultsTable)
 	b.WriteString("\n```\n\n")
 	b.WriteString(summaryGuidelines)
+	b.WriteString(answerLanguageRule(answerLanguage))
 	if extraContext != "" {
 		b.WriteString("\n\n## Additional context\n\n")
 		b.WriteString(extraContext)
@@ -87,12 +167,17 @@ func BuildSummaryPrompt(
 
 // BuildSystemPrompt assembles the old single-stage system prompt, used as
 // a fallback when the two-stage pipeline fails.
-// If extraContext is non-empty, it's appended at the end.
+// If extraContext is non-empty, it's appended at the end. includeDeleted
+// tells the model that dataSummary includes soft-deleted rows and that the
+// user is asking about them. answerLanguage asks for the answer in that
+// language; empty means English.
 func BuildSystemPrompt(
 	tables []TableInfo,
 	dataSummary string,
 	now time.Time,
 	extraContext string,
+	includeDeleted bool,
+	answerLanguage string,
 ) string {
 	var b strings.Builder
 	b.WriteString(fallbackPreamble)
@@ -103,13 +188,20 @@ func BuildSystemPrompt(
 		b.WriteString("\n")
 	}
 	b.WriteString(entityRelationships())
-	b.WriteString(fallbackSchemaNotes)
+	if includeDeleted {
+		b.WriteString(strings.Replace(
+			fallbackSchemaNotes, fallbackExcludeDeletedNote, fallbackIncludeDeletedNote, 1,
+		))
+	} else {
+		b.WriteString(fallbackSchemaNotes)
+	}
 	if dataSummary != "" {
 		b.WriteString("\n\n## Current Data\n\n")
 		b.WriteString(dataSummary)
 	}
 	b.WriteString("\n\n")
 	b.WriteString(fallbackGuidelines)
+	b.WriteString(answerLanguageRule(answerLanguage))
 	if extraContext != "" {
 		b.WriteString("\n\n## Additional context\n\n")
 		b.WriteString(extraContext)
@@ -160,6 +252,22 @@ func ExtractSQL(raw string) string {
 	return strings.TrimSpace(s)
 }
 
+// answerLanguageRule tells the model to answer in language, including the
+// canned replies the guidelines spell out in English. Returns "" for an
+// empty language or English, which the prompts are already written in.
+func answerLanguageRule(language string) string {
+	language = strings.TrimSpace(language)
+	if language == "" || strings.EqualFold(language, "english") {
+		return ""
+	}
+	return fmt.Sprintf(
+		"\n\n## Answer language\n\nWrite your answer in %[1]s, even though these instructions are in English. "+
+			"This includes the fixed replies above, such as saying you didn't find any matching data: "+
+			"give them in %[1]s too. Keep names, numbers, and dates from the data as they are.",
+		language,
+	)
+}
+
 // dateContext returns a short section telling the LLM what the current date
 // is so it can reason about relative time ("last month", "overdue", etc.).
 func dateContext(now time.Time) string {
@@ -262,7 +370,7 @@ RULES:
 2. Use only tables and columns from the schema below.
 3. Only SELECT -- never INSERT, UPDATE, DELETE, DROP, ALTER, or CREATE.
 4. Money columns end in "_ct" and store values in cents. Divide by 100.0 for display.
-5. Soft-deleted rows have deleted_at IS NOT NULL. Exclude them unless asked about deleted items.
+` + sqlExcludeDeletedRule + `
 6. For date math, use SQLite date functions (date, julianday, etc.). Use the current date from the "Current date" section -- do NOT hardcode dates.
 7. When comparing dates (past vs future, oldest, newest, overdue), always compare against the current date provided above.
 8. If the question cannot be answered from the schema, output: SELECT 'I cannot answer that from the available data' AS answer
@@ -278,7 +386,7 @@ COST SEMANTICS -- these distinctions matter:
 
 const sqlSchemaNotes = `
 Notes:
-- Maintenance scheduling: next_due = date(` + data.ColLastServicedAt + `, '+' || ` + data.ColIntervalMonths + ` || ' months')
+- Maintenance scheduling: next_due = date(` + data.ColLastServicedAt + `, '+' || ` + data.ColIntervalMonths + ` || ' months', '+' || ` + data.ColIntervalDays + ` || ' days'). Intervals in months or years are stored in ` + data.ColIntervalMonths + ` (1 year = 12); intervals in days or weeks are stored in ` + data.ColIntervalDays + ` (1 week = 7). An item recurs when either is positive.
 - The UI shows abbreviated status labels: idea=ideating, plan=planned, bid=quoted, wip=underway, hold=delayed, done=completed, drop=abandoned. Map user terms to the stored value.
 - Warranty expiry is in the ` + data.ColWarrantyExpiry + ` column (date string)
 - Incident statuses: open, in_progress. Resolved incidents are soft-deleted (deleted_at IS NOT NULL).
@@ -286,6 +394,21 @@ Notes:
 - ALWAYS use case-insensitive matching for user-facing text (names, titles, descriptions, categories, vendor names). Use LOWER() on both sides for = and LIKE: WHERE LOWER(name) = LOWER('flooring'), WHERE LOWER(title) LIKE LOWER('%hvac%'). The only exception is enum columns with known exact values (status, interval unit).
 - When available, use the exact spellings from the database for statuses, type names, vendor names, etc.`
 
+// The soft-delete rule in sqlSystemPreamble, and its replacement when the
+// question is about deleted items.
+const (
+	sqlExcludeDeletedRule = `5. Soft-deleted rows have deleted_at IS NOT NULL. Exclude them unless asked about deleted items.`
+	sqlIncludeDeletedRule = `5. Soft-deleted rows have deleted_at IS NOT NULL. The user is asking about deleted items: do NOT filter them out. Use deleted_at IS NOT NULL to list only deleted rows, and select deleted_at so the answer can say when each was deleted.`
+)
+
+const sqlDeletedFewShot = `## Examples for deleted items
+
+User: What did I delete recently?
+SQL: SELECT 'project' AS kind, title AS name, deleted_at FROM projects WHERE deleted_at IS NOT NULL UNION ALL SELECT 'appliance', name, deleted_at FROM appliances WHERE deleted_at IS NOT NULL UNION ALL SELECT 'maintenance', name, deleted_at FROM maintenance_items WHERE deleted_at IS NOT NULL UNION ALL SELECT 'vendor', name, deleted_at FROM vendors WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC LIMIT 20
+
+User: Which incidents have I resolved?
+SQL: SELECT title, severity, date_noticed, deleted_at AS resolved_at FROM incidents WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC`
+
 const sqlFewShot = `## Examples
 
 User: How many projects are underway?
@@ -295,7 +418,7 @@ User: What's my most expensive project?
 SQL: SELECT name, budget_cents / 100.0 AS budget_dollars FROM projects WHERE deleted_at IS NULL ORDER BY budget_cents DESC LIMIT 1
 
 User: When is the HVAC filter due?
-SQL: SELECT m.name, m.last_serviced_at, m.interval_months, date(m.last_serviced_at, '+' || m.interval_months || ' months') AS next_due FROM maintenance_items m WHERE LOWER(m.name) LIKE LOWER('%hvac%') AND m.deleted_at IS NULL
+SQL: SELECT m.name, m.last_serviced_at, m.interval_months, m.interval_days, date(m.last_serviced_at, '+' || m.interval_months || ' months', '+' || m.interval_days || ' days') AS next_due FROM maintenance_items m WHERE LOWER(m.name) LIKE LOWER('%hvac%') AND m.deleted_at IS NULL
 
 User: Which appliances have expiring warranties in the next 90 days?
 SQL: SELECT name, warranty_expiry FROM appliances WHERE warranty_expiry IS NOT NULL AND warranty_expiry BETWEEN date('now') AND date('now', '+90 days') AND deleted_at IS NULL
@@ -307,7 +430,7 @@ User: What quotes have I received for plumbing?
 SQL: SELECT v.name AS vendor, q.total_cents / 100.0 AS quote_dollars, p.title AS project FROM quotes q JOIN projects p ON q.project_id = p.id JOIN vendors v ON q.vendor_id = v.id JOIN project_types pt ON p.project_type_id = pt.id WHERE LOWER(pt.name) = LOWER('plumbing') AND p.deleted_at IS NULL AND q.deleted_at IS NULL ORDER BY q.total_cents DESC
 
 User: Show me all maintenance items and when they're next due
-SQL: SELECT name, last_serviced_at, interval_months, date(last_serviced_at, '+' || interval_months || ' months') AS next_due FROM maintenance_items WHERE deleted_at IS NULL ORDER BY next_due
+SQL: SELECT name, last_serviced_at, interval_months, interval_days, date(last_serviced_at, '+' || interval_months || ' months', '+' || interval_days || ' days') AS next_due FROM maintenance_items WHERE deleted_at IS NULL ORDER BY next_due
 
 User: Which projects involve HVAC work?
 SQL: SELECT title, status, description FROM projects WHERE (LOWER(title) LIKE LOWER('%hvac%') OR LOWER(description) LIKE LOWER('%hvac%')) AND deleted_at IS NULL
@@ -354,10 +477,17 @@ RULES:
 5. If asked to change data, say: "Use the micasa edit mode to make changes."
 6. Do NOT repeat the raw data back. Summarize or answer the specific question.`
 
+// The soft-delete note in fallbackSchemaNotes, and its replacement when the
+// question is about deleted items.
+const (
+	fallbackExcludeDeletedNote = `- Soft-deleted rows have a non-NULL deleted_at and should be treated as removed.`
+	fallbackIncludeDeletedNote = `- Rows with a deleted_at value were deleted (resolved, for incidents). The user is asking about deleted items, so answer from those rows and say when each was deleted.`
+)
+
 const fallbackSchemaNotes = `
 Schema notes:
-- Soft-deleted rows have a non-NULL deleted_at and should be treated as removed.
-- Maintenance scheduling: next_due = last_serviced + interval_months.
+` + fallbackExcludeDeletedNote + `
+- Maintenance scheduling: next_due = last_serviced + interval_months months + interval_days days.
 - Project statuses: ideating, planned, quoted, underway, delayed, completed, abandoned (UI abbreviations: idea, plan, bid, wip, hold, done, drop).
 - Incident statuses: open, in_progress. Resolved incidents have deleted_at set.
 - Incident severities: urgent, soon, whenever.
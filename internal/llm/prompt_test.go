@@ -4,11 +4,14 @@
 package llm
 
 import (
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/micasa-dev/micasa/internal/data"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var testTables = []TableInfo{
@@ -36,7 +39,7 @@ var testNow = time.Date(2026, 2, 13, 10, 0, 0, 0, time.UTC)
 
 func TestBuildSystemPromptIncludesSchema(t *testing.T) {
 	t.Parallel()
-	prompt := BuildSystemPrompt(testTables, "", testNow, "")
+	prompt := BuildSystemPrompt(testTables, "", testNow, "", false, "")
 	assert.Contains(t, prompt, "projects")
 	assert.Contains(t, prompt, "id integer PK")
 	assert.Contains(t, prompt, "title text NOT NULL")
@@ -51,6 +54,8 @@ func TestBuildSystemPromptIncludesData(t *testing.T) {
 		"### projects (3 rows)\n\n- id: 1, title: Fix roof\n",
 		testNow,
 		"",
+		false,
+		"",
 	)
 	assert.Contains(t, prompt, "Fix roof")
 	assert.Contains(t, prompt, "Current Data")
@@ -58,19 +63,19 @@ func TestBuildSystemPromptIncludesData(t *testing.T) {
 
 func TestBuildSystemPromptOmitsDataWhenEmpty(t *testing.T) {
 	t.Parallel()
-	prompt := BuildSystemPrompt(nil, "", testNow, "")
+	prompt := BuildSystemPrompt(nil, "", testNow, "", false, "")
 	assert.NotContains(t, prompt, "Current Data")
 }
 
 func TestBuildSystemPromptIncludesCurrentDate(t *testing.T) {
 	t.Parallel()
-	prompt := BuildSystemPrompt(nil, "", testNow, "")
+	prompt := BuildSystemPrompt(nil, "", testNow, "", false, "")
 	assert.Contains(t, prompt, "Friday, February 13, 2026")
 }
 
 func TestBuildSystemPromptIncludesExtraContext(t *testing.T) {
 	t.Parallel()
-	prompt := BuildSystemPrompt(nil, "", testNow, "House is a 1920s craftsman.")
+	prompt := BuildSystemPrompt(nil, "", testNow, "House is a 1920s craftsman.", false, "")
 	assert.Contains(t, prompt, "Additional context")
 	assert.Contains(t, prompt, "1920s craftsman")
 }
@@ -79,7 +84,7 @@ func TestBuildSystemPromptIncludesExtraContext(t *testing.T) {
 
 func TestBuildSQLPromptIncludesDDL(t *testing.T) {
 	t.Parallel()
-	prompt := BuildSQLPrompt(testTables, testNow, "", "")
+	prompt := BuildSQLPrompt(testTables, testNow, "", "", false, 0)
 	assert.Contains(t, prompt, "CREATE TABLE projects")
 	assert.Contains(t, prompt, "id integer PRIMARY KEY")
 	assert.Contains(t, prompt, "title text NOT NULL")
@@ -89,7 +94,7 @@ func TestBuildSQLPromptIncludesDDL(t *testing.T) {
 
 func TestBuildSQLPromptIncludesFewShotExamples(t *testing.T) {
 	t.Parallel()
-	prompt := BuildSQLPrompt(testTables, testNow, "", "")
+	prompt := BuildSQLPrompt(testTables, testNow, "", "", false, 0)
 	assert.Contains(t, prompt, "SELECT COUNT(*)")
 	assert.Contains(t, prompt, "budget_cents / 100.0")
 	assert.Contains(t, prompt, "deleted_at IS NULL")
@@ -97,20 +102,20 @@ func TestBuildSQLPromptIncludesFewShotExamples(t *testing.T) {
 
 func TestBuildSQLPromptIncludesRules(t *testing.T) {
 	t.Parallel()
-	prompt := BuildSQLPrompt(testTables, testNow, "", "")
+	prompt := BuildSQLPrompt(testTables, testNow, "", "", false, 0)
 	assert.Contains(t, prompt, "single SELECT statement")
 	assert.Contains(t, prompt, "never INSERT")
 }
 
 func TestBuildSQLPromptIncludesCurrentDate(t *testing.T) {
 	t.Parallel()
-	prompt := BuildSQLPrompt(testTables, testNow, "", "")
+	prompt := BuildSQLPrompt(testTables, testNow, "", "", false, 0)
 	assert.Contains(t, prompt, "Friday, February 13, 2026")
 }
 
 func TestBuildSQLPromptIncludesExtraContext(t *testing.T) {
 	t.Parallel()
-	prompt := BuildSQLPrompt(testTables, testNow, "", "Budgets are in CAD.")
+	prompt := BuildSQLPrompt(testTables, testNow, "", "Budgets are in CAD.", false, 0)
 	assert.Contains(t, prompt, "Additional context")
 	assert.Contains(t, prompt, "Budgets are in CAD")
 }
@@ -125,6 +130,7 @@ func TestBuildSummaryPromptIncludesAllParts(t *testing.T) {
 		"count\n3\n",
 		testNow,
 		"",
+		"",
 	)
 	assert.Contains(t, prompt, "How many projects?")
 	assert.Contains(t, prompt, "SELECT COUNT(*)")
@@ -134,13 +140,13 @@ func TestBuildSummaryPromptIncludesAllParts(t *testing.T) {
 
 func TestBuildSummaryPromptIncludesCurrentDate(t *testing.T) {
 	t.Parallel()
-	prompt := BuildSummaryPrompt("test", "SELECT 1", "1\n", testNow, "")
+	prompt := BuildSummaryPrompt("test", "SELECT 1", "1\n", testNow, "", "")
 	assert.Contains(t, prompt, "Friday, February 13, 2026")
 }
 
 func TestBuildSummaryPromptIncludesExtraContext(t *testing.T) {
 	t.Parallel()
-	prompt := BuildSummaryPrompt("test", "SELECT 1", "1\n", testNow, "Currency is CAD.")
+	prompt := BuildSummaryPrompt("test", "SELECT 1", "1\n", testNow, "Currency is CAD.", "")
 	assert.Contains(t, prompt, "Additional context")
 	assert.Contains(t, prompt, "Currency is CAD")
 }
@@ -203,7 +209,7 @@ func TestExtractSQLTrimsWhitespace(t *testing.T) {
 
 func TestBuildSQLPromptIncludesEntityRelationships(t *testing.T) {
 	t.Parallel()
-	prompt := BuildSQLPrompt(testTables, testNow, "", "")
+	prompt := BuildSQLPrompt(testTables, testNow, "", "", false, 0)
 	assert.Contains(t, prompt, "## Entity Relationships")
 	assert.Contains(t, prompt, "Foreign key relationships")
 	assert.Contains(t, prompt, "projects.project_type_id")
@@ -215,7 +221,7 @@ func TestBuildSQLPromptIncludesEntityRelationships(t *testing.T) {
 
 func TestBuildSystemPromptIncludesEntityRelationships(t *testing.T) {
 	t.Parallel()
-	prompt := BuildSystemPrompt(testTables, "", testNow, "")
+	prompt := BuildSystemPrompt(testTables, "", testNow, "", false, "")
 	assert.Contains(t, prompt, "## Entity Relationships")
 	assert.Contains(t, prompt, "Foreign key relationships")
 	assert.Contains(t, prompt, "projects.project_type_id")
@@ -227,7 +233,7 @@ func TestBuildSystemPromptIncludesEntityRelationships(t *testing.T) {
 
 func TestBuildSQLPromptIncludesCaseInsensitiveGuidance(t *testing.T) {
 	t.Parallel()
-	prompt := BuildSQLPrompt(testTables, testNow, "", "")
+	prompt := BuildSQLPrompt(testTables, testNow, "", "", false, 0)
 	assert.Contains(t, prompt, "case-insensitive matching")
 	assert.Contains(t, prompt, "LOWER()")
 }
@@ -235,20 +241,20 @@ func TestBuildSQLPromptIncludesCaseInsensitiveGuidance(t *testing.T) {
 func TestBuildSQLPromptIncludesColumnHints(t *testing.T) {
 	t.Parallel()
 	hints := "- project types: electrical, flooring, plumbing\n"
-	prompt := BuildSQLPrompt(testTables, testNow, hints, "")
+	prompt := BuildSQLPrompt(testTables, testNow, hints, "", false, 0)
 	assert.Contains(t, prompt, "Known values in the database")
 	assert.Contains(t, prompt, "electrical, flooring, plumbing")
 }
 
 func TestBuildSQLPromptOmitsColumnHintsWhenEmpty(t *testing.T) {
 	t.Parallel()
-	prompt := BuildSQLPrompt(testTables, testNow, "", "")
+	prompt := BuildSQLPrompt(testTables, testNow, "", "", false, 0)
 	assert.NotContains(t, prompt, "Known values")
 }
 
 func TestBuildSQLPromptIncludesGroupByExamples(t *testing.T) {
 	t.Parallel()
-	prompt := BuildSQLPrompt(testTables, testNow, "", "")
+	prompt := BuildSQLPrompt(testTables, testNow, "", "", false, 0)
 	assert.Contains(t, prompt, "GROUP BY")
 	assert.Contains(t, prompt, "total spending by project status")
 	assert.Contains(t, prompt, "vendors have given me the most quotes")
@@ -257,7 +263,7 @@ func TestBuildSQLPromptIncludesGroupByExamples(t *testing.T) {
 
 func TestBuildSQLPromptIncludesIncidentExamples(t *testing.T) {
 	t.Parallel()
-	prompt := BuildSQLPrompt(testTables, testNow, "", "")
+	prompt := BuildSQLPrompt(testTables, testNow, "", "", false, 0)
 	assert.Contains(t, prompt, "What open incidents do I have?")
 	assert.Contains(t, prompt, "FROM incidents WHERE status IN ('open', 'in_progress')")
 	assert.Contains(t, prompt, "How much have I spent on incidents this year?")
@@ -266,14 +272,145 @@ func TestBuildSQLPromptIncludesIncidentExamples(t *testing.T) {
 
 func TestBuildSQLPromptIncludesIncidentSchemaNotes(t *testing.T) {
 	t.Parallel()
-	prompt := BuildSQLPrompt(testTables, testNow, "", "")
+	prompt := BuildSQLPrompt(testTables, testNow, "", "", false, 0)
 	assert.Contains(t, prompt, "Incident statuses: open, in_progress")
 	assert.Contains(t, prompt, "Incident severities: urgent, soon, whenever")
 }
 
 func TestBuildSystemPromptIncludesIncidentFallbackNotes(t *testing.T) {
 	t.Parallel()
-	prompt := BuildSystemPrompt(testTables, "", testNow, "")
+	prompt := BuildSystemPrompt(testTables, "", testNow, "", false, "")
 	assert.Contains(t, prompt, "Incident statuses: open, in_progress")
 	assert.Contains(t, prompt, "Incident severities: urgent, soon, whenever")
 }
+
+func TestAsksAboutDeleted(t *testing.T) {
+	t.Parallel()
+	for _, q := range []string{
+		"What did I delete last month?",
+		"show deleted projects",
+		"anything in the trash?",
+		"Which vendors were removed?",
+		"list resolved incidents",
+	} {
+		assert.True(t, AsksAboutDeleted(q), q)
+	}
+	for _, q := range []string{
+		"How many projects are underway?",
+		"What's my most expensive appliance?",
+		"trashcan vendors", // not the word "trash"
+		"undeleted",
+	} {
+		assert.False(t, AsksAboutDeleted(q), q)
+	}
+}
+
+func TestBuildSQLPromptExcludesDeletedByDefault(t *testing.T) {
+	t.Parallel()
+	prompt := BuildSQLPrompt(testTables, testNow, "", "", false, 0)
+	assert.Contains(t, prompt, sqlExcludeDeletedRule)
+	assert.NotContains(t, prompt, sqlIncludeDeletedRule)
+	assert.NotContains(t, prompt, "What did I delete recently?")
+}
+
+func TestBuildSQLPromptIncludesDeletedWhenAsked(t *testing.T) {
+	t.Parallel()
+	prompt := BuildSQLPrompt(testTables, testNow, "", "", true, 0)
+	assert.NotContains(t, prompt, sqlExcludeDeletedRule)
+	assert.Contains(t, prompt, sqlIncludeDeletedRule)
+	assert.Contains(t, prompt, "What did I delete recently?")
+	assert.Contains(t, prompt, "FROM projects WHERE deleted_at IS NOT NULL")
+}
+
+func TestBuildSystemPromptIncludesDeletedWhenAsked(t *testing.T) {
+	t.Parallel()
+	prompt := BuildSystemPrompt(testTables, "", testNow, "", false, "")
+	assert.Contains(t, prompt, fallbackExcludeDeletedNote)
+
+	prompt = BuildSystemPrompt(testTables, "", testNow, "", true, "")
+	assert.NotContains(t, prompt, fallbackExcludeDeletedNote)
+	assert.Contains(t, prompt, fallbackIncludeDeletedNote)
+}
+
+// TestDeletedExampleSQL runs the deleted-items examples against a real
+// database: they must return the soft-deleted rows and nothing else.
+func TestDeletedExampleSQL(t *testing.T) {
+	t.Parallel()
+	store, err := data.Open(filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+	require.NoError(t, store.AutoMigrate())
+
+	gone := data.Vendor{Name: "Gone Plumbing"}
+	require.NoError(t, store.CreateVendor(&gone))
+	require.NoError(t, store.DeleteVendor(gone.ID))
+	require.NoError(t, store.CreateVendor(&data.Vendor{Name: "Still Here HVAC"}))
+	fixed := data.Incident{
+		Title: "Fixed fence", Status: data.IncidentStatusOpen, Severity: data.IncidentSeveritySoon,
+	}
+	require.NoError(t, store.CreateIncident(&fixed))
+	require.NoError(t, store.DeleteIncident(fixed.ID))
+	require.NoError(t, store.CreateIncident(&data.Incident{
+		Title: "Open leak", Status: data.IncidentStatusOpen, Severity: data.IncidentSeverityUrgent,
+	}))
+
+	var queries []string
+	for line := range strings.SplitSeq(sqlDeletedFewShot, "\n") {
+		if q, ok := strings.CutPrefix(line, "SQL: "); ok {
+			queries = append(queries, q)
+		}
+	}
+	require.Len(t, queries, 2)
+
+	_, rows, err := store.ReadOnlyQuery(t.Context(), queries[0])
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, []any{"vendor", "Gone Plumbing"}, rows[0][:2])
+
+	_, rows, err = store.ReadOnlyQuery(t.Context(), queries[1])
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, "Fixed fence", rows[0][0])
+}
+
+func TestAnswerLanguage(t *testing.T) {
+	t.Parallel()
+	for _, lang := range []string{"", "English", " english "} {
+		assert.NotContains(t,
+			BuildSummaryPrompt("q", "SELECT 1", "1\n", testNow, "", lang), "Answer language")
+		assert.NotContains(t,
+			BuildSystemPrompt(testTables, "", testNow, "", false, lang), "Answer language")
+	}
+
+	summary := BuildSummaryPrompt("q", "SELECT 1", "1\n", testNow, "Currency is CAD.", "French")
+	assert.Contains(t, summary, "Write your answer in French")
+	assert.Contains(t, summary, "didn't find any matching data: give them in French")
+	assert.Less(t, strings.Index(summary, summaryGuidelines), strings.Index(summary, "## Answer language"))
+	assert.Less(t, strings.Index(summary, "## Answer language"), strings.Index(summary, "Currency is CAD."))
+
+	fallback := BuildSystemPrompt(testTables, "", testNow, "", false, "French")
+	assert.Contains(t, fallback, "Write your answer in French")
+	assert.Contains(t, fallback, fallbackGuidelines)
+
+	assert.NotContains(t, BuildSQLPrompt(testTables, testNow, "", "", false, 0), "Answer language",
+		"SQL generation is language-independent")
+}
+
+func TestBuildSQLPromptDropsExamplesToFit(t *testing.T) {
+	t.Parallel()
+	full := BuildSQLPrompt(testTables, testNow, "", "Budgets are in CAD.", true, 0)
+	budget := EstimateTokens(full) - 200
+	fitted := BuildSQLPrompt(testTables, testNow, "", "Budgets are in CAD.", true, budget)
+	assert.LessOrEqual(t, EstimateTokens(fitted), budget)
+	assert.Contains(t, fitted, "How many projects are underway?", "first examples stay")
+	assert.NotContains(t, fitted, "How much have I spent on incidents this year?",
+		"general examples go from the last")
+	assert.Contains(t, fitted, "What did I delete recently?", "deleted examples go last")
+	assert.Contains(t, fitted, "Budgets are in CAD.")
+
+	bare := BuildSQLPrompt(testTables, testNow, "", "", false, 1)
+	assert.NotContains(t, bare, "User:")
+	assert.NotContains(t, bare, "## Examples")
+	assert.Contains(t, bare, "Now generate SQL for the user's question.")
+	assert.Contains(t, bare, "CREATE TABLE", "the schema is never dropped")
+}
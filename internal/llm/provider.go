@@ -20,6 +20,8 @@ type Base interface {
 	SupportsModelListing() bool
 	Ping(ctx context.Context) error
 	ListModels(ctx context.Context) ([]string, error)
+	SetContextTokens(n int)
+	ContextTokens(ctx context.Context) int
 }
 
 // ChatProvider streams chat completions (NL->SQL, summaries).
@@ -0,0 +1,136 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package llm
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/micasa-dev/micasa/internal/data"
+)
+
+// coreTables are always kept by TrimSchema: most questions are about them,
+// and the SQL prompt's examples query them.
+var coreTables = []string{
+	data.TableProjects,
+	data.TableMaintenanceItems,
+	data.TableAppliances,
+	data.TableVendors,
+	data.TableIncidents,
+}
+
+// EstimateTokens roughly counts the tokens in s, at about four characters
+// per token. Good enough to size prompts; not a tokenizer.
+func EstimateTokens(s string) int {
+	return (len(s) + 3) / 4
+}
+
+// TrimSchema returns the tables worth describing in the SQL prompt when the
+// full schema DDL would take more than maxTokens. It keeps the core tables,
+// tables whose name or columns match a word in question ("warranty" keeps
+// appliances for warranty_expiry), and the tables those link to through
+// their _id columns. Order is preserved. When maxTokens is 0 or the schema
+// already fits, tables is returned unchanged.
+func TrimSchema(tables []TableInfo, question string, maxTokens int) []TableInfo {
+	if maxTokens <= 0 || schemaTokens(tables) <= maxTokens {
+		return tables
+	}
+	words := questionWords(question)
+	keep := make(map[string]bool, len(tables))
+	for _, t := range tables {
+		if slices.Contains(coreTables, t.Name) || tableMatches(t, words) {
+			keep[t.Name] = true
+		}
+	}
+	// One hop along foreign keys, so kept tables can be joined to their
+	// lookups (project types, maintenance categories).
+	for _, t := range tables {
+		if !keep[t.Name] {
+			continue
+		}
+		for _, c := range t.Columns {
+			for _, ref := range tables {
+				if refersTo(c.Name, ref.Name) {
+					keep[ref.Name] = true
+				}
+			}
+		}
+	}
+	out := make([]TableInfo, 0, len(keep))
+	for _, t := range tables {
+		if keep[t.Name] {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+func schemaTokens(tables []TableInfo) int {
+	n := 0
+	for _, t := range tables {
+		n += EstimateTokens(formatDDL(t))
+	}
+	return n
+}
+
+// questionWords returns the singular forms of the question's words of
+// three letters or more, lowercased.
+func questionWords(question string) map[string]bool {
+	words := make(map[string]bool)
+	for _, w := range strings.FieldsFunc(strings.ToLower(question), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	}) {
+		if len(w) >= 3 {
+			words[singular(w)] = true
+		}
+	}
+	return words
+}
+
+// tableMatches reports whether any part of the table's name or of its
+// column names (split on "_") is one of words. Foreign key columns don't
+// count: "projects" should not pull in every table with a project_id.
+func tableMatches(t TableInfo, words map[string]bool) bool {
+	names := []string{t.Name}
+	for _, c := range t.Columns {
+		if !strings.HasSuffix(c.Name, "_id") {
+			names = append(names, c.Name)
+		}
+	}
+	for _, name := range names {
+		for part := range strings.SplitSeq(name, "_") {
+			if words[singular(part)] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// refersTo reports whether col is a foreign key into table by naming
+// convention: project_type_id refers to project_types, and category_id to
+// maintenance_categories.
+func refersTo(col, table string) bool {
+	name, ok := strings.CutSuffix(col, "_id")
+	if !ok || name == "" {
+		return false
+	}
+	s := singular(table)
+	return s == name || strings.HasSuffix(s, "_"+name)
+}
+
+// singular strips a plural ending: "entries" -> "entry", "quotes" ->
+// "quote". Crude, but table and column names are regular.
+func singular(w string) string {
+	switch {
+	case strings.HasSuffix(w, "ies"):
+		return strings.TrimSuffix(w, "ies") + "y"
+	case strings.HasSuffix(w, "ss"):
+		return w
+	case strings.HasSuffix(w, "s"):
+		return strings.TrimSuffix(w, "s")
+	}
+	return w
+}
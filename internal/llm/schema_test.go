@@ -0,0 +1,100 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package llm
+
+import (
+	"testing"
+
+	"github.com/micasa-dev/micasa/internal/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func schemaTestTables() []TableInfo {
+	table := func(name string, cols ...string) TableInfo {
+		t := TableInfo{Name: name, Columns: []ColumnInfo{{Name: data.ColID, Type: "text", PK: true}}}
+		for _, c := range cols {
+			t.Columns = append(t.Columns, ColumnInfo{Name: c, Type: "text"})
+		}
+		return t
+	}
+	return []TableInfo{
+		table(data.TableAppliances, "name", "warranty_expiry"),
+		table(data.TableDocuments, "title", "file_name", "entity_id"),
+		table(data.TableHouseProfiles, "nickname", "roof_type"),
+		table(data.TableIncidents, "title", "appliance_id", "vendor_id"),
+		table(data.TableMaintenanceCategories, "name"),
+		table(data.TableMaintenanceItems, "name", "category_id", "appliance_id"),
+		table(data.TableProjectTypes, "name"),
+		table(data.TableProjects, "title", "project_type_id"),
+		table(data.TableQuotes, "total_cents", "project_id", "vendor_id"),
+		table(data.TableServiceLogEntries, "serviced_at", "maintenance_item_id"),
+		table(data.TableVendors, "name", "phone"),
+	}
+}
+
+func tableNames(tables []TableInfo) []string {
+	names := make([]string, len(tables))
+	for i, t := range tables {
+		names[i] = t.Name
+	}
+	return names
+}
+
+func TestTrimSchemaUnderBudget(t *testing.T) {
+	t.Parallel()
+	tables := schemaTestTables()
+	assert.Equal(t, tables, TrimSchema(tables, "anything", 0), "0 disables trimming")
+	assert.Equal(t, tables, TrimSchema(tables, "anything", schemaTokens(tables)))
+}
+
+func TestTrimSchemaKeepsCoreAndLookups(t *testing.T) {
+	t.Parallel()
+	got := TrimSchema(schemaTestTables(), "How many projects are underway?", 1)
+	assert.Equal(t, []string{
+		data.TableAppliances,
+		data.TableIncidents,
+		data.TableMaintenanceCategories,
+		data.TableMaintenanceItems,
+		data.TableProjectTypes,
+		data.TableProjects,
+		data.TableVendors,
+	}, tableNames(got))
+}
+
+func TestTrimSchemaKeepsMatchingTables(t *testing.T) {
+	t.Parallel()
+	tables := schemaTestTables()
+
+	names := tableNames(TrimSchema(tables, "Which quotes came in over $5k?", 1))
+	assert.Contains(t, names, data.TableQuotes, "table name, singular or plural")
+	assert.NotContains(t, names, data.TableHouseProfiles)
+	assert.NotContains(t, names, data.TableServiceLogEntries)
+
+	names = tableNames(TrimSchema(tables, "When was the HVAC last serviced?", 1))
+	assert.Contains(t, names, data.TableServiceLogEntries, "column name part")
+
+	names = tableNames(TrimSchema(tables, "What's the roof type?", 1))
+	assert.Contains(t, names, data.TableHouseProfiles)
+	assert.NotContains(t, names, data.TableDocuments)
+}
+
+func TestTrimSchemaShrinksPrompt(t *testing.T) {
+	t.Parallel()
+	tables := schemaTestTables()
+	trimmed := TrimSchema(tables, "list my vendors", 1)
+	full := BuildSQLPrompt(tables, testNow, "", "", false, 0)
+	short := BuildSQLPrompt(trimmed, testNow, "", "", false, 0)
+	assert.Less(t, EstimateTokens(short), EstimateTokens(full))
+	assert.NotContains(t, short, "CREATE TABLE "+data.TableHouseProfiles)
+}
+
+func TestRefersTo(t *testing.T) {
+	t.Parallel()
+	assert.True(t, refersTo("project_type_id", data.TableProjectTypes))
+	assert.True(t, refersTo("category_id", data.TableMaintenanceCategories))
+	assert.True(t, refersTo("maintenance_item_id", data.TableMaintenanceItems))
+	assert.False(t, refersTo("project_type_id", data.TableProjects))
+	assert.False(t, refersTo("name", data.TableVendors))
+	assert.False(t, refersTo("_id", data.TableVendors))
+}
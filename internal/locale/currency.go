@@ -30,6 +30,18 @@ type Currency struct {
 	code    string
 	group   string // cached grouping separator (e.g. "," or ".")
 	decimal string // cached decimal separator (e.g. "." or ",")
+	compact compactStyle
+	custom  bool // formatted from a CurrencyFormat rather than CLDR
+
+	// Separators for typed input when it follows a different locale than
+	// display (see WithInputLocale). Empty means use group and decimal.
+	inGroup   string
+	inDecimal string
+
+	rounding Rounding      // how sub-cent values are resolved to whole cents
+	spacing  SymbolSpacing // space between symbol and amount
+
+	formats map[string]CurrencyFormat // custom formats for WithCode
 }
 
 const nbsp = "\u00a0" // non-breaking space between number and suffix symbol
@@ -61,6 +73,7 @@ func Resolve(code string, tag language.Tag) (Currency, error) {
 		code:    code,
 		group:   group,
 		decimal: dec,
+		compact: compactStyleFor(tag),
 	}, nil
 }
 
@@ -82,6 +95,12 @@ func DefaultCurrency() Currency {
 // explicit code > MICASA_LOCALE_CURRENCY env > LC_MONETARY/LANG auto-detect > USD.
 // The formatting locale is always detected from the environment.
 func ResolveDefault(configured string) (Currency, error) {
+	return Resolve(DefaultCode(configured), DetectLocale())
+}
+
+// DefaultCode returns the currency code ResolveDefault would use, without
+// checking that it is a known ISO 4217 code.
+func DefaultCode(configured string) string {
 	code := configured
 	if code == "" {
 		code = os.Getenv("MICASA_LOCALE_CURRENCY")
@@ -92,7 +111,7 @@ func ResolveDefault(configured string) (Currency, error) {
 	if code == "" {
 		code = "USD"
 	}
-	return Resolve(code, DetectLocale())
+	return strings.ToUpper(strings.TrimSpace(code))
 }
 
 // DetectLocale reads the user's formatting locale from the environment.
@@ -109,6 +128,21 @@ func DetectLocale() language.Tag {
 	return language.AmericanEnglish
 }
 
+// DetectNumericLocale reads the user's number-formatting locale from the
+// environment. Checks LC_NUMERIC, LC_ALL, then LANG. Falls back to
+// American English.
+func DetectNumericLocale() language.Tag {
+	for _, key := range []string{"LC_NUMERIC", "LC_ALL", "LANG"} {
+		if val := os.Getenv(key); val != "" {
+			tag, err := parseLocaleString(val)
+			if err == nil {
+				return tag
+			}
+		}
+	}
+	return language.AmericanEnglish
+}
+
 // Code returns the ISO 4217 code (e.g. "USD", "EUR").
 func (c Currency) Code() string {
 	return c.code
@@ -119,6 +153,62 @@ func (c Currency) Symbol() string {
 	return c.symbol
 }
 
+// Rounding returns the mode used to resolve sub-cent values.
+func (c Currency) Rounding() Rounding {
+	return c.rounding
+}
+
+// WithRounding returns a copy of c that resolves sub-cent values with r.
+func (c Currency) WithRounding(r Rounding) Currency {
+	c.rounding = r
+	return c
+}
+
+// Spacing returns the symbol spacing preference.
+func (c Currency) Spacing() SymbolSpacing {
+	return c.spacing
+}
+
+// WithSymbolSpacing returns a copy of c that separates the symbol from the
+// amount according to s.
+func (c Currency) WithSymbolSpacing(s SymbolSpacing) Currency {
+	c.spacing = s
+	return c
+}
+
+// symbolSep returns the text between the symbol and the amount.
+func (c Currency) symbolSep() string {
+	switch c.spacing {
+	case SpacingSpace:
+		return nbsp
+	case SpacingNone:
+		return ""
+	case SpacingAuto:
+		if c.prefix {
+			return ""
+		}
+		return nbsp
+	}
+	return ""
+}
+
+// withSymbol places the symbol around a formatted number.
+func (c Currency) withSymbol(sign, number string) string {
+	if c.prefix {
+		return sign + c.symbol + c.symbolSep() + number
+	}
+	return sign + number + c.symbolSep() + c.symbol
+}
+
+// WithInputLocale returns a copy of c that parses bare typed numbers with
+// tag's grouping and decimal separators, so "1.234,56" means 1234.56 to a
+// German typist even when amounts display as "$1,234.56". Text carrying
+// the currency symbol is display output and keeps the display separators.
+func (c Currency) WithInputLocale(tag language.Tag) Currency {
+	c.inGroup, c.inDecimal = deriveSeparators(tag)
+	return c
+}
+
 // FormatCents formats an int64 cent value as a locale-appropriate currency
 // string. Uses the locale's number grouping and decimal separator, with the
 // currency symbol placed per locale convention (no extra space).
@@ -134,22 +224,23 @@ func (c Currency) FormatCents(cents int64) string {
 	}
 	dollars := cents / 100
 	remainder := cents % 100
-	p := message.NewPrinter(c.tag)
-	numStr := p.Sprintf("%d", dollars)
-	number := fmt.Sprintf("%s%s%02d", numStr, c.decimal, remainder)
-	if c.prefix {
-		return sign + c.symbol + number
+	var numStr string
+	if c.custom {
+		numStr = groupDigits(dollars, c.group)
+	} else {
+		numStr = message.NewPrinter(c.tag).Sprintf("%d", dollars)
 	}
-	return sign + number + nbsp + c.symbol
+	number := fmt.Sprintf("%s%s%02d", numStr, c.decimal, remainder)
+	return c.withSymbol(sign, number)
 }
 
 // StripSymbol removes the currency symbol (and any surrounding whitespace
 // it introduces) from a FormatCents output, leaving just the number.
 func (c Currency) StripSymbol(s string) string {
 	if c.prefix {
-		return strings.Replace(s, c.symbol, "", 1)
+		return strings.Replace(s, c.symbol+c.symbolSep(), "", 1)
 	}
-	return strings.TrimSuffix(s, nbsp+c.symbol)
+	return strings.TrimSuffix(s, c.symbolSep()+c.symbol)
 }
 
 // FormatOptionalCents formats a *int64 cent value, returning "" for nil.
@@ -160,9 +251,32 @@ func (c Currency) FormatOptionalCents(cents *int64) string {
 	return c.FormatCents(*cents)
 }
 
+// compactStyle selects the abbreviations FormatCompactCents uses.
+type compactStyle int
+
+const (
+	compactSI     compactStyle = iota // k, M, G
+	compactIndian                     // k, L (lakh, 10^5), Cr (crore, 10^7)
+)
+
+// lakhCroreRegions count large amounts in lakhs and crores.
+var lakhCroreRegions = map[string]bool{
+	"IN": true, "PK": true, "BD": true, "NP": true, "LK": true,
+}
+
+// compactStyleFor picks abbreviations by the formatting locale's region.
+func compactStyleFor(tag language.Tag) compactStyle {
+	if region, conf := tag.Region(); conf != language.No && lakhCroreRegions[region.String()] {
+		return compactIndian
+	}
+	return compactSI
+}
+
 // FormatCompactCents formats cents using abbreviated notation for large
-// values (e.g. 1.2k, 45k, 1.3M) with the correct currency symbol.
-// Values under 1,000 in the base unit use full precision.
+// values (e.g. 1.2k, 45k, 1.3M) with the correct currency symbol. Locales
+// that count in lakhs and crores abbreviate with L and Cr instead of M
+// (e.g. 1.2L, 3.5Cr). Values under 1,000 in the base unit use full
+// precision.
 func (c Currency) FormatCompactCents(cents int64) string {
 	sign := ""
 	absCents := cents
@@ -181,15 +295,37 @@ func (c Currency) FormatCompactCents(cents int64) string {
 		}
 		return c.FormatCents(cents)
 	}
-	si := humanize.SIWithDigits(dollars, 1, "")
-	si = strings.Replace(si, " ", "", 1)
-	if c.decimal != "." {
-		si = strings.Replace(si, ".", c.decimal, 1)
+	var short string
+	if c.compact == compactIndian {
+		short = lakhCrore(dollars)
+	} else {
+		short = humanize.SIWithDigits(dollars, 1, "")
+		short = strings.Replace(short, " ", "", 1)
 	}
-	if c.prefix {
-		return sign + c.symbol + si
+	if c.decimal != "." {
+		short = strings.Replace(short, ".", c.decimal, 1)
 	}
-	return sign + si + nbsp + c.symbol
+	return c.withSymbol(sign, short)
+}
+
+// lakhCrore abbreviates v (at least 1,000) as thousands, lakhs, or crores
+// with one decimal place, dropping a trailing ".0". A value that rounds up
+// to 100 of one unit moves to the next (99,990 is 1L, not 100k).
+func lakhCrore(v float64) string {
+	units := []struct {
+		size   float64
+		suffix string
+	}{
+		{1e3, "k"},
+		{1e5, "L"},
+		{1e7, "Cr"},
+	}
+	i := 0
+	for i < len(units)-1 && math.Round(v/units[i].size*10)/10 >= units[i+1].size/units[i].size {
+		i++
+	}
+	n := math.Round(v/units[i].size*10) / 10
+	return strconv.FormatFloat(n, 'f', -1, 64) + units[i].suffix
 }
 
 // FormatCompactOptionalCents formats optional cents compactly.
@@ -273,13 +409,25 @@ func (c Currency) parseCents(input string) (int64, error) {
 // normalizeNumber removes locale-specific grouping separators and replaces
 // the locale-specific decimal separator with ".".
 func (c Currency) normalizeNumber(input string) string {
-	result := strings.ReplaceAll(input, c.group, "")
-	if c.decimal != "." {
-		result = strings.Replace(result, c.decimal, ".", 1)
+	group, decimal := c.separatorsFor(input)
+	result := strings.ReplaceAll(input, group, "")
+	if decimal != "." {
+		result = strings.Replace(result, decimal, ".", 1)
 	}
 	return result
 }
 
+// separatorsFor picks the separators to parse input with. Text carrying
+// the symbol came from FormatCents (a table cell or a prefilled form
+// field) and uses the display separators; bare numbers use the input
+// locale's when one is set.
+func (c Currency) separatorsFor(input string) (group, decimal string) {
+	if c.inDecimal == "" || strings.Contains(input, c.symbol) {
+		return c.group, c.decimal
+	}
+	return c.inGroup, c.inDecimal
+}
+
 // deriveSeparators determines grouping and decimal separators for a locale
 // by formatting a known number and inspecting the output. Operates on runes
 // to correctly handle multi-byte separators (e.g. U+00A0 non-breaking space
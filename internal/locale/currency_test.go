@@ -222,6 +222,36 @@ func TestFormatCompactCentsEUR(t *testing.T) {
 	assert.Contains(t, c.FormatCompactCents(100000), "1k")
 }
 
+func TestFormatCompactCentsLakhCrore(t *testing.T) {
+	t.Parallel()
+	c := MustResolve("INR", language.MustParse("en-IN"))
+	tests := []struct {
+		name  string
+		cents int64
+		want  string
+	}{
+		{"under 1k", 99999, "₹999.99"},
+		{"thousands", 4500000, "₹45k"},
+		{"rounds up to lakh", 9999000, "₹1L"},
+		{"lakh", 12000000, "₹1.2L"},
+		{"crore", 3500000000, "₹3.5Cr"},
+		{"negative", -12000000, "-₹1.2L"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, c.FormatCompactCents(tt.cents))
+		})
+	}
+}
+
+func TestFormatCompactCentsStyleFollowsLocale(t *testing.T) {
+	t.Parallel()
+	// Lakh/crore follows the formatting locale, not the currency.
+	assert.Equal(t, "$1.2L", MustResolve("USD", language.MustParse("en-IN")).FormatCompactCents(12000000))
+	assert.Equal(t, "₹120k", MustResolve("INR", language.AmericanEnglish).FormatCompactCents(12000000))
+	assert.Contains(t, MustResolve("PKR", language.MustParse("ur-PK")).FormatCompactCents(12000000), "1.2L")
+}
+
 func TestFormatCompactOptionalCentsNil(t *testing.T) {
 	t.Parallel()
 	c := MustResolve("USD", language.AmericanEnglish)
@@ -392,6 +422,42 @@ func TestDetectLocalePriority(t *testing.T) {
 	assert.Equal(t, language.MustParse("fr-FR"), tag, "LC_MONETARY should take priority")
 }
 
+func TestDetectNumericLocalePriority(t *testing.T) {
+	t.Setenv("LC_NUMERIC", "de_DE.UTF-8")
+	t.Setenv("LC_ALL", "fr_FR.UTF-8")
+	t.Setenv("LANG", "en_US.UTF-8")
+	assert.Equal(t, language.MustParse("de-DE"), DetectNumericLocale(),
+		"LC_NUMERIC should take priority")
+
+	t.Setenv("LC_NUMERIC", "")
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LANG", "")
+	assert.Equal(t, language.AmericanEnglish, DetectNumericLocale())
+}
+
+func TestWithInputLocale(t *testing.T) {
+	t.Parallel()
+	c := MustResolve("USD", language.AmericanEnglish).WithInputLocale(language.German)
+
+	cents, err := c.ParseRequiredCents("1.234,56")
+	require.NoError(t, err)
+	assert.Equal(t, int64(123456), cents, "bare input follows the input locale")
+
+	cents, err = c.ParseRequiredCents("12,5")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1250), cents)
+
+	cents, err = c.ParseRequiredCents(c.FormatCents(123456))
+	require.NoError(t, err)
+	assert.Equal(t, int64(123456), cents, "display output still round-trips")
+	assert.Equal(t, "$1,234.56", c.FormatCents(123456), "display is unchanged")
+
+	plain := MustResolve("USD", language.AmericanEnglish)
+	cents, err = plain.ParseRequiredCents("1,234.56")
+	require.NoError(t, err)
+	assert.Equal(t, int64(123456), cents, "no input locale keeps display separators")
+}
+
 // TestSameCurrencyDifferentLocales verifies that the same currency code
 // produces different formatting when paired with different locales.
 func TestSameCurrencyDifferentLocales(t *testing.T) {
@@ -0,0 +1,155 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package locale
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/language"
+)
+
+// ErrInvalidCurrencyFormat reports a custom currency pattern that does not
+// spell out the sample amount 1234.56 with a symbol.
+var ErrInvalidCurrencyFormat = errors.New("invalid currency format")
+
+// CurrencyFormat is a user-defined display format for a currency that CLDR
+// does not know, or that the detected locale would format wrongly. It is
+// written as the amount 1234.56 the way it should look, e.g. "1.234,56 ₫"
+// or "₦1,234.56": the text around the digits is the symbol, the character
+// after the 1 is the grouping separator (omit it for no grouping), and the
+// character before the 56 is the decimal separator.
+type CurrencyFormat struct {
+	symbol  string
+	prefix  bool
+	group   string
+	decimal string
+}
+
+// ParseCurrencyFormat parses a pattern like "1.234,56 ₫" into a format.
+func ParseCurrencyFormat(pattern string) (CurrencyFormat, error) {
+	s := strings.TrimSpace(pattern)
+	first := strings.IndexAny(s, "0123456789")
+	last := strings.LastIndexAny(s, "0123456789")
+	if first < 0 {
+		return CurrencyFormat{}, formatError(pattern, "no amount")
+	}
+	pre := strings.TrimSpace(s[:first])
+	suf := strings.TrimSpace(s[last+1:])
+	var f CurrencyFormat
+	switch {
+	case pre != "" && suf != "":
+		return CurrencyFormat{}, formatError(pattern, "symbol on both sides of the amount")
+	case pre != "":
+		f.symbol, f.prefix = pre, true
+	case suf != "":
+		f.symbol = suf
+	default:
+		return CurrencyFormat{}, formatError(pattern, "no currency symbol")
+	}
+
+	body := s[first : last+1]
+	digits := strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, body)
+	if digits != "123456" || !strings.HasPrefix(body, "1") || !strings.HasSuffix(body, "56") {
+		return CurrencyFormat{}, formatError(pattern, "amount must be 1234.56")
+	}
+	middle := body[1 : len(body)-2]
+	idx := strings.Index(middle, "234")
+	if idx < 0 {
+		return CurrencyFormat{}, formatError(pattern, "amount must be 1234.56")
+	}
+	f.group = middle[:idx]
+	f.decimal = middle[idx+3:]
+	if f.decimal == "" {
+		return CurrencyFormat{}, formatError(pattern, "no decimal separator")
+	}
+	if f.group == f.decimal {
+		return CurrencyFormat{}, formatError(pattern, "grouping and decimal separators are the same")
+	}
+	return f, nil
+}
+
+func formatError(pattern, reason string) error {
+	return fmt.Errorf(
+		"%w %q: %s -- write 1234.56 with a symbol, e.g. \"1.234,56 ₫\"",
+		ErrInvalidCurrencyFormat, pattern, reason,
+	)
+}
+
+// Currency builds a Currency for code that formats and parses amounts
+// according to f instead of CLDR data. Compact amounts use SI suffixes.
+func (f CurrencyFormat) Currency(code string) Currency {
+	return Currency{
+		symbol:  f.symbol,
+		prefix:  f.prefix,
+		code:    strings.ToUpper(strings.TrimSpace(code)),
+		group:   f.group,
+		decimal: f.decimal,
+		custom:  true,
+		compact: compactSI,
+	}
+}
+
+// ResolveWith is like Resolve but prefers a custom format for code when
+// formats has one, so users can add currencies CLDR doesn't cover.
+func ResolveWith(code string, tag language.Tag, formats map[string]CurrencyFormat) (Currency, error) {
+	var c Currency
+	if f, ok := formats[strings.ToUpper(strings.TrimSpace(code))]; ok {
+		c = f.Currency(code)
+		c.tag = tag
+	} else {
+		var err error
+		if c, err = Resolve(code, tag); err != nil {
+			return Currency{}, err
+		}
+	}
+	c.formats = formats
+	return c, nil
+}
+
+// WithCode returns the currency for code displayed the way c is: the same
+// formatting locale, input separators, rounding, and symbol spacing, and
+// the custom formats c was resolved with. An empty code returns c.
+func (c Currency) WithCode(code string) (Currency, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" || code == c.code {
+		return c, nil
+	}
+	other, err := ResolveWith(code, c.tag, c.formats)
+	if err != nil {
+		return Currency{}, err
+	}
+	other.inGroup, other.inDecimal = c.inGroup, c.inDecimal
+	other.rounding = c.rounding
+	other.spacing = c.spacing
+	return other, nil
+}
+
+// groupDigits renders n with sep between every three digits.
+func groupDigits(n int64, sep string) string {
+	s := strconv.FormatInt(n, 10)
+	if sep == "" || len(s) <= 3 {
+		return s
+	}
+	var b strings.Builder
+	lead := len(s) % 3
+	if lead > 0 {
+		b.WriteString(s[:lead])
+	}
+	for i := lead; i < len(s); i += 3 {
+		if b.Len() > 0 {
+			b.WriteString(sep)
+		}
+		b.WriteString(s[i : i+3])
+	}
+	return b.String()
+}
@@ -0,0 +1,139 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package locale
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestParseCurrencyFormat(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		pattern string
+		cents   int64
+		want    string
+	}{
+		{"1.234,56 ₫", 123456789, "1.234.567,89\u00a0₫"},
+		{"₦1,234.56", 123456789, "₦1,234,567.89"},
+		{"1 234,56 ден", 99900, "999,00\u00a0ден"},
+		{"Ks1234.56", 123456789, "Ks1234567.89"},
+		{"  ₦ 1,234.56  ", 0, "₦0.00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			t.Parallel()
+			f, err := ParseCurrencyFormat(tt.pattern)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, f.Currency("xxx").FormatCents(tt.cents))
+		})
+	}
+}
+
+func TestParseCurrencyFormatInvalid(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		pattern string
+		reason  string
+	}{
+		{"", "no amount"},
+		{"₦", "no amount"},
+		{"1,234.56", "no currency symbol"},
+		{"$1,234.56 USD", "symbol on both sides"},
+		{"₦1,000.00", "amount must be 1234.56"},
+		{"₦12,34.56", "amount must be 1234.56"},
+		{"₦123456", "no decimal separator"},
+		{"₦1.234.56", "grouping and decimal separators are the same"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			t.Parallel()
+			_, err := ParseCurrencyFormat(tt.pattern)
+			require.ErrorIs(t, err, ErrInvalidCurrencyFormat)
+			assert.Contains(t, err.Error(), tt.reason)
+		})
+	}
+}
+
+func TestCustomCurrencyRoundtrip(t *testing.T) {
+	t.Parallel()
+	f, err := ParseCurrencyFormat("1.234,56 ₫")
+	require.NoError(t, err)
+	c := f.Currency("vnd")
+	assert.Equal(t, "VND", c.Code())
+	assert.Equal(t, "₫", c.Symbol())
+
+	for _, cents := range []int64{0, 5, 99900, 123456789} {
+		got, err := c.ParseRequiredCents(c.FormatCents(cents))
+		require.NoError(t, err)
+		assert.Equal(t, cents, got)
+	}
+	got, err := c.ParseRequiredCents("1.234,5")
+	require.NoError(t, err)
+	assert.Equal(t, int64(123450), got)
+	assert.Equal(t, "1.234,56", c.StripSymbol(c.FormatCents(123456)))
+	assert.Equal(t, "1,2k\u00a0₫", c.FormatCompactCents(123456))
+}
+
+func TestResolveWith(t *testing.T) {
+	t.Parallel()
+	f, err := ParseCurrencyFormat("₦1,234.56")
+	require.NoError(t, err)
+	formats := map[string]CurrencyFormat{"NGN": f, "XBT": f}
+
+	c, err := ResolveWith("ngn", language.German, formats)
+	require.NoError(t, err)
+	assert.Equal(t, "₦1,234.56", c.FormatCents(123456), "custom format beats the locale")
+
+	c, err = ResolveWith("XBT", language.AmericanEnglish, formats)
+	require.NoError(t, err, "custom codes need not be ISO 4217")
+	assert.Equal(t, "XBT", c.Code())
+
+	c, err = ResolveWith("EUR", language.German, formats)
+	require.NoError(t, err)
+	assert.Contains(t, c.FormatCents(123456), "1.234,56")
+
+	_, err = ResolveWith("NOPE", language.AmericanEnglish, formats)
+	assert.Error(t, err)
+}
+
+func TestWithCode(t *testing.T) {
+	t.Parallel()
+	f, err := ParseCurrencyFormat("₦1,234.56")
+	require.NoError(t, err)
+	house, err := ResolveWith("USD", language.German, map[string]CurrencyFormat{"NGN": f})
+	require.NoError(t, err)
+	house = house.WithSymbolSpacing(SpacingSpace)
+
+	same, err := house.WithCode("")
+	require.NoError(t, err)
+	assert.Equal(t, "USD", same.Code(), "empty code is the house currency")
+
+	eur, err := house.WithCode("eur")
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", eur.Code())
+	assert.Contains(t, eur.FormatCents(123456), "1.234,56", "keeps the house locale")
+	assert.Equal(t, SpacingSpace, eur.Spacing(), "keeps the house spacing")
+
+	ngn, err := house.WithCode("NGN")
+	require.NoError(t, err)
+	assert.Equal(t, "₦\u00a01,234.56", ngn.FormatCents(123456), "uses the house's custom formats")
+
+	_, err = house.WithCode("NOPE")
+	assert.Error(t, err)
+}
+
+func TestDefaultCode(t *testing.T) {
+	t.Setenv("MICASA_LOCALE_CURRENCY", "")
+	t.Setenv("LC_MONETARY", "")
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LANG", "")
+	assert.Equal(t, "USD", DefaultCode(""))
+	assert.Equal(t, "XBT", DefaultCode(" xbt "))
+	t.Setenv("MICASA_LOCALE_CURRENCY", "ngn")
+	assert.Equal(t, "NGN", DefaultCode(""))
+}
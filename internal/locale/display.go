@@ -0,0 +1,130 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package locale
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// isoDateLayout matches data.DateLayout. Duplicated here because data
+// imports locale.
+const isoDateLayout = "2006-01-02"
+
+// regionDateLayouts maps a region to its customary numeric date order.
+// Regions not listed use ISO dates.
+var regionDateLayouts = map[string]string{
+	// Month first.
+	"US": "01/02/2006",
+	"PH": "01/02/2006",
+	// Day first, slashes.
+	"GB": "02/01/2006",
+	"IE": "02/01/2006",
+	"AU": "02/01/2006",
+	"NZ": "02/01/2006",
+	"IN": "02/01/2006",
+	"FR": "02/01/2006",
+	"BE": "02/01/2006",
+	"ES": "02/01/2006",
+	"IT": "02/01/2006",
+	"PT": "02/01/2006",
+	"BR": "02/01/2006",
+	"MX": "02/01/2006",
+	"AR": "02/01/2006",
+	"GR": "02/01/2006",
+	// Day first, dots.
+	"DE": "02.01.2006",
+	"AT": "02.01.2006",
+	"CH": "02.01.2006",
+	"RU": "02.01.2006",
+	"UA": "02.01.2006",
+	"PL": "02.01.2006",
+	"CZ": "02.01.2006",
+	"SK": "02.01.2006",
+	"FI": "02.01.2006",
+	"NO": "02.01.2006",
+	"DK": "02.01.2006",
+	"TR": "02.01.2006",
+	"RO": "02.01.2006",
+	// Day first, dashes.
+	"NL": "02-01-2006",
+	// Year first, slashes.
+	"JP": "2006/01/02",
+	"CN": "2006/01/02",
+	"TW": "2006/01/02",
+}
+
+// Display formats dates and counts for the UI locale. It is independent of
+// the currency locale: a household can track money in EUR while reading
+// US-style dates. The zero value renders ISO dates and ungrouped counts.
+//
+// Safe for concurrent read access; treat as immutable after creation.
+type Display struct {
+	tag        string
+	dateLayout string
+	printer    *message.Printer
+}
+
+// ParseDisplay creates a Display from a BCP 47 tag such as "en-GB" or "de".
+// An empty tag returns the zero Display.
+func ParseDisplay(tag string) (Display, error) {
+	tag = strings.TrimSpace(tag)
+	if tag == "" {
+		return Display{}, nil
+	}
+	t, err := language.Parse(tag)
+	if err != nil {
+		return Display{}, fmt.Errorf("invalid locale %q: %w", tag, err)
+	}
+	region, _ := t.Region()
+	layout, ok := regionDateLayouts[region.String()]
+	if !ok {
+		layout = isoDateLayout
+	}
+	return Display{
+		tag:        t.String(),
+		dateLayout: layout,
+		printer:    message.NewPrinter(t),
+	}, nil
+}
+
+// Tag returns the canonical BCP 47 tag, or "" for the default display.
+func (d Display) Tag() string {
+	return d.tag
+}
+
+// Date formats t in the locale's numeric date order.
+func (d Display) Date(t time.Time) string {
+	if d.dateLayout == "" {
+		return t.Format(isoDateLayout)
+	}
+	return t.Format(d.dateLayout)
+}
+
+// DateString reformats an ISO date string. Values that aren't ISO dates
+// are returned unchanged.
+func (d Display) DateString(iso string) string {
+	if d.dateLayout == "" || d.dateLayout == isoDateLayout {
+		return iso
+	}
+	t, err := time.Parse(isoDateLayout, strings.TrimSpace(iso))
+	if err != nil {
+		return iso
+	}
+	return t.Format(d.dateLayout)
+}
+
+// Count formats n with the locale's digit grouping, e.g. "1,234" or
+// "1.234".
+func (d Display) Count(n int) string {
+	if d.printer == nil {
+		return strconv.Itoa(n)
+	}
+	return d.printer.Sprintf("%d", n)
+}
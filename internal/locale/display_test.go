@@ -0,0 +1,65 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package locale
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDisplayDates(t *testing.T) {
+	t.Parallel()
+	date := time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		tag  string
+		want string
+	}{
+		{"", "2026-03-07"},
+		{"en-US", "03/07/2026"},
+		{"en-GB", "07/03/2026"},
+		{"de", "07.03.2026"},
+		{"de-CH", "07.03.2026"},
+		{"nl", "07-03-2026"},
+		{"ja", "2026/03/07"},
+		{"sv-SE", "2026-03-07"},
+	} {
+		d, err := ParseDisplay(tc.tag)
+		require.NoError(t, err, tc.tag)
+		assert.Equal(t, tc.want, d.Date(date), tc.tag)
+		assert.Equal(t, tc.want, d.DateString("2026-03-07"), tc.tag)
+	}
+}
+
+func TestParseDisplayRejectsInvalidTag(t *testing.T) {
+	t.Parallel()
+	_, err := ParseDisplay("not a locale")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid locale")
+}
+
+func TestDisplayDateStringPassesThroughNonDates(t *testing.T) {
+	t.Parallel()
+	d, err := ParseDisplay("en-US")
+	require.NoError(t, err)
+	assert.Empty(t, d.DateString(""))
+	assert.Equal(t, "soon", d.DateString("soon"))
+}
+
+func TestDisplayCount(t *testing.T) {
+	t.Parallel()
+	var zero Display
+	assert.Equal(t, "1234", zero.Count(1234))
+
+	us, err := ParseDisplay("en-US")
+	require.NoError(t, err)
+	assert.Equal(t, "1,234", us.Count(1234))
+	assert.Equal(t, "7", us.Count(7))
+
+	de, err := ParseDisplay("de-DE")
+	require.NoError(t, err)
+	assert.Equal(t, "1.234", de.Count(1234))
+}
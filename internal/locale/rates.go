@@ -0,0 +1,83 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package locale
+
+import (
+	"math"
+	"strings"
+)
+
+// Rates converts money between currencies at fixed, user-configured
+// rates. Each rate is the value of one unit of a currency in the base
+// currency. The result is only good for display: approximate totals
+// across currencies, never stored amounts.
+//
+// Safe for concurrent read access; treat as immutable after creation.
+type Rates struct {
+	base  string
+	rates map[string]float64
+}
+
+// NewRates creates Rates quoted in base. Codes are case-insensitive;
+// non-positive rates are dropped.
+func NewRates(base string, rates map[string]float64) Rates {
+	r := Rates{
+		base:  strings.ToUpper(strings.TrimSpace(base)),
+		rates: make(map[string]float64, len(rates)),
+	}
+	for code, rate := range rates {
+		if rate > 0 && !math.IsInf(rate, 0) {
+			r.rates[strings.ToUpper(strings.TrimSpace(code))] = rate
+		}
+	}
+	return r
+}
+
+// IsZero reports whether no rates are configured.
+func (r Rates) IsZero() bool {
+	return len(r.rates) == 0
+}
+
+// WithDefaultBase returns r quoted in code when r has no base of its own.
+func (r Rates) WithDefaultBase(code string) Rates {
+	if r.base == "" {
+		r.base = strings.ToUpper(code)
+	}
+	return r
+}
+
+// Base returns the currency the rates are quoted in, which is also the
+// currency totals are converted into. Empty until one is given.
+func (r Rates) Base() string {
+	return r.base
+}
+
+// Rate returns the value of one unit of code in the base currency, or
+// false when it has none. The base currency's rate is always 1.
+func (r Rates) Rate(code string) (float64, bool) {
+	code = strings.ToUpper(code)
+	if code == r.base && code != "" {
+		return 1, true
+	}
+	rate, ok := r.rates[code]
+	return rate, ok
+}
+
+// Convert converts cents of currency from into currency to, through the
+// base currency, rounding half up. Returns false when either currency has
+// no rate.
+func (r Rates) Convert(cents int64, from, to string) (int64, bool) {
+	if strings.EqualFold(from, to) {
+		return cents, true
+	}
+	fromRate, ok := r.Rate(from)
+	if !ok {
+		return 0, false
+	}
+	toRate, ok := r.Rate(to)
+	if !ok {
+		return 0, false
+	}
+	return RoundHalfUp.Round(float64(cents) * fromRate / toRate), true
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package locale
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRatesConvert(t *testing.T) {
+	t.Parallel()
+	rates := NewRates("usd", map[string]float64{"eur": 1.08, "GBP": 1.25, "JPY": 0, "CHF": -1})
+
+	tests := []struct {
+		name     string
+		cents    int64
+		from, to string
+		want     int64
+		ok       bool
+	}{
+		{"to base", 10000, "EUR", "USD", 10800, true},
+		{"from base", 10800, "USD", "EUR", 10000, true},
+		{"through base", 10000, "GBP", "EUR", 11574, true},
+		{"same currency", 123, "XYZ", "xyz", 123, true},
+		{"missing rate", 100, "CAD", "USD", 0, false},
+		{"zero rate dropped", 100, "JPY", "USD", 0, false},
+		{"negative rate dropped", 100, "CHF", "USD", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := rates.Convert(tt.cents, tt.from, tt.to)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRatesDefaultBase(t *testing.T) {
+	t.Parallel()
+	rates := NewRates("", map[string]float64{"EUR": 1.1})
+	assert.False(t, rates.IsZero())
+	_, ok := rates.Convert(100, "EUR", "USD")
+	assert.False(t, ok, "no base until one is given")
+
+	assert.Empty(t, rates.Base())
+	assert.Equal(t, "USD", rates.WithDefaultBase("usd").Base())
+
+	got, ok := rates.WithDefaultBase("USD").Convert(100, "EUR", "USD")
+	assert.True(t, ok)
+	assert.Equal(t, int64(110), got)
+
+	kept := NewRates("GBP", nil).WithDefaultBase("USD")
+	rate, ok := kept.Rate("GBP")
+	assert.True(t, ok)
+	assert.InDelta(t, 1.0, rate, 0)
+	assert.True(t, NewRates("USD", nil).IsZero())
+}
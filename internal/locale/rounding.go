@@ -0,0 +1,93 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package locale
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Rounding selects how fractional cents are resolved to whole cents when a
+// money value arrives with sub-cent precision (e.g. an LLM emitting
+// 1234.5 for a cents field).
+type Rounding int
+
+const (
+	// RoundHalfUp rounds halves away from zero (1.5 -> 2, 2.5 -> 3).
+	RoundHalfUp Rounding = iota
+	// RoundHalfEven rounds halves to the nearest even value (banker's
+	// rounding: 1.5 -> 2, 2.5 -> 2).
+	RoundHalfEven
+	// RoundTruncate drops the fractional part (1.9 -> 1).
+	RoundTruncate
+)
+
+// Roundings lists the canonical names of every rounding mode.
+var Roundings = []string{"half_up", "half_even", "truncate"}
+
+// String returns the canonical config name of the rounding mode.
+func (r Rounding) String() string {
+	switch r {
+	case RoundHalfUp:
+		return "half_up"
+	case RoundHalfEven:
+		return "half_even"
+	case RoundTruncate:
+		return "truncate"
+	default:
+		return "half_up"
+	}
+}
+
+// ParseRounding parses a config value into a Rounding. Empty input
+// yields RoundHalfUp.
+func ParseRounding(s string) (Rounding, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "half_up":
+		return RoundHalfUp, nil
+	case "half_even":
+		return RoundHalfEven, nil
+	case "truncate":
+		return RoundTruncate, nil
+	default:
+		return RoundHalfUp, fmt.Errorf(
+			"unknown rounding mode %q -- supported: %s",
+			s, strings.Join(Roundings, ", "),
+		)
+	}
+}
+
+// Round resolves a possibly fractional cents value to whole cents.
+func (r Rounding) Round(cents float64) int64 {
+	switch r {
+	case RoundHalfEven:
+		return int64(math.RoundToEven(cents))
+	case RoundTruncate:
+		return int64(math.Trunc(cents))
+	case RoundHalfUp:
+		return int64(math.Round(cents))
+	default:
+		return int64(math.Round(cents))
+	}
+}
+
+// RoundString parses a decimal cents string (e.g. "150000" or "1234.5")
+// and resolves it to whole cents. Integral input is parsed exactly so
+// large values don't lose precision through float64.
+func (r Rounding) RoundString(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return n, nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, ErrInvalidMoney
+	}
+	if f >= math.MaxInt64 || f <= math.MinInt64 {
+		return 0, ErrInvalidMoney
+	}
+	return r.Round(f), nil
+}
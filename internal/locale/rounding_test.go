@@ -0,0 +1,91 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package locale
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRounding(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		in   string
+		want Rounding
+	}{
+		{"", RoundHalfUp},
+		{"half_up", RoundHalfUp},
+		{"HALF_EVEN", RoundHalfEven},
+		{" truncate ", RoundTruncate},
+	} {
+		got, err := ParseRounding(tc.in)
+		require.NoError(t, err, tc.in)
+		assert.Equal(t, tc.want, got, tc.in)
+		if tc.in != "" {
+			assert.Equal(t, tc.want, mustParseRounding(t, got.String()))
+		}
+	}
+}
+
+func TestParseRoundingRejectsUnknown(t *testing.T) {
+	t.Parallel()
+	_, err := ParseRounding("ceiling")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "half_up, half_even, truncate")
+}
+
+func TestRoundingRound(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		in                    float64
+		halfUp, even, truncat int64
+	}{
+		{1234.5, 1235, 1234, 1234},
+		{1235.5, 1236, 1236, 1235},
+		{1234.49, 1234, 1234, 1234},
+		{1234.51, 1235, 1235, 1234},
+		{99.99, 100, 100, 99},
+		{150000, 150000, 150000, 150000},
+	} {
+		assert.Equal(t, tc.halfUp, RoundHalfUp.Round(tc.in), "half_up %v", tc.in)
+		assert.Equal(t, tc.even, RoundHalfEven.Round(tc.in), "half_even %v", tc.in)
+		assert.Equal(t, tc.truncat, RoundTruncate.Round(tc.in), "truncate %v", tc.in)
+	}
+}
+
+func TestRoundingRoundString(t *testing.T) {
+	t.Parallel()
+	n, err := RoundHalfEven.RoundString("2.5")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+
+	// Integral input is parsed exactly, even beyond float64's 2^53.
+	n, err = RoundHalfUp.RoundString("9007199254740993")
+	require.NoError(t, err)
+	assert.Equal(t, int64(9007199254740993), n)
+
+	for _, bad := range []string{"", "abc", "NaN", "1e400"} {
+		_, err := RoundHalfUp.RoundString(bad)
+		require.ErrorIs(t, err, ErrInvalidMoney, bad)
+	}
+}
+
+func TestCurrencyWithRounding(t *testing.T) {
+	t.Parallel()
+	c := DefaultCurrency()
+	assert.Equal(t, RoundHalfUp, c.Rounding())
+	even := c.WithRounding(RoundHalfEven)
+	assert.Equal(t, RoundHalfEven, even.Rounding())
+	assert.Equal(t, RoundHalfUp, c.Rounding(), "original is unchanged")
+	assert.Equal(t, c.FormatCents(12345), even.FormatCents(12345))
+}
+
+func mustParseRounding(t *testing.T, s string) Rounding {
+	t.Helper()
+	r, err := ParseRounding(s)
+	require.NoError(t, err)
+	return r
+}
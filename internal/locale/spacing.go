@@ -0,0 +1,58 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package locale
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SymbolSpacing selects whether a space separates the currency symbol
+// from the amount in formatted money.
+type SymbolSpacing int
+
+const (
+	// SpacingAuto follows the CLDR convention: no space after a prefix
+	// symbol ("$1.00"), a non-breaking space before a suffix ("1,00 €").
+	SpacingAuto SymbolSpacing = iota
+	// SpacingSpace always separates symbol and amount ("$ 1.00").
+	SpacingSpace
+	// SpacingNone never separates them ("1,00€").
+	SpacingNone
+)
+
+// SymbolSpacings lists the canonical names of every spacing preference.
+var SymbolSpacings = []string{"auto", "space", "none"}
+
+// String returns the canonical config name of the spacing preference.
+func (s SymbolSpacing) String() string {
+	switch s {
+	case SpacingAuto:
+		return "auto"
+	case SpacingSpace:
+		return "space"
+	case SpacingNone:
+		return "none"
+	default:
+		return "auto"
+	}
+}
+
+// ParseSymbolSpacing parses a config value into a SymbolSpacing. Empty
+// input yields SpacingAuto.
+func ParseSymbolSpacing(s string) (SymbolSpacing, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "auto":
+		return SpacingAuto, nil
+	case "space":
+		return SpacingSpace, nil
+	case "none":
+		return SpacingNone, nil
+	default:
+		return SpacingAuto, fmt.Errorf(
+			"unknown symbol spacing %q -- supported: %s",
+			s, strings.Join(SymbolSpacings, ", "),
+		)
+	}
+}
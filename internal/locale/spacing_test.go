@@ -0,0 +1,67 @@
+// Copyright 2026 Phillip Cloud
+// Licensed under the Apache License, Version 2.0
+
+package locale
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestParseSymbolSpacing(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		in   string
+		want SymbolSpacing
+	}{
+		{"", SpacingAuto},
+		{"auto", SpacingAuto},
+		{"SPACE", SpacingSpace},
+		{" none ", SpacingNone},
+	} {
+		got, err := ParseSymbolSpacing(tc.in)
+		require.NoError(t, err, tc.in)
+		assert.Equal(t, tc.want, got, tc.in)
+	}
+	_, err := ParseSymbolSpacing("wide")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "auto, space, none")
+}
+
+func TestSymbolSpacingFormatting(t *testing.T) {
+	t.Parallel()
+	usd := MustResolve("USD", language.AmericanEnglish)
+	f, err := ParseCurrencyFormat("1.234,56 " + symbolEuro)
+	require.NoError(t, err)
+	eur := f.Currency("EUR")
+	for _, tc := range []struct {
+		name    string
+		cur     Currency
+		spacing SymbolSpacing
+		want    string
+		compact string
+	}{
+		{"USD auto", usd, SpacingAuto, "$1,234.56", "$1.2k"},
+		{"USD space", usd, SpacingSpace, "$ 1,234.56", "$ 1.2k"},
+		{"USD none", usd, SpacingNone, "$1,234.56", "$1.2k"},
+		{"EUR auto", eur, SpacingAuto, "1.234,56 " + symbolEuro, "1,2k " + symbolEuro},
+		{"EUR space", eur, SpacingSpace, "1.234,56 " + symbolEuro, "1,2k " + symbolEuro},
+		{"EUR none", eur, SpacingNone, "1.234,56" + symbolEuro, "1,2k" + symbolEuro},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			c := tc.cur.WithSymbolSpacing(tc.spacing)
+			formatted := c.FormatCents(123456)
+			assert.Equal(t, tc.want, formatted)
+			assert.Equal(t, tc.compact, c.FormatCompactCents(123456))
+			assert.NotContains(t, c.StripSymbol(formatted), " ")
+
+			cents, err := c.ParseRequiredCents(formatted)
+			require.NoError(t, err)
+			assert.Equal(t, int64(123456), cents)
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	mcpgo "github.com/mark3labs/mcp-go/mcp"
+	"github.com/micasa-dev/micasa/internal/data"
 	"gorm.io/gorm"
 )
 
@@ -103,7 +104,10 @@ func (s *Server) handleQuery(
 		return mcpgo.NewToolResultError(fmt.Sprintf("query failed: %v", err)), nil
 	}
 
-	b, err := json.Marshal(queryResult{Columns: cols, Rows: rows})
+	b, err := json.Marshal(queryResult{
+		Columns: data.ColumnNames(cols),
+		Rows:    data.QueryRowStrings(rows),
+	})
 	if err != nil {
 		return mcpgo.NewToolResultError(fmt.Sprintf("marshal result: %v", err)), nil
 	}
@@ -228,6 +232,7 @@ type maintenanceScheduleItem struct {
 	Season         string     `json:"season"`
 	LastServicedAt *time.Time `json:"last_serviced_at"`
 	IntervalMonths int        `json:"interval_months"`
+	IntervalDays   int        `json:"interval_days"`
 	DueDate        *time.Time `json:"due_date"`
 	Overdue        bool       `json:"overdue"`
 	ApplianceName  string     `json:"appliance_name,omitempty"`
@@ -245,11 +250,7 @@ func (s *Server) handleGetMaintenanceSchedule(
 	now := time.Now()
 	out := make([]maintenanceScheduleItem, 0, len(items))
 	for _, item := range items {
-		due := item.DueDate
-		if due == nil && item.LastServicedAt != nil && item.IntervalMonths > 0 {
-			d := item.LastServicedAt.AddDate(0, item.IntervalMonths, 0)
-			due = &d
-		}
+		due := data.ComputeNextDue(item.LastServicedAt, item.Interval(), item.DueDate)
 
 		overdue := due != nil && due.Before(now)
 
@@ -265,6 +266,7 @@ func (s *Server) handleGetMaintenanceSchedule(
 			Season:         item.Season,
 			LastServicedAt: item.LastServicedAt,
 			IntervalMonths: item.IntervalMonths,
+			IntervalDays:   item.IntervalDays,
 			DueDate:        due,
 			Overdue:        overdue,
 			ApplianceName:  applianceName,